@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry"
 	connector "github.com/kyma-incubator/compass/components/connectivity-adapter/internal/connectorservice"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/health"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
@@ -24,6 +25,7 @@ type config struct {
 
 	AppRegistry appregistry.Config
 	Connector   connector.Config
+	Events      events.Config
 }
 
 func main() {
@@ -64,6 +66,12 @@ func initAPIHandler(cfg config) (http.Handler, error) {
 		return nil, err
 	}
 
+	eventsRouter := applicationRegistryRouter.PathPrefix("/events").Subrouter()
+	err = events.RegisterHandler(eventsRouter, cfg.Events, cfg.AppRegistry.DirectorEndpoint, cfg.AppRegistry.ClientTimeout)
+	if err != nil {
+		return nil, err
+	}
+
 	return router, nil
 }
 
@@ -37,6 +37,22 @@ func (_m *Converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails
 	return r0, r1
 }
 
+// DetailsToLegacyServiceMetadata provides a mock function with given fields: deprecated
+func (_m *Converter) DetailsToLegacyServiceMetadata(deprecated model.ServiceDetails) *service.LegacyServiceMetadata {
+	ret := _m.Called(deprecated)
+
+	var r0 *service.LegacyServiceMetadata
+	if rf, ok := ret.Get(0).(func(model.ServiceDetails) *service.LegacyServiceMetadata); ok {
+		r0 = rf(deprecated)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.LegacyServiceMetadata)
+		}
+	}
+
+	return r0
+}
+
 // GraphQLCreateInputToUpdateInput provides a mock function with given fields: in
 func (_m *Converter) GraphQLCreateInputToUpdateInput(in graphql.PackageCreateInput) graphql.PackageUpdateInput {
 	ret := _m.Called(in)
@@ -85,12 +85,18 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 				}
 			}
 
-			// TODO deprecated.Api.Credentials.CertificateGenWithCSRF not supported
+			// deprecated.Api.Credentials.CertificateGenWithCSRF is not supported by Director, it is stored in the legacy service metadata
 		}
 
-		// old way of providing request headers
-		if deprecated.Api.Headers != nil {
-			h, err := graphql.NewHttpHeadersSerialized(*deprecated.Api.Headers)
+		// the request parameters can be provided in the old way, in the new way, or in both ways, in which case they are merged
+		var headers, queryParameters *map[string][]string
+		if deprecated.Api.RequestParameters != nil {
+			headers = deprecated.Api.RequestParameters.Headers
+			queryParameters = deprecated.Api.RequestParameters.QueryParameters
+		}
+
+		if merged := mergeParameters(deprecated.Api.Headers, headers); merged != nil {
+			h, err := graphql.NewHttpHeadersSerialized(*merged)
 			if err != nil {
 				return graphql.PackageCreateInput{}, err
 			}
@@ -98,9 +104,8 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 			defaultInstanceAuth.AdditionalHeadersSerialized = &h
 		}
 
-		// old way of providing request headers
-		if deprecated.Api.QueryParameters != nil {
-			q, err := graphql.NewQueryParamsSerialized(*deprecated.Api.QueryParameters)
+		if merged := mergeParameters(deprecated.Api.QueryParameters, queryParameters); merged != nil {
+			q, err := graphql.NewQueryParamsSerialized(*merged)
 			if err != nil {
 				return graphql.PackageCreateInput{}, err
 			}
@@ -108,26 +113,6 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 			defaultInstanceAuth.AdditionalQueryParamsSerialized = &q
 		}
 
-		// new way
-		if deprecated.Api.RequestParameters != nil {
-			if deprecated.Api.RequestParameters.Headers != nil {
-				h, err := graphql.NewHttpHeadersSerialized(*deprecated.Api.RequestParameters.Headers)
-				if err != nil {
-					return graphql.PackageCreateInput{}, err
-				}
-
-				defaultInstanceAuth.AdditionalHeadersSerialized = &h
-			}
-			if deprecated.Api.RequestParameters.QueryParameters != nil {
-				q, err := graphql.NewQueryParamsSerialized(*deprecated.Api.RequestParameters.QueryParameters)
-				if err != nil {
-					return graphql.PackageCreateInput{}, err
-				}
-
-				defaultInstanceAuth.AdditionalQueryParamsSerialized = &q
-			}
-		}
-
 		if deprecated.Api.Spec != nil {
 			if apiDef.Spec == nil {
 				apiDef.Spec = &graphql.APISpecInput{}
@@ -289,15 +274,10 @@ func (c *converter) documentsToLegacyDocumentation(documents []*graphql.Document
 
 	var legacyDocs []model.DocsObject
 	for _, doc := range documents {
-		var source string
-		if doc.Data != nil {
-			source = string(*doc.Data)
-		}
-
 		legacyDoc := model.DocsObject{
 			Title:  doc.Title,
 			Type:   ".md", // we don't have any other format in our API anyway
-			Source: source,
+			Source: documentSource(doc),
 		}
 
 		legacyDocs = append(legacyDocs, legacyDoc)
@@ -487,9 +467,187 @@ func (c *converter) GraphQLToServiceDetails(in graphql.PackageExt, legacyService
 		outDeprecated.Documentation = c.documentsToLegacyDocumentation(in.Documents.Data)
 	}
 
+	if legacyServiceReference.Metadata != nil {
+		c.applyLegacyServiceMetadata(&outDeprecated, in, *legacyServiceReference.Metadata)
+	}
+
 	return outDeprecated, nil
 }
 
+// DetailsToLegacyServiceMetadata returns the fields of the legacy service which are lost in the conversion to the Package,
+// so that the service can be returned exactly as it was provided.
+func (c *converter) DetailsToLegacyServiceMetadata(deprecated model.ServiceDetails) *LegacyServiceMetadata {
+	metadata := &LegacyServiceMetadata{
+		Provider:         deprecated.Provider,
+		ShortDescription: deprecated.ShortDescription,
+		Labels:           deprecated.Labels,
+	}
+
+	if deprecated.Api != nil {
+		metadata.API = &LegacyAPIMetadata{
+			APIType:          deprecated.Api.ApiType,
+			SpecificationURL: deprecated.Api.SpecificationUrl,
+			Headers:          deprecated.Api.Headers != nil,
+			QueryParameters:  deprecated.Api.QueryParameters != nil,
+		}
+
+		if deprecated.Api.RequestParameters != nil {
+			metadata.API.RequestParameters = &LegacyRequestParameters{
+				Headers:         deprecated.Api.RequestParameters.Headers != nil,
+				QueryParameters: deprecated.Api.RequestParameters.QueryParameters != nil,
+			}
+		}
+
+		if deprecated.Api.Credentials != nil {
+			metadata.API.CertificateGen = deprecated.Api.Credentials.CertificateGenWithCSRF
+		}
+	}
+
+	if deprecated.Documentation != nil {
+		documentation := *deprecated.Documentation
+		documentation.Docs = nil
+		for _, doc := range deprecated.Documentation.Docs {
+			documentation.Docs = append(documentation.Docs, model.DocsObject{
+				Title: doc.Title,
+				Type:  doc.Type,
+			})
+		}
+		metadata.Documentation = &documentation
+	}
+
+	return metadata
+}
+
+func (c *converter) applyLegacyServiceMetadata(out *model.ServiceDetails, in graphql.PackageExt, metadata LegacyServiceMetadata) {
+	out.Provider = metadata.Provider
+	out.ShortDescription = metadata.ShortDescription
+	out.Labels = metadata.Labels
+
+	if out.Api != nil && metadata.API != nil {
+		c.applyLegacyAPIMetadata(out.Api, *metadata.API)
+	}
+
+	if metadata.Documentation != nil {
+		out.Documentation = c.documentsToLegacyDocumentationWithMetadata(in.Documents.Data, *metadata.Documentation)
+	}
+}
+
+func (c *converter) applyLegacyAPIMetadata(api *model.API, metadata LegacyAPIMetadata) {
+	api.ApiType = metadata.APIType
+	api.SpecificationUrl = metadata.SpecificationURL
+
+	var headers, queryParameters *map[string][]string
+	if api.RequestParameters != nil {
+		headers = api.RequestParameters.Headers
+		queryParameters = api.RequestParameters.QueryParameters
+	}
+
+	api.Headers = nil
+	if metadata.Headers {
+		api.Headers = copyParameters(headers)
+	}
+
+	api.QueryParameters = nil
+	if metadata.QueryParameters {
+		api.QueryParameters = copyParameters(queryParameters)
+	}
+
+	api.RequestParameters = nil
+	if metadata.RequestParameters != nil {
+		api.RequestParameters = &model.RequestParameters{}
+		if metadata.RequestParameters.Headers {
+			api.RequestParameters.Headers = copyParameters(headers)
+		}
+		if metadata.RequestParameters.QueryParameters {
+			api.RequestParameters.QueryParameters = copyParameters(queryParameters)
+		}
+	}
+
+	if metadata.CertificateGen != nil {
+		if api.Credentials == nil {
+			api.Credentials = &model.CredentialsWithCSRF{}
+		}
+		api.Credentials.CertificateGenWithCSRF = metadata.CertificateGen
+	}
+}
+
+// documentsToLegacyDocumentationWithMetadata returns the documents in the order in which they were provided.
+// The documents are matched with the metadata by title, the documents missing in the metadata are appended at the end.
+func (c *converter) documentsToLegacyDocumentationWithMetadata(documents []*graphql.DocumentExt, metadata model.Documentation) *model.Documentation {
+	out := metadata
+	out.Docs = nil
+
+	used := make(map[int]bool)
+	for _, legacyDoc := range metadata.Docs {
+		for i, doc := range documents {
+			if used[i] || doc == nil || doc.Title != legacyDoc.Title {
+				continue
+			}
+
+			used[i] = true
+			out.Docs = append(out.Docs, model.DocsObject{
+				Title:  doc.Title,
+				Type:   legacyDoc.Type,
+				Source: documentSource(doc),
+			})
+			break
+		}
+	}
+
+	for i, doc := range documents {
+		if used[i] || doc == nil {
+			continue
+		}
+
+		out.Docs = append(out.Docs, model.DocsObject{
+			Title:  doc.Title,
+			Type:   ".md",
+			Source: documentSource(doc),
+		})
+	}
+
+	return &out
+}
+
+func documentSource(doc *graphql.DocumentExt) string {
+	if doc.Data == nil {
+		return ""
+	}
+	return string(*doc.Data)
+}
+
+// mergeParameters returns the request parameters provided in the old and in the new way.
+// The values provided in the new way take precedence for the parameters provided in both ways.
+func mergeParameters(deprecated, current *map[string][]string) *map[string][]string {
+	if deprecated == nil {
+		return current
+	}
+	if current == nil {
+		return deprecated
+	}
+
+	out := make(map[string][]string, len(*deprecated)+len(*current))
+	for k, v := range *deprecated {
+		out[k] = v
+	}
+	for k, v := range *current {
+		out[k] = v
+	}
+	return &out
+}
+
+func copyParameters(in *map[string][]string) *map[string][]string {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string][]string, len(*in))
+	for k, v := range *in {
+		out[k] = v
+	}
+	return &out
+}
+
 func (c *converter) GraphQLCreateInputToUpdateInput(in graphql.PackageCreateInput) graphql.PackageUpdateInput {
 	return graphql.PackageUpdateInput{
 		Name:                           in.Name,
@@ -2,10 +2,14 @@ package service_test
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/model"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/service"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/service/validation"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +18,8 @@ import (
 func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 	additionalQueryParamsSerialized := graphql.QueryParamsSerialized(`{"q1":["a","b"],"q2":["c","d"]}`)
 	additionalHeadersSerialized := graphql.HttpHeadersSerialized(`{"h1":["e","f"],"h2":["g","h"]}`)
+	mergedQueryParamsSerialized := graphql.QueryParamsSerialized(`{"old":["old"],"q1":["a","b"],"q2":["c","d"]}`)
+	mergedHeadersSerialized := graphql.HttpHeadersSerialized(`{"h1":["e","f"],"h2":["g","h"],"old":["old"]}`)
 
 	type testCase struct {
 		given    model.ServiceDetails
@@ -221,7 +227,7 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 				},
 			},
 		},
-		"API with query params and headers stored in old and new fields": {
+		"API with query params and headers stored in old and new fields are merged": {
 			given: model.ServiceDetails{
 				Api: &model.API{
 					RequestParameters: &model.RequestParameters{
@@ -243,8 +249,8 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 			},
 			expected: graphql.PackageCreateInput{
 				DefaultInstanceAuth: &graphql.AuthInput{
-					AdditionalQueryParamsSerialized: &additionalQueryParamsSerialized,
-					AdditionalHeadersSerialized:     &additionalHeadersSerialized,
+					AdditionalQueryParamsSerialized: &mergedQueryParamsSerialized,
+					AdditionalHeadersSerialized:     &mergedHeadersSerialized,
 				},
 				APIDefinitions: []*graphql.APIDefinitionInput{
 					{},
@@ -953,6 +959,200 @@ func TestConverter_DetailsToGraphQLInput_TestSpecsRecognition(t *testing.T) {
 	}
 }
 
+func TestConverter_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("testdata/legacy_services/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	conv := service.NewConverter()
+	labeler := service.NewAppLabeler()
+	validator := validation.NewServiceDetailsValidator()
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			// GIVEN
+			payload, err := ioutil.ReadFile(file)
+			require.NoError(t, err)
+
+			var details model.ServiceDetails
+			require.NoError(t, json.Unmarshal(payload, &details))
+			require.Nil(t, validator.Validate(details))
+
+			// WHEN
+			input, err := conv.DetailsToGraphQLCreateInput(details)
+			require.NoError(t, err)
+
+			label, err := labeler.WriteServiceReference(nil, service.LegacyServiceReference{
+				ID:         "foo",
+				Identifier: details.Identifier,
+				Metadata:   conv.DetailsToLegacyServiceMetadata(details),
+			})
+			require.NoError(t, err)
+			labelValue, err := strconv.Unquote(label.Value.(string))
+			require.NoError(t, err)
+
+			legacyServiceReference, err := labeler.ReadServiceReference(graphql.Labels{label.Key: labelValue}, "foo")
+			require.NoError(t, err)
+
+			actual, err := conv.GraphQLToServiceDetails(fixStoredPackage(t, input), legacyServiceReference)
+			require.NoError(t, err)
+
+			// THEN
+			actualPayload, err := json.Marshal(actual)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(payload), string(actualPayload))
+		})
+	}
+}
+
+func TestConverter_DetailsToLegacyServiceMetadata(t *testing.T) {
+	t.Run("does not store request parameters of OAuth token requests", func(t *testing.T) {
+		// GIVEN
+		oauth := model.Oauth{
+			URL:          "http://oauth.url",
+			ClientID:     "client_id",
+			ClientSecret: "client_secret",
+			RequestParameters: &model.RequestParameters{
+				Headers:         &map[string][]string{"X-Token-Header": {"secret-header"}},
+				QueryParameters: &map[string][]string{"token-param": {"secret-param"}},
+			},
+		}
+		details := model.ServiceDetails{
+			Api: &model.API{
+				Credentials:              &model.CredentialsWithCSRF{OauthWithCSRF: &model.OauthWithCSRF{Oauth: oauth}},
+				SpecificationCredentials: &model.Credentials{Oauth: &oauth},
+			},
+		}
+
+		// WHEN
+		metadata := service.NewConverter().DetailsToLegacyServiceMetadata(details)
+
+		// THEN
+		marshalled, err := json.Marshal(metadata)
+		require.NoError(t, err)
+		assert.NotContains(t, string(marshalled), "secret-header")
+		assert.NotContains(t, string(marshalled), "secret-param")
+	})
+}
+
+// fixStoredPackage returns the Package as it is returned by the Director after creating it from the given input.
+// The specification is not fetched.
+func fixStoredPackage(t *testing.T, in graphql.PackageCreateInput) graphql.PackageExt {
+	out := graphql.PackageExt{
+		Package: graphql.Package{
+			Name:                in.Name,
+			Description:         in.Description,
+			DefaultInstanceAuth: fixStoredAuth(t, in.DefaultInstanceAuth),
+		},
+	}
+
+	for _, api := range in.APIDefinitions {
+		apiDef := &graphql.APIDefinitionExt{
+			APIDefinition: graphql.APIDefinition{
+				Name:        api.Name,
+				Description: api.Description,
+				TargetURL:   api.TargetURL,
+			},
+		}
+		if api.Spec != nil {
+			apiDef.Spec = &graphql.APISpecExt{
+				APISpec: graphql.APISpec{
+					Data:   api.Spec.Data,
+					Format: api.Spec.Format,
+					Type:   api.Spec.Type,
+				},
+			}
+			if api.Spec.FetchRequest != nil {
+				apiDef.Spec.FetchRequest = &graphql.FetchRequest{
+					URL:  api.Spec.FetchRequest.URL,
+					Auth: fixStoredAuth(t, api.Spec.FetchRequest.Auth),
+				}
+			}
+		}
+		out.APIDefinitions.Data = append(out.APIDefinitions.Data, apiDef)
+	}
+
+	for _, event := range in.EventDefinitions {
+		eventDef := &graphql.EventAPIDefinitionExt{
+			EventDefinition: graphql.EventDefinition{
+				Name:        event.Name,
+				Description: event.Description,
+			},
+		}
+		if event.Spec != nil {
+			eventDef.Spec = &graphql.EventAPISpecExt{
+				EventSpec: graphql.EventSpec{
+					Data:   event.Spec.Data,
+					Type:   event.Spec.Type,
+					Format: event.Spec.Format,
+				},
+			}
+		}
+		out.EventDefinitions.Data = append(out.EventDefinitions.Data, eventDef)
+	}
+
+	for _, doc := range in.Documents {
+		out.Documents.Data = append(out.Documents.Data, &graphql.DocumentExt{
+			Document: graphql.Document{
+				Title:       doc.Title,
+				DisplayName: doc.DisplayName,
+				Description: doc.Description,
+				Format:      doc.Format,
+				Data:        doc.Data,
+			},
+		})
+	}
+
+	return out
+}
+
+func fixStoredAuth(t *testing.T, in *graphql.AuthInput) *graphql.Auth {
+	if in == nil {
+		return nil
+	}
+
+	out := &graphql.Auth{}
+	if in.Credential != nil {
+		if in.Credential.Basic != nil {
+			out.Credential = &graphql.BasicCredentialData{
+				Username: in.Credential.Basic.Username,
+				Password: in.Credential.Basic.Password,
+			}
+		}
+		if in.Credential.Oauth != nil {
+			out.Credential = &graphql.OAuthCredentialData{
+				ClientID:     in.Credential.Oauth.ClientID,
+				ClientSecret: in.Credential.Oauth.ClientSecret,
+				URL:          in.Credential.Oauth.URL,
+			}
+		}
+	}
+
+	if in.AdditionalHeadersSerialized != nil {
+		headers, err := in.AdditionalHeadersSerialized.Unmarshal()
+		require.NoError(t, err)
+		asHeaders := graphql.HttpHeaders(headers)
+		out.AdditionalHeaders = &asHeaders
+	}
+
+	if in.AdditionalQueryParamsSerialized != nil {
+		queryParams, err := in.AdditionalQueryParamsSerialized.Unmarshal()
+		require.NoError(t, err)
+		asQueryParams := graphql.QueryParams(queryParams)
+		out.AdditionalQueryParams = &asQueryParams
+	}
+
+	if in.RequestAuth != nil && in.RequestAuth.Csrf != nil {
+		out.RequestAuth = &graphql.CredentialRequestAuth{
+			Csrf: &graphql.CSRFTokenCredentialRequestAuth{
+				TokenEndpointURL: in.RequestAuth.Csrf.TokenEndpointURL,
+			},
+		}
+	}
+
+	return out
+}
+
 func emptyLabels() *map[string]string {
 	return &map[string]string{}
 }
@@ -0,0 +1,11 @@
+package service
+
+import "time"
+
+func (h *Handler) SetTimestampGen(timestampGen func() time.Time) {
+	h.timestampGen = timestampGen
+}
+
+func (l *labeler) SetTimestampGen(timestampGen func() time.Time) {
+	l.timestampGen = timestampGen
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/model"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
@@ -37,6 +38,7 @@ type DirectorClient interface {
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
 type Converter interface {
 	DetailsToGraphQLCreateInput(deprecated model.ServiceDetails) (graphql.PackageCreateInput, error)
+	DetailsToLegacyServiceMetadata(deprecated model.ServiceDetails) *LegacyServiceMetadata
 	GraphQLCreateInputToUpdateInput(in graphql.PackageCreateInput) graphql.PackageUpdateInput
 	GraphQLToServiceDetails(converted graphql.PackageExt, legacyServiceReference LegacyServiceReference) (model.ServiceDetails, error)
 	ServiceDetailsToService(in model.ServiceDetails, serviceID string) (model.Service, error)
@@ -60,7 +62,14 @@ type AppLabeler interface {
 	ListServiceReferences(appLabels graphql.Labels) ([]LegacyServiceReference, error)
 }
 
-const serviceIDVarKey = "serviceId"
+const (
+	serviceIDVarKey = "serviceId"
+
+	// IdempotencyKeyHeader identifies the create request, so that the client can retry it without creating the service twice
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	revertTimeout = 60 * time.Second
+)
 
 type Handler struct {
 	logger             *log.Logger
@@ -68,6 +77,7 @@ type Handler struct {
 	converter          Converter
 	reqContextProvider RequestContextProvider
 	appLabeler         AppLabeler
+	timestampGen       func() time.Time
 }
 
 func NewHandler(converter Converter, validator Validator, reqContextProvider RequestContextProvider, logger *log.Logger, appLabeler AppLabeler) *Handler {
@@ -77,6 +87,7 @@ func NewHandler(converter Converter, validator Validator, reqContextProvider Req
 		reqContextProvider: reqContextProvider,
 		logger:             logger,
 		appLabeler:         appLabeler,
+		timestampGen:       time.Now,
 	}
 }
 
@@ -104,41 +115,108 @@ func (h *Handler) Create(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	if err := h.ensureUniqueIdentifier(serviceDetails.Identifier, reqContext); err != nil {
+	idempotencyKey := request.Header.Get(IdempotencyKeyHeader)
+	serviceID := ""
+	if idempotencyKey != "" {
+		existing, found, err := h.findServiceByIdempotencyKey(idempotencyKey, reqContext)
+		if err != nil {
+			h.logger.Error(err)
+			res.WriteError(writer, err, apperrors.CodeInternal)
+			return
+		}
+
+		if found && !existing.Pending {
+			h.logger.Infof("Service with ID '%s' has already been created for idempotency key '%s'", existing.ID, idempotencyKey)
+			h.writeCreateResponse(writer, existing.ID)
+			return
+		}
+
+		if found {
+			serviceID, err = h.findPackageOfPendingService(request.Context(), reqContext, existing)
+			if err != nil {
+				h.logger.Error(err)
+				res.WriteError(writer, err, apperrors.CodeInternal)
+				return
+			}
+		}
+	}
+
+	if err := h.ensureUniqueIdentifier(serviceDetails.Identifier, idempotencyKey, reqContext); err != nil {
 		h.logger.Error(errors.Wrap(err, "while ensuring legacy service identifier is unique"))
 		res.WriteAppError(writer, err)
 		return
 	}
 
-	h.logger.Infoln("doing GraphQL request...")
+	createdAt := h.timestampGen()
+	pendingServiceRef := LegacyServiceReference{
+		Identifier:     serviceDetails.Identifier,
+		IdempotencyKey: idempotencyKey,
+		Pending:        true,
+		CreatedAt:      &createdAt,
+	}
 
-	appID := reqContext.AppID
-	serviceID, err := reqContext.DirectorClient.CreatePackage(request.Context(), appID, converted)
-	if err != nil {
-		wrappedErr := errors.Wrap(err, "while creating Service")
-		h.logger.Error(wrappedErr)
-		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
-		return
+	if idempotencyKey != "" && serviceID == "" {
+		err = h.setAppLabelWithServiceRef(request.Context(), pendingServiceRef, reqContext)
+		if err != nil {
+			wrappedErr := errors.Wrap(err, "while setting Application label with pending legacy service reference")
+			h.logger.Error(wrappedErr)
+			res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+			return
+		}
+	}
+
+	if serviceID == "" {
+		h.logger.Infoln("doing GraphQL request...")
+
+		// The pending reference is left in place when the creation fails, so that the retried request can reuse the identifier.
+		serviceID, err = reqContext.DirectorClient.CreatePackage(request.Context(), reqContext.AppID, converted)
+		if err != nil {
+			wrappedErr := errors.Wrap(err, "while creating Service")
+			h.logger.Error(wrappedErr)
+			res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+			return
+		}
+
+		if idempotencyKey != "" {
+			pendingServiceRef.ID = serviceID
+			err = h.setAppLabelWithServiceRef(request.Context(), pendingServiceRef, reqContext)
+			if err != nil {
+				wrappedErr := errors.Wrap(err, "while setting Application label with created Package in pending legacy service reference")
+				h.logger.Error(wrappedErr)
+				wrappedErr = withRevertError(wrappedErr, h.revertCreate(reqContext, serviceID))
+				res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+				return
+			}
+		}
+	} else {
+		h.logger.Infof("Package with ID '%s' created by a previous request with idempotency key '%s' found", serviceID, idempotencyKey)
 	}
 
 	legacyServiceRef := LegacyServiceReference{
-		ID:         serviceID,
-		Identifier: serviceDetails.Identifier,
+		ID:             serviceID,
+		Identifier:     serviceDetails.Identifier,
+		IdempotencyKey: idempotencyKey,
+		Metadata:       h.converter.DetailsToLegacyServiceMetadata(serviceDetails),
 	}
 
 	err = h.setAppLabelWithServiceRef(request.Context(), legacyServiceRef, reqContext)
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "while setting Application label with legacy service metadata")
 		h.logger.Error(wrappedErr)
+		wrappedErr = withRevertError(wrappedErr, h.revertCreate(reqContext, serviceID))
 		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
 		return
 	}
 
+	h.writeCreateResponse(writer, serviceID)
+}
+
+func (h *Handler) writeCreateResponse(writer http.ResponseWriter, serviceID string) {
 	successResponse := SuccessfulCreateResponse{
 		ID: serviceID,
 	}
 
-	err = res.WriteJSONResponse(writer, &successResponse)
+	err := res.WriteJSONResponse(writer, &successResponse)
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "while encoding response")
 		h.logger.Error(wrappedErr)
@@ -267,6 +345,14 @@ func (h *Handler) Update(writer http.ResponseWriter, request *http.Request) {
 
 	pkgID := previousPackage.ID
 
+	legacyServiceRef, err := h.appLabeler.ReadServiceReference(reqContext.AppLabels, pkgID)
+	if err != nil {
+		wrappedErr := errors.Wrapf(err, "while reading legacy service reference for Package with ID '%s'", pkgID)
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+		return
+	}
+
 	err = dirCli.UpdatePackage(request.Context(), id, h.converter.GraphQLCreateInputToUpdateInput(createInput))
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "while updating Service")
@@ -279,6 +365,7 @@ func (h *Handler) Update(writer http.ResponseWriter, request *http.Request) {
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "while deleting related objects for Service")
 		h.logger.WithField("ID", id).Error(wrappedErr)
+		wrappedErr = withRevertError(wrappedErr, h.revertUpdate(reqContext, previousPackage))
 		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
 		return
 	}
@@ -287,14 +374,25 @@ func (h *Handler) Update(writer http.ResponseWriter, request *http.Request) {
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "while creating related objects for Service")
 		h.logger.WithField("ID", id).Error(wrappedErr)
+		wrappedErr = withRevertError(wrappedErr, h.revertUpdate(reqContext, previousPackage))
 		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
 		return
 	}
 
-	// Legacy service reference should be updated, but right now it contains only identifier field
-	// which has to preserved during update (to match old metadata service behaviour), so there's nothing to update.
+	// Identifier has to be preserved during update (to match old metadata service behaviour), only the metadata is replaced.
+	legacyServiceRef.ID = pkgID
+	legacyServiceRef.Metadata = h.converter.DetailsToLegacyServiceMetadata(serviceDetails)
 
-	h.getAndWriteServiceByID(request.Context(), writer, pkgID, reqContext)
+	err = h.setAppLabelWithServiceRef(request.Context(), legacyServiceRef, reqContext)
+	if err != nil {
+		wrappedErr := errors.Wrap(err, "while setting Application label with legacy service metadata")
+		h.logger.WithField("ID", id).Error(wrappedErr)
+		wrappedErr = withRevertError(wrappedErr, h.revertUpdate(reqContext, previousPackage))
+		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+		return
+	}
+
+	h.getAndWriteServiceWithReference(request.Context(), writer, pkgID, reqContext, legacyServiceRef)
 }
 
 func (h *Handler) Delete(writer http.ResponseWriter, request *http.Request) {
@@ -470,15 +568,8 @@ func (h *Handler) deleteRelatedObjectsForPackage(ctx context.Context, dirCli Dir
 }
 
 func (h *Handler) getAndWriteServiceByID(ctx context.Context, writer http.ResponseWriter, serviceID string, reqContext RequestContext) {
-	output, err := reqContext.DirectorClient.GetPackage(ctx, reqContext.AppID, serviceID)
-	if err != nil {
-		if apperrors.IsNotFoundError(err) {
-			h.writeErrorNotFound(writer, serviceID)
-			return
-		}
-		wrappedErr := errors.Wrap(err, "while fetching service")
-		h.logger.Error(wrappedErr)
-		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+	output, ok := h.getPackage(ctx, writer, serviceID, reqContext)
+	if !ok {
 		return
 	}
 
@@ -490,6 +581,37 @@ func (h *Handler) getAndWriteServiceByID(ctx context.Context, writer http.Respon
 		return
 	}
 
+	h.writeService(writer, output, legacyServiceReference)
+}
+
+// getAndWriteServiceWithReference writes the service with the given legacy service reference,
+// which may be newer than the one in the Application labels loaded for the request.
+func (h *Handler) getAndWriteServiceWithReference(ctx context.Context, writer http.ResponseWriter, serviceID string, reqContext RequestContext, legacyServiceReference LegacyServiceReference) {
+	output, ok := h.getPackage(ctx, writer, serviceID, reqContext)
+	if !ok {
+		return
+	}
+
+	h.writeService(writer, output, legacyServiceReference)
+}
+
+func (h *Handler) getPackage(ctx context.Context, writer http.ResponseWriter, serviceID string, reqContext RequestContext) (graphql.PackageExt, bool) {
+	output, err := reqContext.DirectorClient.GetPackage(ctx, reqContext.AppID, serviceID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			h.writeErrorNotFound(writer, serviceID)
+			return graphql.PackageExt{}, false
+		}
+		wrappedErr := errors.Wrap(err, "while fetching service")
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+		return graphql.PackageExt{}, false
+	}
+
+	return output, true
+}
+
+func (h *Handler) writeService(writer http.ResponseWriter, output graphql.PackageExt, legacyServiceReference LegacyServiceReference) {
 	service, err := h.converter.GraphQLToServiceDetails(output, legacyServiceReference)
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "while converting service")
@@ -507,7 +629,10 @@ func (h *Handler) getAndWriteServiceByID(ctx context.Context, writer http.Respon
 	}
 }
 
-func (h *Handler) ensureUniqueIdentifier(identifier string, reqContext RequestContext) apperrors.AppError {
+// ensureUniqueIdentifier checks that no other service uses the identifier.
+// The pending reference written by a previous request with the same idempotency key and the stale pending references
+// of abandoned requests are not taken into account.
+func (h *Handler) ensureUniqueIdentifier(identifier, idempotencyKey string, reqContext RequestContext) apperrors.AppError {
 	if identifier == "" {
 		return nil
 	}
@@ -518,7 +643,11 @@ func (h *Handler) ensureUniqueIdentifier(identifier string, reqContext RequestCo
 		return apperrors.Internal(wrappedError.Error())
 	}
 
+	now := h.timestampGen()
 	for _, svc := range services {
+		if svc.Pending && (svc.IdempotencyKey == idempotencyKey || svc.isStale(now)) {
+			continue
+		}
 		if svc.Identifier == identifier {
 			return apperrors.AlreadyExists("Service with Identifier %s already exists", identifier)
 		}
@@ -527,6 +656,134 @@ func (h *Handler) ensureUniqueIdentifier(identifier string, reqContext RequestCo
 	return nil
 }
 
+func (h *Handler) findServiceByIdempotencyKey(idempotencyKey string, reqContext RequestContext) (LegacyServiceReference, bool, error) {
+	services, err := h.appLabeler.ListServiceReferences(reqContext.AppLabels)
+	if err != nil {
+		return LegacyServiceReference{}, false, errors.Wrapf(err, "while listing legacy services for Application with ID '%s'", reqContext.AppID)
+	}
+
+	var pending *LegacyServiceReference
+	for i, svc := range services {
+		if svc.IdempotencyKey != idempotencyKey {
+			continue
+		}
+		if !svc.Pending {
+			return svc, true, nil
+		}
+		pending = &services[i]
+	}
+
+	if pending != nil {
+		return *pending, true, nil
+	}
+
+	return LegacyServiceReference{}, false, nil
+}
+
+// findPackageOfPendingService returns the ID of the Package created by a previous request, which failed after the Package
+// was recorded in the pending reference. If no Package was recorded or it does not exist anymore, an empty ID is returned
+// and a new Package is created.
+func (h *Handler) findPackageOfPendingService(ctx context.Context, reqContext RequestContext, pending LegacyServiceReference) (string, error) {
+	if pending.ID == "" {
+		return "", nil
+	}
+
+	_, err := reqContext.DirectorClient.GetPackage(ctx, reqContext.AppID, pending.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "while fetching Service with ID '%s'", pending.ID)
+	}
+
+	return pending.ID, nil
+}
+
+// revertCreate deletes the Package of the service which could not be registered, so that it is not left behind.
+// It does not use the request context, as the request may have been already cancelled by the client.
+func (h *Handler) revertCreate(reqContext RequestContext, packageID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), revertTimeout)
+	defer cancel()
+
+	err := reqContext.DirectorClient.DeletePackage(ctx, packageID)
+	if err != nil {
+		wrappedErr := errors.Wrapf(err, "while reverting creation of Service with ID '%s'", packageID)
+		h.logger.Error(wrappedErr)
+		return wrappedErr
+	}
+	h.logger.WithField("ID", packageID).Info("Creation of Service reverted")
+
+	return nil
+}
+
+// revertUpdate restores the Package and its related objects to the state from before the failed update.
+// The related objects are recreated in the same way as during the update.
+func (h *Handler) revertUpdate(reqContext RequestContext, previous graphql.PackageExt) error {
+	ctx, cancel := context.WithTimeout(context.Background(), revertTimeout)
+	defer cancel()
+
+	err := h.restorePackage(ctx, reqContext, previous)
+	if err != nil {
+		wrappedErr := errors.Wrapf(err, "while reverting update of Service with ID '%s'", previous.ID)
+		h.logger.Error(wrappedErr)
+		return wrappedErr
+	}
+	h.logger.WithField("ID", previous.ID).Info("Update of Service reverted")
+
+	return nil
+}
+
+// withRevertError adds the error of the failed revert to the error of the operation, as the service may have been
+// left in an inconsistent state which the client has to handle.
+func withRevertError(err, revertErr error) error {
+	if revertErr == nil {
+		return err
+	}
+
+	return fmt.Errorf("%s; %s", err, revertErr)
+}
+
+func (h *Handler) restorePackage(ctx context.Context, reqContext RequestContext, previous graphql.PackageExt) error {
+	dirCli := reqContext.DirectorClient
+
+	legacyServiceReference, err := h.appLabeler.ReadServiceReference(reqContext.AppLabels, previous.ID)
+	if err != nil {
+		return errors.Wrap(err, "while reading legacy service reference")
+	}
+
+	previousDetails, err := h.converter.GraphQLToServiceDetails(previous, legacyServiceReference)
+	if err != nil {
+		return errors.Wrap(err, "while converting previous Package to service details")
+	}
+
+	previousInput, err := h.converter.DetailsToGraphQLCreateInput(previousDetails)
+	if err != nil {
+		return errors.Wrap(err, "while converting previous service details")
+	}
+
+	current, err := dirCli.GetPackage(ctx, reqContext.AppID, previous.ID)
+	if err != nil {
+		return errors.Wrap(err, "while fetching Package")
+	}
+
+	err = h.deleteRelatedObjectsForPackage(ctx, dirCli, current)
+	if err != nil {
+		return errors.Wrap(err, "while deleting related objects")
+	}
+
+	err = dirCli.UpdatePackage(ctx, previous.ID, h.converter.GraphQLCreateInputToUpdateInput(previousInput))
+	if err != nil {
+		return errors.Wrap(err, "while updating Package")
+	}
+
+	err = h.createRelatedObjectsForPackage(ctx, dirCli, previous.ID, previousInput)
+	if err != nil {
+		return errors.Wrap(err, "while creating related objects")
+	}
+
+	return nil
+}
+
 func (h *Handler) setAppLabelWithServiceRef(ctx context.Context, serviceRef LegacyServiceReference, reqContext RequestContext) error {
 	label, err := h.appLabeler.WriteServiceReference(reqContext.AppLabels, serviceRef)
 	if err != nil {
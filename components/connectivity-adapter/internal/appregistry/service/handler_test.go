@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
 
@@ -33,6 +34,8 @@ func TestHandler_Create(t *testing.T) {
 	target := "http://example.com/foo"
 	testErr := errors.New("test")
 	testServiceDetails := fixServiceDetails()
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	timestampGen := func() time.Time { return now }
 
 	t.Run("Error when unmarshalling input", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(""))
@@ -257,10 +260,12 @@ func TestHandler_Create(t *testing.T) {
 
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
 		mockClient.On("CreatePackage", mock.Anything, mock.Anything, mock.Anything).Return("test", nil)
+		mockClient.On("DeletePackage", mock.Anything, "test").Return(nil)
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
 
@@ -297,10 +302,12 @@ func TestHandler_Create(t *testing.T) {
 
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
 		mockClient.On("CreatePackage", mock.Anything, mock.Anything, mock.Anything).Return("test", nil)
+		mockClient.On("DeletePackage", mock.Anything, "test").Return(nil)
 		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(testErr)
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
@@ -338,6 +345,7 @@ func TestHandler_Create(t *testing.T) {
 
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
@@ -385,6 +393,7 @@ func TestHandler_Create(t *testing.T) {
 
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
@@ -418,6 +427,575 @@ func TestHandler_Create(t *testing.T) {
 		mockLabeler.AssertExpectations(t)
 	})
 
+	t.Run("Success when service with the same idempotency key already exists", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				ID:             "existing",
+				IdempotencyKey: "key",
+			},
+		}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response service.SuccessfulCreateResponse
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, "existing", response.ID)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Success when idempotency key specified", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("CreatePackage", mock.Anything, mock.Anything, mock.Anything).Return("test", nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(nil)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				ID:             "foo",
+				IdempotencyKey: "other",
+			},
+		}, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "test",
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "test",
+			IdempotencyKey: "key",
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, "", http.StatusOK)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when writing pending legacy service reference", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		expectedError := "while setting Application label with pending legacy service reference: while setting Application label: test"
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(testErr)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return(nil, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusInternalServerError)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when recording created Package in pending legacy service reference", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		expectedError := "while setting Application label with created Package in pending legacy service reference: while setting Application label: test"
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("CreatePackage", mock.Anything, mock.Anything, mock.Anything).Return("test", nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(nil).Once()
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(testErr).Once()
+		mockClient.On("DeletePackage", mock.Anything, "test").Return(nil)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return(nil, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "test",
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusInternalServerError)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Success when Package of pending service was created by previous request", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("GetPackage", mock.Anything, "test", "created").Return(graphql.PackageExt{}, nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(nil).Once()
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				ID: "referenced",
+			},
+			{
+				ID:             "created",
+				IdempotencyKey: "key",
+				Pending:        true,
+				CreatedAt:      &now,
+			},
+		}, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "created",
+			IdempotencyKey: "key",
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response service.SuccessfulCreateResponse
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, "created", response.ID)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Success when Package recorded in pending service does not exist", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("GetPackage", mock.Anything, "test", "created").Return(graphql.PackageExt{}, apperrors.NotFound("test"))
+		mockClient.On("CreatePackage", mock.Anything, "test", graphql.PackageCreateInput{}).Return("recreated", nil).Once()
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(nil)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				ID: "referenced",
+			},
+			{
+				ID:             "created",
+				IdempotencyKey: "key",
+				Pending:        true,
+				CreatedAt:      &now,
+			},
+		}, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "recreated",
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "recreated",
+			IdempotencyKey: "key",
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, "", http.StatusOK)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Success when Package of pending service was not created by previous request", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("CreatePackage", mock.Anything, "test", graphql.PackageCreateInput{}).Return("created", nil).Once()
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(nil)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				IdempotencyKey: "key",
+				Pending:        true,
+				CreatedAt:      &now,
+			},
+		}, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "created",
+			IdempotencyKey: "key",
+			Pending:        true,
+			CreatedAt:      &now,
+		}).Return(graphql.LabelInput{}, nil).Once()
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:             "created",
+			IdempotencyKey: "key",
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, "", http.StatusOK)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when fetching Package recorded in pending service", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		expectedError := "while fetching Service with ID 'created': test"
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		req.Header.Set(service.IdempotencyKeyHeader, "key")
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("GetPackage", mock.Anything, "test", "created").Return(graphql.PackageExt{}, testErr)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				ID: "referenced",
+			},
+			{
+				ID:             "created",
+				IdempotencyKey: "key",
+				Pending:        true,
+				CreatedAt:      &now,
+			},
+		}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusInternalServerError)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Success when identifier is reserved by stale pending service", func(t *testing.T) {
+		body, err := json.Marshal(fixServiceDetailsWithIdentifier())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("CreatePackage", mock.Anything, mock.Anything, mock.Anything).Return("test", nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(nil)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		stale := now.Add(-2 * time.Hour)
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				Identifier:     "Test",
+				IdempotencyKey: "other",
+				Pending:        true,
+				CreatedAt:      &stale,
+			},
+		}, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:         "test",
+			Identifier: "Test",
+		}).Return(graphql.LabelInput{}, nil).Once()
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, "", http.StatusOK)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when identifier is reserved by pending service of another request", func(t *testing.T) {
+		body, err := json.Marshal(fixServiceDetailsWithIdentifier())
+		require.NoError(t, err)
+
+		expectedError := "Service with Identifier Test already exists"
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ListServiceReferences", graphql.Labels(nil)).Return([]service.LegacyServiceReference{
+			{
+				Identifier:     "Test",
+				IdempotencyKey: "other",
+				Pending:        true,
+				CreatedAt:      &now,
+			},
+		}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.SetTimestampGen(timestampGen)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusConflict)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when reverting creation of service failed", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		expectedError := "while setting Application label with legacy service metadata: while setting Application label: test; while reverting creation of Service with ID 'test': test"
+
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("CreatePackage", mock.Anything, mock.Anything, mock.Anything).Return("test", nil)
+		mockClient.On("DeletePackage", mock.Anything, "test").Return(testErr)
+		mockClient.On("SetApplicationLabel", mock.Anything, "test", graphql.LabelInput{}).Return(testErr)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{
+			ID:         "test",
+			Identifier: "",
+		}).Return(graphql.LabelInput{}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.Create(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusInternalServerError)
+
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
 }
 
 func TestHandler_Get(t *testing.T) {
@@ -938,7 +1516,10 @@ func TestHandler_Update(t *testing.T) {
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
 
-		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), nil)
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ReadServiceReference", mock.Anything, mock.Anything).Return(service.LegacyServiceReference{}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
 		handler.Update(w, req)
 
 		resp := w.Result()
@@ -963,16 +1544,21 @@ func TestHandler_Update(t *testing.T) {
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
 		mockConverter.On("GraphQLCreateInputToUpdateInput", mock.Anything).Return(graphql.PackageUpdateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
 		mockClient.On("GetPackage", mock.Anything, mock.Anything, mock.Anything).Return(graphql.PackageExt{}, nil).Once()
 		mockClient.On("GetPackage", mock.Anything, mock.Anything, mock.Anything).Return(graphql.PackageExt{}, testErr).Once()
 		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, mock.Anything, graphql.LabelInput{}).Return(nil)
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
 
 		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ReadServiceReference", mock.Anything, mock.Anything).Return(service.LegacyServiceReference{}, nil)
+
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{}).Return(graphql.LabelInput{}, nil)
 
 		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
 		handler.Update(w, req)
@@ -999,12 +1585,10 @@ func TestHandler_Update(t *testing.T) {
 
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
-		mockConverter.On("GraphQLCreateInputToUpdateInput", mock.Anything).Return(graphql.PackageUpdateInput{}, nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
 		mockClient.On("GetPackage", mock.Anything, mock.Anything, mock.Anything).Return(graphql.PackageExt{}, nil)
-		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
 
@@ -1037,18 +1621,127 @@ func TestHandler_Update(t *testing.T) {
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
 		mockConverter.On("GraphQLCreateInputToUpdateInput", mock.Anything).Return(graphql.PackageUpdateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 		mockConverter.On("GraphQLToServiceDetails", mock.Anything, mock.Anything).Return(model.ServiceDetails{}, testErr)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
 		mockClient.On("GetPackage", mock.Anything, mock.Anything, mock.Anything).Return(graphql.PackageExt{}, nil)
 		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, mock.Anything, graphql.LabelInput{}).Return(nil)
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
 
 		mockLabeler := automock.AppLabeler{}
 		mockLabeler.On("ReadServiceReference", mock.Anything, mock.Anything).Return(service.LegacyServiceReference{}, nil)
 
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{}).Return(graphql.LabelInput{}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.Update(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusInternalServerError)
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when creating related objects reverts update", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, target, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		expectedError := "while creating related objects for Service: while creating API Definition: test"
+
+		newAPI := graphql.APIDefinitionInput{Name: "new"}
+		newInput := graphql.PackageCreateInput{Name: "new", APIDefinitions: []*graphql.APIDefinitionInput{&newAPI}}
+		previousAPI := graphql.APIDefinitionInput{Name: "previous"}
+		previousInput := graphql.PackageCreateInput{Name: "previous", APIDefinitions: []*graphql.APIDefinitionInput{&previousAPI}}
+		previousDetails := model.ServiceDetails{Name: "previous"}
+		previousPackage := graphql.PackageExt{
+			Package: graphql.Package{ID: "pkg"},
+			APIDefinitions: graphql.APIDefinitionPageExt{
+				Data: []*graphql.APIDefinitionExt{{APIDefinition: graphql.APIDefinition{ID: "previous-api"}}},
+			},
+		}
+		currentPackage := graphql.PackageExt{Package: graphql.Package{ID: "pkg"}}
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", testServiceDetails).Return(newInput, nil).Once()
+		mockConverter.On("GraphQLCreateInputToUpdateInput", newInput).Return(graphql.PackageUpdateInput{Name: "new"}).Once()
+		mockConverter.On("GraphQLToServiceDetails", previousPackage, service.LegacyServiceReference{ID: "pkg"}).Return(previousDetails, nil).Once()
+		mockConverter.On("DetailsToGraphQLCreateInput", previousDetails).Return(previousInput, nil).Once()
+		mockConverter.On("GraphQLCreateInputToUpdateInput", previousInput).Return(graphql.PackageUpdateInput{Name: "previous"}).Once()
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("GetPackage", mock.Anything, "test", mock.Anything).Return(previousPackage, nil).Once()
+		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, graphql.PackageUpdateInput{Name: "new"}).Return(nil).Once()
+		mockClient.On("DeleteAPIDefinition", mock.Anything, "previous-api").Return(nil).Once()
+		mockClient.On("CreateAPIDefinition", mock.Anything, "pkg", newAPI).Return("", testErr).Once()
+		mockClient.On("GetPackage", mock.Anything, "test", "pkg").Return(currentPackage, nil).Once()
+		mockClient.On("UpdatePackage", mock.Anything, "pkg", graphql.PackageUpdateInput{Name: "previous"}).Return(nil).Once()
+		mockClient.On("CreateAPIDefinition", mock.Anything, "pkg", previousAPI).Return("restored-api", nil).Once()
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ReadServiceReference", graphql.Labels(nil), "pkg").Return(service.LegacyServiceReference{ID: "pkg"}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.Update(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, expectedError, http.StatusInternalServerError)
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
+	t.Run("Error when reverting update failed", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, target, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		expectedError := "while creating related objects for Service: while creating API Definition: test; while reverting update of Service with ID 'pkg': while fetching Package: test"
+
+		newAPI := graphql.APIDefinitionInput{Name: "new"}
+		newInput := graphql.PackageCreateInput{Name: "new", APIDefinitions: []*graphql.APIDefinitionInput{&newAPI}}
+		previousInput := graphql.PackageCreateInput{Name: "previous"}
+		previousDetails := model.ServiceDetails{Name: "previous"}
+		previousPackage := graphql.PackageExt{Package: graphql.Package{ID: "pkg"}}
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", testServiceDetails).Return(newInput, nil).Once()
+		mockConverter.On("GraphQLCreateInputToUpdateInput", newInput).Return(graphql.PackageUpdateInput{Name: "new"}).Once()
+		mockConverter.On("GraphQLToServiceDetails", previousPackage, service.LegacyServiceReference{ID: "pkg"}).Return(previousDetails, nil).Once()
+		mockConverter.On("DetailsToGraphQLCreateInput", previousDetails).Return(previousInput, nil).Once()
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("GetPackage", mock.Anything, "test", mock.Anything).Return(previousPackage, nil).Once()
+		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, graphql.PackageUpdateInput{Name: "new"}).Return(nil).Once()
+		mockClient.On("CreateAPIDefinition", mock.Anything, "pkg", newAPI).Return("", testErr).Once()
+		mockClient.On("GetPackage", mock.Anything, "test", "pkg").Return(graphql.PackageExt{}, testErr).Once()
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ReadServiceReference", graphql.Labels(nil), "pkg").Return(service.LegacyServiceReference{ID: "pkg"}, nil)
+
 		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
 		handler.Update(w, req)
 
@@ -1061,6 +1754,57 @@ func TestHandler_Update(t *testing.T) {
 		mockLabeler.AssertExpectations(t)
 	})
 
+	t.Run("Success with legacy service metadata replaced", func(t *testing.T) {
+		body, err := json.Marshal(testServiceDetails)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, target, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+
+		previousRef := service.LegacyServiceReference{
+			Identifier:     "identifier",
+			IdempotencyKey: "key",
+			Metadata:       &service.LegacyServiceMetadata{Provider: "previous"},
+		}
+		expectedRef := service.LegacyServiceReference{
+			Identifier:     "identifier",
+			IdempotencyKey: "key",
+			Metadata:       &service.LegacyServiceMetadata{Provider: "Test"},
+		}
+
+		mockValidator := automock.Validator{}
+		mockValidator.On("Validate", mock.Anything).Return(nil)
+
+		mockConverter := automock.Converter{}
+		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
+		mockConverter.On("GraphQLCreateInputToUpdateInput", mock.Anything).Return(graphql.PackageUpdateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", testServiceDetails).Return(&service.LegacyServiceMetadata{Provider: "Test"})
+		mockConverter.On("GraphQLToServiceDetails", mock.Anything, expectedRef).Return(model.ServiceDetails{}, nil)
+
+		mockContextProvider := automock.RequestContextProvider{}
+		mockClient := automock.DirectorClient{}
+		mockClient.On("GetPackage", mock.Anything, mock.Anything, mock.Anything).Return(graphql.PackageExt{}, nil)
+		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, mock.Anything, graphql.LabelInput{}).Return(nil)
+		mockContextProvider.On("ForRequest", mock.Anything).
+			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
+
+		mockLabeler := automock.AppLabeler{}
+		mockLabeler.On("ReadServiceReference", mock.Anything, mock.Anything).Return(previousRef, nil)
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), expectedRef).Return(graphql.LabelInput{}, nil)
+
+		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
+		handler.Update(w, req)
+
+		resp := w.Result()
+		assertErrorResponse(t, resp, "", http.StatusOK)
+		mockValidator.AssertExpectations(t)
+		mockConverter.AssertExpectations(t)
+		mockContextProvider.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+		mockLabeler.AssertExpectations(t)
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		body, err := json.Marshal(testServiceDetails)
 		require.NoError(t, err)
@@ -1075,18 +1819,22 @@ func TestHandler_Update(t *testing.T) {
 		mockConverter := automock.Converter{}
 		mockConverter.On("DetailsToGraphQLCreateInput", mock.Anything).Return(graphql.PackageCreateInput{}, nil)
 		mockConverter.On("GraphQLCreateInputToUpdateInput", mock.Anything).Return(graphql.PackageUpdateInput{}, nil)
+		mockConverter.On("DetailsToLegacyServiceMetadata", mock.Anything).Return(nil)
 		mockConverter.On("GraphQLToServiceDetails", mock.Anything, mock.Anything).Return(model.ServiceDetails{}, nil)
 
 		mockContextProvider := automock.RequestContextProvider{}
 		mockClient := automock.DirectorClient{}
 		mockClient.On("GetPackage", mock.Anything, mock.Anything, mock.Anything).Return(graphql.PackageExt{}, nil)
 		mockClient.On("UpdatePackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("SetApplicationLabel", mock.Anything, mock.Anything, graphql.LabelInput{}).Return(nil)
 		mockContextProvider.On("ForRequest", mock.Anything).
 			Return(service.RequestContext{AppID: "test", DirectorClient: &mockClient}, nil)
 
 		mockLabeler := automock.AppLabeler{}
 		mockLabeler.On("ReadServiceReference", mock.Anything, mock.Anything).Return(service.LegacyServiceReference{}, nil)
 
+		mockLabeler.On("WriteServiceReference", graphql.Labels(nil), service.LegacyServiceReference{}).Return(graphql.LabelInput{}, nil)
+
 		handler := service.NewHandler(&mockConverter, &mockValidator, &mockContextProvider, logrus.New(), &mockLabeler)
 		handler.Update(w, req)
 
@@ -4,31 +4,102 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/pkg/errors"
 )
 
-const legacyServicesLabelKey = "legacy_servicesMetadata"
+const (
+	legacyServicesLabelKey = "legacy_servicesMetadata"
 
+	pendingServiceReferencePrefix = "pending-"
+
+	// pendingServiceReferenceTTL is the time after which a pending reference is considered abandoned, for example because
+	// the client never retried the failed request. Abandoned references do not reserve the identifier and are removed.
+	pendingServiceReferenceTTL = time.Hour
+)
+
+// LegacyServiceReference links the Package with the legacy service fields.
+// A pending reference is written before the Package is created and gets the ID of the Package once it is created,
+// so that a retried create request with the same idempotency key can find the Package even if the request which
+// created it failed before it was referenced.
 type LegacyServiceReference struct {
-	ID         string `json:"id"`
-	Identifier string `json:"identifier"`
+	ID             string                 `json:"id"`
+	Identifier     string                 `json:"identifier"`
+	IdempotencyKey string                 `json:"idempotencyKey,omitempty"`
+	Pending        bool                   `json:"pending,omitempty"`
+	CreatedAt      *time.Time             `json:"createdAt,omitempty"`
+	Metadata       *LegacyServiceMetadata `json:"metadata,omitempty"`
+}
+
+// isStale tells whether the pending reference was abandoned. Pending references without creation timestamp are stale.
+func (r LegacyServiceReference) isStale(now time.Time) bool {
+	if !r.Pending {
+		return false
+	}
+
+	return r.CreatedAt == nil || now.Sub(*r.CreatedAt) > pendingServiceReferenceTTL
+}
+
+// LegacyServiceMetadata contains the fields of the legacy service which cannot be stored in the Package.
+// Credentials and request parameters are stored only in the Package, the metadata describes how they were provided.
+// The request parameters of the OAuth token requests are not stored, as they may contain secrets and the Package auth cannot hold them.
+type LegacyServiceMetadata struct {
+	Provider         string               `json:"provider,omitempty"`
+	ShortDescription string               `json:"shortDescription,omitempty"`
+	Labels           *map[string]string   `json:"labels,omitempty"`
+	API              *LegacyAPIMetadata   `json:"api,omitempty"`
+	Documentation    *model.Documentation `json:"documentation,omitempty"`
+}
+
+type LegacyAPIMetadata struct {
+	APIType           string                        `json:"apiType,omitempty"`
+	SpecificationURL  string                        `json:"specificationUrl,omitempty"`
+	Headers           bool                          `json:"headers,omitempty"`
+	QueryParameters   bool                          `json:"queryParameters,omitempty"`
+	RequestParameters *LegacyRequestParameters      `json:"requestParameters,omitempty"`
+	CertificateGen    *model.CertificateGenWithCSRF `json:"certificateGen,omitempty"`
 }
 
-type labeler struct{}
+// LegacyRequestParameters marks which of the request parameters were provided in the new way
+type LegacyRequestParameters struct {
+	Headers         bool `json:"headers,omitempty"`
+	QueryParameters bool `json:"queryParameters,omitempty"`
+}
+
+type labeler struct {
+	timestampGen func() time.Time
+}
 
 func NewAppLabeler() *labeler {
-	return &labeler{}
+	return &labeler{timestampGen: time.Now}
 }
 
+// WriteServiceReference writes the reference and removes the stale pending references of abandoned requests.
 func (l *labeler) WriteServiceReference(appLabels graphql.Labels, serviceReference LegacyServiceReference) (graphql.LabelInput, error) {
 	services, err := l.readLabel(appLabels)
 	if err != nil {
 		return graphql.LabelInput{}, err
 	}
 
+	now := l.timestampGen()
+	for key, svc := range services {
+		if svc.isStale(now) {
+			delete(services, key)
+		}
+	}
+
+	if serviceReference.Pending {
+		services[pendingServiceReferenceKey(serviceReference.IdempotencyKey)] = serviceReference
+		return l.writeLabel(services)
+	}
+
+	if serviceReference.IdempotencyKey != "" {
+		delete(services, pendingServiceReferenceKey(serviceReference.IdempotencyKey))
+	}
 	services[serviceReference.ID] = serviceReference
 
 	return l.writeLabel(services)
@@ -75,6 +146,10 @@ func (l *labeler) ListServiceReferences(appLabels graphql.Labels) ([]LegacyServi
 	return serviceReferences, nil
 }
 
+func pendingServiceReferenceKey(idempotencyKey string) string {
+	return pendingServiceReferencePrefix + idempotencyKey
+}
+
 func (l *labeler) readLabel(appLabels graphql.Labels) (map[string]LegacyServiceReference, error) {
 	value := appLabels[legacyServicesLabelKey]
 	if value == nil {
@@ -3,6 +3,7 @@ package service_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -17,7 +18,9 @@ const legacyServicesLabelKey = "legacy_servicesMetadata"
 
 func TestLabeler_WriteServiceReference(t *testing.T) {
 	// GIVEN
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
 	labeler := service.NewAppLabeler()
+	labeler.SetTimestampGen(func() time.Time { return now })
 
 	testCases := []struct {
 		Name              string
@@ -54,6 +57,56 @@ func TestLabeler_WriteServiceReference(t *testing.T) {
 			},
 			ExpectedError: nil,
 		},
+		{
+			Name: "Success when pending reference is written",
+			InputLabels: graphql.Labels{
+				legacyServicesLabelKey: `{"foo":{"id":"foo","identifier":"bar"}}`,
+			},
+			InputSvcReference: service.LegacyServiceReference{
+				IdempotencyKey: "key",
+				Pending:        true,
+				CreatedAt:      &now,
+			},
+			ExpectedOutput: graphql.LabelInput{
+				Key:   legacyServicesLabelKey,
+				Value: `"{\"foo\":{\"id\":\"foo\",\"identifier\":\"bar\"},\"pending-key\":{\"id\":\"\",\"identifier\":\"\",\"idempotencyKey\":\"key\",\"pending\":true,\"createdAt\":\"2020-01-01T12:00:00Z\"}}"`,
+			},
+			ExpectedError: nil,
+		},
+		{
+			Name: "Success when pending reference is replaced",
+			InputLabels: graphql.Labels{
+				legacyServicesLabelKey: `{"pending-key":{"id":"","identifier":"","idempotencyKey":"key","pending":true,"createdAt":"2020-01-01T11:30:00Z"}}`,
+			},
+			InputSvcReference: service.LegacyServiceReference{
+				ID:             "foo",
+				IdempotencyKey: "key",
+			},
+			ExpectedOutput: graphql.LabelInput{
+				Key:   legacyServicesLabelKey,
+				Value: `"{\"foo\":{\"id\":\"foo\",\"identifier\":\"\",\"idempotencyKey\":\"key\"}}"`,
+			},
+			ExpectedError: nil,
+		},
+		{
+			Name: "Success when stale pending references are removed",
+			InputLabels: graphql.Labels{
+				legacyServicesLabelKey: `{"foo":{"id":"foo","identifier":"bar"},` +
+					`"pending-stale":{"id":"","identifier":"baz","idempotencyKey":"stale","pending":true,"createdAt":"2020-01-01T10:00:00Z"},` +
+					`"pending-unknown":{"id":"","identifier":"","idempotencyKey":"unknown","pending":true},` +
+					`"pending-recent":{"id":"","identifier":"","idempotencyKey":"recent","pending":true,"createdAt":"2020-01-01T11:30:00Z"}}`,
+			},
+			InputSvcReference: service.LegacyServiceReference{
+				ID:         "biz",
+				Identifier: "baz",
+			},
+			ExpectedOutput: graphql.LabelInput{
+				Key: legacyServicesLabelKey,
+				Value: `"{\"biz\":{\"id\":\"biz\",\"identifier\":\"baz\"},\"foo\":{\"id\":\"foo\",\"identifier\":\"bar\"},` +
+					`\"pending-recent\":{\"id\":\"\",\"identifier\":\"\",\"idempotencyKey\":\"recent\",\"pending\":true,\"createdAt\":\"2020-01-01T11:30:00Z\"}}"`,
+			},
+			ExpectedError: nil,
+		},
 		{
 			Name: "Error when value is not a string",
 			InputLabels: graphql.Labels{
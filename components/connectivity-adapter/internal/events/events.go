@@ -0,0 +1,41 @@
+package events
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	ClientTimeout  time.Duration `envconfig:"default=30s"`
+	ClientCertPath string        `envconfig:"optional"`
+	ClientKeyPath  string        `envconfig:"optional"`
+	// CAPath is an optional path to the CA bundle used to verify the Runtime. If it is empty, the system CA pool is used.
+	CAPath string `envconfig:"optional"`
+}
+
+func RegisterHandler(router *mux.Router, cfg Config, directorURL string, directorTimeout time.Duration) error {
+	logger := logrus.New().WithField("component", "events").Logger
+	logger.SetReportCaller(true)
+
+	publisher, err := NewPublisher(cfg)
+	if err != nil {
+		return errors.Wrap(err, "while creating events publisher")
+	}
+
+	gqlCliProvider := gqlcli.NewProvider(directorURL, directorTimeout)
+	appMiddleware := appdetails.NewApplicationMiddleware(gqlCliProvider, logger)
+	certMiddleware := NewCertificateMiddleware(logger)
+
+	eventsHandler := NewHandler(publisher, logger)
+
+	router.Use(certMiddleware.Middleware, appMiddleware.Middleware)
+	router.HandleFunc("", eventsHandler.Publish).Methods(http.MethodPost)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
+	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	// cloudEventsPathFormat is the path of the Event Service endpoint in the runtime which accepts CloudEvents
+	cloudEventsPathFormat = "/%s/v2/events"
+	dataContentType       = "application/json"
+)
+
+type Handler struct {
+	publisher Publisher
+	logger    *log.Logger
+}
+
+func NewHandler(publisher Publisher, logger *log.Logger) *Handler {
+	return &Handler{
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+func (h *Handler) Publish(writer http.ResponseWriter, request *http.Request) {
+	defer h.closeBody(request)
+
+	app, err := appdetails.LoadFromContext(request.Context())
+	if err != nil {
+		wrappedErr := errors.Wrap(err, "while loading Application details")
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+		return
+	}
+
+	clientID := request.Header.Get(oathkeeper.ClientIdFromCertificateHeader)
+	if clientID != app.ID {
+		message := fmt.Sprintf("certificate does not belong to application %s", app.Name)
+		h.logger.Warn(message)
+		res.WriteErrorMessage(writer, message, apperrors.CodeForbidden)
+		return
+	}
+
+	var publishRequest PublishRequest
+	err = json.NewDecoder(request.Body).Decode(&publishRequest)
+	if err != nil {
+		wrappedErr := errors.Wrap(err, "while unmarshalling event")
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeWrongInput)
+		return
+	}
+
+	if appErr := validate(publishRequest); appErr != nil {
+		h.logger.Error(appErr)
+		res.WriteAppError(writer, appErr)
+		return
+	}
+
+	eventsURL, appErr := cloudEventsURL(app.EventingConfiguration.DefaultURL, app.Name)
+	if appErr != nil {
+		h.logger.Error(appErr)
+		res.WriteAppError(writer, appErr)
+		return
+	}
+
+	event := toCloudEvent(app.Name, publishRequest)
+
+	h.logger.WithField("ID", event.ID).Infof("publishing event to '%s'...", eventsURL)
+	err = h.publisher.Publish(request.Context(), eventsURL, event)
+	if err != nil {
+		wrappedErr := errors.Wrap(err, "while publishing event")
+		h.logger.WithField("ID", event.ID).Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeUpstreamServerCallFailed)
+		return
+	}
+
+	err = res.WriteJSONResponse(writer, PublishResponse{EventID: event.ID})
+	if err != nil {
+		wrappedErr := errors.Wrap(err, "while encoding response")
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeInternal)
+		return
+	}
+}
+
+func (h *Handler) closeBody(rq *http.Request) {
+	err := rq.Body.Close()
+	if err != nil {
+		h.logger.Error(errors.Wrap(err, "while closing body"))
+	}
+}
+
+func validate(rq PublishRequest) apperrors.AppError {
+	if rq.EventType == "" {
+		return apperrors.WrongInput("event-type is required")
+	}
+
+	if rq.EventTypeVersion == "" {
+		return apperrors.WrongInput("event-type-version is required")
+	}
+
+	if rq.EventID != "" {
+		if _, err := uuid.Parse(rq.EventID); err != nil {
+			return apperrors.WrongInput("event-id must be a valid UUID")
+		}
+	}
+
+	if _, err := time.Parse(time.RFC3339, rq.EventTime); err != nil {
+		return apperrors.WrongInput("event-time must be in the RFC 3339 format")
+	}
+
+	if len(rq.Data) == 0 {
+		return apperrors.WrongInput("data is required")
+	}
+
+	return nil
+}
+
+// cloudEventsURL builds the CloudEvents endpoint of the Event Service in the default eventing runtime of the Application.
+// The Director returns the legacy events endpoint of that runtime, built from its runtime_eventServiceUrl label.
+func cloudEventsURL(defaultURL string, appName string) (string, apperrors.AppError) {
+	if defaultURL == "" {
+		return "", apperrors.NotFound("default eventing runtime for application %s not found", appName)
+	}
+
+	eventsURL, err := url.Parse(defaultURL)
+	if err != nil {
+		return "", apperrors.Internal("while parsing eventing URL of application %s: %s", appName, err)
+	}
+	eventsURL.Path = fmt.Sprintf(cloudEventsPathFormat, appName)
+
+	return eventsURL.String(), nil
+}
+
+func toCloudEvent(appName string, rq PublishRequest) CloudEvent {
+	eventID := rq.EventID
+	if eventID == "" {
+		eventID = uuid.New().String()
+	}
+
+	return CloudEvent{
+		SpecVersion:      cloudEventsSpecVersion,
+		ID:               eventID,
+		Source:           appName,
+		Type:             rq.EventType,
+		Time:             rq.EventTime,
+		DataContentType:  dataContentType,
+		EventTypeVersion: rq.EventTypeVersion,
+		Data:             rq.Data,
+	}
+}
@@ -0,0 +1,238 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events/automock"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
+	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	appID   = "0dc5a4ac-8e08-4b1d-8d0f-8b3e7f2ab6e1"
+	appName = "app-name"
+	eventID = "8954ad1c-78ed-4c58-a639-68bd44031de0"
+)
+
+func TestHandler_Publish(t *testing.T) {
+	target := "http://example.com/app-name/v1/events"
+	validEvent := `{"event-type":"order.created","event-type-version":"v1","event-id":"` + eventID + `","event-time":"2020-09-28T14:47:16.491Z","data":{"orderId":"123"}}`
+	expectedEvent := events.CloudEvent{
+		SpecVersion:      "1.0",
+		ID:               eventID,
+		Source:           appName,
+		Type:             "order.created",
+		Time:             "2020-09-28T14:47:16.491Z",
+		DataContentType:  "application/json",
+		EventTypeVersion: "v1",
+		Data:             json.RawMessage(`{"orderId":"123"}`),
+	}
+	expectedURL := "https://gateway.runtime.local/app-name/v2/events"
+
+	t.Run("success", func(t *testing.T) {
+		//GIVEN
+		logger, _ := test.NewNullLogger()
+		publisher := &automock.Publisher{}
+		publisher.On("Publish", mock.Anything, expectedURL, expectedEvent).Return(nil)
+
+		handler := events.NewHandler(publisher, logger)
+		rw := httptest.NewRecorder()
+
+		//WHEN
+		handler.Publish(rw, fixRequest(validEvent, fixApplication("https://gateway.runtime.local/app-name/v1/events")))
+
+		//THEN
+		assert.Equal(t, http.StatusOK, rw.Code)
+		var response events.PublishResponse
+		require.NoError(t, json.NewDecoder(rw.Body).Decode(&response))
+		assert.Equal(t, eventID, response.EventID)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("success when event ID not provided", func(t *testing.T) {
+		//GIVEN
+		logger, _ := test.NewNullLogger()
+		publisher := &automock.Publisher{}
+		publisher.On("Publish", mock.Anything, expectedURL, mock.MatchedBy(func(event events.CloudEvent) bool {
+			return event.ID != "" && event.Type == "order.created"
+		})).Return(nil)
+
+		handler := events.NewHandler(publisher, logger)
+		rw := httptest.NewRecorder()
+		body := `{"event-type":"order.created","event-type-version":"v1","event-time":"2020-09-28T14:47:16.491Z","data":"test"}`
+
+		//WHEN
+		handler.Publish(rw, fixRequest(body, fixApplication("https://gateway.runtime.local/app-name/v1/events")))
+
+		//THEN
+		assert.Equal(t, http.StatusOK, rw.Code)
+		var response events.PublishResponse
+		require.NoError(t, json.NewDecoder(rw.Body).Decode(&response))
+		assert.NotEmpty(t, response.EventID)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("error when certificate does not belong to application", func(t *testing.T) {
+		//GIVEN
+		logger, _ := test.NewNullLogger()
+		publisher := &automock.Publisher{}
+
+		handler := events.NewHandler(publisher, logger)
+		rw := httptest.NewRecorder()
+		req := fixRequest(validEvent, fixApplication("https://gateway.runtime.local/app-name/v1/events"))
+		req.Header.Set(oathkeeper.ClientIdFromCertificateHeader, "other")
+
+		//WHEN
+		handler.Publish(rw, req)
+
+		//THEN
+		assertErrorResponse(t, rw, http.StatusForbidden, "certificate does not belong to application app-name")
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("error when application details not in context", func(t *testing.T) {
+		//GIVEN
+		logger, _ := test.NewNullLogger()
+		publisher := &automock.Publisher{}
+
+		handler := events.NewHandler(publisher, logger)
+		rw := httptest.NewRecorder()
+
+		//WHEN
+		handler.Publish(rw, httptest.NewRequest(http.MethodPost, target, strings.NewReader(validEvent)))
+
+		//THEN
+		assertErrorResponse(t, rw, http.StatusInternalServerError, "while loading Application details: cannot read Application details from context")
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("error when event is invalid", func(t *testing.T) {
+		testCases := []struct {
+			Name          string
+			Body          string
+			ExpectedError string
+		}{
+			{
+				Name:          "malformed JSON",
+				Body:          "{",
+				ExpectedError: "while unmarshalling event: unexpected EOF",
+			},
+			{
+				Name:          "missing event type",
+				Body:          `{"event-type-version":"v1","event-time":"2020-09-28T14:47:16.491Z","data":{}}`,
+				ExpectedError: "event-type is required",
+			},
+			{
+				Name:          "missing event type version",
+				Body:          `{"event-type":"order.created","event-time":"2020-09-28T14:47:16.491Z","data":{}}`,
+				ExpectedError: "event-type-version is required",
+			},
+			{
+				Name:          "invalid event ID",
+				Body:          `{"event-type":"order.created","event-type-version":"v1","event-id":"123","event-time":"2020-09-28T14:47:16.491Z","data":{}}`,
+				ExpectedError: "event-id must be a valid UUID",
+			},
+			{
+				Name:          "invalid event time",
+				Body:          `{"event-type":"order.created","event-type-version":"v1","event-time":"yesterday","data":{}}`,
+				ExpectedError: "event-time must be in the RFC 3339 format",
+			},
+			{
+				Name:          "missing data",
+				Body:          `{"event-type":"order.created","event-type-version":"v1","event-time":"2020-09-28T14:47:16.491Z"}`,
+				ExpectedError: "data is required",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				//GIVEN
+				logger, _ := test.NewNullLogger()
+				publisher := &automock.Publisher{}
+
+				handler := events.NewHandler(publisher, logger)
+				rw := httptest.NewRecorder()
+
+				//WHEN
+				handler.Publish(rw, fixRequest(testCase.Body, fixApplication("https://gateway.runtime.local/app-name/v1/events")))
+
+				//THEN
+				assertErrorResponse(t, rw, http.StatusBadRequest, testCase.ExpectedError)
+				publisher.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("error when application has no default eventing runtime", func(t *testing.T) {
+		//GIVEN
+		logger, _ := test.NewNullLogger()
+		publisher := &automock.Publisher{}
+
+		handler := events.NewHandler(publisher, logger)
+		rw := httptest.NewRecorder()
+
+		//WHEN
+		handler.Publish(rw, fixRequest(validEvent, fixApplication("")))
+
+		//THEN
+		assertErrorResponse(t, rw, http.StatusNotFound, "default eventing runtime for application app-name not found")
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("error when publishing event fails", func(t *testing.T) {
+		//GIVEN
+		logger, _ := test.NewNullLogger()
+		publisher := &automock.Publisher{}
+		publisher.On("Publish", mock.Anything, expectedURL, expectedEvent).Return(errors.New("test"))
+
+		handler := events.NewHandler(publisher, logger)
+		rw := httptest.NewRecorder()
+
+		//WHEN
+		handler.Publish(rw, fixRequest(validEvent, fixApplication("https://gateway.runtime.local/app-name/v1/events")))
+
+		//THEN
+		assertErrorResponse(t, rw, http.StatusBadGateway, "while publishing event: test")
+		publisher.AssertExpectations(t)
+	})
+}
+
+func fixApplication(eventingURL string) graphql.ApplicationExt {
+	return graphql.ApplicationExt{
+		Application: graphql.Application{
+			ID:   appID,
+			Name: appName,
+		},
+		EventingConfiguration: graphql.ApplicationEventingConfiguration{
+			DefaultURL: eventingURL,
+		},
+	}
+}
+
+func fixRequest(body string, app graphql.ApplicationExt) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/app-name/v1/events", strings.NewReader(body))
+	req.Header.Set(oathkeeper.ClientIdFromCertificateHeader, appID)
+	req.Header.Set(oathkeeper.ClientCertificateHashHeader, "hash")
+
+	return req.WithContext(appdetails.SaveToContext(req.Context(), app))
+}
+
+func assertErrorResponse(t *testing.T, rw *httptest.ResponseRecorder, expectedCode int, expectedError string) {
+	assert.Equal(t, expectedCode, rw.Code)
+
+	var response res.ErrorResponse
+	require.NoError(t, json.NewDecoder(rw.Body).Decode(&response))
+	assert.Equal(t, expectedError, response.Error)
+}
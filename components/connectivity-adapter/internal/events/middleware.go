@@ -0,0 +1,36 @@
+package events
+
+import (
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
+	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	log "github.com/sirupsen/logrus"
+)
+
+type certificateMiddleware struct {
+	logger *log.Logger
+}
+
+// NewCertificateMiddleware creates middleware which accepts only requests authenticated with the Application client certificate.
+// The certificate is verified by Oathkeeper, which passes its subject and hash in the request headers.
+func NewCertificateMiddleware(logger *log.Logger) *certificateMiddleware {
+	return &certificateMiddleware{logger: logger}
+}
+
+func (mw *certificateMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID := r.Header.Get(oathkeeper.ClientIdFromCertificateHeader)
+		certificateHash := r.Header.Get(oathkeeper.ClientCertificateHashHeader)
+
+		if clientID == "" || certificateHash == "" {
+			message := "request is not authenticated with the Application certificate"
+			mw.logger.Warn(message)
+			res.WriteErrorMessage(w, message, apperrors.CodeForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
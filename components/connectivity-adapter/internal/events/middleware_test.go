@@ -0,0 +1,67 @@
+package events_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateMiddleware(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Headers      map[string]string
+		ExpectedCode int
+	}{
+		{
+			Name: "success",
+			Headers: map[string]string{
+				oathkeeper.ClientIdFromCertificateHeader: appID,
+				oathkeeper.ClientCertificateHashHeader:   "hash",
+			},
+			ExpectedCode: http.StatusOK,
+		},
+		{
+			Name: "authenticated with token",
+			Headers: map[string]string{
+				oathkeeper.ClientIdFromTokenHeader: appID,
+			},
+			ExpectedCode: http.StatusForbidden,
+		},
+		{
+			Name: "missing certificate hash",
+			Headers: map[string]string{
+				oathkeeper.ClientIdFromCertificateHeader: appID,
+			},
+			ExpectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			logger, _ := test.NewNullLogger()
+			middleware := events.NewCertificateMiddleware(logger)
+
+			req := httptest.NewRequest(http.MethodPost, "/app-name/v1/events", nil)
+			for key, value := range testCase.Headers {
+				req.Header.Set(key, value)
+			}
+			rw := httptest.NewRecorder()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			//WHEN
+			middleware.Middleware(next).ServeHTTP(rw, req)
+
+			//THEN
+			assert.Equal(t, testCase.ExpectedCode, rw.Code)
+		})
+	}
+}
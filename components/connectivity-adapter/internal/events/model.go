@@ -0,0 +1,28 @@
+package events
+
+import "encoding/json"
+
+// PublishRequest is the event format of the legacy Event Service API
+type PublishRequest struct {
+	EventType        string          `json:"event-type"`
+	EventTypeVersion string          `json:"event-type-version"`
+	EventID          string          `json:"event-id,omitempty"`
+	EventTime        string          `json:"event-time"`
+	Data             json.RawMessage `json:"data"`
+}
+
+type PublishResponse struct {
+	EventID string `json:"event-id"`
+}
+
+// CloudEvent is the structured mode representation of the event in the CloudEvents 1.0 format
+type CloudEvent struct {
+	SpecVersion      string          `json:"specversion"`
+	ID               string          `json:"id"`
+	Source           string          `json:"source"`
+	Type             string          `json:"type"`
+	Time             string          `json:"time"`
+	DataContentType  string          `json:"datacontenttype"`
+	EventTypeVersion string          `json:"eventtypeversion"`
+	Data             json.RawMessage `json:"data"`
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/pkg/errors"
+)
+
+const cloudEventsContentType = "application/cloudevents+json"
+
+//go:generate mockery -name=Publisher -output=automock -outpkg=automock -case=underscore
+type Publisher interface {
+	Publish(ctx context.Context, url string, event CloudEvent) error
+}
+
+type publisher struct {
+	httpClient *http.Client
+}
+
+func NewPublisher(cfg Config) (*publisher, error) {
+	tlsConfig := &tls.Config{}
+
+	if cfg.ClientCertPath != "" || cfg.ClientKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "while loading client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.CAPath != "" {
+		caPEM, err := ioutil.ReadFile(cfg.CAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading CA bundle")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("no certificates found in CA bundle %s", cfg.CAPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	return &publisher{
+		httpClient: &http.Client{
+			Transport: httputil.NewCorrelationIDTransport(transport),
+			Timeout:   cfg.ClientTimeout,
+		},
+	}, nil
+}
+
+func (p *publisher) Publish(ctx context.Context, url string, event CloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling event")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+	req.Header.Set("Content-Type", cloudEventsContentType)
+
+	resp, err := p.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "while sending event")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrapf(err, "while reading response body with status %d", resp.StatusCode)
+		}
+		return fmt.Errorf("runtime responded with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
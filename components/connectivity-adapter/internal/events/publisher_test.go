@@ -0,0 +1,142 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	event := events.CloudEvent{
+		SpecVersion: "1.0",
+		ID:          eventID,
+		Source:      appName,
+		Type:        "order.created",
+		Data:        json.RawMessage(`{"orderId":"123"}`),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		//GIVEN
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+
+			var received events.CloudEvent
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			assert.Equal(t, event, received)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		publisher, err := events.NewPublisher(events.Config{ClientTimeout: time.Second})
+		require.NoError(t, err)
+
+		//WHEN
+		err = publisher.Publish(context.TODO(), server.URL, event)
+
+		//THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("error when runtime rejects event", func(t *testing.T) {
+		//GIVEN
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte("invalid event"))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		publisher, err := events.NewPublisher(events.Config{ClientTimeout: time.Second})
+		require.NoError(t, err)
+
+		//WHEN
+		err = publisher.Publish(context.TODO(), server.URL, event)
+
+		//THEN
+		require.EqualError(t, err, "runtime responded with status 400: invalid event")
+	})
+
+	t.Run("success when runtime certificate is trusted by CA bundle", func(t *testing.T) {
+		//GIVEN
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		caPath := writeTempFile(t, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+		defer os.Remove(caPath)
+
+		publisher, err := events.NewPublisher(events.Config{ClientTimeout: time.Second, CAPath: caPath})
+		require.NoError(t, err)
+
+		//WHEN
+		err = publisher.Publish(context.TODO(), server.URL, event)
+
+		//THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("error when runtime certificate is not trusted", func(t *testing.T) {
+		//GIVEN
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		publisher, err := events.NewPublisher(events.Config{ClientTimeout: time.Second})
+		require.NoError(t, err)
+
+		//WHEN
+		err = publisher.Publish(context.TODO(), server.URL, event)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate")
+	})
+
+	t.Run("error when CA bundle contains no certificates", func(t *testing.T) {
+		//GIVEN
+		caPath := writeTempFile(t, []byte("not a certificate"))
+		defer os.Remove(caPath)
+
+		//WHEN
+		_, err := events.NewPublisher(events.Config{CAPath: caPath})
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no certificates found in CA bundle")
+	})
+
+	t.Run("error when client certificate cannot be loaded", func(t *testing.T) {
+		//WHEN
+		_, err := events.NewPublisher(events.Config{ClientCertPath: "not-existing.crt", ClientKeyPath: "not-existing.key"})
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading client certificate")
+	})
+}
+
+func writeTempFile(t *testing.T, content []byte) string {
+	file, err := ioutil.TempFile("", "publisher")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, file.Close())
+	}()
+
+	_, err = file.Write(content)
+	require.NoError(t, err)
+
+	return file.Name()
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/vrischmann/envconfig"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
@@ -46,18 +47,23 @@ func main() {
 	k8sClientSet, appErr := newK8SClientSet(ctx, cfg.KubernetesClient.PollInteval, cfg.KubernetesClient.PollTimeout, cfg.KubernetesClient.Timeout)
 	exitOnError(appErr, "Failed to initialize Kubernetes client.")
 
-	internalComponents, certsLoader, revokedCertsLoader := config.InitInternalComponents(cfg, k8sClientSet)
+	internalComponents, certsLoader, revokedCertsLoader, renewalsLoader, err := config.InitInternalComponents(cfg, k8sClientSet)
+	exitOnError(err, "Failed to initialize internal components")
+
 	go certsLoader.Run(ctx)
 	go revokedCertsLoader.Run(ctx)
+	go renewalsLoader.Run(ctx)
 
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
-		internalComponents.CSRSubjectConsts,
+		internalComponents.CertificateProfiles,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.RenewalService,
+		internalComponents.RateLimitGuard)
 
 	authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
 
@@ -67,15 +73,19 @@ func main() {
 	internalGqlServer, err := config.PrepareInternalGraphQLServer(cfg, api.NewTokenResolver(internalComponents.TokenService), correlation.AttachCorrelationIDToContext(), log.RequestLogger())
 	exitOnError(err, "Failed configuring internal graphQL handler")
 
-	hydratorServer, err := config.PrepareHydratorServer(cfg, internalComponents.TokenService, internalComponents.CSRSubjectConsts, internalComponents.RevokedCertsRepository, correlation.AttachCorrelationIDToContext(), log.RequestLogger())
+	hydratorServer, err := config.PrepareHydratorServer(cfg, internalComponents.TokenService, internalComponents.CertificateProfiles, internalComponents.RevokedCertsRepository, internalComponents.RenewalService, internalComponents.RateLimitGuard, correlation.AttachCorrelationIDToContext(), log.RequestLogger())
 	exitOnError(err, "Failed configuring hydrator handler")
 
+	prometheus.MustRegister(internalComponents.MetricsCollector)
+	metricsServer := config.PrepareMetricsServer(cfg)
+
 	wg := &sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(4)
 
 	go startServer(ctx, externalGqlServer, wg)
 	go startServer(ctx, internalGqlServer, wg)
 	go startServer(ctx, hydratorServer, wg)
+	go startServer(ctx, metricsServer, wg)
 
 	wg.Wait()
 }
@@ -1,13 +1,19 @@
 package config
 
 import (
+	"crypto/x509"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/metrics"
 	"github.com/kyma-incubator/compass/components/connector/internal/namespacedname"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/secrets"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
@@ -20,18 +26,27 @@ type Components struct {
 
 	CertificateService     certificates.Service
 	RevokedCertsRepository revocation.RevokedCertificatesRepository
+	RenewalService         renewal.Service
+
+	CertificateProfiles *certificates.Profiles
 
-	CSRSubjectConsts certificates.CSRSubjectConsts
+	RateLimitGuard   ratelimit.Guard
+	MetricsCollector *metrics.Collector
 }
 
-func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Components, certificates.Loader, revocation.Loader) {
+func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Components, certificates.Loader, revocation.Loader, revocation.Loader, error) {
 	caSecret := namespacedname.Parse(cfg.CASecret.Name)
 	rootCASecret := namespacedname.Parse(cfg.RootCASecret.Name)
 
+	certificateProfiles, err := newCertificateProfiles(cfg)
+	if err != nil {
+		return Components{}, nil, nil, nil, errors.Wrap(err, "while creating certificate profiles")
+	}
+
 	certsCache := certificates.NewCertificateCache()
 	certsService := certificates.NewCertificateService(
 		certsCache,
-		certificates.NewCertificateUtility(cfg.CertificateValidityTime),
+		certificates.NewCertificateUtility(),
 		caSecret.Name,
 		rootCASecret.Name,
 		cfg.CASecret.CertificateKey,
@@ -49,6 +64,40 @@ func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Comp
 		time.Second,
 	)
 
+	renewalsCache := renewal.NewCache()
+	renewalsConfigMap := namespacedname.Parse(cfg.CertificateRenewal.ConfigMapName)
+	renewalsConfigMapManager := k8sClientSet.CoreV1().ConfigMaps(renewalsConfigMap.Namespace)
+	renewalsRepository := renewal.NewRepository(renewalsConfigMapManager, renewalsConfigMap.Name, renewalsCache)
+	renewalService := renewal.NewService(
+		renewalsRepository,
+		revokedCertsRepository,
+		cfg.CertificateRenewal.Window,
+		cfg.CertificateRenewal.Overlap,
+	)
+	renewalsLoader := renewal.NewLoader(renewalsCache,
+		renewalsRepository,
+		renewalsConfigMapManager,
+		renewalsConfigMap.Name,
+		time.Second,
+	)
+
+	metricsCollector := metrics.NewCollector()
+	rateLimitGuard := ratelimit.NewGuard(
+		ratelimit.NewLimiter(ratelimit.Config{
+			Attempts:        cfg.RateLimit.SourceIP.Attempts,
+			Period:          cfg.RateLimit.SourceIP.Period,
+			MaxFailures:     cfg.RateLimit.SourceIP.MaxFailures,
+			LockoutDuration: cfg.RateLimit.SourceIP.LockoutDuration,
+		}),
+		ratelimit.NewLimiter(ratelimit.Config{
+			Attempts:        cfg.RateLimit.ClientId.Attempts,
+			Period:          cfg.RateLimit.ClientId.Period,
+			MaxFailures:     cfg.RateLimit.ClientId.MaxFailures,
+			LockoutDuration: cfg.RateLimit.ClientId.LockoutDuration,
+		}),
+		metricsCollector,
+	)
+
 	return Components{
 		Authenticator: authentication.NewAuthenticator(),
 		TokenService: tokens.NewTokenService(
@@ -56,8 +105,11 @@ func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Comp
 			tokens.NewTokenGenerator(cfg.Token.Length)),
 		CertificateService:     certsService,
 		RevokedCertsRepository: revokedCertsRepository,
-		CSRSubjectConsts:       newCSRSubjectConsts(cfg),
-	}, certsLoader, revokedCertsLoader
+		RenewalService:         renewalService,
+		CertificateProfiles:    certificateProfiles,
+		RateLimitGuard:         rateLimitGuard,
+		MetricsCollector:       metricsCollector,
+	}, certsLoader, revokedCertsLoader, renewalsLoader, nil
 }
 
 func newRevokedCertsRepository(k8sClientSet kubernetes.Interface, revokedCertsConfigMap types.NamespacedName, revokedCertsCache revocation.Cache) revocation.RevokedCertificatesRepository {
@@ -74,6 +126,78 @@ func newSecretsRepository(k8sClientSet kubernetes.Interface) secrets.Repository
 	})
 }
 
+func newCertificateProfiles(config Config) (*certificates.Profiles, error) {
+	defaultProfile := certificates.Profile{
+		Subject:     newCSRSubjectConsts(config),
+		Validity:    config.CertificateValidityTime,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	runtimeProfile, err := newCertificateProfile(config.CertificateProfiles.Runtime, defaultProfile)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating Runtime certificate profile")
+	}
+
+	applicationProfile, err := newCertificateProfile(config.CertificateProfiles.Application, defaultProfile)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating Application certificate profile")
+	}
+
+	return certificates.NewProfiles(defaultProfile).
+		Add(tokens.RuntimeToken, runtimeProfile).
+		Add(tokens.ApplicationToken, applicationProfile), nil
+}
+
+func newCertificateProfile(profileConfig CertificateProfile, defaultProfile certificates.Profile) (certificates.Profile, error) {
+	keyUsage := defaultProfile.KeyUsage
+	if len(profileConfig.KeyUsages) > 0 {
+		var err error
+		if keyUsage, err = certificates.ParseKeyUsage(profileConfig.KeyUsages); err != nil {
+			return certificates.Profile{}, err
+		}
+	}
+
+	extKeyUsage := defaultProfile.ExtKeyUsage
+	if len(profileConfig.ExtKeyUsages) > 0 {
+		var err error
+		if extKeyUsage, err = certificates.ParseExtKeyUsage(profileConfig.ExtKeyUsages); err != nil {
+			return certificates.Profile{}, err
+		}
+	}
+
+	subject := defaultProfile.Subject
+	overrideIfSet(&subject.Country, profileConfig.Subject.Country)
+	overrideIfSet(&subject.Organization, profileConfig.Subject.Organization)
+	overrideIfSet(&subject.OrganizationalUnit, profileConfig.Subject.OrganizationalUnit)
+	overrideIfSet(&subject.Locality, profileConfig.Subject.Locality)
+	overrideIfSet(&subject.Province, profileConfig.Subject.Province)
+
+	validity := defaultProfile.Validity
+	if profileConfig.ValidityTime > 0 {
+		validity = profileConfig.ValidityTime
+	}
+
+	return certificates.Profile{
+		Subject:     subject,
+		Validity:    validity,
+		KeyUsage:    keyUsage,
+		ExtKeyUsage: extKeyUsage,
+		SANRules: certificates.SANRules{
+			DNSNamePatterns:     profileConfig.SAN.DNSNamePatterns,
+			AllowIPAddresses:    profileConfig.SAN.AllowIPAddresses,
+			AllowEmailAddresses: profileConfig.SAN.AllowEmailAddresses,
+			AllowURIs:           profileConfig.SAN.AllowURIs,
+		},
+	}, nil
+}
+
+func overrideIfSet(value *string, override string) {
+	if override != "" {
+		*value = override
+	}
+}
+
 func newCSRSubjectConsts(config Config) certificates.CSRSubjectConsts {
 	return certificates.CSRSubjectConsts{
 		Country:            config.CSRSubject.Country,
@@ -16,6 +16,7 @@ type Config struct {
 	Log log.Config
 
 	HydratorAddress string `envconfig:"default=127.0.0.1:8080"`
+	MetricsAddress  string `envconfig:"default=127.0.0.1:3002"`
 
 	ServerTimeout time.Duration `envconfig:"default=100s"`
 
@@ -37,9 +38,37 @@ type Config struct {
 		CertificateKey string `envconfig:"optional"`
 	}
 
+	CertificateProfiles struct {
+		Runtime     CertificateProfile
+		Application CertificateProfile
+	}
+
 	CertificateDataHeader   string `envconfig:"default=Certificate-Data"`
 	RevocationConfigMapName string `envconfig:"default=compass-system/revocations-Config"`
 
+	CertificateRenewal struct {
+		Window  time.Duration `envconfig:"default=720h"`
+		Overlap time.Duration `envconfig:"default=1h"`
+		// ConfigMapName is the namespace and the name prefix of the config maps holding the renewal record of each certificate
+		ConfigMapName string `envconfig:"default=compass-system/certificate-renewals"`
+	}
+
+	RateLimit struct {
+		SourceIP struct {
+			Attempts        int           `envconfig:"default=60"`
+			Period          time.Duration `envconfig:"default=1m"`
+			MaxFailures     int           `envconfig:"default=10"`
+			LockoutDuration time.Duration `envconfig:"default=15m"`
+		}
+		ClientId struct {
+			Attempts        int           `envconfig:"default=10"`
+			Period          time.Duration `envconfig:"default=1m"`
+			MaxFailures     int           `envconfig:"default=5"`
+			LockoutDuration time.Duration `envconfig:"default=15m"`
+		}
+		TrustedProxies int `envconfig:"default=1"`
+	}
+
 	Token struct {
 		Length                int           `envconfig:"default=64"`
 		RuntimeExpiration     time.Duration `envconfig:"default=60m"`
@@ -56,24 +85,54 @@ type Config struct {
 	}
 }
 
+// CertificateProfile overrides the global CSRSubject and CertificateValidityTime for one consumer type.
+// Fields left empty fall back to the global values.
+type CertificateProfile struct {
+	Subject struct {
+		Country            string `envconfig:"optional"`
+		Organization       string `envconfig:"optional"`
+		OrganizationalUnit string `envconfig:"optional"`
+		Locality           string `envconfig:"optional"`
+		Province           string `envconfig:"optional"`
+	}
+	ValidityTime time.Duration `envconfig:"optional"`
+	KeyUsages    []string      `envconfig:"optional"`
+	ExtKeyUsages []string      `envconfig:"optional"`
+	SAN          struct {
+		DNSNamePatterns     []string `envconfig:"optional"`
+		AllowIPAddresses    bool     `envconfig:"optional"`
+		AllowEmailAddresses bool     `envconfig:"optional"`
+		AllowURIs           bool     `envconfig:"optional"`
+	}
+}
+
+func (p CertificateProfile) String() string {
+	return fmt.Sprintf("Subject: %+v, ValidityTime: %s, KeyUsages: %v, ExtKeyUsages: %v, SAN: %+v",
+		p.Subject, p.ValidityTime, p.KeyUsages, p.ExtKeyUsages, p.SAN)
+}
+
 func (c *Config) String() string {
-	return fmt.Sprintf("ExternalAddress: %s, InternalAddress: %s, APIEndpoint: %s, HydratorAddress: %s, "+
+	return fmt.Sprintf("ExternalAddress: %s, InternalAddress: %s, APIEndpoint: %s, HydratorAddress: %s, MetricsAddress: %s, "+
 		"CSRSubjectCountry: %s, CSRSubjectOrganization: %s, CSRSubjectOrganizationalUnit: %s, "+
 		"CSRSubjectLocality: %s, CSRSubjectProvince: %s, "+
-		"CertificateValidityTime: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
+		"CertificateValidityTime: %s, RuntimeCertificateProfile: {%s}, ApplicationCertificateProfile: {%s}, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
 		"RootCASecretName: %s, RootCASecretCertificateKey: %s, CertificateDataHeader: %s, "+
 		"CertificateSecuredConnectorURL: %s, "+
 		"RevocationConfigMapName: %s, "+
+		"CertificateRenewalWindow: %s, CertificateRenewalOverlap: %s, CertificateRenewalConfigMapName: %s, "+
+		"RateLimitSourceIP: %+v, RateLimitClientId: %+v, RateLimitTrustedProxies: %d, "+
 		"TokenLength: %d, TokenRuntimeExpiration: %s, TokenApplicationExpiration: %s, TokenCSRExpiration: %s, "+
 		"DirectorURL: %s "+
 		"KubernetesClientPollInteval: %s, KubernetesClientPollTimeout: %s",
-		c.ExternalAddress, c.InternalAddress, c.APIEndpoint, c.HydratorAddress,
+		c.ExternalAddress, c.InternalAddress, c.APIEndpoint, c.HydratorAddress, c.MetricsAddress,
 		c.CSRSubject.Country, c.CSRSubject.Organization, c.CSRSubject.OrganizationalUnit,
 		c.CSRSubject.Locality, c.CSRSubject.Province,
-		c.CertificateValidityTime, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
+		c.CertificateValidityTime, c.CertificateProfiles.Runtime, c.CertificateProfiles.Application, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
 		c.RootCASecret.Name, c.RootCASecret.CertificateKey, c.CertificateDataHeader,
 		c.CertificateSecuredConnectorURL,
 		c.RevocationConfigMapName,
+		c.CertificateRenewal.Window, c.CertificateRenewal.Overlap, c.CertificateRenewal.ConfigMapName,
+		c.RateLimit.SourceIP, c.RateLimit.ClientId, c.RateLimit.TrustedProxies,
 		c.Token.Length, c.Token.RuntimeExpiration.String(), c.Token.ApplicationExpiration.String(), c.Token.CSRExpiration.String(),
 		c.DirectorURL,
 		c.KubernetesClient.PollInteval, c.KubernetesClient.PollTimeout)
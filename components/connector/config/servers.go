@@ -10,11 +10,14 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	"github.com/kyma-incubator/compass/components/connector/internal/healthz"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/internalschema"
 	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
@@ -68,10 +71,10 @@ func PrepareInternalGraphQLServer(cfg Config, tokenResolver api.TokenResolver, m
 	}, nil
 }
 
-func PrepareHydratorServer(cfg Config, tokenService tokens.Service, subjectConsts certificates.CSRSubjectConsts, revokedCertsRepository revocation.RevokedCertificatesRepository, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
-	certHeaderParser := oathkeeper.NewHeaderParser(cfg.CertificateDataHeader, subjectConsts)
+func PrepareHydratorServer(cfg Config, tokenService tokens.Service, certificateProfiles *certificates.Profiles, revokedCertsRepository revocation.RevokedCertificatesRepository, renewalService renewal.Service, rateLimitGuard ratelimit.Guard, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
+	certHeaderParser := oathkeeper.NewHeaderParser(cfg.CertificateDataHeader, certificateProfiles)
 
-	validationHydrator := oathkeeper.NewValidationHydrator(tokenService, certHeaderParser, revokedCertsRepository)
+	validationHydrator := oathkeeper.NewValidationHydrator(tokenService, certHeaderParser, revokedCertsRepository, renewalService, rateLimitGuard, cfg.RateLimit.TrustedProxies)
 
 	router := mux.NewRouter()
 	router.Path("/health").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -97,3 +100,14 @@ func PrepareHydratorServer(cfg Config, tokenService tokens.Service, subjectConst
 		ReadHeaderTimeout: cfg.ServerTimeout,
 	}, nil
 }
+
+func PrepareMetricsServer(cfg Config) *http.Server {
+	router := mux.NewRouter()
+	router.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{
+		Addr:              cfg.MetricsAddress,
+		Handler:           router,
+		ReadHeaderTimeout: cfg.ServerTimeout,
+	}
+}
@@ -2,13 +2,18 @@ package api
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
@@ -25,28 +30,34 @@ type certificateResolver struct {
 	authenticator                  authentication.Authenticator
 	tokenService                   tokens.Service
 	certificatesService            certificates.Service
-	csrSubjectConsts               certificates.CSRSubjectConsts
+	certificateProfiles            *certificates.Profiles
 	directorURL                    string
 	certificateSecuredConnectorURL string
 	revokedCertsRepository         revocation.RevokedCertificatesRepository
+	renewalService                 renewal.Service
+	guard                          ratelimit.Guard
 }
 
 func NewCertificateResolver(
 	authenticator authentication.Authenticator,
 	tokenService tokens.Service,
 	certificatesService certificates.Service,
-	csrSubjectConsts certificates.CSRSubjectConsts,
+	certificateProfiles *certificates.Profiles,
 	directorURL string,
 	certificateSecuredConnectorURL string,
-	revokedCertsRepository revocation.RevokedCertificatesRepository) CertificateResolver {
+	revokedCertsRepository revocation.RevokedCertificatesRepository,
+	renewalService renewal.Service,
+	guard ratelimit.Guard) CertificateResolver {
 	return &certificateResolver{
 		authenticator:                  authenticator,
 		tokenService:                   tokenService,
 		certificatesService:            certificatesService,
-		csrSubjectConsts:               csrSubjectConsts,
+		certificateProfiles:            certificateProfiles,
 		directorURL:                    directorURL,
 		certificateSecuredConnectorURL: certificateSecuredConnectorURL,
 		revokedCertsRepository:         revokedCertsRepository,
+		renewalService:                 renewalService,
+		guard:                          guard,
 	}
 }
 
@@ -60,15 +71,19 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 	}
 	log.C(ctx).Infof("Fetching configuration for client with id %s", clientId)
 
+	consumerType := consumerTypeFromContext(ctx)
+	renewedCertificateHash := renewedCertificateHashFromContext(ctx)
+	binding := r.tokenBinding(ctx)
+
 	log.C(ctx).Infof("Creating one-time token as part of fetching configuration process for client with id %s", clientId)
-	token, err := r.tokenService.CreateToken(ctx, clientId, tokens.CSRToken)
+	token, err := r.tokenService.CreateCSRToken(ctx, clientId, consumerType, renewedCertificateHash, binding)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while creating one-time token for client with id %s during fetching configuration process", clientId)
 		return nil, errors.Wrap(err, "Failed to create one-time token during fetching configuration process")
 	}
 
 	csrInfo := &externalschema.CertificateSigningRequestInfo{
-		Subject:      r.csrSubjectConsts.ToString(clientId),
+		Subject:      r.certificateProfiles.ForType(consumerType).Subject.ToString(clientId),
 		KeyAlgorithm: "rsa2048",
 	}
 
@@ -80,6 +95,7 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 		ManagementPlaneInfo: &externalschema.ManagementPlaneInfo{
 			DirectorURL:                    &r.directorURL,
 			CertificateSecuredConnectorURL: &r.certificateSecuredConnectorURL,
+			CertificateRenewal:             toCertificateRenewalInfo(r.renewalService.Info(renewedCertificateHash)),
 		},
 	}, nil
 }
@@ -93,28 +109,65 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 		return nil, errors.Wrap(err, "Failed to authenticate with token")
 	}
 
+	sourceIP := sourceIPFromContext(ctx)
+	if err := r.guard.Allow(ctx, ratelimit.CSRSigning, sourceIP, clientId); err != nil {
+		log.C(ctx).WithError(err).Errorf("Signing Certificate Signing Request for client with id %s rejected.", clientId)
+		return nil, err
+	}
+
 	log.C(ctx).Infof("Signing Certificate Signing Request for client with id %s", clientId)
 
 	rawCSR, err := decodeStringFromBase64(csr)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Failed to decode the input CSR of client with id %s during the certificate signing process.", clientId)
+		r.guard.Failure(ctx, ratelimit.CSRSigning, sourceIP, clientId)
 		return nil, errors.Wrap(err, "Error while decoding Certificate Signing Request")
 	}
 
-	subject := certificates.CSRSubject{
-		CommonName:       clientId,
-		CSRSubjectConsts: r.csrSubjectConsts,
+	renewedCertificateHash := renewedCertificateHashFromContext(ctx)
+	if renewedCertificateHash != "" {
+		log.C(ctx).Debugf("Checking renewal window of the certificate of client with id %s", clientId)
+		if err := r.renewalService.CheckRenewal(ctx, renewedCertificateHash); err != nil {
+			log.C(ctx).WithError(err).Errorf("Certificate of client with id %s cannot be renewed", clientId)
+			return nil, errors.Wrap(err, "Failed to renew certificate")
+		}
 	}
 
-	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject)
+	profile := r.certificateProfiles.ForType(consumerTypeFromContext(ctx))
+
+	binding := r.tokenBinding(ctx)
+	if !binding.IsEmpty() {
+		bindingExtension, err := certificates.BindingExtension(binding)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to embed token binding in the certificate of client with id %s", clientId)
+			return nil, errors.Wrap(err, "Error while signing Certificate Signing Request")
+		}
+		profile = profile.WithExtensions(bindingExtension)
+	}
+
+	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, clientId, profile)
 	if err != nil {
-		log.C(ctx).WithError(err).Errorf("Error occurred while signing the CSR with Common Name %s of client with id %s", subject.CommonName, clientId)
+		log.C(ctx).WithError(err).Errorf("Error occurred while signing the CSR with Common Name %s of client with id %s", clientId, clientId)
+		r.guard.Failure(ctx, ratelimit.CSRSigning, sourceIP, clientId)
 		return nil, errors.Wrap(err, "Error while signing Certificate Signing Request")
 	}
 
+	r.guard.Success(sourceIP, clientId)
+
+	notAfter, err := clientCertificateNotAfter(encodedCertificates)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to read the expiration of the issued certificate of client with id %s", clientId)
+		return nil, errors.Wrap(err, "Error while registering issued certificate")
+	}
+
+	if err := r.renewalService.CertificateIssued(ctx, encodedCertificates.ClientCertificateHash, clientId, notAfter, renewedCertificateHash, binding); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to register the issued certificate of client with id %s", clientId)
+		return nil, errors.Wrap(err, "Error while registering issued certificate")
+	}
+
 	certificationResult := certificates.ToCertificationResult(encodedCertificates)
 
-	log.C(ctx).Infof("Certificate Signing Request with Common Name %s of client with id %s successfully signed.", subject.CommonName, clientId)
+	log.C(ctx).Infof("Certificate Signing Request with Common Name %s of client with id %s in tenant %s requested by %s successfully signed.", clientId, clientId, binding.Tenant, binding.CreatedBy)
 	return &certificationResult, nil
 }
 
@@ -140,6 +193,101 @@ func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, erro
 	return true, nil
 }
 
+// tokenBinding returns the binding of the token used for authentication. Clients authenticated with a certificate
+// get the binding of that certificate without the source restrictions, which apply only to pairing.
+func (r *certificateResolver) tokenBinding(ctx context.Context) tokens.Binding {
+	if clientIdFromToken, err := authentication.GetStringFromContext(ctx, authentication.ClientIdFromTokenKey); err == nil && clientIdFromToken != "" {
+		return tokenBindingFromContext(ctx)
+	}
+
+	certificateHash, err := authentication.GetStringFromContext(ctx, authentication.ClientCertificateHashKey)
+	if err != nil || certificateHash == "" {
+		return tokens.Binding{}
+	}
+
+	binding, _ := r.renewalService.Binding(certificateHash)
+	binding.AllowedSourceCIDRs = nil
+	return binding
+}
+
+func tokenBindingFromContext(ctx context.Context) tokens.Binding {
+	encodedBinding, err := authentication.GetStringFromContext(ctx, authentication.TokenBindingKey)
+	if err != nil || encodedBinding == "" {
+		return tokens.Binding{}
+	}
+
+	binding, err := tokens.DecodeBinding(encodedBinding)
+	if err != nil {
+		log.C(ctx).WithError(err).Warn("Failed to decode token binding")
+		return tokens.Binding{}
+	}
+
+	return binding
+}
+
+func consumerTypeFromContext(ctx context.Context) tokens.TokenType {
+	consumerType, err := authentication.GetStringFromContext(ctx, authentication.ConsumerTypeKey)
+	if err != nil {
+		log.C(ctx).Debugf("Consumer type not found in context, default certificate profile will be used: %s", err.Error())
+		return ""
+	}
+
+	return tokens.TokenType(consumerType)
+}
+
+func renewedCertificateHashFromContext(ctx context.Context) string {
+	renewedCertificateHash, err := authentication.GetStringFromContext(ctx, authentication.RenewedCertificateHashKey)
+	if err != nil {
+		return ""
+	}
+
+	return renewedCertificateHash
+}
+
+func sourceIPFromContext(ctx context.Context) string {
+	sourceIP, err := authentication.GetStringFromContext(ctx, authentication.SourceIPKey)
+	if err != nil {
+		return ""
+	}
+
+	return sourceIP
+}
+
+func toCertificateRenewalInfo(info renewal.Info) *externalschema.CertificateRenewalInfo {
+	renewalInfo := &externalschema.CertificateRenewalInfo{
+		RenewalWindow: info.Window.String(),
+		OverlapPeriod: info.Overlap.String(),
+	}
+
+	if info.WindowStart != nil {
+		windowStart := info.WindowStart.UTC().Format(time.RFC3339)
+		renewalInfo.RenewalWindowStart = &windowStart
+	}
+
+	return renewalInfo
+}
+
+// clientCertificateNotAfter returns the expiration of the signed client certificate, so that its renewal record
+// expires together with the certificate instead of the time computed again from the profile
+func clientCertificateNotAfter(encodedChain certificates.EncodedCertificateChain) (time.Time, error) {
+	rawCertificate, err := base64.StdEncoding.DecodeString(encodedChain.ClientCertificate)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Failed to decode client certificate")
+	}
+
+	pemBlock, _ := pem.Decode(rawCertificate)
+	if pemBlock == nil {
+		return time.Time{}, errors.New("Failed to decode pem block of client certificate")
+	}
+
+	certificate, err := x509.ParseCertificate(pemBlock.Bytes)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Failed to parse client certificate")
+	}
+
+	return certificate.NotAfter, nil
+}
+
 func decodeStringFromBase64(string string) ([]byte, apperrors.AppError) {
 	bytes, err := base64.StdEncoding.DecodeString(string)
 	if err != nil {
@@ -2,16 +2,29 @@ package api
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	authenticationMocks "github.com/kyma-incubator/compass/components/connector/internal/authentication/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	rateLimitMocks "github.com/kyma-incubator/compass/components/connector/internal/ratelimit/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	renewalMocks "github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
 	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	tokensMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
@@ -20,25 +33,40 @@ import (
 )
 
 const (
-	clientId        = "clientId"
-	certificateHash = "somehash"
+	clientId              = "clientId"
+	certificateHash       = "somehash"
+	clientCertificateHash = "newhash"
 )
 
 var (
 	CSR           = "Q1NSCg=="
 	decodedCSR, _ = decodeStringFromBase64(CSR)
-	subject       = certificates.CSRSubject{
-		CommonName: "clientId",
-		CSRSubjectConsts: certificates.CSRSubjectConsts{
-			Country:            "country",
-			Organization:       "organization",
-			OrganizationalUnit: "organizationalunit",
-			Locality:           "locality",
-			Province:           "province",
-		},
+	subject       = certificates.CSRSubjectConsts{
+		Country:            "country",
+		Organization:       "organization",
+		OrganizationalUnit: "organizationalunit",
+		Locality:           "locality",
+		Province:           "province",
 	}
+	runtimeSubject = certificates.CSRSubjectConsts{
+		Country:            "country",
+		Organization:       "organization",
+		OrganizationalUnit: "runtimes",
+		Locality:           "locality",
+		Province:           "province",
+	}
+	defaultProfile = certificates.Profile{
+		Subject:  subject,
+		Validity: 2160 * time.Hour,
+	}
+	runtimeProfile = certificates.Profile{
+		Subject:  runtimeSubject,
+		Validity: 720 * time.Hour,
+	}
+	profiles                = certificates.NewProfiles(defaultProfile).Add(tokens.RuntimeToken, runtimeProfile)
 	directorURL             = "https://compass-gateway.kyma.local/director/graphql"
 	certSecuredConnectorURL = "https://compass-gateway-mtls.kyma.local/connector/graphql"
+	notAfter                = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 )
 
 func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
@@ -46,23 +74,26 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		// given
 		certChainBase64 := "certChainBase64"
 		caCertificate := "caCertificate"
-		clientCertificate := "clientCertificate"
+		clientCertificate := fixClientCertificate(t, notAfter)
 
 		encodedChain := certificates.EncodedCertificateChain{
-			CertificateChain:  certChainBase64,
-			CaCertificate:     caCertificate,
-			ClientCertificate: clientCertificate,
+			CertificateChain:      certChainBase64,
+			CaCertificate:         caCertificate,
+			ClientCertificate:     clientCertificate,
+			ClientCertificateHash: clientCertificateHash,
 		}
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, defaultProfile).Return(encodedChain, nil)
+		renewalService.On("CertificateIssued", mock.Anything, clientCertificateHash, clientId, notAfter, "", tokens.Binding{}).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
 
 		// when
 		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -72,7 +103,159 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		assert.Equal(t, certChainBase64, certificationResult.CertificateChain)
 		assert.Equal(t, caCertificate, certificationResult.CaCertificate)
 		assert.Equal(t, clientCertificate, certificationResult.ClientCertificate)
-		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, renewalService)
+	})
+
+	t.Run("should sign client certificate with profile of consumer type", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.TODO(), authentication.ConsumerTypeKey, string(tokens.RuntimeToken))
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, profiles.ForType(tokens.RuntimeToken)).Return(fixEncodedChain(t), nil)
+		renewalService.On("CertificateIssued", mock.Anything, clientCertificateHash, clientId, notAfter, "", tokens.Binding{}).Return(nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, certService)
+	})
+
+	t.Run("should renew client certificate", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.TODO(), authentication.RenewedCertificateHashKey, certificateHash)
+		encodedChain := fixEncodedChain(t)
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CheckRenewal", mock.Anything, certificateHash).Return(nil)
+		renewalService.On("CertificateIssued", mock.Anything, clientCertificateHash, clientId, notAfter, certificateHash, tokens.Binding{}).Return(nil)
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, defaultProfile).Return(encodedChain, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, certService, renewalService)
+	})
+
+	t.Run("should sign client certificate with token binding", func(t *testing.T) {
+		// given
+		binding := tokens.Binding{Tenant: "tenant", CreatedBy: "admin", CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+		encodedBinding, err := binding.Encode()
+		require.NoError(t, err)
+
+		ctx := authentication.PutIntoContext(context.TODO(), authentication.ClientIdFromTokenKey, clientId)
+		ctx = authentication.PutIntoContext(ctx, authentication.TokenBindingKey, encodedBinding)
+
+		bindingExtension, err := certificates.BindingExtension(binding)
+		require.NoError(t, err)
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CertificateIssued", mock.Anything, clientCertificateHash, clientId, notAfter, "", binding).Return(nil)
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, defaultProfile.WithExtensions(bindingExtension)).
+			Return(fixEncodedChain(t), nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
+
+		// when
+		_, err = certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, certService, renewalService)
+	})
+
+	t.Run("should return error when certificate is outside of renewal window", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.TODO(), authentication.RenewedCertificateHashKey, certificateHash)
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CheckRenewal", mock.Anything, certificateHash).Return(apperrors.Forbidden("error"))
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.Error(t, err)
+		certService.AssertNotCalled(t, "SignCSR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mock.AssertExpectationsForObjects(t, authenticator, renewalService)
+	})
+
+	t.Run("should return error when failed to register issued certificate", func(t *testing.T) {
+		// given
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CertificateIssued", mock.Anything, clientCertificateHash, clientId, notAfter, "", tokens.Binding{}).Return(apperrors.Internal("error"))
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, defaultProfile).Return(fixEncodedChain(t), nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, certService, renewalService)
+	})
+
+	t.Run("should return error when failed to parse issued certificate", func(t *testing.T) {
+		// given
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, defaultProfile).
+			Return(certificates.EncodedCertificateChain{ClientCertificate: "Y2xpZW50Q2VydGlmaWNhdGU=", ClientCertificateHash: clientCertificateHash}, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.Error(t, err)
+		renewalService.AssertNotCalled(t, "CertificateIssued", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mock.AssertExpectationsForObjects(t, authenticator, certService)
 	})
 
 	t.Run("should return error when unauthenticated call", func(t *testing.T) {
@@ -89,13 +272,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return("", fmt.Errorf("error"))
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", decodedCSR, clientId, defaultProfile).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -119,13 +303,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", decodedCSR, clientId, defaultProfile).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, allowingGuard())
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), "not base 64 csr")
@@ -139,20 +324,52 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		// given
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
+		certService.On("SignCSR", mock.Anything, decodedCSR, clientId, defaultProfile).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.CSRSigning, "", clientId).Return(nil)
+		guard.On("Failure", mock.Anything, ratelimit.CSRSigning, "", clientId).Return()
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, guard)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
 
 		// then
 		require.Error(t, err)
-		mock.AssertExpectationsForObjects(t, tokenService, authenticator, certService)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, certService, guard)
+	})
+
+	t.Run("should return error when rate limit exceeded", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.TODO(), authentication.SourceIPKey, "192.168.0.1")
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.CSRSigning, "192.168.0.1", clientId).Return(apperrors.TooManyRequests("error"))
+
+		certService := &certificatesMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, guard)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.Error(t, err)
+		appErr, ok := err.(apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, apperrors.CodeTooManyRequests, appErr.Code())
+		certService.AssertNotCalled(t, "SignCSR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mock.AssertExpectationsForObjects(t, authenticator, guard)
 	})
 }
 
@@ -163,9 +380,10 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -180,9 +398,10 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return("", "", errors.Errorf("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -197,9 +416,10 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(errors.Errorf("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -217,10 +437,12 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.Background()).Return(clientId, nil)
 		tokenService := &tokensMocks.Service{}
-		tokenService.On("CreateToken", mock.Anything, subject.CommonName, tokens.CSRToken).Return(token, nil)
+		tokenService.On("CreateCSRToken", mock.Anything, clientId, tokens.TokenType(""), "", tokens.Binding{}).Return(token, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("Info", "").Return(renewal.Info{Window: 720 * time.Hour, Overlap: time.Hour})
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -230,8 +452,86 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, token, configurationResult.Token.Token)
 		assert.Equal(t, &directorURL, configurationResult.ManagementPlaneInfo.DirectorURL)
 		assert.Equal(t, &certSecuredConnectorURL, configurationResult.ManagementPlaneInfo.CertificateSecuredConnectorURL)
-		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
+		assert.Equal(t, expectedSubject(subject, clientId), configurationResult.CertificateSigningRequestInfo.Subject)
 		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, "720h0m0s", configurationResult.ManagementPlaneInfo.CertificateRenewal.RenewalWindow)
+		assert.Equal(t, "1h0m0s", configurationResult.ManagementPlaneInfo.CertificateRenewal.OverlapPeriod)
+		assert.Nil(t, configurationResult.ManagementPlaneInfo.CertificateRenewal.RenewalWindowStart)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
+	})
+
+	t.Run("should return configuration for certificate renewal", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.Background(), authentication.RenewedCertificateHashKey, certificateHash)
+		windowStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		tokenService := &tokensMocks.Service{}
+		tokenService.On("CreateCSRToken", mock.Anything, clientId, tokens.TokenType(""), certificateHash, tokens.Binding{}).Return(token, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("Info", certificateHash).Return(renewal.Info{Window: 720 * time.Hour, Overlap: time.Hour, WindowStart: &windowStart})
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
+
+		// when
+		configurationResult, err := certificateResolver.Configuration(ctx)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, configurationResult.ManagementPlaneInfo.CertificateRenewal.RenewalWindowStart)
+		assert.Equal(t, "2020-01-01T00:00:00Z", *configurationResult.ManagementPlaneInfo.CertificateRenewal.RenewalWindowStart)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, renewalService)
+	})
+
+	t.Run("should return configuration with binding of certificate used for authentication", func(t *testing.T) {
+		// given
+		binding := tokens.Binding{Tenant: "tenant", CreatedBy: "admin", AllowedSourceCIDRs: []string{"10.0.0.0/8"}}
+
+		ctx := authentication.PutIntoContext(context.Background(), authentication.ClientCertificateHashKey, certificateHash)
+		ctx = authentication.PutIntoContext(ctx, authentication.RenewedCertificateHashKey, certificateHash)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		tokenService := &tokensMocks.Service{}
+		tokenService.On("CreateCSRToken", mock.Anything, clientId, tokens.TokenType(""), certificateHash, tokens.Binding{Tenant: "tenant", CreatedBy: "admin"}).Return(token, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("Binding", certificateHash).Return(binding, true)
+		renewalService.On("Info", certificateHash).Return(renewal.Info{})
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
+
+		// when
+		configurationResult, err := certificateResolver.Configuration(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, token, configurationResult.Token.Token)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, renewalService)
+	})
+
+	t.Run("should return configuration with subject of consumer type profile", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.Background(), authentication.ConsumerTypeKey, string(tokens.RuntimeToken))
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		tokenService := &tokensMocks.Service{}
+		tokenService.On("CreateCSRToken", mock.Anything, clientId, tokens.RuntimeToken, "", tokens.Binding{}).Return(token, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("Info", "").Return(renewal.Info{})
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
+
+		// when
+		configurationResult, err := certificateResolver.Configuration(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expectedSubject(runtimeSubject, clientId), configurationResult.CertificateSigningRequestInfo.Subject)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
@@ -240,10 +540,11 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.Background()).Return(clientId, nil)
 		tokenService := &tokensMocks.Service{}
-		tokenService.On("CreateToken", mock.Anything, subject.CommonName, tokens.CSRToken).Return("", apperrors.Internal("error"))
+		tokenService.On("CreateCSRToken", mock.Anything, clientId, tokens.TokenType(""), "", tokens.Binding{}).Return("", apperrors.Internal("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -260,8 +561,9 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator.On("Authenticate", context.Background()).Return("", apperrors.Forbidden("Error"))
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		renewalService := &renewalMocks.Service{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -277,3 +579,35 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 func expectedSubject(c certificates.CSRSubjectConsts, commonName string) string {
 	return fmt.Sprintf("O=%s,OU=%s,L=%s,ST=%s,C=%s,CN=%s", c.Organization, c.OrganizationalUnit, c.Locality, c.Province, c.Country, commonName)
 }
+
+func allowingGuard() *rateLimitMocks.Guard {
+	guard := &rateLimitMocks.Guard{}
+	guard.On("Allow", mock.Anything, ratelimit.CSRSigning, mock.Anything, mock.Anything).Return(nil)
+	guard.On("Failure", mock.Anything, ratelimit.CSRSigning, mock.Anything, mock.Anything).Return()
+	guard.On("Success", mock.Anything, mock.Anything).Return()
+	return guard
+}
+
+func fixEncodedChain(t *testing.T) certificates.EncodedCertificateChain {
+	return certificates.EncodedCertificateChain{
+		ClientCertificate:     fixClientCertificate(t, notAfter),
+		ClientCertificateHash: clientCertificateHash,
+	}
+}
+
+func fixClientCertificate(t *testing.T, expiration time.Time) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: clientId},
+		NotBefore:    expiration.Add(-time.Hour),
+		NotAfter:     expiration,
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rawCertificate}))
+}
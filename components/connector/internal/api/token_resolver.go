@@ -7,12 +7,13 @@ import (
 
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/internalschema"
 	"github.com/pkg/errors"
 )
 
 type TokenResolver interface {
-	GenerateApplicationToken(ctx context.Context, authID string) (*externalschema.Token, error)
-	GenerateRuntimeToken(ctx context.Context, authID string) (*externalschema.Token, error)
+	GenerateApplicationToken(ctx context.Context, authID string, binding *internalschema.TokenBindingInput) (*externalschema.Token, error)
+	GenerateRuntimeToken(ctx context.Context, authID string, binding *internalschema.TokenBindingInput) (*externalschema.Token, error)
 	IsHealthy(ctx context.Context) (bool, error)
 }
 
@@ -26,32 +27,52 @@ func NewTokenResolver(tokenService tokens.Service) TokenResolver {
 	}
 }
 
-func (r *tokenResolver) GenerateApplicationToken(ctx context.Context, authID string) (*externalschema.Token, error) {
+func (r *tokenResolver) GenerateApplicationToken(ctx context.Context, authID string, binding *internalschema.TokenBindingInput) (*externalschema.Token, error) {
 	log.C(ctx).Infof("Generating one-time token for Application with authID %s", authID)
 
-	token, err := r.tokenService.CreateToken(ctx, authID, tokens.ApplicationToken)
+	tokenBinding := toTokenBinding(binding)
+	token, err := r.tokenService.CreateToken(ctx, authID, tokens.ApplicationToken, tokenBinding)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while creating one-time token for Application with authID %s", authID)
 		return &externalschema.Token{}, errors.Wrap(err, "Failed to create one-time token for Application")
 	}
 
-	log.C(ctx).Infof("One-time token generated successfully for Application with authID %s", authID)
+	log.C(ctx).Infof("One-time token generated successfully for Application with authID %s in tenant %s requested by %s", authID, tokenBinding.Tenant, tokenBinding.CreatedBy)
 	return &externalschema.Token{Token: token}, nil
 }
 
-func (r *tokenResolver) GenerateRuntimeToken(ctx context.Context, authID string) (*externalschema.Token, error) {
+func (r *tokenResolver) GenerateRuntimeToken(ctx context.Context, authID string, binding *internalschema.TokenBindingInput) (*externalschema.Token, error) {
 	log.C(ctx).Infof("Generating one-time token for Runtime with authID %s", authID)
 
-	token, err := r.tokenService.CreateToken(ctx, authID, tokens.RuntimeToken)
+	tokenBinding := toTokenBinding(binding)
+	token, err := r.tokenService.CreateToken(ctx, authID, tokens.RuntimeToken, tokenBinding)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while creating one-time token for Runtime with authID %s", authID)
 		return &externalschema.Token{}, errors.Wrap(err, "Failed to create one-time token for Runtime")
 	}
 
-	log.C(ctx).Infof("One-time token generated successfully for Runtime with authID %s", authID)
+	log.C(ctx).Infof("One-time token generated successfully for Runtime with authID %s in tenant %s requested by %s", authID, tokenBinding.Tenant, tokenBinding.CreatedBy)
 	return &externalschema.Token{Token: token}, nil
 }
 
 func (r *tokenResolver) IsHealthy(_ context.Context) (bool, error) {
 	return true, nil
 }
+
+func toTokenBinding(input *internalschema.TokenBindingInput) tokens.Binding {
+	if input == nil {
+		return tokens.Binding{}
+	}
+
+	binding := tokens.Binding{
+		AllowedSourceCIDRs: input.AllowedSourceCIDRs,
+	}
+	if input.Tenant != nil {
+		binding.Tenant = *input.Tenant
+	}
+	if input.CreatedBy != nil {
+		binding.CreatedBy = *input.CreatedBy
+	}
+
+	return binding
+}
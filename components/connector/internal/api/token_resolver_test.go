@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/internalschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -24,12 +25,12 @@ func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 	t.Run("should generate Application token", func(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", mock.Anything, appAuthId, tokens.ApplicationToken).Return(token, nil)
+		tokenSvc.On("CreateToken", mock.Anything, appAuthId, tokens.ApplicationToken, tokens.Binding{}).Return(token, nil)
 
 		tokenResolver := NewTokenResolver(tokenSvc)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appAuthId)
+		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appAuthId, nil)
 
 		// then
 		require.NoError(t, err)
@@ -40,12 +41,12 @@ func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 	t.Run("should return error when failed generate Application token", func(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", mock.Anything, appAuthId, tokens.ApplicationToken).Return("", apperrors.Internal("error"))
+		tokenSvc.On("CreateToken", mock.Anything, appAuthId, tokens.ApplicationToken, tokens.Binding{}).Return("", apperrors.Internal("error"))
 
 		tokenResolver := NewTokenResolver(tokenSvc)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appAuthId)
+		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appAuthId, nil)
 
 		// then
 		require.Error(t, err)
@@ -60,12 +61,32 @@ func TestTokenResolver_GenerateRuntimeToken(t *testing.T) {
 	t.Run("should generate Runtime token", func(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken).Return(token, nil)
+		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken, tokens.Binding{}).Return(token, nil)
 
 		tokenResolver := NewTokenResolver(tokenSvc)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId)
+		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, token, generatedToken.Token)
+		mock.AssertExpectationsForObjects(t, tokenSvc)
+	})
+
+	t.Run("should generate Runtime token with binding", func(t *testing.T) {
+		// given
+		tenant := "tenant"
+		createdBy := "admin"
+		binding := &internalschema.TokenBindingInput{Tenant: &tenant, CreatedBy: &createdBy, AllowedSourceCIDRs: []string{"10.0.0.0/8"}}
+
+		tokenSvc := &mocks.Service{}
+		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken, tokens.Binding{Tenant: tenant, CreatedBy: createdBy, AllowedSourceCIDRs: []string{"10.0.0.0/8"}}).Return(token, nil)
+
+		tokenResolver := NewTokenResolver(tokenSvc)
+
+		// when
+		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId, binding)
 
 		// then
 		require.NoError(t, err)
@@ -76,12 +97,12 @@ func TestTokenResolver_GenerateRuntimeToken(t *testing.T) {
 	t.Run("should return error when failed generate Runtime token", func(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken).Return("", apperrors.Internal("error"))
+		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken, tokens.Binding{}).Return("", apperrors.Internal("error"))
 
 		tokenResolver := NewTokenResolver(tokenSvc)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId)
+		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId, nil)
 
 		// then
 		require.Error(t, err)
@@ -10,6 +10,7 @@ const (
 	CodeUpstreamServerCallFailed = 5
 	CodeForbidden                = 5
 	CodeBadRequest               = 6
+	CodeTooManyRequests          = 7
 )
 
 type AppError interface {
@@ -55,6 +56,10 @@ func BadRequest(format string, a ...interface{}) AppError {
 	return errorf(CodeBadRequest, format, a...)
 }
 
+func TooManyRequests(format string, a ...interface{}) AppError {
+	return errorf(CodeTooManyRequests, format, a...)
+}
+
 func (ae appError) Append(additionalFormat string, a ...interface{}) AppError {
 	format := additionalFormat + ", " + ae.message
 	return errorf(ae.code, format, a...)
@@ -16,6 +16,7 @@ func TestAppError(t *testing.T) {
 		assert.Equal(t, CodeUpstreamServerCallFailed, UpstreamServerCallFailed("error").Code())
 		assert.Equal(t, CodeForbidden, Forbidden("error").Code())
 		assert.Equal(t, CodeBadRequest, BadRequest("error").Code())
+		assert.Equal(t, CodeTooManyRequests, TooManyRequests("error").Code())
 	})
 
 	t.Run("should create error with simple message", func(t *testing.T) {
@@ -26,6 +27,7 @@ func TestAppError(t *testing.T) {
 		assert.Equal(t, "error", UpstreamServerCallFailed("error").Error())
 		assert.Equal(t, "error", Forbidden("error").Error())
 		assert.Equal(t, "error", BadRequest("error").Error())
+		assert.Equal(t, "error", TooManyRequests("error").Error())
 	})
 
 	t.Run("should create error with formatted message", func(t *testing.T) {
@@ -36,6 +38,7 @@ func TestAppError(t *testing.T) {
 		assert.Equal(t, "code: 1, error: bug", UpstreamServerCallFailed("code: %d, error: %s", 1, "bug").Error())
 		assert.Equal(t, "code: 1, error: bug", Forbidden("code: %d, error: %s", 1, "bug").Error())
 		assert.Equal(t, "code: 1, error: bug", BadRequest("code: %d, error: %s", 1, "bug").Error())
+		assert.Equal(t, "code: 1, error: bug", TooManyRequests("code: %d, error: %s", 1, "bug").Error())
 
 	})
 
@@ -13,6 +13,10 @@ const (
 	ClientIdFromTokenKey       ContextKey = "ClientIdFromToken"
 	ClientIdFromCertificateKey ContextKey = "ClientIdFromCertificate"
 	ClientCertificateHashKey   ContextKey = "ClientCertificateHash"
+	ConsumerTypeKey            ContextKey = "ConsumerType"
+	RenewedCertificateHashKey  ContextKey = "RenewedCertificateHash"
+	SourceIPKey                ContextKey = "SourceIP"
+	TokenBindingKey            ContextKey = "TokenBinding"
 )
 
 func GetStringFromContext(ctx context.Context, key ContextKey) (string, error) {
@@ -24,6 +24,21 @@ func (acm *authContextMiddleware) PropagateAuthentication(handler http.Handler)
 		clientCertificateHash := r.Header.Get(oathkeeper.ClientCertificateHashHeader)
 		r = r.WithContext(PutIntoContext(r.Context(), ClientCertificateHashKey, clientCertificateHash))
 
+		consumerType := r.Header.Get(oathkeeper.ConsumerTypeFromCertificateHeader)
+		renewedCertificateHash := clientCertificateHash
+		tokenBinding := ""
+		if clientIdFromToken != "" {
+			consumerType = r.Header.Get(oathkeeper.ConsumerTypeFromTokenHeader)
+			renewedCertificateHash = r.Header.Get(oathkeeper.RenewedCertificateHashFromTokenHeader)
+			tokenBinding = r.Header.Get(oathkeeper.TokenBindingFromTokenHeader)
+		}
+		r = r.WithContext(PutIntoContext(r.Context(), ConsumerTypeKey, consumerType))
+		r = r.WithContext(PutIntoContext(r.Context(), RenewedCertificateHashKey, renewedCertificateHash))
+		r = r.WithContext(PutIntoContext(r.Context(), TokenBindingKey, tokenBinding))
+
+		sourceIP := r.Header.Get(oathkeeper.ClientSourceIPHeader)
+		r = r.WithContext(PutIntoContext(r.Context(), SourceIPKey, sourceIP))
+
 		handler.ServeHTTP(w, r)
 	})
 }
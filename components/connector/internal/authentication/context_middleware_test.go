@@ -29,6 +29,22 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, certHash, hash)
 
+			consumerType, err := GetStringFromContext(r.Context(), ConsumerTypeKey)
+			require.NoError(t, err)
+			assert.Equal(t, "Runtime", consumerType)
+
+			renewedCertificateHash, err := GetStringFromContext(r.Context(), RenewedCertificateHashKey)
+			require.NoError(t, err)
+			assert.Equal(t, "renewed-hash", renewedCertificateHash)
+
+			sourceIP, err := GetStringFromContext(r.Context(), SourceIPKey)
+			require.NoError(t, err)
+			assert.Equal(t, "192.168.0.1", sourceIP)
+
+			tokenBinding, err := GetStringFromContext(r.Context(), TokenBindingKey)
+			require.NoError(t, err)
+			assert.Equal(t, "binding", tokenBinding)
+
 			w.WriteHeader(http.StatusOK)
 		})
 
@@ -36,6 +52,35 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 		require.NoError(t, err)
 
 		request.Header.Add(oathkeeper.ClientIdFromTokenHeader, clientId)
+		request.Header.Add(oathkeeper.ClientIdFromCertificateHeader, clientId)
+		request.Header.Add(oathkeeper.ClientCertificateHashHeader, certHash)
+		request.Header.Add(oathkeeper.ConsumerTypeFromTokenHeader, "Runtime")
+		request.Header.Add(oathkeeper.ConsumerTypeFromCertificateHeader, "Application")
+		request.Header.Add(oathkeeper.RenewedCertificateHashFromTokenHeader, "renewed-hash")
+		request.Header.Add(oathkeeper.ClientSourceIPHeader, "192.168.0.1")
+		request.Header.Add(oathkeeper.TokenBindingFromTokenHeader, "binding")
+		rr := httptest.NewRecorder()
+
+		authContextMiddleware := NewAuthenticationContextMiddleware()
+
+		// when
+		handlerWithMiddleware := authContextMiddleware.PropagateAuthentication(handler)
+		handlerWithMiddleware.ServeHTTP(rr, request)
+	})
+
+	t.Run("should put hash of certificate used for authentication as renewed certificate hash", func(t *testing.T) {
+		// given
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			renewedCertificateHash, err := GetStringFromContext(r.Context(), RenewedCertificateHashKey)
+			require.NoError(t, err)
+			assert.Equal(t, certHash, renewedCertificateHash)
+
+			w.WriteHeader(http.StatusOK)
+		})
+
+		request, err := http.NewRequest(http.MethodGet, "", nil)
+		require.NoError(t, err)
+
 		request.Header.Add(oathkeeper.ClientIdFromCertificateHeader, clientId)
 		request.Header.Add(oathkeeper.ClientCertificateHashHeader, certHash)
 		rr := httptest.NewRecorder()
@@ -0,0 +1,73 @@
+package certificates
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+)
+
+// CommentExtensionOID is the OID of the Netscape comment extension, which is shown by common tools such as openssl
+var CommentExtensionOID = asn1.ObjectIdentifier{2, 16, 840, 1, 113730, 1, 13}
+
+// BindingExtension returns the non-critical comment extension describing the context the token of the certificate was issued in,
+// so the certificate can be traced back to the tenant and the user which requested the token
+func BindingExtension(binding tokens.Binding) (pkix.Extension, apperrors.AppError) {
+	comment, err := json.Marshal(binding)
+	if err != nil {
+		return pkix.Extension{}, apperrors.Internal("Failed to marshal token binding: %s", err)
+	}
+
+	value, err := asn1.MarshalWithParams(escapeNonASCII(string(comment)), "ia5")
+	if err != nil {
+		return pkix.Extension{}, apperrors.Internal("Failed to marshal token binding extension: %s", err)
+	}
+
+	return pkix.Extension{Id: CommentExtensionOID, Value: value}, nil
+}
+
+// BindingFromExtensions returns the token binding embedded in the certificate extensions if there is one
+func BindingFromExtensions(extensions []pkix.Extension) (tokens.Binding, bool) {
+	for _, extension := range extensions {
+		if !extension.Id.Equal(CommentExtensionOID) {
+			continue
+		}
+
+		var comment string
+		if _, err := asn1.UnmarshalWithParams(extension.Value, &comment, "ia5"); err != nil {
+			return tokens.Binding{}, false
+		}
+
+		var binding tokens.Binding
+		if err := json.Unmarshal([]byte(comment), &binding); err != nil {
+			return tokens.Binding{}, false
+		}
+
+		return binding, true
+	}
+
+	return tokens.Binding{}, false
+}
+
+// escapeNonASCII escapes characters not allowed in IA5String in the marshalled JSON
+func escapeNonASCII(marshalled string) string {
+	var escaped strings.Builder
+	for _, r := range marshalled {
+		if r < utf8.RuneSelf {
+			escaped.WriteRune(r)
+			continue
+		}
+		if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+			escaped.WriteString(fmt.Sprintf(`\u%04x\u%04x`, r1, r2))
+			continue
+		}
+		escaped.WriteString(fmt.Sprintf(`\u%04x`, r))
+	}
+	return escaped.String()
+}
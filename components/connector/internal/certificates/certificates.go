@@ -16,19 +16,16 @@ type CertificateUtility interface {
 	LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError)
 	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
-	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	CheckCSRValues(csr *x509.CertificateRequest, commonName string, profile Profile) apperrors.AppError
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey, profile Profile) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
 }
 
 type certificateUtility struct {
-	certificateValidityTime time.Duration
 }
 
-func NewCertificateUtility(certificateValidityTime time.Duration) CertificateUtility {
-	return &certificateUtility{
-		certificateValidityTime: certificateValidityTime,
-	}
+func NewCertificateUtility() CertificateUtility {
+	return &certificateUtility{}
 }
 
 func (cu *certificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError) {
@@ -82,8 +79,10 @@ func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequ
 	return clientCSR, nil
 }
 
-func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError {
-	if csr.Subject.CommonName != subject.CommonName {
+func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, commonName string, profile Profile) apperrors.AppError {
+	subject := profile.Subject
+
+	if csr.Subject.CommonName != commonName {
 		return apperrors.WrongInput("CSR: Invalid common name provided.")
 	}
 
@@ -99,9 +98,11 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 		return apperrors.WrongInput("CSR: Invalid organization provided.")
 	}
 
-	if csr.Subject.OrganizationalUnit == nil {
+	// renewed certificates may be requested with the subject of the previous certificate, which carries the consumer type
+	organizationalUnits, _ := SplitOrganizationalUnits(csr.Subject.OrganizationalUnit)
+	if len(organizationalUnits) == 0 {
 		return apperrors.WrongInput("CSR: No organizational unit provided.")
-	} else if csr.Subject.OrganizationalUnit[0] != subject.OrganizationalUnit {
+	} else if organizationalUnits[0] != subject.OrganizationalUnit {
 		return apperrors.WrongInput("CSR: Invalid organizational unit provided.")
 	}
 
@@ -116,11 +117,12 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	} else if csr.Subject.Province[0] != subject.Province {
 		return apperrors.WrongInput("CSR: Invalid province provided.")
 	}
-	return nil
+
+	return profile.SANRules.Check(csr)
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey, profile Profile) ([]byte, apperrors.AppError) {
+	clientCRTTemplate := cu.prepareCRTTemplate(csr, profile)
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,16 +132,29 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, profile Profile) x509.Certificate {
+	// the Organizational Units are set by the Connector, so that the consumer type cannot be requested in the CSR
+	subject := csr.Subject
+	subject.OrganizationalUnit = []string{profile.Subject.OrganizationalUnit}
+	if profile.ConsumerType != "" {
+		subject.OrganizationalUnit = append(subject.OrganizationalUnit, ConsumerTypeOU(profile.ConsumerType))
+	}
+
 	return x509.Certificate{
 		SignatureAlgorithm: csr.SignatureAlgorithm,
 
-		SerialNumber: big.NewInt(2),
-		Subject:      csr.Subject,
-		NotBefore:    time.Now(),
-		NotAfter:     time.Now().Add(cu.certificateValidityTime),
-		KeyUsage:     x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		SerialNumber:   big.NewInt(2),
+		Subject:        subject,
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(profile.Validity),
+		KeyUsage:       profile.KeyUsage,
+		ExtKeyUsage:    profile.ExtKeyUsage,
+		DNSNames:       csr.DNSNames,
+		IPAddresses:    csr.IPAddresses,
+		EmailAddresses: csr.EmailAddresses,
+		URIs:           csr.URIs,
+
+		ExtraExtensions: profile.ExtraExtensions,
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -229,7 +230,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should load cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedCert)
@@ -248,7 +249,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail decoding cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadCert([]byte("invalid data"))
@@ -261,7 +262,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail parsing cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedInvalidCert)
@@ -277,7 +278,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load RSA key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedRSAKey)
@@ -289,7 +290,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedKey)
@@ -301,7 +302,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadKey([]byte("invalid data"))
@@ -314,7 +315,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail parsing key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadKey(encodedInvalidKey)
@@ -330,7 +331,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should load CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		key, err := certificateUtility.LoadCSR([]byte(CSR))
@@ -342,7 +343,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte("aW52YWxpZCBkYXRh"))
@@ -355,7 +356,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail parsing CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte(invalidCSR))
@@ -382,9 +383,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should successfully check CSR values", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "cname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "country",
 				Organization:       "organization",
 				OrganizationalUnit: "organizationalUnit",
@@ -393,31 +394,54 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.NoError(t, err)
 	})
 
-	t.Run("should fail when subject country is nil", func(t *testing.T) {
+	t.Run("should successfully check CSR values with consumer type of renewed certificate", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
+		renewalCSR := &x509.CertificateRequest{Subject: csr.Subject}
+		renewalCSR.Subject.OrganizationalUnit = []string{ConsumerTypeOU(tokens.RuntimeToken), "organizationalUnit"}
+
+		profile := Profile{
+			Subject: CSRSubjectConsts{
+				Country:            "country",
+				Organization:       "organization",
+				OrganizationalUnit: "organizationalUnit",
+				Locality:           "locality",
+				Province:           "province",
+			},
 		}
 
+		certificateUtility := NewCertificateUtility()
+
+		// when
+		err := certificateUtility.CheckCSRValues(renewalCSR, "cname", profile)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail when subject country is nil", func(t *testing.T) {
+		// given
+		commonName := "cname"
+		profile := Profile{}
+
 		csr := &x509.CertificateRequest{
 			Subject: pkix.Name{
 				CommonName: "cname",
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -427,9 +451,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should fail when CommonName differs", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "differentCname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "differentCname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "country",
 				Organization:       "organization",
 				OrganizationalUnit: "organizationalUnit",
@@ -438,10 +462,10 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -451,9 +475,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should fail when Country differs", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "cname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "invalidCountry",
 				Organization:       "organization",
 				OrganizationalUnit: "organizationalUnit",
@@ -462,10 +486,10 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -476,9 +500,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should fail when organization differs", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "cname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "country",
 				Organization:       "invalidOrganization",
 				OrganizationalUnit: "organizationalUnit",
@@ -487,10 +511,10 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -500,9 +524,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should fail when OrganizationalUnit differs", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "cname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "country",
 				Organization:       "organization",
 				OrganizationalUnit: "invalidOrganizationalUnit",
@@ -511,10 +535,10 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -524,9 +548,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should fail when Locality differs", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "cname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "country",
 				Organization:       "organization",
 				OrganizationalUnit: "organizationalUnit",
@@ -535,10 +559,10 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -548,9 +572,9 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 
 	t.Run("should fail when Province differs", func(t *testing.T) {
 		// given
-		csrSubject := CSRSubject{
-			CommonName: "cname",
-			CSRSubjectConsts: CSRSubjectConsts{
+		commonName := "cname"
+		profile := Profile{
+			Subject: CSRSubjectConsts{
 				Country:            "country",
 				Organization:       "organization",
 				OrganizationalUnit: "organizationalUnit",
@@ -559,10 +583,10 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		err := certificateUtility.CheckCSRValues(csr, csrSubject)
+		err := certificateUtility.CheckCSRValues(csr, commonName, profile)
 
 		// then
 		require.Error(t, err)
@@ -571,15 +595,75 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 	})
 }
 
+func TestCertificateUtility_CheckCSRValues_SANRules(t *testing.T) {
+
+	subject := CSRSubjectConsts{
+		Country:            "country",
+		Organization:       "organization",
+		OrganizationalUnit: "organizationalUnit",
+		Locality:           "locality",
+		Province:           "province",
+	}
+
+	csr := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:         "cname",
+			Country:            []string{"country"},
+			Organization:       []string{"organization"},
+			OrganizationalUnit: []string{"organizationalUnit"},
+			Locality:           []string{"locality"},
+			Province:           []string{"province"},
+		},
+		DNSNames: []string{"runtime.cluster.local"},
+	}
+
+	t.Run("should accept DNS name matching the profile pattern", func(t *testing.T) {
+		// given
+		profile := Profile{
+			Subject:  subject,
+			SANRules: SANRules{DNSNamePatterns: []string{"*.cluster.local"}},
+		}
+
+		certificateUtility := NewCertificateUtility()
+
+		// when
+		err := certificateUtility.CheckCSRValues(csr, "cname", profile)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail when DNS name is not allowed by the profile", func(t *testing.T) {
+		// given
+		profile := Profile{
+			Subject: subject,
+		}
+
+		certificateUtility := NewCertificateUtility()
+
+		// when
+		err := certificateUtility.CheckCSRValues(csr, "cname", profile)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		assert.Contains(t, err.Error(), "DNS name runtime.cluster.local is not allowed")
+	})
+}
+
 func TestCertificateUtility_SignCSR(t *testing.T) {
 
 	t.Run("should sign client certificate", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
 
 		// when
-		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, Profile{
+			Validity:    validityTime,
+			KeyUsage:    x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
 
 		//then
 		require.NoError(t, apperr)
@@ -590,6 +674,62 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 		certificateValidityTime := calculateValidityTime(decodedCrt)
 		assert.Equal(t, validityTime, certificateValidityTime)
+		assert.Equal(t, x509.KeyUsageDigitalSignature, decodedCrt.KeyUsage)
+		assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, decodedCrt.ExtKeyUsage)
+	})
+
+	t.Run("should sign client certificate with token binding", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility()
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+
+		binding := tokens.Binding{Tenant: "tenant", CreatedBy: "zoë", CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+		extension, apperr := BindingExtension(binding)
+		require.NoError(t, apperr)
+
+		profile := Profile{
+			Validity:    validityTime,
+			KeyUsage:    x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, profile.WithExtensions(extension))
+
+		//then
+		require.NoError(t, apperr)
+		assert.Empty(t, profile.ExtraExtensions)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+
+		embeddedBinding, found := BindingFromExtensions(decodedCrt.Extensions)
+		require.True(t, found)
+		assert.Equal(t, binding, embeddedBinding)
+	})
+
+	t.Run("should sign client certificate with consumer type of profile", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility()
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+		csr.Subject.OrganizationalUnit = []string{"OrgUnit", ConsumerTypeOU(tokens.ApplicationToken)}
+
+		profiles := NewProfiles(Profile{}).Add(tokens.RuntimeToken, Profile{
+			Subject:     CSRSubjectConsts{OrganizationalUnit: "OrgUnit"},
+			Validity:    validityTime,
+			KeyUsage:    x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, profiles.ForType(tokens.RuntimeToken))
+
+		//then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"OrgUnit", ConsumerTypeOU(tokens.RuntimeToken)}, decodedCrt.Subject.OrganizationalUnit)
 	})
 
 	t.Run("should return when failed to create certificate", func(t *testing.T) {
@@ -598,10 +738,10 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		csr := &x509.CertificateRequest{}
 		key := &rsa.PrivateKey{}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 
 		// when
-		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key, Profile{})
 
 		// then
 		require.Error(t, err)
@@ -615,7 +755,7 @@ func TestCertificateUtility_AddCertificateHeaderAndFooter(t *testing.T) {
 
 	t.Run("should add certificate header and footer", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility()
 		certificate, apperr := certificateUtility.LoadCert([]byte(cert))
 		require.NoError(t, apperr)
 
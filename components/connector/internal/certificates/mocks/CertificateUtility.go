@@ -34,13 +34,13 @@ func (_m *CertificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byt
 	return r0
 }
 
-// CheckCSRValues provides a mock function with given fields: csr, subject
-func (_m *CertificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subject certificates.CSRSubject) apperrors.AppError {
-	ret := _m.Called(csr, subject)
+// CheckCSRValues provides a mock function with given fields: csr, commonName, profile
+func (_m *CertificateUtility) CheckCSRValues(csr *x509.CertificateRequest, commonName string, profile certificates.Profile) apperrors.AppError {
+	ret := _m.Called(csr, commonName, profile)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(*x509.CertificateRequest, certificates.CSRSubject) apperrors.AppError); ok {
-		r0 = rf(csr, subject)
+	if rf, ok := ret.Get(0).(func(*x509.CertificateRequest, string, certificates.Profile) apperrors.AppError); ok {
+		r0 = rf(csr, commonName, profile)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -125,13 +125,13 @@ func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 	return r0, r1
 }
 
-// SignCSR provides a mock function with given fields: caCrt, csr, caKey
-func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	ret := _m.Called(caCrt, csr, caKey)
+// SignCSR provides a mock function with given fields: caCrt, csr, caKey, profile
+func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey, profile certificates.Profile) ([]byte, apperrors.AppError) {
+	ret := _m.Called(caCrt, csr, caKey, profile)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) []byte); ok {
-		r0 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey, certificates.Profile) []byte); ok {
+		r0 = rf(caCrt, csr, caKey, profile)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -139,8 +139,8 @@ func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) apperrors.AppError); ok {
-		r1 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey, certificates.Profile) apperrors.AppError); ok {
+		r1 = rf(caCrt, csr, caKey, profile)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
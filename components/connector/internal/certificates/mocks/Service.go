@@ -16,20 +16,20 @@ type Service struct {
 	mock.Mock
 }
 
-// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject
-func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject) (certificates.EncodedCertificateChain, apperrors.AppError) {
-	ret := _m.Called(ctx, encodedCSR, subject)
+// SignCSR provides a mock function with given fields: ctx, encodedCSR, commonName, profile
+func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, commonName string, profile certificates.Profile) (certificates.EncodedCertificateChain, apperrors.AppError) {
+	ret := _m.Called(ctx, encodedCSR, commonName, profile)
 
 	var r0 certificates.EncodedCertificateChain
-	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject) certificates.EncodedCertificateChain); ok {
-		r0 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, certificates.Profile) certificates.EncodedCertificateChain); ok {
+		r0 = rf(ctx, encodedCSR, commonName, profile)
 	} else {
 		r0 = ret.Get(0).(certificates.EncodedCertificateChain)
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject) apperrors.AppError); ok {
-		r1 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string, certificates.Profile) apperrors.AppError); ok {
+		r1 = rf(ctx, encodedCSR, commonName, profile)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -3,14 +3,10 @@ package certificates
 import (
 	"fmt"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 )
 
-type CSRSubject struct {
-	CommonName string
-	CSRSubjectConsts
-}
-
 type CSRSubjectConsts struct {
 	Country            string
 	Organization       string
@@ -23,10 +19,19 @@ func (s CSRSubjectConsts) ToString(commonName string) string {
 	return fmt.Sprintf("O=%s,OU=%s,L=%s,ST=%s,C=%s,CN=%s", s.Organization, s.OrganizationalUnit, s.Locality, s.Province, s.Country, commonName)
 }
 
+// CertificateData describes a client certificate presented by the consumer
+type CertificateData struct {
+	CommonName   string
+	Hash         string
+	ConsumerType tokens.TokenType
+}
+
 type EncodedCertificateChain struct {
 	CertificateChain  string
 	ClientCertificate string
 	CaCertificate     string
+	// ClientCertificateHash is the hex encoded SHA-256 of the client certificate, as passed in the certificate header
+	ClientCertificateHash string
 }
 
 func ToCertificationResult(encodedChain EncodedCertificateChain) externalschema.CertificationResult {
@@ -0,0 +1,190 @@
+package certificates
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+)
+
+// ConsumerTypeOUPrefix starts the Organizational Unit which the issued certificates carry in addition to the one of the profile
+// subject, so that the consumer type does not have to be inferred from the subject, which may be shared by several profiles
+const ConsumerTypeOUPrefix = "ConsumerType:"
+
+// Profile describes the certificates issued for one consumer type
+type Profile struct {
+	// ConsumerType is set by Profiles.Add, the default profile issues certificates without a consumer type
+	ConsumerType tokens.TokenType
+	Subject      CSRSubjectConsts
+	Validity     time.Duration
+	KeyUsage     x509.KeyUsage
+	ExtKeyUsage  []x509.ExtKeyUsage
+	SANRules     SANRules
+	// ExtraExtensions are added to the issued certificates, such as the binding of the token the certificate was requested with
+	ExtraExtensions []pkix.Extension
+}
+
+// SANRules describes which Subject Alternative Names a CSR may request.
+// SANs which are not allowed cause the CSR to be rejected.
+type SANRules struct {
+	DNSNamePatterns     []string
+	AllowIPAddresses    bool
+	AllowEmailAddresses bool
+	AllowURIs           bool
+}
+
+// WithExtensions returns a copy of the profile issuing certificates with given extensions
+func (p Profile) WithExtensions(extensions ...pkix.Extension) Profile {
+	extraExtensions := make([]pkix.Extension, 0, len(p.ExtraExtensions)+len(extensions))
+	p.ExtraExtensions = append(append(extraExtensions, p.ExtraExtensions...), extensions...)
+	return p
+}
+
+type Profiles struct {
+	defaultProfile Profile
+	types          []tokens.TokenType
+	profiles       map[tokens.TokenType]Profile
+}
+
+func NewProfiles(defaultProfile Profile) *Profiles {
+	return &Profiles{
+		defaultProfile: defaultProfile,
+		profiles:       map[tokens.TokenType]Profile{},
+	}
+}
+
+func (p *Profiles) Add(consumerType tokens.TokenType, profile Profile) *Profiles {
+	if _, exists := p.profiles[consumerType]; !exists {
+		p.types = append(p.types, consumerType)
+	}
+	profile.ConsumerType = consumerType
+	p.profiles[consumerType] = profile
+	return p
+}
+
+// ForType returns the profile of given consumer type, or the default one if no profile is configured for it
+func (p *Profiles) ForType(consumerType tokens.TokenType) Profile {
+	if profile, found := p.profiles[consumerType]; found {
+		return profile
+	}
+	return p.defaultProfile
+}
+
+// MatchSubject returns the consumer type of the only profile whose subject matches the given one.
+// Certificates whose subject fits more than one profile, or only the default one, are matched with an empty type.
+// It is used only for the certificates issued before the consumer type was added to their Organizational Units.
+func (p *Profiles) MatchSubject(matches func(subject CSRSubjectConsts) bool) (tokens.TokenType, bool) {
+	var matchedType tokens.TokenType
+	matchedCount := 0
+	for _, consumerType := range p.types {
+		if matches(p.profiles[consumerType].Subject) {
+			matchedType = consumerType
+			matchedCount++
+		}
+	}
+
+	if matchedCount == 1 {
+		return matchedType, true
+	}
+
+	if matchedCount > 1 || matches(p.defaultProfile.Subject) {
+		return "", true
+	}
+
+	return "", false
+}
+
+// ConsumerTypeOU returns the Organizational Unit carrying the consumer type
+func ConsumerTypeOU(consumerType tokens.TokenType) string {
+	return ConsumerTypeOUPrefix + string(consumerType)
+}
+
+// SplitOrganizationalUnits separates the Organizational Unit carrying the consumer type from the other ones
+func SplitOrganizationalUnits(units []string) ([]string, tokens.TokenType) {
+	var consumerType tokens.TokenType
+	others := make([]string, 0, len(units))
+	for _, unit := range units {
+		if strings.HasPrefix(unit, ConsumerTypeOUPrefix) {
+			consumerType = tokens.TokenType(strings.TrimPrefix(unit, ConsumerTypeOUPrefix))
+			continue
+		}
+		others = append(others, unit)
+	}
+	return others, consumerType
+}
+
+func (r SANRules) Check(csr *x509.CertificateRequest) apperrors.AppError {
+	for _, dnsName := range csr.DNSNames {
+		if !r.isDNSNameAllowed(dnsName) {
+			return apperrors.WrongInput("CSR: DNS name %s is not allowed.", dnsName)
+		}
+	}
+
+	if len(csr.IPAddresses) > 0 && !r.AllowIPAddresses {
+		return apperrors.WrongInput("CSR: IP addresses are not allowed.")
+	}
+
+	if len(csr.EmailAddresses) > 0 && !r.AllowEmailAddresses {
+		return apperrors.WrongInput("CSR: Email addresses are not allowed.")
+	}
+
+	if len(csr.URIs) > 0 && !r.AllowURIs {
+		return apperrors.WrongInput("CSR: URIs are not allowed.")
+	}
+
+	return nil
+}
+
+func (r SANRules) isDNSNameAllowed(dnsName string) bool {
+	for _, pattern := range r.DNSNamePatterns {
+		if matched, err := path.Match(pattern, dnsName); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+var keyUsages = map[string]x509.KeyUsage{
+	"digitalsignature":  x509.KeyUsageDigitalSignature,
+	"contentcommitment": x509.KeyUsageContentCommitment,
+	"keyencipherment":   x509.KeyUsageKeyEncipherment,
+	"dataencipherment":  x509.KeyUsageDataEncipherment,
+	"keyagreement":      x509.KeyUsageKeyAgreement,
+}
+
+var extKeyUsages = map[string]x509.ExtKeyUsage{
+	"clientauth":      x509.ExtKeyUsageClientAuth,
+	"serverauth":      x509.ExtKeyUsageServerAuth,
+	"codesigning":     x509.ExtKeyUsageCodeSigning,
+	"emailprotection": x509.ExtKeyUsageEmailProtection,
+}
+
+// ParseKeyUsage converts key usage names, such as "digitalSignature" or "keyEncipherment", to x509.KeyUsage
+func ParseKeyUsage(names []string) (x509.KeyUsage, apperrors.AppError) {
+	var usage x509.KeyUsage
+	for _, name := range names {
+		parsed, found := keyUsages[strings.ToLower(strings.TrimSpace(name))]
+		if !found {
+			return 0, apperrors.Internal("Unknown key usage %s", name)
+		}
+		usage |= parsed
+	}
+	return usage, nil
+}
+
+// ParseExtKeyUsage converts extended key usage names, such as "clientAuth" or "serverAuth", to x509.ExtKeyUsage
+func ParseExtKeyUsage(names []string) ([]x509.ExtKeyUsage, apperrors.AppError) {
+	usages := make([]x509.ExtKeyUsage, 0, len(names))
+	for _, name := range names {
+		parsed, found := extKeyUsages[strings.ToLower(strings.TrimSpace(name))]
+		if !found {
+			return nil, apperrors.Internal("Unknown extended key usage %s", name)
+		}
+		usages = append(usages, parsed)
+	}
+	return usages, nil
+}
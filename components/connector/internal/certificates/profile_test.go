@@ -0,0 +1,136 @@
+package certificates
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiles_ForType(t *testing.T) {
+	defaultProfile := Profile{Validity: 2160 * time.Hour}
+	runtimeProfile := Profile{Validity: 720 * time.Hour}
+
+	profiles := NewProfiles(defaultProfile).Add(tokens.RuntimeToken, runtimeProfile)
+
+	t.Run("should return profile of consumer type", func(t *testing.T) {
+		expected := runtimeProfile
+		expected.ConsumerType = tokens.RuntimeToken
+		assert.Equal(t, expected, profiles.ForType(tokens.RuntimeToken))
+	})
+
+	t.Run("should return default profile if consumer type has no profile", func(t *testing.T) {
+		assert.Equal(t, defaultProfile, profiles.ForType(tokens.ApplicationToken))
+		assert.Equal(t, defaultProfile, profiles.ForType(""))
+	})
+}
+
+func TestProfiles_MatchSubject(t *testing.T) {
+	defaultSubject := CSRSubjectConsts{OrganizationalUnit: "OrgUnit"}
+	runtimeSubject := CSRSubjectConsts{OrganizationalUnit: "Runtimes"}
+
+	matching := func(expected CSRSubjectConsts) func(CSRSubjectConsts) bool {
+		return func(subject CSRSubjectConsts) bool {
+			return subject == expected
+		}
+	}
+
+	t.Run("should match consumer type by subject", func(t *testing.T) {
+		// given
+		profiles := NewProfiles(Profile{Subject: defaultSubject}).
+			Add(tokens.RuntimeToken, Profile{Subject: runtimeSubject}).
+			Add(tokens.ApplicationToken, Profile{Subject: defaultSubject})
+
+		// when
+		consumerType, found := profiles.MatchSubject(matching(runtimeSubject))
+
+		// then
+		require.True(t, found)
+		assert.Equal(t, tokens.RuntimeToken, consumerType)
+	})
+
+	t.Run("should match without consumer type if subject is ambiguous", func(t *testing.T) {
+		// given
+		profiles := NewProfiles(Profile{Subject: defaultSubject}).
+			Add(tokens.RuntimeToken, Profile{Subject: defaultSubject}).
+			Add(tokens.ApplicationToken, Profile{Subject: defaultSubject})
+
+		// when
+		consumerType, found := profiles.MatchSubject(matching(defaultSubject))
+
+		// then
+		require.True(t, found)
+		assert.Empty(t, consumerType)
+	})
+
+	t.Run("should not match if no profile has the subject", func(t *testing.T) {
+		// given
+		profiles := NewProfiles(Profile{Subject: defaultSubject})
+
+		// when
+		_, found := profiles.MatchSubject(matching(runtimeSubject))
+
+		// then
+		require.False(t, found)
+	})
+}
+
+func TestSplitOrganizationalUnits(t *testing.T) {
+
+	t.Run("should separate consumer type from other organizational units", func(t *testing.T) {
+		units, consumerType := SplitOrganizationalUnits([]string{ConsumerTypeOU(tokens.RuntimeToken), "OrgUnit"})
+
+		assert.Equal(t, []string{"OrgUnit"}, units)
+		assert.Equal(t, tokens.RuntimeToken, consumerType)
+	})
+
+	t.Run("should return empty consumer type if there is none", func(t *testing.T) {
+		units, consumerType := SplitOrganizationalUnits([]string{"OrgUnit"})
+
+		assert.Equal(t, []string{"OrgUnit"}, units)
+		assert.Empty(t, consumerType)
+	})
+}
+
+func TestParseKeyUsage(t *testing.T) {
+
+	t.Run("should parse key usages", func(t *testing.T) {
+		// when
+		usage, err := ParseKeyUsage([]string{"digitalSignature", " keyEncipherment"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, usage)
+	})
+
+	t.Run("should fail on unknown key usage", func(t *testing.T) {
+		// when
+		_, err := ParseKeyUsage([]string{"unknown"})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestParseExtKeyUsage(t *testing.T) {
+
+	t.Run("should parse extended key usages", func(t *testing.T) {
+		// when
+		usages, err := ParseExtKeyUsage([]string{"clientAuth", "serverAuth"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}, usages)
+	})
+
+	t.Run("should fail on unknown extended key usage", func(t *testing.T) {
+		// when
+		_, err := ParseExtKeyUsage([]string{"unknown"})
+
+		// then
+		require.Error(t, err)
+	})
+}
@@ -2,8 +2,10 @@ package certificates
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -12,9 +14,9 @@ import (
 
 //go:generate mockery -name=Service
 type Service interface {
-	// SignCSR takes encoded CSR, validates subject and generates Certificate based on CA stored in secret
+	// SignCSR takes encoded CSR, validates it against the profile and generates Certificate based on CA stored in secret
 	// returns base64 encoded certificate chain
-	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError)
+	SignCSR(ctx context.Context, encodedCSR []byte, commonName string, profile Profile) (EncodedCertificateChain, apperrors.AppError)
 }
 
 type certificateService struct {
@@ -44,31 +46,31 @@ func NewCertificateService(
 	}
 }
 
-func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError) {
+func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, commonName string, profile Profile) (EncodedCertificateChain, apperrors.AppError) {
 	csr, err := svc.certUtil.LoadCSR(encodedCSR)
 	if err != nil {
-		log.C(ctx).WithError(err).Errorf("Error occurred while loading the CSR with Common Name %s", subject.CommonName)
+		log.C(ctx).WithError(err).Errorf("Error occurred while loading the CSR with Common Name %s", commonName)
 		return EncodedCertificateChain{}, err
 	}
-	log.C(ctx).Debugf("Successfully loaded the CSR with Common Name %s", subject.CommonName)
+	log.C(ctx).Debugf("Successfully loaded the CSR with Common Name %s", commonName)
 
-	err = svc.checkCSR(csr, subject)
+	err = svc.checkCSR(csr, commonName, profile)
 	if err != nil {
-		log.C(ctx).WithError(err).Errorf("Error occurred while checking the values of the CSR with Common Name %s", subject.CommonName)
+		log.C(ctx).WithError(err).Errorf("Error occurred while checking the values of the CSR with Common Name %s", commonName)
 		return EncodedCertificateChain{}, err
 	}
-	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s", subject.CommonName)
+	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s", commonName)
 
-	encodedCertChain, err := svc.signCSR(csr)
+	encodedCertChain, err := svc.signCSR(csr, profile)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
-	log.C(ctx).Debugf("Successfully signed CSR with Common Name %s", subject.CommonName)
+	log.C(ctx).Debugf("Successfully signed CSR with Common Name %s", commonName)
 
 	return encodedCertChain, nil
 }
 
-func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCertificateChain, apperrors.AppError) {
+func (svc *certificateService) signCSR(csr *x509.CertificateRequest, profile Profile) (EncodedCertificateChain, apperrors.AppError) {
 	secretData, err := svc.certsCache.Get(svc.caCertSecretName)
 	if err != nil {
 		return EncodedCertificateChain{}, err
@@ -84,7 +86,7 @@ func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCer
 		return EncodedCertificateChain{}, err
 	}
 
-	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey)
+	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey, profile)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
@@ -107,7 +109,10 @@ func (svc *certificateService) encodeCertificates(rawCaCertificate, rawClientCer
 
 	certChain := append(signedCrtBytes, caCrtBytes...)
 
-	return encodeCertificateBase64(certChain, signedCrtBytes, caCrtBytes), nil
+	encodedChain := encodeCertificateBase64(certChain, signedCrtBytes, caCrtBytes)
+	encodedChain.ClientCertificateHash = certificateHash(rawClientCertificate)
+
+	return encodedChain, nil
 }
 
 func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
@@ -124,8 +129,8 @@ func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
 	return svc.certUtil.AddCertificateHeaderAndFooter(rootCACrt.Raw), nil
 }
 
-func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject) apperrors.AppError {
-	return svc.certUtil.CheckCSRValues(csr, expectedSubject)
+func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, commonName string, profile Profile) apperrors.AppError {
+	return svc.certUtil.CheckCSRValues(csr, commonName, profile)
 }
 
 func encodeCertificateBase64(certChain, clientCRT, caCRT []byte) EncodedCertificateChain {
@@ -136,6 +141,11 @@ func encodeCertificateBase64(certChain, clientCRT, caCRT []byte) EncodedCertific
 	}
 }
 
+func certificateHash(rawCertificate []byte) string {
+	hash := sha256.Sum256(rawCertificate)
+	return hex.EncodeToString(hash[:])
+}
+
 func encodeStringBase64(bytes []byte) string {
 	return base64.StdEncoding.EncodeToString(bytes)
 }
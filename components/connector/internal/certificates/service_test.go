@@ -3,8 +3,10 @@ package certificates_test
 import (
 	"context"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
@@ -59,9 +61,8 @@ var (
 	caCRTBytes     = []byte("caCRTBytes")
 	certChain      = append(clientCRTBytes, caCRTBytes...)
 
-	subjectValues = certificates.CSRSubject{
-		CommonName: appName,
-		CSRSubjectConsts: certificates.CSRSubjectConsts{
+	profile = certificates.Profile{
+		Subject: certificates.CSRSubjectConsts{
 			Country:            country,
 			Organization:       organization,
 			OrganizationalUnit: organizationalUnit,
@@ -82,8 +83,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
@@ -97,7 +98,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.NoError(t, apperr)
@@ -111,6 +112,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, certChain, decodedChain)
 
+		clientCRTHash := sha256.Sum256(clientCRT)
+		assert.Equal(t, hex.EncodeToString(clientCRTHash[:]), encodedCertChain.ClientCertificateHash)
+
 		certUtils.AssertExpectations(t)
 	})
 
@@ -127,8 +131,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			On("LoadCert", rootCaEncoded).Return(rootCACrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).Once().
 			On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
@@ -143,7 +147,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.NoError(t, apperr)
@@ -165,7 +169,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache := certificates.NewCertificateCache()
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(nil)
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
@@ -177,7 +181,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.Error(t, err)
@@ -203,7 +207,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.Error(t, err)
@@ -218,7 +222,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(apperrors.Forbidden("error"))
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(apperrors.Forbidden("error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
@@ -230,7 +234,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.Error(t, err)
@@ -246,7 +250,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
 
 		certificatesService := certificates.NewCertificateService(
@@ -259,7 +263,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.Error(t, err)
@@ -275,7 +279,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
 
@@ -289,7 +293,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.Error(t, err)
@@ -307,8 +311,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(nil, apperrors.Internal("error"))
+		certUtils.On("CheckCSRValues", csr, appName, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(nil, apperrors.Internal("error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
@@ -320,7 +324,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, appName, profile)
 
 		// then
 		require.Error(t, err)
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Collector struct {
+	rejectedAttemptsTotal *prometheus.CounterVec
+	failedAttemptsTotal   *prometheus.CounterVec
+	lockoutsTotal         *prometheus.CounterVec
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		rejectedAttemptsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: ConnectorSubsystem,
+			Name:      "rejected_attempts_total",
+			Help:      "Total attempts rejected by rate limits and lockouts",
+		}, []string{"operation", "limit", "reason"}),
+		failedAttemptsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: ConnectorSubsystem,
+			Name:      "failed_attempts_total",
+			Help:      "Total failed token redemption and certificate signing attempts",
+		}, []string{"operation"}),
+		lockoutsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: ConnectorSubsystem,
+			Name:      "lockouts_total",
+			Help:      "Total lockouts after repeated failed attempts",
+		}, []string{"limit"}),
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.rejectedAttemptsTotal.Describe(ch)
+	c.failedAttemptsTotal.Describe(ch)
+	c.lockoutsTotal.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.rejectedAttemptsTotal.Collect(ch)
+	c.failedAttemptsTotal.Collect(ch)
+	c.lockoutsTotal.Collect(ch)
+}
+
+func (c *Collector) RecordRejectedAttempt(operation, limit, reason string) {
+	c.rejectedAttemptsTotal.WithLabelValues(operation, limit, reason).Inc()
+}
+
+func (c *Collector) RecordFailedAttempt(operation string) {
+	c.failedAttemptsTotal.WithLabelValues(operation).Inc()
+}
+
+func (c *Collector) RecordLockout(limit string) {
+	c.lockoutsTotal.WithLabelValues(limit).Inc()
+}
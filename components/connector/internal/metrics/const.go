@@ -0,0 +1,6 @@
+package metrics
+
+const (
+	Namespace          = "compass"
+	ConnectorSubsystem = "connector"
+)
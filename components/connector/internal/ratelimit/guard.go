@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+type Operation string
+
+const (
+	TokenRedemption Operation = "token_redemption"
+	CSRSigning      Operation = "csr_signing"
+)
+
+const (
+	sourceIPLimit = "source_ip"
+	clientIdLimit = "client_id"
+)
+
+//go:generate mockery -name=MetricsCollector
+type MetricsCollector interface {
+	RecordRejectedAttempt(operation, limit, reason string)
+	RecordFailedAttempt(operation string)
+	RecordLockout(limit string)
+}
+
+//go:generate mockery -name=Guard
+type Guard interface {
+	// Allow registers an attempt of given operation and returns an error if the source IP or the client id
+	// exceeded its rate limit or is locked out. Empty source IP or client id is not checked.
+	Allow(ctx context.Context, operation Operation, sourceIP, clientId string) apperrors.AppError
+	// Failure registers a failed attempt of given operation, which can lock out the source IP or the client id
+	Failure(ctx context.Context, operation Operation, sourceIP, clientId string)
+	// Success resets the failed attempts of the source IP and the client id
+	Success(sourceIP, clientId string)
+}
+
+type guard struct {
+	sourceIPLimiter  Limiter
+	clientIdLimiter  Limiter
+	metricsCollector MetricsCollector
+}
+
+func NewGuard(sourceIPLimiter, clientIdLimiter Limiter, metricsCollector MetricsCollector) Guard {
+	return &guard{
+		sourceIPLimiter:  sourceIPLimiter,
+		clientIdLimiter:  clientIdLimiter,
+		metricsCollector: metricsCollector,
+	}
+}
+
+func (g *guard) Allow(ctx context.Context, operation Operation, sourceIP, clientId string) apperrors.AppError {
+	if sourceIP != "" {
+		if rejection, allowed := g.sourceIPLimiter.Allow(sourceIP); !allowed {
+			log.C(ctx).Warnf("Attempt of %s from source IP %s rejected: %s", operation, sourceIP, rejection)
+			g.metricsCollector.RecordRejectedAttempt(string(operation), sourceIPLimit, string(rejection))
+			return apperrors.TooManyRequests("Too many attempts, try again later")
+		}
+	}
+
+	if clientId != "" {
+		if rejection, allowed := g.clientIdLimiter.Allow(clientId); !allowed {
+			log.C(ctx).Warnf("Attempt of %s for client with id %s rejected: %s", operation, clientId, rejection)
+			g.metricsCollector.RecordRejectedAttempt(string(operation), clientIdLimit, string(rejection))
+			return apperrors.TooManyRequests("Too many attempts, try again later")
+		}
+	}
+
+	return nil
+}
+
+func (g *guard) Failure(ctx context.Context, operation Operation, sourceIP, clientId string) {
+	g.metricsCollector.RecordFailedAttempt(string(operation))
+
+	if sourceIP != "" && g.sourceIPLimiter.Failure(sourceIP) {
+		log.C(ctx).Warnf("Source IP %s locked out after repeated failed attempts of %s", sourceIP, operation)
+		g.metricsCollector.RecordLockout(sourceIPLimit)
+	}
+
+	if clientId != "" && g.clientIdLimiter.Failure(clientId) {
+		log.C(ctx).Warnf("Client with id %s locked out after repeated failed attempts of %s", clientId, operation)
+		g.metricsCollector.RecordLockout(clientIdLimit)
+	}
+}
+
+func (g *guard) Success(sourceIP, clientId string) {
+	if sourceIP != "" {
+		g.sourceIPLimiter.Success(sourceIP)
+	}
+
+	if clientId != "" {
+		g.clientIdLimiter.Success(clientId)
+	}
+}
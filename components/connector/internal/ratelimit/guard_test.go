@@ -0,0 +1,118 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sourceIP = "10.0.0.1"
+	clientId = "clientId"
+)
+
+func TestGuard_Allow(t *testing.T) {
+
+	t.Run("should allow attempt within limits", func(t *testing.T) {
+		// given
+		sourceIPLimiter := &mocks.Limiter{}
+		sourceIPLimiter.On("Allow", sourceIP).Return(ratelimit.Rejection(""), true)
+		clientIdLimiter := &mocks.Limiter{}
+		clientIdLimiter.On("Allow", clientId).Return(ratelimit.Rejection(""), true)
+		metricsCollector := &mocks.MetricsCollector{}
+
+		guard := ratelimit.NewGuard(sourceIPLimiter, clientIdLimiter, metricsCollector)
+
+		// when
+		err := guard.Allow(context.TODO(), ratelimit.CSRSigning, sourceIP, clientId)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, sourceIPLimiter, clientIdLimiter, metricsCollector)
+	})
+
+	t.Run("should reject attempt from rate limited source IP", func(t *testing.T) {
+		// given
+		sourceIPLimiter := &mocks.Limiter{}
+		sourceIPLimiter.On("Allow", sourceIP).Return(ratelimit.RateLimited, false)
+		clientIdLimiter := &mocks.Limiter{}
+		metricsCollector := &mocks.MetricsCollector{}
+		metricsCollector.On("RecordRejectedAttempt", "token_redemption", "source_ip", "rate_limited").Return()
+
+		guard := ratelimit.NewGuard(sourceIPLimiter, clientIdLimiter, metricsCollector)
+
+		// when
+		err := guard.Allow(context.TODO(), ratelimit.TokenRedemption, sourceIP, "")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeTooManyRequests, err.Code())
+		mock.AssertExpectationsForObjects(t, sourceIPLimiter, clientIdLimiter, metricsCollector)
+	})
+
+	t.Run("should reject attempt for locked out client id", func(t *testing.T) {
+		// given
+		sourceIPLimiter := &mocks.Limiter{}
+		clientIdLimiter := &mocks.Limiter{}
+		clientIdLimiter.On("Allow", clientId).Return(ratelimit.LockedOut, false)
+		metricsCollector := &mocks.MetricsCollector{}
+		metricsCollector.On("RecordRejectedAttempt", "csr_signing", "client_id", "locked_out").Return()
+
+		guard := ratelimit.NewGuard(sourceIPLimiter, clientIdLimiter, metricsCollector)
+
+		// when
+		err := guard.Allow(context.TODO(), ratelimit.CSRSigning, "", clientId)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeTooManyRequests, err.Code())
+		mock.AssertExpectationsForObjects(t, sourceIPLimiter, clientIdLimiter, metricsCollector)
+	})
+}
+
+func TestGuard_Failure(t *testing.T) {
+
+	t.Run("should record failure and lockout", func(t *testing.T) {
+		// given
+		sourceIPLimiter := &mocks.Limiter{}
+		sourceIPLimiter.On("Failure", sourceIP).Return(true)
+		clientIdLimiter := &mocks.Limiter{}
+		clientIdLimiter.On("Failure", clientId).Return(false)
+		metricsCollector := &mocks.MetricsCollector{}
+		metricsCollector.On("RecordFailedAttempt", "csr_signing").Return()
+		metricsCollector.On("RecordLockout", "source_ip").Return()
+
+		guard := ratelimit.NewGuard(sourceIPLimiter, clientIdLimiter, metricsCollector)
+
+		// when
+		guard.Failure(context.TODO(), ratelimit.CSRSigning, sourceIP, clientId)
+
+		// then
+		mock.AssertExpectationsForObjects(t, sourceIPLimiter, clientIdLimiter, metricsCollector)
+	})
+}
+
+func TestGuard_Success(t *testing.T) {
+
+	t.Run("should reset failures", func(t *testing.T) {
+		// given
+		sourceIPLimiter := &mocks.Limiter{}
+		sourceIPLimiter.On("Success", sourceIP).Return()
+		clientIdLimiter := &mocks.Limiter{}
+		clientIdLimiter.On("Success", clientId).Return()
+
+		guard := ratelimit.NewGuard(sourceIPLimiter, clientIdLimiter, &mocks.MetricsCollector{})
+
+		// when
+		guard.Success(sourceIP, clientId)
+
+		// then
+		mock.AssertExpectationsForObjects(t, sourceIPLimiter, clientIdLimiter)
+	})
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+const cleanupInterval = 1 * time.Minute
+
+// Config describes the limits applied to one kind of key, such as the source IP or the client id
+type Config struct {
+	// Attempts is the number of attempts allowed within Period. Zero disables the rate limit.
+	Attempts int
+	Period   time.Duration
+	// MaxFailures is the number of consecutive failed attempts after which the key is locked out. Zero disables the lockout.
+	MaxFailures     int
+	LockoutDuration time.Duration
+}
+
+type Rejection string
+
+const (
+	RateLimited Rejection = "rate_limited"
+	LockedOut   Rejection = "locked_out"
+)
+
+//go:generate mockery -name=Limiter
+type Limiter interface {
+	// Allow registers an attempt of given key and returns the reason of rejection if the attempt is not allowed
+	Allow(key string) (Rejection, bool)
+	// Failure registers a failed attempt of given key and returns true if the key has been locked out
+	Failure(key string) bool
+	// Success resets the failed attempts of given key
+	Success(key string)
+}
+
+type limiter struct {
+	config   Config
+	mutex    sync.Mutex
+	attempts *cache.Cache
+	failures *cache.Cache
+	lockouts *cache.Cache
+}
+
+func NewLimiter(config Config) Limiter {
+	return &limiter{
+		config:   config,
+		attempts: cache.New(config.Period, cleanupInterval),
+		failures: cache.New(config.LockoutDuration, cleanupInterval),
+		lockouts: cache.New(config.LockoutDuration, cleanupInterval),
+	}
+}
+
+func (l *limiter) Allow(key string) (Rejection, bool) {
+	if _, lockedOut := l.lockouts.Get(key); lockedOut {
+		return LockedOut, false
+	}
+
+	if l.config.Attempts <= 0 {
+		return "", true
+	}
+
+	if attempts := l.increment(l.attempts, key, l.config.Period); attempts > l.config.Attempts {
+		return RateLimited, false
+	}
+
+	return "", true
+}
+
+func (l *limiter) Failure(key string) bool {
+	if l.config.MaxFailures <= 0 {
+		return false
+	}
+
+	if failures := l.increment(l.failures, key, l.config.LockoutDuration); failures < l.config.MaxFailures {
+		return false
+	}
+
+	l.lockouts.Set(key, true, l.config.LockoutDuration)
+	l.failures.Delete(key)
+	return true
+}
+
+func (l *limiter) Success(key string) {
+	l.failures.Delete(key)
+}
+
+// increment counts the occurrences of given key within the window started by its first occurrence
+func (l *limiter) increment(counters *cache.Cache, key string, window time.Duration) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := counters.Add(key, 1, window); err == nil {
+		return 1
+	}
+
+	count, err := counters.IncrementInt(key, 1)
+	if err != nil {
+		// the window expired in the meantime
+		counters.Set(key, 1, window)
+		return 1
+	}
+
+	return count
+}
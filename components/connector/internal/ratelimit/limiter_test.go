@@ -0,0 +1,128 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+const key = "key"
+
+func TestLimiter_Allow(t *testing.T) {
+
+	t.Run("should allow attempts within rate limit", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{Attempts: 2, Period: time.Minute})
+
+		// when
+		_, firstAllowed := limiter.Allow(key)
+		_, secondAllowed := limiter.Allow(key)
+		rejection, thirdAllowed := limiter.Allow(key)
+
+		// then
+		assert.True(t, firstAllowed)
+		assert.True(t, secondAllowed)
+		assert.False(t, thirdAllowed)
+		assert.Equal(t, ratelimit.RateLimited, rejection)
+	})
+
+	t.Run("should count attempts of each key separately", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{Attempts: 1, Period: time.Minute})
+
+		// when
+		_, firstAllowed := limiter.Allow(key)
+		_, otherAllowed := limiter.Allow("other")
+
+		// then
+		assert.True(t, firstAllowed)
+		assert.True(t, otherAllowed)
+	})
+
+	t.Run("should allow attempts again after period", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{Attempts: 1, Period: 50 * time.Millisecond})
+		limiter.Allow(key)
+
+		// when
+		time.Sleep(100 * time.Millisecond)
+		_, allowed := limiter.Allow(key)
+
+		// then
+		assert.True(t, allowed)
+	})
+
+	t.Run("should not limit attempts if rate limit is disabled", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{})
+
+		// when
+		for i := 0; i < 100; i++ {
+			limiter.Allow(key)
+		}
+		_, allowed := limiter.Allow(key)
+
+		// then
+		assert.True(t, allowed)
+	})
+}
+
+func TestLimiter_Failure(t *testing.T) {
+
+	t.Run("should lock out key after max failures", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{MaxFailures: 2, LockoutDuration: time.Minute})
+
+		// when
+		firstLockedOut := limiter.Failure(key)
+		secondLockedOut := limiter.Failure(key)
+		rejection, allowed := limiter.Allow(key)
+
+		// then
+		assert.False(t, firstLockedOut)
+		assert.True(t, secondLockedOut)
+		assert.False(t, allowed)
+		assert.Equal(t, ratelimit.LockedOut, rejection)
+	})
+
+	t.Run("should reset failures on success", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{MaxFailures: 2, LockoutDuration: time.Minute})
+
+		// when
+		limiter.Failure(key)
+		limiter.Success(key)
+		lockedOut := limiter.Failure(key)
+
+		// then
+		assert.False(t, lockedOut)
+	})
+
+	t.Run("should allow attempts again after lockout", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{MaxFailures: 1, LockoutDuration: 50 * time.Millisecond})
+		limiter.Failure(key)
+
+		// when
+		time.Sleep(100 * time.Millisecond)
+		_, allowed := limiter.Allow(key)
+
+		// then
+		assert.True(t, allowed)
+	})
+
+	t.Run("should not lock out key if lockout is disabled", func(t *testing.T) {
+		// given
+		limiter := ratelimit.NewLimiter(ratelimit.Config{})
+
+		// when
+		lockedOut := limiter.Failure(key)
+		_, allowed := limiter.Allow(key)
+
+		// then
+		assert.False(t, lockedOut)
+		assert.True(t, allowed)
+	})
+}
@@ -0,0 +1,45 @@
+package renewal
+
+import (
+	"sync"
+)
+
+// Cache holds the renewal records of certificates by their hashes
+type Cache interface {
+	Put(hash string, certificate Certificate)
+	Delete(hash string)
+	Get(hash string) (Certificate, bool)
+}
+
+type certificateCache struct {
+	mutex        sync.RWMutex
+	certificates map[string]Certificate
+}
+
+func NewCache() Cache {
+	return &certificateCache{
+		certificates: map[string]Certificate{},
+	}
+}
+
+func (c *certificateCache) Put(hash string, certificate Certificate) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.certificates[hash] = certificate
+}
+
+func (c *certificateCache) Delete(hash string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.certificates, hash)
+}
+
+func (c *certificateCache) Get(hash string) (Certificate, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	certificate, found := c.certificates[hash]
+	return certificate, found
+}
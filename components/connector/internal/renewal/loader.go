@@ -0,0 +1,126 @@
+package renewal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const renewalRecordsLoaderCorrelationID = "renewal-records-loader"
+
+// recordsLoader keeps the cache in sync with the renewal records of all replicas. The records of expired certificates
+// are removed when they are loaded, all records are loaded again every time the watch is restarted.
+type recordsLoader struct {
+	cache             Cache
+	repository        Repository
+	configMapManager  Manager
+	namePrefix        string
+	reconnectInterval time.Duration
+}
+
+func NewLoader(cache Cache, repository Repository, configMapManager Manager, namePrefix string, reconnectInterval time.Duration) revocation.Loader {
+	return &recordsLoader{
+		cache:             cache,
+		repository:        repository,
+		configMapManager:  configMapManager,
+		namePrefix:        namePrefix,
+		reconnectInterval: reconnectInterval,
+	}
+}
+
+func (rl *recordsLoader) Run(ctx context.Context) {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, renewalRecordsLoaderCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	rl.startKubeWatch(ctx)
+}
+
+func (rl *recordsLoader) startKubeWatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping renewal records watcher...")
+			return
+		default:
+		}
+		log.C(ctx).Info("Starting watcher for renewal records changes...")
+		watcher, err := rl.configMapManager.Watch(metav1.ListOptions{
+			LabelSelector: recordLabel + "=" + rl.namePrefix,
+			Watch:         true,
+		})
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Could not initialize watcher. Sleep for %s and try again...", rl.reconnectInterval.String())
+			time.Sleep(rl.reconnectInterval)
+			continue
+		}
+		log.C(ctx).Info("Waiting for renewal records events...")
+
+		rl.processEvents(ctx, watcher.ResultChan())
+
+		// Cleanup any allocated resources
+		watcher.Stop()
+		time.Sleep(rl.reconnectInterval)
+	}
+}
+
+func (rl *recordsLoader) processEvents(ctx context.Context, events <-chan watch.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			switch ev.Type {
+			case watch.Added:
+				fallthrough
+			case watch.Modified:
+				configMap, ok := ev.Object.(*v1.ConfigMap)
+				if !ok {
+					log.C(ctx).Error("Unexpected error: object is not configmap. Try again")
+					continue
+				}
+				rl.load(ctx, configMap.Data)
+			case watch.Deleted:
+				configMap, ok := ev.Object.(*v1.ConfigMap)
+				if !ok {
+					log.C(ctx).Error("Unexpected error: object is not configmap. Try again")
+					continue
+				}
+				rl.cache.Delete(configMap.Data[hashKey])
+			case watch.Error:
+				log.C(ctx).Error("Error event is received, stop renewal records watcher and try again...")
+				return
+			}
+		}
+	}
+}
+
+func (rl *recordsLoader) load(ctx context.Context, data map[string]string) {
+	hash := data[hashKey]
+
+	var certificate Certificate
+	if err := json.Unmarshal([]byte(data[certificateKey]), &certificate); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to unmarshal renewal record of certificate with hash %s", hash)
+		return
+	}
+
+	if certificate.NotAfter.Before(time.Now()) {
+		log.C(ctx).Debugf("Removing renewal record of expired certificate with hash %s", hash)
+		if err := rl.repository.Delete(hash); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to remove renewal record of expired certificate with hash %s", hash)
+		}
+		return
+	}
+
+	rl.cache.Put(hash, certificate)
+}
@@ -0,0 +1,154 @@
+package renewal_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const namePrefix = "certificate-renewals"
+
+func prepLoader(ctx context.Context, repository renewal.Repository) (renewal.Cache, *testWatch, *mocks.Manager) {
+	cache := renewal.NewCache()
+	watcher := &testWatch{
+		events: make(chan watch.Event, 100),
+	}
+	managerMock := &mocks.Manager{}
+	managerMock.
+		On("Watch", metav1.ListOptions{LabelSelector: "connector.compass.kyma-project.io/certificate-renewal=" + namePrefix, Watch: true}).
+		Return(watcher, nil).
+		Once()
+	loader := renewal.NewLoader(cache, repository, managerMock, namePrefix, time.Millisecond)
+
+	go loader.Run(ctx)
+	return cache, watcher, managerMock
+}
+
+func TestLoader(t *testing.T) {
+
+	certificate := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(time.Hour).UTC().Truncate(time.Second)}
+
+	t.Run("should load record on add event", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache, watcher, managerMock := prepLoader(ctx, &mocks.Repository{})
+
+		// when
+		watcher.putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: fixRecord(t, hash, certificate),
+		})
+
+		// then
+		assert.Eventually(t, func() bool {
+			cached, found := cache.Get(hash)
+			return found && assert.ObjectsAreEqual(certificate, cached)
+		}, time.Second*2, time.Millisecond*100)
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should load record on modify event", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache, watcher, managerMock := prepLoader(ctx, &mocks.Repository{})
+
+		modified := certificate
+		modified.ReplacedBy = renewedHash
+
+		// when
+		watcher.putEvent(watch.Event{Type: watch.Added, Object: fixRecord(t, hash, certificate)})
+		watcher.putEvent(watch.Event{Type: watch.Modified, Object: fixRecord(t, hash, modified)})
+
+		// then
+		assert.Eventually(t, func() bool {
+			cached, found := cache.Get(hash)
+			return found && assert.ObjectsAreEqual(modified, cached)
+		}, time.Second*2, time.Millisecond*100)
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should remove record from cache on delete event", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache, watcher, managerMock := prepLoader(ctx, &mocks.Repository{})
+
+		watcher.putEvent(watch.Event{Type: watch.Added, Object: fixRecord(t, hash, certificate)})
+		assert.Eventually(t, func() bool {
+			_, found := cache.Get(hash)
+			return found
+		}, time.Second*2, time.Millisecond*100)
+
+		// when
+		watcher.putEvent(watch.Event{Type: watch.Deleted, Object: fixRecord(t, hash, certificate)})
+
+		// then
+		assert.Eventually(t, func() bool {
+			_, found := cache.Get(hash)
+			return !found
+		}, time.Second*2, time.Millisecond*100)
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should remove record of expired certificate", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		deleted := make(chan struct{})
+		repository := &mocks.Repository{}
+		repository.On("Delete", hash).Return(nil).Once().Run(func(mock.Arguments) { close(deleted) })
+		cache, watcher, managerMock := prepLoader(ctx, repository)
+
+		// when
+		watcher.putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: fixRecord(t, hash, renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(-time.Hour)}),
+		})
+
+		// then
+		select {
+		case <-deleted:
+		case <-time.After(time.Second * 2):
+			t.Fatal("record of expired certificate was not removed")
+		}
+		_, found := cache.Get(hash)
+		assert.False(t, found)
+		mock.AssertExpectationsForObjects(t, repository, managerMock)
+	})
+}
+
+func fixRecord(t *testing.T, hash string, certificate renewal.Certificate) *v1.ConfigMap {
+	marshalled, err := json.Marshal(certificate)
+	require.NoError(t, err)
+
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: namePrefix + "-" + hash},
+		Data:       map[string]string{"hash": hash, "certificate": string(marshalled)},
+	}
+}
+
+type testWatch struct {
+	events chan watch.Event
+}
+
+func (tw *testWatch) putEvent(ev watch.Event) {
+	tw.events <- ev
+}
+
+func (tw *testWatch) Stop() {}
+func (tw *testWatch) ResultChan() <-chan watch.Event {
+	return tw.events
+}
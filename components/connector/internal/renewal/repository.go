@@ -0,0 +1,135 @@
+package renewal
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
+)
+
+// Certificate describes a client certificate issued by the Connector
+type Certificate struct {
+	ClientId string    `json:"clientId"`
+	NotAfter time.Time `json:"notAfter"`
+	// Replaces is the hash of the certificate renewed with this one. It is revoked when this one is first used.
+	Replaces string `json:"replaces,omitempty"`
+	// ReplacedBy is the hash of the certificate this one was renewed with
+	ReplacedBy string `json:"replacedBy,omitempty"`
+	// RevokeAt is the end of the overlap period of a renewed certificate
+	RevokeAt time.Time `json:"revokeAt,omitempty"`
+	// Binding is the context the token used to request the first certificate of the client was issued in
+	Binding tokens.Binding `json:"binding"`
+}
+
+//go:generate mockery -name=Repository
+type Repository interface {
+	Get(hash string) (Certificate, bool)
+	Upsert(hash string, certificate Certificate) error
+	Delete(hash string) error
+}
+
+//go:generate mockery -name=Manager
+type Manager interface {
+	Get(name string, options metav1.GetOptions) (*v1.ConfigMap, error)
+	Create(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	Update(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+}
+
+const (
+	// recordLabel marks the config maps holding renewal records, its value is the name prefix of the records
+	recordLabel = "connector.compass.kyma-project.io/certificate-renewal"
+
+	hashKey        = "hash"
+	certificateKey = "certificate"
+)
+
+// repository keeps the renewal record of each certificate in its own config map, so the number of tracked
+// certificates is not limited by the size of a single config map. Records are removed once their certificate
+// expires or is revoked.
+type repository struct {
+	configMapManager Manager
+	namePrefix       string
+	cache            Cache
+}
+
+func NewRepository(configMapManager Manager, namePrefix string, cache Cache) Repository {
+	return &repository{
+		configMapManager: configMapManager,
+		namePrefix:       namePrefix,
+		cache:            cache,
+	}
+}
+
+func (r *repository) Get(hash string) (Certificate, bool) {
+	certificate, found := r.cache.Get(hash)
+	if !found || certificate.NotAfter.Before(time.Now()) {
+		return Certificate{}, false
+	}
+
+	return certificate, true
+}
+
+func (r *repository) Upsert(hash string, certificate Certificate) error {
+	value, err := json.Marshal(certificate)
+	if err != nil {
+		return err
+	}
+
+	name := recordName(r.namePrefix, hash)
+	data := map[string]string{
+		hashKey:        hash,
+		certificateKey: string(value),
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := r.configMapManager.Get(name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			_, err = r.configMapManager.Create(&v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{recordLabel: r.namePrefix},
+				},
+				Data: data,
+			})
+			if k8serrors.IsAlreadyExists(err) {
+				// the record was created by another replica in the meantime, it is updated on retry
+				return k8serrors.NewConflict(v1.Resource("configmaps"), name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		configMap.Data = data
+		_, err = r.configMapManager.Update(configMap)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// the loader refreshes the cache asynchronously, the update is visible to this replica right away
+	r.cache.Put(hash, certificate)
+	return nil
+}
+
+func (r *repository) Delete(hash string) error {
+	if err := r.configMapManager.Delete(recordName(r.namePrefix, hash), &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	r.cache.Delete(hash)
+	return nil
+}
+
+func recordName(namePrefix, hash string) string {
+	return namePrefix + "-" + hash
+}
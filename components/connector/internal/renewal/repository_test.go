@@ -0,0 +1,211 @@
+package renewal_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRepository(t *testing.T) {
+
+	recordName := namePrefix + "-" + hash
+	certificate := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(time.Hour).UTC().Truncate(time.Second)}
+	marshalled, err := json.Marshal(certificate)
+	require.NoError(t, err)
+	recordData := map[string]string{"hash": hash, "certificate": string(marshalled)}
+	notFound := k8serrors.NewNotFound(v1.Resource("configmaps"), recordName)
+
+	t.Run("should return certificate if present", func(t *testing.T) {
+		// given
+		cache := renewal.NewCache()
+		cache.Put(hash, certificate)
+
+		repository := renewal.NewRepository(&mocks.Manager{}, namePrefix, cache)
+
+		// when
+		result, found := repository.Get(hash)
+
+		// then
+		require.True(t, found)
+		assert.Equal(t, certificate, result)
+	})
+
+	t.Run("should not return certificate if not present", func(t *testing.T) {
+		// given
+		repository := renewal.NewRepository(&mocks.Manager{}, namePrefix, renewal.NewCache())
+
+		// when
+		_, found := repository.Get(hash)
+
+		// then
+		assert.False(t, found)
+	})
+
+	t.Run("should not return expired certificate", func(t *testing.T) {
+		// given
+		cache := renewal.NewCache()
+		cache.Put(hash, renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(-time.Hour)})
+
+		repository := renewal.NewRepository(&mocks.Manager{}, namePrefix, cache)
+
+		// when
+		_, found := repository.Get(hash)
+
+		// then
+		assert.False(t, found)
+	})
+
+	t.Run("should create record of certificate", func(t *testing.T) {
+		// given
+		cache := renewal.NewCache()
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", recordName, mock.AnythingOfType("v1.GetOptions")).Return(nil, notFound)
+
+		created := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   recordName,
+				Labels: map[string]string{"connector.compass.kyma-project.io/certificate-renewal": namePrefix},
+			},
+			Data: recordData,
+		}
+		configMapManager.On("Create", created).Return(created, nil)
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, cache)
+
+		// when
+		err = repository.Upsert(hash, certificate)
+
+		// then
+		require.NoError(t, err)
+		cached, found := cache.Get(hash)
+		require.True(t, found)
+		assert.Equal(t, certificate, cached)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should update existing record of certificate", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", recordName, mock.AnythingOfType("v1.GetOptions")).Return(
+			&v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: recordName},
+				Data:       map[string]string{"hash": hash, "certificate": "{}"},
+			}, nil)
+
+		updated := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: recordName}, Data: recordData}
+		configMapManager.On("Update", updated).Return(updated, nil)
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, renewal.NewCache())
+
+		// when
+		err := repository.Upsert(hash, certificate)
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should update record created by another replica in the meantime", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", recordName, mock.AnythingOfType("v1.GetOptions")).Return(nil, notFound).Once()
+		configMapManager.On("Create", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, k8serrors.NewAlreadyExists(v1.Resource("configmaps"), recordName)).Once()
+		configMapManager.On("Get", recordName, mock.AnythingOfType("v1.GetOptions")).Return(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: recordName}}, nil).Once()
+
+		updated := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: recordName}, Data: recordData}
+		configMapManager.On("Update", updated).Return(updated, nil).Once()
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, renewal.NewCache())
+
+		// when
+		err := repository.Upsert(hash, certificate)
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to update config map", func(t *testing.T) {
+		// given
+		cache := renewal.NewCache()
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", recordName, mock.AnythingOfType("v1.GetOptions")).Return(&v1.ConfigMap{}, nil)
+		configMapManager.On("Update", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, errors.New("some error"))
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, cache)
+
+		// when
+		err := repository.Upsert(hash, certificate)
+
+		// then
+		require.Error(t, err)
+		_, found := cache.Get(hash)
+		assert.False(t, found)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should delete record of certificate", func(t *testing.T) {
+		// given
+		cache := renewal.NewCache()
+		cache.Put(hash, certificate)
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Delete", recordName, &metav1.DeleteOptions{}).Return(nil)
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, cache)
+
+		// when
+		err := repository.Delete(hash)
+
+		// then
+		require.NoError(t, err)
+		_, found := cache.Get(hash)
+		assert.False(t, found)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should not return error when deleted record does not exist", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Delete", recordName, &metav1.DeleteOptions{}).Return(notFound)
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, renewal.NewCache())
+
+		// when
+		err := repository.Delete(hash)
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to delete record", func(t *testing.T) {
+		// given
+		cache := renewal.NewCache()
+		cache.Put(hash, certificate)
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Delete", recordName, &metav1.DeleteOptions{}).Return(errors.New("some error"))
+
+		repository := renewal.NewRepository(configMapManager, namePrefix, cache)
+
+		// when
+		err := repository.Delete(hash)
+
+		// then
+		require.Error(t, err)
+		_, found := cache.Get(hash)
+		assert.True(t, found)
+		configMapManager.AssertExpectations(t)
+	})
+}
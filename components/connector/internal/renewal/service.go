@@ -0,0 +1,183 @@
+package renewal
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// Info describes when certificates can be renewed
+type Info struct {
+	Window      time.Duration
+	Overlap     time.Duration
+	WindowStart *time.Time
+}
+
+//go:generate mockery -name=Service
+type Service interface {
+	// Info returns the renewal window, and its start for the certificate with given hash if it is known
+	Info(certificateHash string) Info
+	// CheckRenewal verifies that the certificate with given hash is inside its renewal window, and that it has not been
+	// renewed with a certificate which is already in use
+	CheckRenewal(ctx context.Context, certificateHash string) apperrors.AppError
+	// CertificateIssued registers the issued certificate with its token binding, and the certificate it renews if any
+	CertificateIssued(ctx context.Context, certificateHash, clientId string, notAfter time.Time, renewedCertificateHash string, binding tokens.Binding) apperrors.AppError
+	// Binding returns the token binding of the certificate with given hash if it is known
+	Binding(certificateHash string) (tokens.Binding, bool)
+	// CertificateUsed revokes the certificate renewed by the used one and returns false if the used certificate
+	// has been renewed and its overlap period has passed
+	CertificateUsed(ctx context.Context, certificateHash string) bool
+}
+
+type service struct {
+	repository             Repository
+	revokedCertsRepository revocation.RevokedCertificatesRepository
+	window                 time.Duration
+	overlap                time.Duration
+}
+
+func NewService(repository Repository, revokedCertsRepository revocation.RevokedCertificatesRepository, window, overlap time.Duration) Service {
+	return &service{
+		repository:             repository,
+		revokedCertsRepository: revokedCertsRepository,
+		window:                 window,
+		overlap:                overlap,
+	}
+}
+
+func (s *service) Info(certificateHash string) Info {
+	info := Info{
+		Window:  s.window,
+		Overlap: s.overlap,
+	}
+
+	if certificateHash == "" {
+		return info
+	}
+
+	if certificate, found := s.repository.Get(certificateHash); found {
+		windowStart := certificate.NotAfter.Add(-s.window)
+		info.WindowStart = &windowStart
+	}
+
+	return info
+}
+
+func (s *service) CheckRenewal(ctx context.Context, certificateHash string) apperrors.AppError {
+	certificate, found := s.repository.Get(certificateHash)
+	if !found {
+		log.C(ctx).Infof("Certificate with hash %s was not issued with renewal tracking, renewal window is not enforced", certificateHash)
+		return nil
+	}
+
+	if certificate.ReplacedBy != "" {
+		replacement, found := s.repository.Get(certificate.ReplacedBy)
+		if found && replacement.Replaces == "" {
+			return apperrors.Forbidden("Certificate has already been renewed")
+		}
+
+		log.C(ctx).Infof("Certificate with hash %s has been renewed with certificate with hash %s, which has not been used yet, allowing renewal again", certificateHash, certificate.ReplacedBy)
+		return nil
+	}
+
+	windowStart := certificate.NotAfter.Add(-s.window)
+	if time.Now().Before(windowStart) {
+		return apperrors.Forbidden("Certificate can be renewed from %s", windowStart.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (s *service) CertificateIssued(ctx context.Context, certificateHash, clientId string, notAfter time.Time, renewedCertificateHash string, binding tokens.Binding) apperrors.AppError {
+	issued := Certificate{
+		ClientId: clientId,
+		NotAfter: notAfter,
+		Replaces: renewedCertificateHash,
+		Binding:  binding,
+	}
+
+	if renewedCertificateHash != "" {
+		renewed, found := s.repository.Get(renewedCertificateHash)
+		if !found {
+			renewed = Certificate{ClientId: clientId, NotAfter: time.Now().Add(s.overlap)}
+		}
+
+		if renewed.ReplacedBy != "" && renewed.ReplacedBy != certificateHash {
+			log.C(ctx).Infof("Certificate with hash %s is renewed again, revoking unused certificate with hash %s", renewedCertificateHash, renewed.ReplacedBy)
+			if err := s.revokedCertsRepository.Insert(renewed.ReplacedBy); err != nil {
+				return apperrors.Internal("Failed to revoke unused certificate: %s", err)
+			}
+			s.removeRevoked(ctx, renewed.ReplacedBy)
+		}
+
+		renewed.ReplacedBy = certificateHash
+		if renewed.RevokeAt.IsZero() {
+			renewed.RevokeAt = time.Now().Add(s.overlap)
+		}
+
+		log.C(ctx).Debugf("Marking certificate with hash %s as renewed", renewedCertificateHash)
+		if err := s.repository.Upsert(renewedCertificateHash, renewed); err != nil {
+			return apperrors.Internal("Failed to mark certificate as renewed: %s", err)
+		}
+	}
+
+	log.C(ctx).Debugf("Registering issued certificate with hash %s", certificateHash)
+	if err := s.repository.Upsert(certificateHash, issued); err != nil {
+		return apperrors.Internal("Failed to register issued certificate: %s", err)
+	}
+
+	return nil
+}
+
+func (s *service) Binding(certificateHash string) (tokens.Binding, bool) {
+	certificate, found := s.repository.Get(certificateHash)
+	if !found {
+		return tokens.Binding{}, false
+	}
+
+	return certificate.Binding, true
+}
+
+func (s *service) CertificateUsed(ctx context.Context, certificateHash string) bool {
+	certificate, found := s.repository.Get(certificateHash)
+	if !found {
+		return true
+	}
+
+	if certificate.Replaces != "" {
+		log.C(ctx).Infof("Renewed certificate used for the first time, revoking certificate with hash %s", certificate.Replaces)
+		if err := s.revokedCertsRepository.Insert(certificate.Replaces); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to revoke renewed certificate with hash %s", certificate.Replaces)
+			return true
+		}
+		s.removeRevoked(ctx, certificate.Replaces)
+
+		certificate.Replaces = ""
+		if err := s.repository.Upsert(certificateHash, certificate); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to update certificate with hash %s", certificateHash)
+		}
+	}
+
+	if certificate.ReplacedBy != "" && time.Now().After(certificate.RevokeAt) {
+		log.C(ctx).Infof("Overlap period of renewed certificate with hash %s has passed, revoking it", certificateHash)
+		if err := s.revokedCertsRepository.Insert(certificateHash); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to revoke renewed certificate with hash %s", certificateHash)
+			return false
+		}
+		s.removeRevoked(ctx, certificateHash)
+		return false
+	}
+
+	return true
+}
+
+// removeRevoked removes the renewal record of the revoked certificate, which takes no further part in renewals
+func (s *service) removeRevoked(ctx context.Context, certificateHash string) {
+	if err := s.repository.Delete(certificateHash); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to remove renewal record of revoked certificate with hash %s", certificateHash)
+	}
+}
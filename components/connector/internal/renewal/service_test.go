@@ -0,0 +1,365 @@
+package renewal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
+	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	window  = 720 * time.Hour
+	overlap = time.Hour
+
+	clientId    = "clientId"
+	hash        = "hash"
+	renewedHash = "renewedHash"
+)
+
+func TestService_Info(t *testing.T) {
+
+	t.Run("should return renewal window start of known certificate", func(t *testing.T) {
+		// given
+		notAfter := time.Now().Add(window * 2)
+
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{ClientId: clientId, NotAfter: notAfter}, true)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		info := service.Info(hash)
+
+		// then
+		assert.Equal(t, window, info.Window)
+		assert.Equal(t, overlap, info.Overlap)
+		require.NotNil(t, info.WindowStart)
+		assert.Equal(t, notAfter.Add(-window), *info.WindowStart)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return renewal window without start if certificate is unknown", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{}, false)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		info := service.Info(hash)
+
+		// then
+		assert.Equal(t, window, info.Window)
+		assert.Nil(t, info.WindowStart)
+		repository.AssertExpectations(t)
+	})
+}
+
+func TestService_CheckRenewal(t *testing.T) {
+
+	t.Run("should allow renewal inside renewal window", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2)}, true)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CheckRenewal(context.TODO(), hash)
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should allow renewal of unknown certificate", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{}, false)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CheckRenewal(context.TODO(), hash)
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should deny renewal before renewal window", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window * 2)}, true)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CheckRenewal(context.TODO(), hash)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should allow renewal of renewed certificate if new certificate has not been used", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2), ReplacedBy: renewedHash}, true)
+		repository.On("Get", renewedHash).Return(renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window * 3), Replaces: hash}, true)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CheckRenewal(context.TODO(), hash)
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should deny renewal of renewed certificate if new certificate has been used", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2), ReplacedBy: renewedHash}, true)
+		repository.On("Get", renewedHash).Return(renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window * 3)}, true)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CheckRenewal(context.TODO(), hash)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+		repository.AssertExpectations(t)
+	})
+}
+
+func TestService_CertificateIssued(t *testing.T) {
+	notAfter := time.Now().Add(window * 3)
+
+	t.Run("should register issued certificate", func(t *testing.T) {
+		// given
+		binding := tokens.Binding{Tenant: "tenant", CreatedBy: "admin"}
+
+		repository := &mocks.Repository{}
+		repository.On("Upsert", hash, renewal.Certificate{ClientId: clientId, NotAfter: notAfter, Binding: binding}).Return(nil)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CertificateIssued(context.TODO(), hash, clientId, notAfter, "", binding)
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should register issued certificate and mark renewed certificate", func(t *testing.T) {
+		// given
+		renewed := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2)}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", renewedHash).Return(renewed, true)
+		repository.On("Upsert", renewedHash, mock.MatchedBy(func(certificate renewal.Certificate) bool {
+			return certificate.ReplacedBy == hash && certificate.RevokeAt.After(time.Now())
+		})).Return(nil)
+		repository.On("Upsert", hash, renewal.Certificate{ClientId: clientId, NotAfter: notAfter, Replaces: renewedHash}).Return(nil)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CertificateIssued(context.TODO(), hash, clientId, notAfter, renewedHash, tokens.Binding{})
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should revoke unused certificate when renewed certificate is renewed again", func(t *testing.T) {
+		// given
+		revokeAt := time.Now().Add(overlap / 2)
+		renewed := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2), ReplacedBy: "unusedHash", RevokeAt: revokeAt}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", renewedHash).Return(renewed, true)
+		repository.On("Upsert", renewedHash, mock.MatchedBy(func(certificate renewal.Certificate) bool {
+			return certificate.ReplacedBy == hash && certificate.RevokeAt.Equal(revokeAt)
+		})).Return(nil)
+		repository.On("Upsert", hash, renewal.Certificate{ClientId: clientId, NotAfter: notAfter, Replaces: renewedHash}).Return(nil)
+
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", "unusedHash").Return(nil)
+		repository.On("Delete", "unusedHash").Return(nil)
+
+		service := renewal.NewService(repository, revokedCertsRepository, window, overlap)
+
+		// when
+		err := service.CertificateIssued(context.TODO(), hash, clientId, notAfter, renewedHash, tokens.Binding{})
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, repository, revokedCertsRepository)
+	})
+
+	t.Run("should return error if failed to register issued certificate", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Upsert", hash, mock.AnythingOfType("renewal.Certificate")).Return(errors.New("error"))
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		err := service.CertificateIssued(context.TODO(), hash, clientId, notAfter, "", tokens.Binding{})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		repository.AssertExpectations(t)
+	})
+}
+
+func TestService_Binding(t *testing.T) {
+
+	t.Run("should return binding of known certificate", func(t *testing.T) {
+		// given
+		binding := tokens.Binding{Tenant: "tenant", CreatedBy: "admin"}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{ClientId: clientId, Binding: binding}, true)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		certificateBinding, found := service.Binding(hash)
+
+		// then
+		assert.True(t, found)
+		assert.Equal(t, binding, certificateBinding)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should not return binding of unknown certificate", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{}, false)
+
+		service := renewal.NewService(repository, nil, window, overlap)
+
+		// when
+		_, found := service.Binding(hash)
+
+		// then
+		assert.False(t, found)
+		repository.AssertExpectations(t)
+	})
+}
+
+func TestService_CertificateUsed(t *testing.T) {
+
+	t.Run("should accept unknown certificate", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(renewal.Certificate{}, false)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := renewal.NewService(repository, revokedCertsRepository, window, overlap)
+
+		// when
+		accepted := service.CertificateUsed(context.TODO(), hash)
+
+		// then
+		assert.True(t, accepted)
+		mock.AssertExpectationsForObjects(t, repository, revokedCertsRepository)
+	})
+
+	t.Run("should revoke renewed certificate when new certificate is used", func(t *testing.T) {
+		// given
+		certificate := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window * 3), Replaces: renewedHash}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(certificate, true)
+		repository.On("Upsert", hash, renewal.Certificate{ClientId: clientId, NotAfter: certificate.NotAfter}).Return(nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", renewedHash).Return(nil)
+		repository.On("Delete", renewedHash).Return(nil)
+
+		service := renewal.NewService(repository, revokedCertsRepository, window, overlap)
+
+		// when
+		accepted := service.CertificateUsed(context.TODO(), hash)
+
+		// then
+		assert.True(t, accepted)
+		mock.AssertExpectationsForObjects(t, repository, revokedCertsRepository)
+	})
+
+	t.Run("should accept renewed certificate during overlap period", func(t *testing.T) {
+		// given
+		certificate := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2), ReplacedBy: renewedHash, RevokeAt: time.Now().Add(overlap)}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(certificate, true)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := renewal.NewService(repository, revokedCertsRepository, window, overlap)
+
+		// when
+		accepted := service.CertificateUsed(context.TODO(), hash)
+
+		// then
+		assert.True(t, accepted)
+		mock.AssertExpectationsForObjects(t, repository, revokedCertsRepository)
+	})
+
+	t.Run("should revoke renewed certificate after overlap period", func(t *testing.T) {
+		// given
+		certificate := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2), ReplacedBy: renewedHash, RevokeAt: time.Now().Add(-time.Minute)}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(certificate, true)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", hash).Return(nil)
+		repository.On("Delete", hash).Return(nil)
+
+		service := renewal.NewService(repository, revokedCertsRepository, window, overlap)
+
+		// when
+		accepted := service.CertificateUsed(context.TODO(), hash)
+
+		// then
+		assert.False(t, accepted)
+		mock.AssertExpectationsForObjects(t, repository, revokedCertsRepository)
+	})
+
+	t.Run("should keep renewal record of renewed certificate if it failed to be revoked", func(t *testing.T) {
+		// given
+		certificate := renewal.Certificate{ClientId: clientId, NotAfter: time.Now().Add(window / 2), ReplacedBy: renewedHash, RevokeAt: time.Now().Add(-time.Minute)}
+
+		repository := &mocks.Repository{}
+		repository.On("Get", hash).Return(certificate, true)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", hash).Return(errors.New("error"))
+
+		service := renewal.NewService(repository, revokedCertsRepository, window, overlap)
+
+		// when
+		accepted := service.CertificateUsed(context.TODO(), hash)
+
+		// then
+		assert.False(t, accepted)
+		repository.AssertNotCalled(t, "Delete", hash)
+		mock.AssertExpectationsForObjects(t, repository, revokedCertsRepository)
+	})
+}
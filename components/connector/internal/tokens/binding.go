@@ -0,0 +1,98 @@
+package tokens
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+// Binding describes the context a one-time token was requested in. CSR tokens and issued certificates inherit it.
+type Binding struct {
+	Tenant    string    `json:"tenant,omitempty"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	// AllowedSourceCIDRs restricts the addresses the token can be redeemed from, any address is allowed if it is empty
+	AllowedSourceCIDRs []string `json:"allowedSourceCIDRs,omitempty"`
+}
+
+func (b Binding) IsEmpty() bool {
+	return b.Tenant == "" && b.CreatedBy == "" && b.CreatedAt.IsZero() && len(b.AllowedSourceCIDRs) == 0
+}
+
+// Validate checks that allowed sources are either IP addresses or CIDRs
+func (b Binding) Validate() apperrors.AppError {
+	for _, source := range b.AllowedSourceCIDRs {
+		if _, err := parseSource(source); err != nil {
+			return apperrors.WrongInput("Invalid allowed source %s: %s", source, err.Error())
+		}
+	}
+	return nil
+}
+
+// AllowsSource returns true if the token can be redeemed from given address
+func (b Binding) AllowsSource(sourceIP string) bool {
+	if len(b.AllowedSourceCIDRs) == 0 {
+		return true
+	}
+
+	ip := net.ParseIP(sourceIP)
+	if ip == nil {
+		return false
+	}
+
+	for _, source := range b.AllowedSourceCIDRs {
+		network, err := parseSource(source)
+		if err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Encode returns the binding in the form passed in headers between the hydrator and the Connector
+func (b Binding) Encode() (string, error) {
+	marshalled, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(marshalled), nil
+}
+
+func DecodeBinding(encoded string) (Binding, error) {
+	marshalled, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return Binding{}, err
+	}
+
+	var binding Binding
+	if err := json.Unmarshal(marshalled, &binding); err != nil {
+		return Binding{}, err
+	}
+
+	return binding, nil
+}
+
+// parseSource parses a CIDR, or an IP address as a single address network
+func parseSource(source string) (*net.IPNet, error) {
+	if !strings.Contains(source, "/") {
+		ip := net.ParseIP(source)
+		if ip == nil {
+			return nil, &net.ParseError{Type: "IP address", Text: source}
+		}
+
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+
+	_, network, err := net.ParseCIDR(source)
+	return network, err
+}
@@ -0,0 +1,65 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinding_AllowsSource(t *testing.T) {
+	binding := Binding{AllowedSourceCIDRs: []string{"10.0.0.0/8", "192.168.0.1", "fd00::/8"}}
+
+	for _, testCase := range []struct {
+		sourceIP string
+		allowed  bool
+	}{
+		{sourceIP: "10.1.2.3", allowed: true},
+		{sourceIP: "192.168.0.1", allowed: true},
+		{sourceIP: "fd00::1", allowed: true},
+		{sourceIP: "192.168.0.2", allowed: false},
+		{sourceIP: "", allowed: false},
+		{sourceIP: "not-an-ip", allowed: false},
+	} {
+		t.Run("source IP: "+testCase.sourceIP, func(t *testing.T) {
+			assert.Equal(t, testCase.allowed, binding.AllowsSource(testCase.sourceIP))
+		})
+	}
+
+	t.Run("should allow any source if not restricted", func(t *testing.T) {
+		assert.True(t, Binding{}.AllowsSource("192.168.0.2"))
+		assert.True(t, Binding{}.AllowsSource(""))
+	})
+}
+
+func TestBinding_Encode(t *testing.T) {
+
+	t.Run("should encode and decode binding", func(t *testing.T) {
+		// given
+		binding := Binding{
+			Tenant:             "tenant",
+			CreatedBy:          "admin",
+			CreatedAt:          time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			AllowedSourceCIDRs: []string{"10.0.0.0/8"},
+		}
+
+		// when
+		encoded, err := binding.Encode()
+		require.NoError(t, err)
+
+		decoded, err := DecodeBinding(encoded)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, binding, decoded)
+	})
+
+	t.Run("should return error when binding is not encoded", func(t *testing.T) {
+		// when
+		_, err := DecodeBinding("{}")
+
+		// then
+		require.Error(t, err)
+	})
+}
@@ -17,20 +17,43 @@ type Service struct {
 	mock.Mock
 }
 
-// CreateToken provides a mock function with given fields: ctx, clientId, tokenType
-func (_m *Service) CreateToken(ctx context.Context, clientId string, tokenType tokens.TokenType) (string, apperrors.AppError) {
-	ret := _m.Called(ctx, clientId, tokenType)
+// CreateCSRToken provides a mock function with given fields: ctx, clientId, consumerType, renewedCertificateHash, binding
+func (_m *Service) CreateCSRToken(ctx context.Context, clientId string, consumerType tokens.TokenType, renewedCertificateHash string, binding tokens.Binding) (string, apperrors.AppError) {
+	ret := _m.Called(ctx, clientId, consumerType, renewedCertificateHash, binding)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, string, tokens.TokenType) string); ok {
-		r0 = rf(ctx, clientId, tokenType)
+	if rf, ok := ret.Get(0).(func(context.Context, string, tokens.TokenType, string, tokens.Binding) string); ok {
+		r0 = rf(ctx, clientId, consumerType, renewedCertificateHash, binding)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(context.Context, string, tokens.TokenType) apperrors.AppError); ok {
-		r1 = rf(ctx, clientId, tokenType)
+	if rf, ok := ret.Get(1).(func(context.Context, string, tokens.TokenType, string, tokens.Binding) apperrors.AppError); ok {
+		r1 = rf(ctx, clientId, consumerType, renewedCertificateHash, binding)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateToken provides a mock function with given fields: ctx, clientId, tokenType, binding
+func (_m *Service) CreateToken(ctx context.Context, clientId string, tokenType tokens.TokenType, binding tokens.Binding) (string, apperrors.AppError) {
+	ret := _m.Called(ctx, clientId, tokenType, binding)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, tokens.TokenType, tokens.Binding) string); ok {
+		r0 = rf(ctx, clientId, tokenType, binding)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string, tokens.TokenType, tokens.Binding) apperrors.AppError); ok {
+		r1 = rf(ctx, clientId, tokenType, binding)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
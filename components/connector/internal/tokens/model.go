@@ -11,4 +11,10 @@ const (
 type TokenData struct {
 	Type     TokenType
 	ClientId string
+	// ConsumerType is the type of the token the consumer started pairing with. CSR tokens inherit it.
+	ConsumerType TokenType
+	// RenewedCertificateHash is set for CSR tokens issued to consumers authenticated with a certificate
+	RenewedCertificateHash string
+	// Binding is the context the token was requested in. CSR tokens inherit it.
+	Binding Binding
 }
@@ -2,6 +2,7 @@ package tokens
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -9,7 +10,8 @@ import (
 
 //go:generate mockery -name=Service
 type Service interface {
-	CreateToken(ctx context.Context, clientId string, tokenType TokenType) (string, apperrors.AppError)
+	CreateToken(ctx context.Context, clientId string, tokenType TokenType, binding Binding) (string, apperrors.AppError)
+	CreateCSRToken(ctx context.Context, clientId string, consumerType TokenType, renewedCertificateHash string, binding Binding) (string, apperrors.AppError)
 	Resolve(token string) (TokenData, apperrors.AppError)
 	Delete(token string)
 }
@@ -26,15 +28,34 @@ func NewTokenService(store Cache, generator TokenGenerator) *tokenService {
 	}
 }
 
-func (svc *tokenService) CreateToken(ctx context.Context, clientId string, tokenType TokenType) (string, apperrors.AppError) {
-	token, err := svc.generator.NewToken()
-	if err != nil {
+func (svc *tokenService) CreateToken(ctx context.Context, clientId string, tokenType TokenType, binding Binding) (string, apperrors.AppError) {
+	if err := binding.Validate(); err != nil {
 		return "", err
 	}
+	binding.CreatedAt = time.Now().UTC()
 
-	tokenData := TokenData{
-		Type:     tokenType,
-		ClientId: clientId,
+	return svc.createToken(ctx, TokenData{
+		Type:         tokenType,
+		ClientId:     clientId,
+		ConsumerType: tokenType,
+		Binding:      binding,
+	})
+}
+
+func (svc *tokenService) CreateCSRToken(ctx context.Context, clientId string, consumerType TokenType, renewedCertificateHash string, binding Binding) (string, apperrors.AppError) {
+	return svc.createToken(ctx, TokenData{
+		Type:                   CSRToken,
+		ClientId:               clientId,
+		ConsumerType:           consumerType,
+		RenewedCertificateHash: renewedCertificateHash,
+		Binding:                binding,
+	})
+}
+
+func (svc *tokenService) createToken(ctx context.Context, tokenData TokenData) (string, apperrors.AppError) {
+	token, err := svc.generator.NewToken()
+	if err != nil {
+		return "", err
 	}
 
 	log.C(ctx).Debugf("Storing token for %s with id %s in the cache", tokenData.Type, tokenData.ClientId)
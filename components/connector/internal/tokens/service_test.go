@@ -25,24 +25,27 @@ func TestTokenService(t *testing.T) {
 			description: "should save, resolve and delete ApplicationToken",
 			tokenType:   ApplicationToken,
 			expectedTokenData: TokenData{
-				Type:     ApplicationToken,
-				ClientId: clientId,
+				Type:         ApplicationToken,
+				ClientId:     clientId,
+				ConsumerType: ApplicationToken,
 			},
 		},
 		{
 			description: "should save, resolve and delete RuntimeToken",
 			tokenType:   RuntimeToken,
 			expectedTokenData: TokenData{
-				Type:     RuntimeToken,
-				ClientId: clientId,
+				Type:         RuntimeToken,
+				ClientId:     clientId,
+				ConsumerType: RuntimeToken,
 			},
 		},
 		{
 			description: "should save, resolve and delete CSRToken",
 			tokenType:   CSRToken,
 			expectedTokenData: TokenData{
-				Type:     CSRToken,
-				ClientId: clientId,
+				Type:         CSRToken,
+				ClientId:     clientId,
+				ConsumerType: CSRToken,
 			},
 		},
 	} {
@@ -51,7 +54,7 @@ func TestTokenService(t *testing.T) {
 			tokenService := newTokenService()
 
 			// when
-			token, err := tokenService.CreateToken(context.TODO(), clientId, testCase.tokenType)
+			token, err := tokenService.CreateToken(context.TODO(), clientId, testCase.tokenType, Binding{})
 
 			// then
 			require.NoError(t, err)
@@ -62,6 +65,8 @@ func TestTokenService(t *testing.T) {
 
 			// then
 			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now(), tokenData.Binding.CreatedAt, time.Minute)
+			tokenData.Binding.CreatedAt = time.Time{}
 			assert.Equal(t, testCase.expectedTokenData, tokenData)
 
 			// when
@@ -76,6 +81,66 @@ func TestTokenService(t *testing.T) {
 	}
 }
 
+func TestTokenService_CreateToken(t *testing.T) {
+
+	t.Run("should save token with binding", func(t *testing.T) {
+		// given
+		tokenService := newTokenService()
+		binding := Binding{Tenant: "tenant", CreatedBy: "admin", AllowedSourceCIDRs: []string{"10.0.0.0/8", "192.168.0.1"}}
+
+		// when
+		token, err := tokenService.CreateToken(context.TODO(), clientId, RuntimeToken, binding)
+
+		// then
+		require.NoError(t, err)
+
+		// when
+		tokenData, err := tokenService.Resolve(token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "tenant", tokenData.Binding.Tenant)
+		assert.Equal(t, "admin", tokenData.Binding.CreatedBy)
+		assert.Equal(t, binding.AllowedSourceCIDRs, tokenData.Binding.AllowedSourceCIDRs)
+		assert.False(t, tokenData.Binding.CreatedAt.IsZero())
+	})
+
+	t.Run("should return error when allowed source is invalid", func(t *testing.T) {
+		// given
+		tokenService := newTokenService()
+
+		// when
+		_, err := tokenService.CreateToken(context.TODO(), clientId, RuntimeToken, Binding{AllowedSourceCIDRs: []string{"10.0.0.0/33"}})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+}
+
+func TestTokenService_CreateCSRToken(t *testing.T) {
+
+	t.Run("should save CSRToken with consumer type and binding", func(t *testing.T) {
+		// given
+		tokenService := newTokenService()
+		binding := Binding{Tenant: "tenant", CreatedBy: "admin", CreatedAt: time.Now()}
+
+		// when
+		token, err := tokenService.CreateCSRToken(context.TODO(), clientId, RuntimeToken, "hash", binding)
+
+		// then
+		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+
+		// when
+		tokenData, err := tokenService.Resolve(token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, TokenData{Type: CSRToken, ClientId: clientId, ConsumerType: RuntimeToken, RenewedCertificateHash: "hash", Binding: binding}, tokenData)
+	})
+}
+
 func TestTokenService_Resolve(t *testing.T) {
 
 	t.Run("should return error when token not found", func(t *testing.T) {
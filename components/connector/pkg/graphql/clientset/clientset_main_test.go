@@ -37,6 +37,8 @@ const (
 
 	testSecretName    = "test-secret"
 	testConfigMapName = "test-secret"
+
+	testRenewalsConfigMapName = "test-renewals"
 )
 
 var (
@@ -50,6 +52,8 @@ func TestMain(m *testing.M) {
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
 	err = os.Setenv("APP_REVOCATION_CONFIG_MAP_NAME", testConfigMapName)
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
+	err = os.Setenv("APP_CERTIFICATE_RENEWAL_CONFIG_MAP_NAME", testRenewalsConfigMapName)
+	exitOnError(err, "Error setting APP_CERTIFICATE_RENEWAL_CONFIG_MAP_NAME env")
 
 	cfg := config.Config{}
 	err = envconfig.InitWithPrefix(&cfg, "APP")
@@ -73,12 +77,17 @@ func TestMain(m *testing.M) {
 			Data:       nil,
 			BinaryData: nil,
 		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: testRenewalsConfigMapName, Namespace: "default"},
+		},
 	)
 
-	internalComponents, certsLoader, revokedCertsLoader := config.InitInternalComponents(cfg, k8sClientSet)
+	internalComponents, certsLoader, revokedCertsLoader, renewalsLoader, err := config.InitInternalComponents(cfg, k8sClientSet)
+	exitOnError(err, "Error initializing internal components")
 
 	go certsLoader.Run(context.TODO())
 	go revokedCertsLoader.Run(context.TODO())
+	go renewalsLoader.Run(context.TODO())
 
 	tokenService = internalComponents.TokenService
 	externalAPIUrl = fmt.Sprintf("https://%s%s", cfg.ExternalAddress, cfg.APIEndpoint)
@@ -87,10 +96,12 @@ func TestMain(m *testing.M) {
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
-		internalComponents.CSRSubjectConsts,
+		internalComponents.CertificateProfiles,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.RenewalService,
+		internalComponents.RateLimitGuard)
 
 	authContextTestMiddleware := func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
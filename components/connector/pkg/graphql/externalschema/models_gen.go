@@ -2,6 +2,13 @@
 
 package externalschema
 
+type CertificateRenewalInfo struct {
+	RenewalWindow string `json:"renewalWindow"`
+	OverlapPeriod string `json:"overlapPeriod"`
+	// start of the renewal window of the certificate with which the request was issued, in RFC3339 format
+	RenewalWindowStart *string `json:"renewalWindowStart"`
+}
+
 type CertificateSigningRequestInfo struct {
 	Subject      string `json:"subject"`
 	KeyAlgorithm string `json:"keyAlgorithm"`
@@ -20,8 +27,9 @@ type Configuration struct {
 }
 
 type ManagementPlaneInfo struct {
-	DirectorURL                    *string `json:"directorURL"`
-	CertificateSecuredConnectorURL *string `json:"certificateSecuredConnectorURL"`
+	DirectorURL                    *string                 `json:"directorURL"`
+	CertificateSecuredConnectorURL *string                 `json:"certificateSecuredConnectorURL"`
+	CertificateRenewal             *CertificateRenewalInfo `json:"certificateRenewal"`
 }
 
 type Token struct {
@@ -42,6 +42,12 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CertificateRenewalInfo struct {
+		OverlapPeriod      func(childComplexity int) int
+		RenewalWindow      func(childComplexity int) int
+		RenewalWindowStart func(childComplexity int) int
+	}
+
 	CertificateSigningRequestInfo struct {
 		KeyAlgorithm func(childComplexity int) int
 		Subject      func(childComplexity int) int
@@ -60,6 +66,7 @@ type ComplexityRoot struct {
 	}
 
 	ManagementPlaneInfo struct {
+		CertificateRenewal             func(childComplexity int) int
 		CertificateSecuredConnectorURL func(childComplexity int) int
 		DirectorURL                    func(childComplexity int) int
 	}
@@ -101,6 +108,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CertificateRenewalInfo.overlapPeriod":
+		if e.complexity.CertificateRenewalInfo.OverlapPeriod == nil {
+			break
+		}
+
+		return e.complexity.CertificateRenewalInfo.OverlapPeriod(childComplexity), true
+
+	case "CertificateRenewalInfo.renewalWindow":
+		if e.complexity.CertificateRenewalInfo.RenewalWindow == nil {
+			break
+		}
+
+		return e.complexity.CertificateRenewalInfo.RenewalWindow(childComplexity), true
+
+	case "CertificateRenewalInfo.renewalWindowStart":
+		if e.complexity.CertificateRenewalInfo.RenewalWindowStart == nil {
+			break
+		}
+
+		return e.complexity.CertificateRenewalInfo.RenewalWindowStart(childComplexity), true
+
 	case "CertificateSigningRequestInfo.keyAlgorithm":
 		if e.complexity.CertificateSigningRequestInfo.KeyAlgorithm == nil {
 			break
@@ -157,6 +185,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Configuration.Token(childComplexity), true
 
+	case "ManagementPlaneInfo.certificateRenewal":
+		if e.complexity.ManagementPlaneInfo.CertificateRenewal == nil {
+			break
+		}
+
+		return e.complexity.ManagementPlaneInfo.CertificateRenewal(childComplexity), true
+
 	case "ManagementPlaneInfo.certificateSecuredConnectorURL":
 		if e.complexity.ManagementPlaneInfo.CertificateSecuredConnectorURL == nil {
 			break
@@ -298,6 +333,15 @@ type ManagementPlaneInfo {
     directorURL: String # eg.: "https://director.cluster.kyma.cx/graphql"
     # TODO: we can consider renaming this URL to something like renewalURL/connectionMaintananceURL or something like that.
     certificateSecuredConnectorURL: String # eg.: "https://connector-mtls.cluster.kyma.cx/graphql"
+    certificateRenewal: CertificateRenewalInfo
+}
+
+# CertificateRenewalInfo
+type CertificateRenewalInfo {
+    renewalWindow: String! # eg.: "720h0m0s"
+    overlapPeriod: String! # eg.: "1h0m0s"
+    """start of the renewal window of the certificate with which the request was issued, in RFC3339 format"""
+    renewalWindowStart: String # eg.: "2020-01-01T00:00:00Z"
 }
 
 type Configuration {
@@ -393,6 +437,84 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _CertificateRenewalInfo_renewalWindow(ctx context.Context, field graphql.CollectedField, obj *CertificateRenewalInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateRenewalInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RenewalWindow, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateRenewalInfo_overlapPeriod(ctx context.Context, field graphql.CollectedField, obj *CertificateRenewalInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateRenewalInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OverlapPeriod, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateRenewalInfo_renewalWindowStart(ctx context.Context, field graphql.CollectedField, obj *CertificateRenewalInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateRenewalInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RenewalWindowStart, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _CertificateSigningRequestInfo_subject(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -648,6 +770,30 @@ func (ec *executionContext) _ManagementPlaneInfo_certificateSecuredConnectorURL(
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _ManagementPlaneInfo_certificateRenewal(ctx context.Context, field graphql.CollectedField, obj *ManagementPlaneInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "ManagementPlaneInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CertificateRenewal, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*CertificateRenewalInfo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCertificateRenewalInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateRenewalInfo(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Mutation_signCertificateSigningRequest(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -1657,6 +1803,40 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** object.gotpl ****************************
 
+var certificateRenewalInfoImplementors = []string{"CertificateRenewalInfo"}
+
+func (ec *executionContext) _CertificateRenewalInfo(ctx context.Context, sel ast.SelectionSet, obj *CertificateRenewalInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, certificateRenewalInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CertificateRenewalInfo")
+		case "renewalWindow":
+			out.Values[i] = ec._CertificateRenewalInfo_renewalWindow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "overlapPeriod":
+			out.Values[i] = ec._CertificateRenewalInfo_overlapPeriod(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "renewalWindowStart":
+			out.Values[i] = ec._CertificateRenewalInfo_renewalWindowStart(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var certificateSigningRequestInfoImplementors = []string{"CertificateSigningRequestInfo"}
 
 func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context, sel ast.SelectionSet, obj *CertificateSigningRequestInfo) graphql.Marshaler {
@@ -1769,6 +1949,8 @@ func (ec *executionContext) _ManagementPlaneInfo(ctx context.Context, sel ast.Se
 			out.Values[i] = ec._ManagementPlaneInfo_directorURL(ctx, field, obj)
 		case "certificateSecuredConnectorURL":
 			out.Values[i] = ec._ManagementPlaneInfo_certificateSecuredConnectorURL(ctx, field, obj)
+		case "certificateRenewal":
+			out.Values[i] = ec._ManagementPlaneInfo_certificateRenewal(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2437,6 +2619,17 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return ec.marshalOBoolean2bool(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOCertificateRenewalInfo2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateRenewalInfo(ctx context.Context, sel ast.SelectionSet, v CertificateRenewalInfo) graphql.Marshaler {
+	return ec._CertificateRenewalInfo(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOCertificateRenewalInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateRenewalInfo(ctx context.Context, sel ast.SelectionSet, v *CertificateRenewalInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CertificateRenewalInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOCertificateSigningRequestInfo2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateSigningRequestInfo(ctx context.Context, sel ast.SelectionSet, v CertificateSigningRequestInfo) graphql.Marshaler {
 	return ec._CertificateSigningRequestInfo(ctx, sel, &v)
 }
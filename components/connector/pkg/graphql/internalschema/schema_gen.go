@@ -44,8 +44,8 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	Mutation struct {
-		GenerateApplicationToken func(childComplexity int, authID string) int
-		GenerateRuntimeToken     func(childComplexity int, authID string) int
+		GenerateApplicationToken func(childComplexity int, authID string, binding *TokenBindingInput) int
+		GenerateRuntimeToken     func(childComplexity int, authID string, binding *TokenBindingInput) int
 	}
 
 	Query struct {
@@ -58,8 +58,8 @@ type ComplexityRoot struct {
 }
 
 type MutationResolver interface {
-	GenerateApplicationToken(ctx context.Context, authID string) (*externalschema.Token, error)
-	GenerateRuntimeToken(ctx context.Context, authID string) (*externalschema.Token, error)
+	GenerateApplicationToken(ctx context.Context, authID string, binding *TokenBindingInput) (*externalschema.Token, error)
+	GenerateRuntimeToken(ctx context.Context, authID string, binding *TokenBindingInput) (*externalschema.Token, error)
 }
 type QueryResolver interface {
 	IsHealthy(ctx context.Context) (bool, error)
@@ -90,7 +90,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.GenerateApplicationToken(childComplexity, args["authID"].(string)), true
+		return e.complexity.Mutation.GenerateApplicationToken(childComplexity, args["authID"].(string), args["binding"].(*TokenBindingInput)), true
 
 	case "Mutation.generateRuntimeToken":
 		if e.complexity.Mutation.GenerateRuntimeToken == nil {
@@ -102,7 +102,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.GenerateRuntimeToken(childComplexity, args["authID"].(string)), true
+		return e.complexity.Mutation.GenerateRuntimeToken(childComplexity, args["authID"].(string), args["binding"].(*TokenBindingInput)), true
 
 	case "Query.isHealthy":
 		if e.complexity.Query.IsHealthy == nil {
@@ -200,14 +200,21 @@ type Token {
     token: String! # eg.: "1edfc34g"
 }
 
+# TokenBindingInput describes the context a token is requested in. It is embedded in the issued certificate.
+input TokenBindingInput {
+    tenant: String
+    createdBy: String
+    allowedSourceCIDRs: [String!] # eg.: ["10.0.0.0/8", "192.168.0.1"]
+}
+
 type Query {	
     isHealthy: Boolean!	
 }	
 
 type Mutation {	
     # Tokens	
-    generateApplicationToken(authID: ID!): Token!
-    generateRuntimeToken(authID: ID!): Token!
+    generateApplicationToken(authID: ID!, binding: TokenBindingInput): Token!
+    generateRuntimeToken(authID: ID!, binding: TokenBindingInput): Token!
 }
 `},
 )
@@ -227,6 +234,14 @@ func (ec *executionContext) field_Mutation_generateApplicationToken_args(ctx con
 		}
 	}
 	args["authID"] = arg0
+	var arg1 *TokenBindingInput
+	if tmp, ok := rawArgs["binding"]; ok {
+		arg1, err = ec.unmarshalOTokenBindingInput2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋinternalschemaᚐTokenBindingInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["binding"] = arg1
 	return args, nil
 }
 
@@ -241,6 +256,14 @@ func (ec *executionContext) field_Mutation_generateRuntimeToken_args(ctx context
 		}
 	}
 	args["authID"] = arg0
+	var arg1 *TokenBindingInput
+	if tmp, ok := rawArgs["binding"]; ok {
+		arg1, err = ec.unmarshalOTokenBindingInput2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋinternalschemaᚐTokenBindingInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["binding"] = arg1
 	return args, nil
 }
 
@@ -310,7 +333,7 @@ func (ec *executionContext) _Mutation_generateApplicationToken(ctx context.Conte
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().GenerateApplicationToken(rctx, args["authID"].(string))
+		return ec.resolvers.Mutation().GenerateApplicationToken(rctx, args["authID"].(string), args["binding"].(*TokenBindingInput))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -344,7 +367,7 @@ func (ec *executionContext) _Mutation_generateRuntimeToken(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().GenerateRuntimeToken(rctx, args["authID"].(string))
+		return ec.resolvers.Mutation().GenerateRuntimeToken(rctx, args["authID"].(string), args["binding"].(*TokenBindingInput))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1298,6 +1321,36 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputTokenBindingInput(ctx context.Context, v interface{}) (TokenBindingInput, error) {
+	var it TokenBindingInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "tenant":
+			var err error
+			it.Tenant, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "createdBy":
+			var err error
+			it.CreatedBy, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allowedSourceCIDRs":
+			var err error
+			it.AllowedSourceCIDRs, err = ec.unmarshalOString2ᚕstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -1971,6 +2024,38 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -1986,6 +2071,18 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return ec.marshalOString2string(ctx, sel, *v)
 }
 
+func (ec *executionContext) unmarshalOTokenBindingInput2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋinternalschemaᚐTokenBindingInput(ctx context.Context, v interface{}) (TokenBindingInput, error) {
+	return ec.unmarshalInputTokenBindingInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalOTokenBindingInput2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋinternalschemaᚐTokenBindingInput(ctx context.Context, v interface{}) (*TokenBindingInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOTokenBindingInput2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋinternalschemaᚐTokenBindingInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋvendorᚋgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -5,34 +5,36 @@ import (
 	"regexp"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 )
 
 //go:generate mockery -name=CertificateHeaderParser
 type CertificateHeaderParser interface {
-	GetCertificateData(r *http.Request) (string, string, bool)
+	GetCertificateData(r *http.Request) (certificates.CertificateData, bool)
 }
 
 type certificateInfo struct {
-	Hash    string
-	Subject string
+	Hash         string
+	Subject      string
+	ConsumerType tokens.TokenType
 }
 
 type headerParser struct {
 	certHeaderName string
-	certificates.CSRSubjectConsts
+	profiles       *certificates.Profiles
 }
 
-func NewHeaderParser(certHeaderName string, csrSubjectConsts certificates.CSRSubjectConsts) CertificateHeaderParser {
+func NewHeaderParser(certHeaderName string, profiles *certificates.Profiles) CertificateHeaderParser {
 	return &headerParser{
-		certHeaderName:   certHeaderName,
-		CSRSubjectConsts: csrSubjectConsts,
+		certHeaderName: certHeaderName,
+		profiles:       profiles,
 	}
 }
 
-func (hp *headerParser) GetCertificateData(r *http.Request) (string, string, bool) {
+func (hp *headerParser) GetCertificateData(r *http.Request) (certificates.CertificateData, bool) {
 	certHeader := r.Header.Get(hp.certHeaderName)
 	if certHeader == "" {
-		return "", "", false
+		return certificates.CertificateData{}, false
 	}
 
 	subjectRegex := regexp.MustCompile(`Subject="(.*?)"`)
@@ -45,10 +47,14 @@ func (hp *headerParser) GetCertificateData(r *http.Request) (string, string, boo
 
 	certificateInfo, found := hp.getCertificateInfoWithMatchingSubject(certificateInfos)
 	if !found {
-		return "", "", false
+		return certificates.CertificateData{}, false
 	}
 
-	return GetCommonName(certificateInfo.Subject), certificateInfo.Hash, true
+	return certificates.CertificateData{
+		CommonName:   GetCommonName(certificateInfo.Subject),
+		Hash:         certificateInfo.Hash,
+		ConsumerType: certificateInfo.ConsumerType,
+	}, true
 }
 
 func createCertInfos(subjects, hashes []string) []certificateInfo {
@@ -66,7 +72,21 @@ func createCertInfos(subjects, hashes []string) []certificateInfo {
 
 func (hp *headerParser) getCertificateInfoWithMatchingSubject(infos []certificateInfo) (certificateInfo, bool) {
 	for _, info := range infos {
-		if hp.isSubjectMatching(info) {
+		// the consumer type is taken from the certificate, the profiles are matched by subject only for older certificates
+		if _, consumerType := certificates.SplitOrganizationalUnits(GetOrganizationalUnits(info.Subject)); consumerType != "" {
+			profile := hp.profiles.ForType(consumerType)
+			if profile.ConsumerType == consumerType && isSubjectMatching(info, profile.Subject) {
+				info.ConsumerType = consumerType
+				return info, true
+			}
+			continue
+		}
+
+		consumerType, matched := hp.profiles.MatchSubject(func(subject certificates.CSRSubjectConsts) bool {
+			return isSubjectMatching(info, subject)
+		})
+		if matched {
+			info.ConsumerType = consumerType
 			return info, true
 		}
 	}
@@ -81,9 +101,10 @@ func newCertificateInfo(subject, hash string) certificateInfo {
 	return certInfo
 }
 
-func (hp *headerParser) isSubjectMatching(i certificateInfo) bool {
-	return GetOrganization(i.Subject) == hp.Organization && GetOrganizationalUnit(i.Subject) == hp.OrganizationalUnit &&
-		GetCountry(i.Subject) == hp.Country && GetLocality(i.Subject) == hp.Locality && GetProvince(i.Subject) == hp.Province
+func isSubjectMatching(i certificateInfo, subject certificates.CSRSubjectConsts) bool {
+	organizationalUnits, _ := certificates.SplitOrganizationalUnits(GetOrganizationalUnits(i.Subject))
+	return GetOrganization(i.Subject) == subject.Organization && len(organizationalUnits) > 0 && organizationalUnits[0] == subject.OrganizationalUnit &&
+		GetCountry(i.Subject) == subject.Country && GetLocality(i.Subject) == subject.Locality && GetProvince(i.Subject) == subject.Province
 }
 
 func extractFromHeader(certHeader string, regex *regexp.Regexp) []string {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,8 +23,22 @@ var (
 		Locality:           "Waldorf",
 		Province:           "Waldorf",
 	}
+
+	runtimeSubjectConsts = certificates.CSRSubjectConsts{
+		Country:            "DE",
+		Organization:       "organization",
+		OrganizationalUnit: "Runtimes",
+		Locality:           "Waldorf",
+		Province:           "Waldorf",
+	}
 )
 
+func newProfiles() *certificates.Profiles {
+	return certificates.NewProfiles(certificates.Profile{Subject: csrSubjectConsts}).
+		Add(tokens.ApplicationToken, certificates.Profile{Subject: csrSubjectConsts}).
+		Add(tokens.RuntimeToken, certificates.Profile{Subject: runtimeSubjectConsts})
+}
+
 func TestParseCertHeader(t *testing.T) {
 
 	t.Run("should return valid common name and hash", func(t *testing.T) {
@@ -34,15 +49,72 @@ func TestParseCertHeader(t *testing.T) {
 		r.Header.Set(certHeader, "Hash=f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad;Subject=\"CN=test-application,OU=OrgUnit,O=organization,L=Waldorf,ST=Waldorf,C=DE\";URI=spiffe://cluster.local/ns/kyma-integration/sa/default;"+
 			"Hash=6d1f9f3a6ac94ff925841aeb9c15bb3323014e3da2c224ea7697698acf413226;Subject=\"\";URI=spiffe://cluster.local/ns/istio-system/sa/istio-ingressgateway-service-account")
 
-		hp := NewHeaderParser(certHeader, csrSubjectConsts)
+		hp := NewHeaderParser(certHeader, newProfiles())
+
+		//when
+		certData, found := hp.GetCertificateData(r)
+
+		//then
+		require.True(t, found)
+		assert.Equal(t, "f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad", certData.Hash)
+		assert.Equal(t, "test-application", certData.CommonName)
+		assert.Equal(t, tokens.ApplicationToken, certData.ConsumerType)
+	})
+
+	t.Run("should return consumer type of the profile with matching subject", func(t *testing.T) {
+		//given
+		r, err := http.NewRequest("GET", "", nil)
+		require.NoError(t, err)
+
+		r.Header.Set(certHeader, "Hash=f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad;Subject=\"CN=test-runtime,OU=Runtimes,O=organization,L=Waldorf,ST=Waldorf,C=DE\";URI=spiffe://cluster.local/ns/kyma-integration/sa/default")
+
+		hp := NewHeaderParser(certHeader, newProfiles())
+
+		//when
+		certData, found := hp.GetCertificateData(r)
+
+		//then
+		require.True(t, found)
+		assert.Equal(t, "test-runtime", certData.CommonName)
+		assert.Equal(t, tokens.RuntimeToken, certData.ConsumerType)
+	})
+
+	t.Run("should return consumer type from certificate if profiles share subject", func(t *testing.T) {
+		//given
+		r, err := http.NewRequest("GET", "", nil)
+		require.NoError(t, err)
+
+		r.Header.Set(certHeader, "Hash=f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad;Subject=\"CN=test-runtime,OU=ConsumerType:Runtime+OU=OrgUnit,O=organization,L=Waldorf,ST=Waldorf,C=DE\";URI=spiffe://cluster.local/ns/kyma-integration/sa/default")
+
+		profiles := certificates.NewProfiles(certificates.Profile{Subject: csrSubjectConsts}).
+			Add(tokens.ApplicationToken, certificates.Profile{Subject: csrSubjectConsts}).
+			Add(tokens.RuntimeToken, certificates.Profile{Subject: csrSubjectConsts})
+		hp := NewHeaderParser(certHeader, profiles)
 
 		//when
-		commonName, hash, found := hp.GetCertificateData(r)
+		certData, found := hp.GetCertificateData(r)
 
 		//then
 		require.True(t, found)
-		assert.Equal(t, "f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad", hash)
-		assert.Equal(t, "test-application", commonName)
+		assert.Equal(t, "test-runtime", certData.CommonName)
+		assert.Equal(t, tokens.RuntimeToken, certData.ConsumerType)
+	})
+
+	t.Run("should not found certificate data if consumer type from certificate has no profile", func(t *testing.T) {
+		//given
+		r, err := http.NewRequest("GET", "", nil)
+		require.NoError(t, err)
+
+		r.Header.Set(certHeader, "Hash=f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad;Subject=\"CN=test-runtime,OU=OrgUnit+OU=ConsumerType:Unknown,O=organization,L=Waldorf,ST=Waldorf,C=DE\";URI=spiffe://cluster.local/ns/kyma-integration/sa/default")
+
+		hp := NewHeaderParser(certHeader, newProfiles())
+
+		//when
+		certData, found := hp.GetCertificateData(r)
+
+		//then
+		require.False(t, found)
+		assert.Empty(t, certData)
 	})
 
 	t.Run("should not found certificate data if non is matching", func(t *testing.T) {
@@ -53,15 +125,14 @@ func TestParseCertHeader(t *testing.T) {
 		r.Header.Set(certHeader, "Hash=f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad;Subject=\"\";URI=spiffe://cluster.local/ns/kyma-integration/sa/default;"+
 			"Hash=6d1f9f3a6ac94ff925841aeb9c15bb3323014e3da2c224ea7697698acf413226;Subject=\"\";URI=spiffe://cluster.local/ns/istio-system/sa/istio-ingressgateway-service-account")
 
-		hp := NewHeaderParser(certHeader, csrSubjectConsts)
+		hp := NewHeaderParser(certHeader, newProfiles())
 
 		//when
-		commonName, hash, found := hp.GetCertificateData(r)
+		certData, found := hp.GetCertificateData(r)
 
 		//then
 		require.False(t, found)
-		assert.Empty(t, commonName)
-		assert.Empty(t, hash)
+		assert.Empty(t, certData)
 	})
 
 	t.Run("should not found certificate data if header is invalid", func(t *testing.T) {
@@ -71,15 +142,14 @@ func TestParseCertHeader(t *testing.T) {
 
 		r.Header.Set(certHeader, "invalid header")
 
-		hp := NewHeaderParser(certHeader, csrSubjectConsts)
+		hp := NewHeaderParser(certHeader, newProfiles())
 
 		//when
-		commonName, hash, found := hp.GetCertificateData(r)
+		certData, found := hp.GetCertificateData(r)
 
 		//then
 		require.False(t, found)
-		assert.Empty(t, commonName)
-		assert.Empty(t, hash)
+		assert.Empty(t, certData)
 	})
 
 	t.Run("should not found certificate data if header is empty", func(t *testing.T) {
@@ -87,14 +157,13 @@ func TestParseCertHeader(t *testing.T) {
 		r, err := http.NewRequest("GET", "", nil)
 		require.NoError(t, err)
 
-		hp := NewHeaderParser(certHeader, csrSubjectConsts)
+		hp := NewHeaderParser(certHeader, newProfiles())
 
 		//when
-		commonName, hash, found := hp.GetCertificateData(r)
+		certData, found := hp.GetCertificateData(r)
 
 		// then
 		require.False(t, found)
-		assert.Empty(t, commonName)
-		assert.Empty(t, hash)
+		assert.Empty(t, certData)
 	})
 }
@@ -5,11 +5,20 @@ import "net/http"
 const (
 	ConnectorTokenHeader string = "Connector-Token"
 
+	ForwardedForHeader string = "X-Forwarded-For"
+
 	ConnectorTokenQueryParam string = "token"
 
 	ClientIdFromTokenHeader       = "Client-Id-From-Token"
 	ClientIdFromCertificateHeader = "Client-Id-From-Certificate"
 	ClientCertificateHashHeader   = "Client-Certificate-Hash"
+	ClientSourceIPHeader          = "Client-Source-Ip"
+
+	ConsumerTypeFromTokenHeader       = "Consumer-Type-From-Token"
+	ConsumerTypeFromCertificateHeader = "Consumer-Type-From-Certificate"
+
+	RenewedCertificateHashFromTokenHeader = "Renewed-Certificate-Hash-From-Token"
+	TokenBindingFromTokenHeader           = "Token-Binding-From-Token"
 )
 
 type AuthenticationSession struct {
@@ -5,6 +5,8 @@ package mocks
 import (
 	http "net/http"
 
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,29 +16,22 @@ type CertificateHeaderParser struct {
 }
 
 // GetCertificateData provides a mock function with given fields: r
-func (_m *CertificateHeaderParser) GetCertificateData(r *http.Request) (string, string, bool) {
+func (_m *CertificateHeaderParser) GetCertificateData(r *http.Request) (certificates.CertificateData, bool) {
 	ret := _m.Called(r)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*http.Request) string); ok {
+	var r0 certificates.CertificateData
+	if rf, ok := ret.Get(0).(func(*http.Request) certificates.CertificateData); ok {
 		r0 = rf(r)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(certificates.CertificateData)
 	}
 
-	var r1 string
-	if rf, ok := ret.Get(1).(func(*http.Request) string); ok {
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*http.Request) bool); ok {
 		r1 = rf(r)
 	} else {
-		r1 = ret.Get(1).(string)
-	}
-
-	var r2 bool
-	if rf, ok := ret.Get(2).(func(*http.Request) bool); ok {
-		r2 = rf(r)
-	} else {
-		r2 = ret.Get(2).(bool)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
@@ -2,12 +2,23 @@ package oathkeeper
 
 import "regexp"
 
+var organizationalUnitsRegex = regexp.MustCompile("(?:^|[,+])OU=([^,+]+)")
+
 func GetOrganization(subject string) string {
 	return getRegexMatch("O=([^,]+)", subject)
 }
 
 func GetOrganizationalUnit(subject string) string {
-	return getRegexMatch("OU=([^,]+)", subject)
+	return getRegexMatch("OU=([^,+]+)", subject)
+}
+
+// GetOrganizationalUnits returns all Organizational Units, including the ones of multi-valued RDNs, such as OU=a+OU=b
+func GetOrganizationalUnits(subject string) []string {
+	var units []string
+	for _, match := range organizationalUnitsRegex.FindAllStringSubmatch(subject, -1) {
+		units = append(units, match[1])
+	}
+	return units
 }
 
 func GetCountry(subject string) string {
@@ -46,4 +46,11 @@ func TestSubjectExtraction(t *testing.T) {
 		})
 	}
 
+	t.Run("should extract organizational units of multi-valued RDN", func(t *testing.T) {
+		subject := "CN=application,OU=OrgUnit+OU=ConsumerType:Application,O=Org,L=Waldorf,ST=Waldorf,C=DE"
+
+		assert.Equal(t, "OrgUnit", GetOrganizationalUnit(subject))
+		assert.Equal(t, []string{"OrgUnit", "ConsumerType:Application"}, GetOrganizationalUnits(subject))
+		assert.Equal(t, "Org", GetOrganization(subject))
+	})
 }
@@ -3,11 +3,15 @@ package oathkeeper
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/httputils"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/pkg/errors"
@@ -22,13 +26,21 @@ type validationHydrator struct {
 	tokenService           tokens.Service
 	certHeaderParser       CertificateHeaderParser
 	revokedCertsRepository revocation.RevokedCertificatesRepository
+	renewalService         renewal.Service
+	guard                  ratelimit.Guard
+	trustedProxies         int
 }
 
-func NewValidationHydrator(tokenService tokens.Service, certHeaderParser CertificateHeaderParser, revokedCertsRepository revocation.RevokedCertificatesRepository) ValidationHydrator {
+// NewValidationHydrator creates the hydrator. trustedProxies is the number of proxies appending to the X-Forwarded-For header
+// in front of the hydrator, which is used to determine the source IP of the request.
+func NewValidationHydrator(tokenService tokens.Service, certHeaderParser CertificateHeaderParser, revokedCertsRepository revocation.RevokedCertificatesRepository, renewalService renewal.Service, guard ratelimit.Guard, trustedProxies int) ValidationHydrator {
 	return &validationHydrator{
 		tokenService:           tokenService,
 		certHeaderParser:       certHeaderParser,
 		revokedCertsRepository: revokedCertsRepository,
+		renewalService:         renewalService,
+		guard:                  guard,
+		trustedProxies:         trustedProxies,
 	}
 }
 
@@ -55,20 +67,66 @@ func (tvh *validationHydrator) ResolveConnectorTokenHeader(w http.ResponseWriter
 		return
 	}
 
+	sourceIP := tvh.sourceIP(r)
+	if err := tvh.guard.Allow(ctx, ratelimit.TokenRedemption, sourceIP, ""); err != nil {
+		log.C(ctx).Infof("Token redemption rejected: %s", err.Error())
+		respondWithAuthSession(ctx, w, authSession)
+		return
+	}
+
 	log.C(ctx).Info("Trying to resolve token...")
 
 	tokenData, err := tvh.tokenService.Resolve(connectorToken)
 	if err != nil {
 		log.C(ctx).Infof("Invalid token provided: %s", err.Error())
+		tvh.guard.Failure(ctx, ratelimit.TokenRedemption, sourceIP, "")
+		respondWithAuthSession(ctx, w, authSession)
+		return
+	}
+
+	if !tokenData.Binding.AllowsSource(sourceIP) {
+		log.C(ctx).Infof("Token for %s redeemed from not allowed address %s", tokenData.ClientId, sourceIP)
+		tvh.guard.Failure(ctx, ratelimit.TokenRedemption, sourceIP, tokenData.ClientId)
+		respondWithAuthSession(ctx, w, authSession)
+		return
+	}
+
+	if err := checkTokenContext(tokenData); err != nil {
+		log.C(ctx).Infof("Token for %s rejected: %s", tokenData.ClientId, err.Error())
+		respondWithAuthSession(ctx, w, authSession)
+		return
+	}
+
+	if err := tvh.guard.Allow(ctx, ratelimit.TokenRedemption, "", tokenData.ClientId); err != nil {
+		log.C(ctx).Infof("Token redemption for client with id %s rejected: %s", tokenData.ClientId, err.Error())
 		respondWithAuthSession(ctx, w, authSession)
 		return
 	}
+	tvh.guard.Success(sourceIP, tokenData.ClientId)
+
+	var binding string
+	if !tokenData.Binding.IsEmpty() {
+		binding, err = tokenData.Binding.Encode()
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to encode binding of token for %s", tokenData.ClientId)
+			respondWithAuthSession(ctx, w, authSession)
+			return
+		}
+	}
 
 	if authSession.Header == nil {
 		authSession.Header = map[string][]string{}
 	}
 
 	authSession.Header.Add(ClientIdFromTokenHeader, tokenData.ClientId)
+	authSession.Header.Add(ClientSourceIPHeader, sourceIP)
+	authSession.Header.Add(ConsumerTypeFromTokenHeader, string(tokenData.ConsumerType))
+	if tokenData.RenewedCertificateHash != "" {
+		authSession.Header.Add(RenewedCertificateHashFromTokenHeader, tokenData.RenewedCertificateHash)
+	}
+	if binding != "" {
+		authSession.Header.Add(TokenBindingFromTokenHeader, binding)
+	}
 
 	tvh.tokenService.Delete(connectorToken)
 
@@ -90,30 +148,78 @@ func (tvh *validationHydrator) ResolveIstioCertHeader(w http.ResponseWriter, r *
 
 	log.C(ctx).Info("Trying to validate certificate header...")
 
-	commonName, hash, found := tvh.certHeaderParser.GetCertificateData(r)
+	certData, found := tvh.certHeaderParser.GetCertificateData(r)
 	if !found {
 		log.C(ctx).Info("No valid certificate header found")
 		respondWithAuthSession(ctx, w, authSession)
 		return
 	}
 
-	if isCertificateRevoked := tvh.revokedCertsRepository.Contains(hash); isCertificateRevoked {
+	if isCertificateRevoked := tvh.revokedCertsRepository.Contains(certData.Hash); isCertificateRevoked {
 		log.C(ctx).Info("Certificate is revoked.")
 		respondWithAuthSession(ctx, w, authSession)
 		return
 	}
 
+	if isCertificateValid := tvh.renewalService.CertificateUsed(ctx, certData.Hash); !isCertificateValid {
+		log.C(ctx).Info("Certificate has been renewed and its overlap period has passed.")
+		respondWithAuthSession(ctx, w, authSession)
+		return
+	}
+
 	if authSession.Header == nil {
 		authSession.Header = map[string][]string{}
 	}
 
-	authSession.Header.Add(ClientIdFromCertificateHeader, commonName)
-	authSession.Header.Add(ClientCertificateHashHeader, hash)
+	authSession.Header.Add(ClientIdFromCertificateHeader, certData.CommonName)
+	authSession.Header.Add(ClientCertificateHashHeader, certData.Hash)
+	authSession.Header.Add(ClientSourceIPHeader, tvh.sourceIP(r))
+	authSession.Header.Add(ConsumerTypeFromCertificateHeader, string(certData.ConsumerType))
 
 	log.C(ctx).Info("Certificate header validated successfully")
 	respondWithAuthSession(ctx, w, authSession)
 }
 
+// checkTokenContext verifies that the token is issued for a known consumer type and bound to a tenant.
+// CSR tokens issued for renewal of certificates which predate token binding are not bound to any tenant.
+func checkTokenContext(tokenData tokens.TokenData) error {
+	if tokenData.ConsumerType != tokens.ApplicationToken && tokenData.ConsumerType != tokens.RuntimeToken {
+		return errors.Errorf("unknown consumer type %s", tokenData.ConsumerType)
+	}
+
+	if tokenData.Type != tokens.CSRToken && tokenData.Type != tokenData.ConsumerType {
+		return errors.Errorf("token of type %s issued for consumer type %s", tokenData.Type, tokenData.ConsumerType)
+	}
+
+	if tokenData.Binding.Tenant == "" && tokenData.RenewedCertificateHash == "" {
+		return errors.New("token is not bound to any tenant")
+	}
+
+	return nil
+}
+
+// sourceIP returns the address appended to the X-Forwarded-For header by the outermost trusted proxy,
+// or the remote address of the request if there is no such proxy
+func (tvh *validationHydrator) sourceIP(r *http.Request) string {
+	var forwardedFor []string
+	for _, address := range strings.Split(r.Header.Get(ForwardedForHeader), ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			forwardedFor = append(forwardedFor, address)
+		}
+	}
+
+	if tvh.trustedProxies > 0 && len(forwardedFor) >= tvh.trustedProxies {
+		return forwardedFor[len(forwardedFor)-tvh.trustedProxies]
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
 func respondWithAuthSession(ctx context.Context, w http.ResponseWriter, authSession AuthenticationSession) {
 	httputils.RespondWithBody(ctx, w, http.StatusOK, authSession)
 }
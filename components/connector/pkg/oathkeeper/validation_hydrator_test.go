@@ -9,10 +9,14 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	rateLimitMocks "github.com/kyma-incubator/compass/components/connector/internal/ratelimit/mocks"
+	renewalMocks "github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
 	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	mocks2 "github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper/mocks"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 
 	"github.com/stretchr/testify/assert"
 
@@ -26,12 +30,37 @@ const (
 	token    = "abcd-token"
 	clientId = "abcd-client-id"
 	hash     = "qwertyuiop"
+
+	forwardedFor = "10.0.0.1, 192.168.0.1"
+	sourceIP     = "192.168.0.1"
 )
 
 var (
 	tokenData = tokens.TokenData{
-		Type:     tokens.ApplicationToken,
-		ClientId: clientId,
+		Type:         tokens.ApplicationToken,
+		ClientId:     clientId,
+		ConsumerType: tokens.ApplicationToken,
+		Binding: tokens.Binding{
+			Tenant: "tenant",
+		},
+	}
+
+	csrTokenData = tokens.TokenData{
+		Type:                   tokens.CSRToken,
+		ClientId:               clientId,
+		ConsumerType:           tokens.RuntimeToken,
+		RenewedCertificateHash: hash,
+		Binding: tokens.Binding{
+			Tenant:             "tenant",
+			CreatedBy:          "admin",
+			AllowedSourceCIDRs: []string{"192.168.0.0/16"},
+		},
+	}
+
+	certData = certificates.CertificateData{
+		CommonName:   clientId,
+		Hash:         hash,
+		ConsumerType: tokens.RuntimeToken,
 	}
 )
 
@@ -43,12 +72,14 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPost, "", bytes.NewBuffer(marshalledSession))
 		require.NoError(t, err)
 		req.Header.Add(ConnectorTokenHeader, token)
+		req.Header.Add(ForwardedForHeader, forwardedFor)
 		return req
 	}
 
 	createAuthRequestWithTokenQueryParam := func(t *testing.T) *http.Request {
 		req, err := http.NewRequest(http.MethodPost, "?token="+token, bytes.NewBuffer(marshalledSession))
 		require.NoError(t, err)
+		req.Header.Add(ForwardedForHeader, forwardedFor)
 		return req
 	}
 
@@ -60,8 +91,12 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		tokenService := &mocks.Service{}
 		tokenService.On("Resolve", token).Return(tokenData, nil)
 		tokenService.On("Delete", token).Return(nil)
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, "", clientId).Return(nil)
+		guard.On("Success", sourceIP, clientId).Return()
 
-		validator := NewValidationHydrator(tokenService, nil, nil)
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
 
 		// when
 		validator.ResolveConnectorTokenHeader(rr, req)
@@ -74,7 +109,9 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, []string{clientId}, authSession.Header[ClientIdFromTokenHeader])
-		mock.AssertExpectationsForObjects(t, tokenService)
+		assert.Equal(t, []string{string(tokens.ApplicationToken)}, authSession.Header[ConsumerTypeFromTokenHeader])
+		assert.Equal(t, []string{sourceIP}, authSession.Header[ClientSourceIPHeader])
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
 	})
 
 	t.Run("should resolve token from query params and add header to response", func(t *testing.T) {
@@ -83,10 +120,14 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		rr := httptest.NewRecorder()
 
 		tokenService := &mocks.Service{}
-		tokenService.On("Resolve", token).Return(tokenData, nil)
+		tokenService.On("Resolve", token).Return(csrTokenData, nil)
 		tokenService.On("Delete", token).Return(nil)
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, "", clientId).Return(nil)
+		guard.On("Success", sourceIP, clientId).Return()
 
-		validator := NewValidationHydrator(tokenService, nil, nil)
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
 
 		// when
 		validator.ResolveConnectorTokenHeader(rr, req)
@@ -99,7 +140,13 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, []string{clientId}, authSession.Header[ClientIdFromTokenHeader])
-		mock.AssertExpectationsForObjects(t, tokenService)
+		assert.Equal(t, []string{string(tokens.RuntimeToken)}, authSession.Header[ConsumerTypeFromTokenHeader])
+		assert.Equal(t, []string{hash}, authSession.Header[RenewedCertificateHashFromTokenHeader])
+
+		binding, err := tokens.DecodeBinding(authSession.Header.Get(TokenBindingFromTokenHeader))
+		require.NoError(t, err)
+		assert.Equal(t, csrTokenData.Binding, binding)
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
 	})
 
 	t.Run("should not modify authentication session if failed to resolved token", func(t *testing.T) {
@@ -109,8 +156,11 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 
 		tokenService := &mocks.Service{}
 		tokenService.On("Resolve", token).Return(tokens.TokenData{}, apperrors.NotFound("error"))
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+		guard.On("Failure", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return()
 
-		validator := NewValidationHydrator(tokenService, nil, nil)
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
 
 		// when
 		validator.ResolveConnectorTokenHeader(rr, req)
@@ -123,7 +173,189 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, emptyAuthSession(), authSession)
-		mock.AssertExpectationsForObjects(t, tokenService)
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
+	})
+
+	t.Run("should not modify authentication session if source IP is rate limited", func(t *testing.T) {
+		// given
+		req := createAuthRequestWithTokenHeader(t)
+		rr := httptest.NewRecorder()
+
+		tokenService := &mocks.Service{}
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(apperrors.TooManyRequests("error"))
+
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
+
+		// when
+		validator.ResolveConnectorTokenHeader(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var authSession AuthenticationSession
+		err = json.NewDecoder(rr.Body).Decode(&authSession)
+		require.NoError(t, err)
+
+		assert.Equal(t, emptyAuthSession(), authSession)
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
+	})
+
+	t.Run("should not modify authentication session nor delete token if client is rate limited", func(t *testing.T) {
+		// given
+		req := createAuthRequestWithTokenHeader(t)
+		rr := httptest.NewRecorder()
+
+		tokenService := &mocks.Service{}
+		tokenService.On("Resolve", token).Return(tokenData, nil)
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, "", clientId).Return(apperrors.TooManyRequests("error"))
+
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
+
+		// when
+		validator.ResolveConnectorTokenHeader(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var authSession AuthenticationSession
+		err = json.NewDecoder(rr.Body).Decode(&authSession)
+		require.NoError(t, err)
+
+		assert.Equal(t, emptyAuthSession(), authSession)
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
+	})
+
+	t.Run("should not modify authentication session nor delete token if source IP is not allowed by token", func(t *testing.T) {
+		// given
+		req := createAuthRequestWithTokenHeader(t)
+		rr := httptest.NewRecorder()
+
+		boundTokenData := tokenData
+		boundTokenData.Binding = tokens.Binding{AllowedSourceCIDRs: []string{"10.0.0.0/8"}}
+
+		tokenService := &mocks.Service{}
+		tokenService.On("Resolve", token).Return(boundTokenData, nil)
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+		guard.On("Failure", mock.Anything, ratelimit.TokenRedemption, sourceIP, clientId).Return()
+
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
+
+		// when
+		validator.ResolveConnectorTokenHeader(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var authSession AuthenticationSession
+		err = json.NewDecoder(rr.Body).Decode(&authSession)
+		require.NoError(t, err)
+
+		assert.Equal(t, emptyAuthSession(), authSession)
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
+	})
+
+	for _, testCase := range []struct {
+		name      string
+		tokenData tokens.TokenData
+	}{
+		{
+			name: "token is not bound to tenant",
+			tokenData: tokens.TokenData{
+				Type:         tokens.RuntimeToken,
+				ClientId:     clientId,
+				ConsumerType: tokens.RuntimeToken,
+			},
+		},
+		{
+			name: "CSR token is not bound to tenant",
+			tokenData: tokens.TokenData{
+				Type:         tokens.CSRToken,
+				ClientId:     clientId,
+				ConsumerType: tokens.RuntimeToken,
+			},
+		},
+		{
+			name: "consumer type is unknown",
+			tokenData: tokens.TokenData{
+				Type:         tokens.CSRToken,
+				ClientId:     clientId,
+				ConsumerType: tokens.CSRToken,
+				Binding:      tokens.Binding{Tenant: "tenant"},
+			},
+		},
+		{
+			name: "token type does not match consumer type",
+			tokenData: tokens.TokenData{
+				Type:         tokens.ApplicationToken,
+				ClientId:     clientId,
+				ConsumerType: tokens.RuntimeToken,
+				Binding:      tokens.Binding{Tenant: "tenant"},
+			},
+		},
+	} {
+		t.Run("should not modify authentication session nor delete token if "+testCase.name, func(t *testing.T) {
+			// given
+			req := createAuthRequestWithTokenHeader(t)
+			rr := httptest.NewRecorder()
+
+			tokenService := &mocks.Service{}
+			tokenService.On("Resolve", token).Return(testCase.tokenData, nil)
+			guard := &rateLimitMocks.Guard{}
+			guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+
+			validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
+
+			// when
+			validator.ResolveConnectorTokenHeader(rr, req)
+
+			// then
+			assert.Equal(t, http.StatusOK, rr.Code)
+
+			var authSession AuthenticationSession
+			err = json.NewDecoder(rr.Body).Decode(&authSession)
+			require.NoError(t, err)
+
+			assert.Equal(t, emptyAuthSession(), authSession)
+			mock.AssertExpectationsForObjects(t, tokenService, guard)
+		})
+	}
+
+	t.Run("should resolve CSR token of renewal not bound to tenant", func(t *testing.T) {
+		// given
+		req := createAuthRequestWithTokenHeader(t)
+		rr := httptest.NewRecorder()
+
+		renewalTokenData := csrTokenData
+		renewalTokenData.Binding = tokens.Binding{}
+
+		tokenService := &mocks.Service{}
+		tokenService.On("Resolve", token).Return(renewalTokenData, nil)
+		tokenService.On("Delete", token).Return(nil)
+		guard := &rateLimitMocks.Guard{}
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, sourceIP, "").Return(nil)
+		guard.On("Allow", mock.Anything, ratelimit.TokenRedemption, "", clientId).Return(nil)
+		guard.On("Success", sourceIP, clientId).Return()
+
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, guard, 1)
+
+		// when
+		validator.ResolveConnectorTokenHeader(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var authSession AuthenticationSession
+		err = json.NewDecoder(rr.Body).Decode(&authSession)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{clientId}, authSession.Header[ClientIdFromTokenHeader])
+		assert.Equal(t, []string{hash}, authSession.Header[RenewedCertificateHashFromTokenHeader])
+		assert.Empty(t, authSession.Header[TokenBindingFromTokenHeader])
+		mock.AssertExpectationsForObjects(t, tokenService, guard)
 	})
 
 	t.Run("should not modify authentication session if no token provided", func(t *testing.T) {
@@ -134,7 +366,7 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 
 		tokenService := &mocks.Service{}
 
-		validator := NewValidationHydrator(tokenService, nil, nil)
+		validator := NewValidationHydrator(tokenService, nil, nil, nil, nil, 1)
 
 		// when
 		validator.ResolveConnectorTokenHeader(rr, req)
@@ -150,13 +382,42 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, tokenService)
 	})
 
+	t.Run("should not modify authentication session if certificate has been renewed", func(t *testing.T) {
+		// given
+		req, err := http.NewRequest(http.MethodPost, "", bytes.NewBuffer(marshalledSession))
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		certHeaderParser := &mocks2.CertificateHeaderParser{}
+		certHeaderParser.On("GetCertificateData", req).Return(certData, true)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", hash).Return(false)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CertificateUsed", mock.Anything, hash).Return(false)
+
+		validator := NewValidationHydrator(nil, certHeaderParser, revokedCertsRepository, renewalService, nil, 1)
+
+		// when
+		validator.ResolveIstioCertHeader(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var authSession AuthenticationSession
+		err = json.NewDecoder(rr.Body).Decode(&authSession)
+		require.NoError(t, err)
+
+		assert.Equal(t, emptyAuthSession(), authSession)
+		mock.AssertExpectationsForObjects(t, certHeaderParser, renewalService)
+	})
+
 	t.Run("should return error when failed to unmarshal authentication session", func(t *testing.T) {
 		// given
 		req, err := http.NewRequest(http.MethodPost, "", bytes.NewBuffer([]byte("wrong body")))
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 
-		validator := NewValidationHydrator(nil, nil, nil)
+		validator := NewValidationHydrator(nil, nil, nil, nil, nil, 1)
 
 		// when
 		validator.ResolveConnectorTokenHeader(rr, req)
@@ -174,14 +435,17 @@ func TestValidationHydrator_ResolveIstioCertHeader(t *testing.T) {
 		// given
 		req, err := http.NewRequest(http.MethodPost, "", bytes.NewBuffer(marshalledSession))
 		require.NoError(t, err)
+		req.Header.Add(ForwardedForHeader, forwardedFor)
 		rr := httptest.NewRecorder()
 
 		certHeaderParser := &mocks2.CertificateHeaderParser{}
-		certHeaderParser.On("GetCertificateData", req).Return(clientId, hash, true)
+		certHeaderParser.On("GetCertificateData", req).Return(certData, true)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Contains", hash).Return(false)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CertificateUsed", mock.Anything, hash).Return(true)
 
-		validator := NewValidationHydrator(nil, certHeaderParser, revokedCertsRepository)
+		validator := NewValidationHydrator(nil, certHeaderParser, revokedCertsRepository, renewalService, nil, 1)
 
 		// when
 		validator.ResolveIstioCertHeader(rr, req)
@@ -194,7 +458,9 @@ func TestValidationHydrator_ResolveIstioCertHeader(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, []string{clientId}, authSession.Header[ClientIdFromCertificateHeader])
-		mock.AssertExpectationsForObjects(t, certHeaderParser)
+		assert.Equal(t, []string{string(tokens.RuntimeToken)}, authSession.Header[ConsumerTypeFromCertificateHeader])
+		assert.Equal(t, []string{sourceIP}, authSession.Header[ClientSourceIPHeader])
+		mock.AssertExpectationsForObjects(t, certHeaderParser, renewalService)
 	})
 
 	t.Run("should not modify authentication session if no valid cert header found", func(t *testing.T) {
@@ -204,9 +470,9 @@ func TestValidationHydrator_ResolveIstioCertHeader(t *testing.T) {
 		rr := httptest.NewRecorder()
 
 		certHeaderParser := &mocks2.CertificateHeaderParser{}
-		certHeaderParser.On("GetCertificateData", req).Return("", "", false)
+		certHeaderParser.On("GetCertificateData", req).Return(certificates.CertificateData{}, false)
 
-		validator := NewValidationHydrator(nil, certHeaderParser, nil)
+		validator := NewValidationHydrator(nil, certHeaderParser, nil, nil, nil, 1)
 
 		// when
 		validator.ResolveIstioCertHeader(rr, req)
@@ -229,11 +495,11 @@ func TestValidationHydrator_ResolveIstioCertHeader(t *testing.T) {
 		rr := httptest.NewRecorder()
 
 		certHeaderParser := &mocks2.CertificateHeaderParser{}
-		certHeaderParser.On("GetCertificateData", req).Return(clientId, hash, true)
+		certHeaderParser.On("GetCertificateData", req).Return(certData, true)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Contains", hash).Return(true)
 
-		validator := NewValidationHydrator(nil, certHeaderParser, revokedCertsRepository)
+		validator := NewValidationHydrator(nil, certHeaderParser, revokedCertsRepository, nil, nil, 1)
 
 		// when
 		validator.ResolveIstioCertHeader(rr, req)
@@ -249,13 +515,42 @@ func TestValidationHydrator_ResolveIstioCertHeader(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, certHeaderParser)
 	})
 
+	t.Run("should not modify authentication session if certificate has been renewed", func(t *testing.T) {
+		// given
+		req, err := http.NewRequest(http.MethodPost, "", bytes.NewBuffer(marshalledSession))
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		certHeaderParser := &mocks2.CertificateHeaderParser{}
+		certHeaderParser.On("GetCertificateData", req).Return(certData, true)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", hash).Return(false)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CertificateUsed", mock.Anything, hash).Return(false)
+
+		validator := NewValidationHydrator(nil, certHeaderParser, revokedCertsRepository, renewalService, nil, 1)
+
+		// when
+		validator.ResolveIstioCertHeader(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var authSession AuthenticationSession
+		err = json.NewDecoder(rr.Body).Decode(&authSession)
+		require.NoError(t, err)
+
+		assert.Equal(t, emptyAuthSession(), authSession)
+		mock.AssertExpectationsForObjects(t, certHeaderParser, renewalService)
+	})
+
 	t.Run("should return error when failed to unmarshal authentication session", func(t *testing.T) {
 		// given
 		req, err := http.NewRequest(http.MethodPost, "", bytes.NewBuffer([]byte("wrong body")))
 		require.NoError(t, err)
 		rr := httptest.NewRecorder()
 
-		validator := NewValidationHydrator(nil, nil, nil)
+		validator := NewValidationHydrator(nil, nil, nil, nil, nil, 1)
 
 		// when
 		validator.ResolveIstioCertHeader(rr, req)
@@ -265,6 +560,37 @@ func TestValidationHydrator_ResolveIstioCertHeader(t *testing.T) {
 	})
 }
 
+func TestValidationHydrator_SourceIP(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		forwardedFor   string
+		remoteAddr     string
+		trustedProxies int
+		expected       string
+	}{
+		{name: "address appended by trusted proxy", forwardedFor: forwardedFor, remoteAddr: "10.1.0.1:8080", trustedProxies: 1, expected: sourceIP},
+		{name: "address appended by outermost trusted proxy", forwardedFor: forwardedFor, remoteAddr: "10.1.0.1:8080", trustedProxies: 2, expected: "10.0.0.1"},
+		{name: "remote address if header is shorter than trusted proxies", forwardedFor: sourceIP, remoteAddr: "10.1.0.1:8080", trustedProxies: 2, expected: "10.1.0.1"},
+		{name: "remote address if no proxies are trusted", forwardedFor: forwardedFor, remoteAddr: "10.1.0.1:8080", trustedProxies: 0, expected: "10.1.0.1"},
+	} {
+		t.Run("should return "+testCase.name, func(t *testing.T) {
+			// given
+			req, err := http.NewRequest(http.MethodPost, "", nil)
+			require.NoError(t, err)
+			req.Header.Add(ForwardedForHeader, testCase.forwardedFor)
+			req.RemoteAddr = testCase.remoteAddr
+
+			validator := &validationHydrator{trustedProxies: testCase.trustedProxies}
+
+			// when
+			result := validator.sourceIP(req)
+
+			// then
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
 func emptyAuthSession() AuthenticationSession {
 	return AuthenticationSession{
 		Subject: "client",
@@ -0,0 +1,190 @@
+package pairing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/pem"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/clientset"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
+	"github.com/pkg/errors"
+)
+
+// Client pairs a runtime or an application with Compass using the Connector and maintains its client certificate
+type Client struct {
+	clientSet *clientset.ConnectorClientSet
+	store     Store
+}
+
+func NewClient(store Store, options ...clientset.Option) *Client {
+	return &Client{
+		clientSet: clientset.NewConnectorClientSet(options...),
+		store:     store,
+	}
+}
+
+// Pair exchanges the one-time token for a client certificate and saves it in the store.
+// The token can be either raw, in which case the connectorURL is required, or rawEncoded, which contains the Connector URL.
+func (c *Client) Pair(ctx context.Context, token, connectorURL string) (Credentials, error) {
+	oneTimeToken := ParseToken(token)
+	if oneTimeToken.ConnectorURL == "" {
+		oneTimeToken.ConnectorURL = connectorURL
+	}
+	if oneTimeToken.ConnectorURL == "" {
+		return Credentials{}, errors.New("Connector URL not provided")
+	}
+
+	connectorClient := c.clientSet.TokenSecuredClient(oneTimeToken.ConnectorURL)
+
+	configuration, err := connectorClient.Configuration(ctx, oneTimeToken.Token)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	if configuration.Token == nil {
+		return Credentials{}, errors.New("Configuration does not contain CSR token")
+	}
+
+	key, csr, err := newCSR(configuration)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	result, err := connectorClient.SignCSR(ctx, csr, configuration.Token.Token)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	return c.save(key, result, configuration.ManagementPlaneInfo)
+}
+
+// Renew replaces the client certificate with a new one, signed for a newly generated key
+func (c *Client) Renew(ctx context.Context) (Credentials, error) {
+	current, err := c.store.Load()
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while loading credentials")
+	}
+
+	connectorClient := c.clientSet.CertificateSecuredClient(current.ManagementPlaneInfo.CertificateSecuredConnectorURL, current.TLSCertificate())
+
+	configuration, err := connectorClient.Configuration(ctx)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	key, csr, err := newCSR(configuration)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	result, err := connectorClient.SignCSR(ctx, csr)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	return c.save(key, result, configuration.ManagementPlaneInfo)
+}
+
+// RenewIfExpiring renews the client certificate if it expires within given period.
+// It returns the current credentials and false if the renewal is not needed.
+func (c *Client) RenewIfExpiring(ctx context.Context, period time.Duration) (Credentials, bool, error) {
+	current, err := c.store.Load()
+	if err != nil {
+		return Credentials{}, false, errors.Wrap(err, "while loading credentials")
+	}
+
+	if !current.ExpiresWithin(period) {
+		return current, false, nil
+	}
+
+	renewed, err := c.Renew(ctx)
+	if err != nil {
+		return Credentials{}, false, err
+	}
+
+	return renewed, true, nil
+}
+
+// Revoke revokes the client certificate and deletes it from the store
+func (c *Client) Revoke(ctx context.Context) error {
+	current, err := c.store.Load()
+	if err != nil {
+		return errors.Wrap(err, "while loading credentials")
+	}
+
+	connectorClient := c.clientSet.CertificateSecuredClient(current.ManagementPlaneInfo.CertificateSecuredConnectorURL, current.TLSCertificate())
+
+	revoked, err := connectorClient.RevokeCertificate(ctx)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return errors.New("Certificate has not been revoked")
+	}
+
+	return errors.Wrap(c.store.Delete(), "while deleting credentials")
+}
+
+// Credentials returns the stored credentials, or ErrCredentialsNotFound if the client is not paired
+func (c *Client) Credentials() (Credentials, error) {
+	return c.store.Load()
+}
+
+func (c *Client) save(key *rsa.PrivateKey, result externalschema.CertificationResult, info *externalschema.ManagementPlaneInfo) (Credentials, error) {
+	credentials, err := newCredentials(key, result, info)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	if err := c.store.Save(credentials); err != nil {
+		return Credentials{}, errors.Wrap(err, "while saving credentials")
+	}
+
+	return credentials, nil
+}
+
+func newCSR(configuration externalschema.Configuration) (*rsa.PrivateKey, string, error) {
+	csrInfo := configuration.CertificateSigningRequestInfo
+	if csrInfo == nil {
+		return nil, "", errors.New("Configuration does not contain CSR info")
+	}
+
+	key, err := newKey(csrInfo.KeyAlgorithm)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, csr, err := clientset.NewCSR(csrInfo.Subject, key)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "while creating CSR")
+	}
+
+	pemCSR := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})
+
+	return key, base64.StdEncoding.EncodeToString(pemCSR), nil
+}
+
+// newKey generates the key for algorithm in the form returned by the Connector, such as rsa2048
+func newKey(keyAlgorithm string) (*rsa.PrivateKey, error) {
+	algorithm := strings.ToLower(keyAlgorithm)
+	if !strings.HasPrefix(algorithm, "rsa") {
+		return nil, errors.Errorf("Unsupported key algorithm %s", keyAlgorithm)
+	}
+
+	bits, err := strconv.Atoi(strings.TrimPrefix(algorithm, "rsa"))
+	if err != nil {
+		return nil, errors.Errorf("Unsupported key algorithm %s", keyAlgorithm)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, errors.Wrap(err, "while generating key")
+	}
+
+	return key, nil
+}
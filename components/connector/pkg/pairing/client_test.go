@@ -0,0 +1,181 @@
+package pairing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/clientset"
+	"github.com/kyma-incubator/compass/components/connector/pkg/pairing"
+	"github.com/kyma-incubator/compass/components/connector/pkg/pairing/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const clientId = "runtime-id"
+
+func TestClient_Pair(t *testing.T) {
+	connector, err := fake.NewConnector()
+	require.NoError(t, err)
+	defer connector.Close()
+
+	t.Run("should pair with raw token", func(t *testing.T) {
+		// given
+		token, err := connector.IssueToken(clientId)
+		require.NoError(t, err)
+
+		store := pairing.NewMemoryStore()
+		client := pairing.NewClient(store, clientset.WithSkipTLSVerify(true))
+
+		// when
+		credentials, err := client.Pair(context.TODO(), token, connector.URL())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, clientId, credentials.ClientCertificate().Subject.CommonName)
+		require.NoError(t, credentials.ClientCertificate().CheckSignatureFrom(connector.CACertificate()))
+		assert.Equal(t, connector.URL(), credentials.ManagementPlaneInfo.CertificateSecuredConnectorURL)
+
+		stored, err := client.Credentials()
+		require.NoError(t, err)
+		assert.Equal(t, credentials, stored)
+	})
+
+	t.Run("should pair with rawEncoded token", func(t *testing.T) {
+		// given
+		token, err := connector.IssueEncodedToken(clientId)
+		require.NoError(t, err)
+
+		client := pairing.NewClient(pairing.NewMemoryStore(), clientset.WithSkipTLSVerify(true))
+
+		// when
+		credentials, err := client.Pair(context.TODO(), token, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, clientId, credentials.ClientCertificate().Subject.CommonName)
+	})
+
+	t.Run("should fail to pair with used token", func(t *testing.T) {
+		// given
+		token, err := connector.IssueToken(clientId)
+		require.NoError(t, err)
+
+		client := pairing.NewClient(pairing.NewMemoryStore(), clientset.WithSkipTLSVerify(true))
+		_, err = client.Pair(context.TODO(), token, connector.URL())
+		require.NoError(t, err)
+
+		// when
+		_, err = client.Pair(context.TODO(), token, connector.URL())
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should fail to pair without Connector URL", func(t *testing.T) {
+		// given
+		client := pairing.NewClient(pairing.NewMemoryStore(), clientset.WithSkipTLSVerify(true))
+
+		// when
+		_, err := client.Pair(context.TODO(), "token", "")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestClient_Renew(t *testing.T) {
+	connector, err := fake.NewConnector(fake.WithCertificateValidity(time.Hour))
+	require.NoError(t, err)
+	defer connector.Close()
+
+	pair := func(t *testing.T) (*pairing.Client, pairing.Credentials) {
+		token, err := connector.IssueToken(clientId)
+		require.NoError(t, err)
+
+		client := pairing.NewClient(pairing.NewMemoryStore(), clientset.WithSkipTLSVerify(true))
+		credentials, err := client.Pair(context.TODO(), token, connector.URL())
+		require.NoError(t, err)
+
+		return client, credentials
+	}
+
+	t.Run("should renew certificate and revoke the renewed one when the new one is used", func(t *testing.T) {
+		// given
+		client, paired := pair(t)
+
+		// when
+		renewed, err := client.Renew(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.NotEqual(t, paired.ClientCertificate().Raw, renewed.ClientCertificate().Raw)
+		assert.NotEqual(t, paired.PrivateKey, renewed.PrivateKey)
+		assert.False(t, connector.IsRevoked(paired.ClientCertificate()))
+
+		// when
+		_, err = client.Renew(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, connector.IsRevoked(paired.ClientCertificate()))
+	})
+
+	t.Run("should renew certificate only if it is expiring", func(t *testing.T) {
+		// given
+		client, paired := pair(t)
+
+		// when
+		current, renewed, err := client.RenewIfExpiring(context.TODO(), time.Minute)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, renewed)
+		assert.Equal(t, paired, current)
+
+		// when
+		current, renewed, err = client.RenewIfExpiring(context.TODO(), 2*time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, renewed)
+		assert.NotEqual(t, paired.ClientCertificate().Raw, current.ClientCertificate().Raw)
+	})
+
+	t.Run("should fail to renew if not paired", func(t *testing.T) {
+		// given
+		client := pairing.NewClient(pairing.NewMemoryStore())
+
+		// when
+		_, err := client.Renew(context.TODO())
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestClient_Revoke(t *testing.T) {
+	connector, err := fake.NewConnector()
+	require.NoError(t, err)
+	defer connector.Close()
+
+	t.Run("should revoke certificate and delete credentials", func(t *testing.T) {
+		// given
+		token, err := connector.IssueToken(clientId)
+		require.NoError(t, err)
+
+		client := pairing.NewClient(pairing.NewMemoryStore(), clientset.WithSkipTLSVerify(true))
+		credentials, err := client.Pair(context.TODO(), token, connector.URL())
+		require.NoError(t, err)
+
+		// when
+		err = client.Revoke(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, connector.IsRevoked(credentials.ClientCertificate()))
+
+		_, err = client.Credentials()
+		assert.Equal(t, pairing.ErrCredentialsNotFound, err)
+	})
+}
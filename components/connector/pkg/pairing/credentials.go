@@ -0,0 +1,106 @@
+package pairing
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/clientset"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
+	"github.com/pkg/errors"
+)
+
+// Credentials are the client certificate issued by the Connector together with the information needed to use it
+type Credentials struct {
+	PrivateKey *rsa.PrivateKey
+	// CertificateChain starts with the client certificate
+	CertificateChain    []*x509.Certificate
+	CACertificates      []*x509.Certificate
+	ManagementPlaneInfo ManagementPlaneInfo
+}
+
+type ManagementPlaneInfo struct {
+	DirectorURL                    string `json:"directorURL"`
+	CertificateSecuredConnectorURL string `json:"certificateSecuredConnectorURL"`
+}
+
+func (c Credentials) ClientCertificate() *x509.Certificate {
+	return c.CertificateChain[0]
+}
+
+func (c Credentials) TLSCertificate() tls.Certificate {
+	return clientset.NewTLSCertificate(c.PrivateKey, c.CertificateChain...)
+}
+
+// ExpiresWithin returns true if the client certificate expires within given period
+func (c Credentials) ExpiresWithin(period time.Duration) bool {
+	return time.Now().Add(period).After(c.ClientCertificate().NotAfter)
+}
+
+func newCredentials(key *rsa.PrivateKey, result externalschema.CertificationResult, info *externalschema.ManagementPlaneInfo) (Credentials, error) {
+	chain, err := decodeCertificates(result.CertificateChain)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while decoding certificate chain")
+	}
+
+	caCertificates, err := decodeCertificates(result.CaCertificate)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while decoding CA certificate")
+	}
+
+	credentials := Credentials{
+		PrivateKey:       key,
+		CertificateChain: chain,
+		CACertificates:   caCertificates,
+	}
+
+	if info != nil {
+		if info.DirectorURL != nil {
+			credentials.ManagementPlaneInfo.DirectorURL = *info.DirectorURL
+		}
+		if info.CertificateSecuredConnectorURL != nil {
+			credentials.ManagementPlaneInfo.CertificateSecuredConnectorURL = *info.CertificateSecuredConnectorURL
+		}
+	}
+
+	return credentials, nil
+}
+
+func decodeCertificates(encoded string) ([]*x509.Certificate, error) {
+	pemCertificates, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCertificates(pemCertificates)
+}
+
+func parseCertificates(pemCertificates []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	for block, rest := pem.Decode(pemCertificates); block != nil; block, rest = pem.Decode(rest) {
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to decode one of the pem blocks")
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("No certificates found in the pem block")
+	}
+
+	return certificates, nil
+}
+
+func encodeCertificates(certificates []*x509.Certificate) []byte {
+	var pemCertificates []byte
+	for _, certificate := range certificates {
+		pemCertificates = append(pemCertificates, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})...)
+	}
+	return pemCertificates
+}
@@ -0,0 +1,318 @@
+// Package fake provides an in-process Connector for testing its clients
+package fake
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/99designs/gqlgen/handler"
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connector/internal/api"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/metrics"
+	"github.com/kyma-incubator/compass/components/connector/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
+	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	"github.com/pkg/errors"
+)
+
+const (
+	apiEndpoint           = "/graphql"
+	certificateDataHeader = "Certificate-Data"
+
+	caSecretName     = "ca"
+	caCertificateKey = "ca.crt"
+	caKeyKey         = "ca.key"
+
+	tokenLength = 64
+	tokenTTL    = 5 * time.Minute
+
+	tenant = "fake-tenant"
+)
+
+var subject = certificates.CSRSubjectConsts{
+	Country:            "DE",
+	Organization:       "Org",
+	OrganizationalUnit: "OrgUnit",
+	Locality:           "Locality",
+	Province:           "State",
+}
+
+// Connector serves the external GraphQL API of the Connector over TLS. It authenticates the requests
+// with the one-time token or the client certificate the way the Compass Gateway and Oathkeeper do.
+type Connector struct {
+	server                 *httptest.Server
+	tokenService           tokens.Service
+	revokedCertsRepository *revokedCertificatesRepository
+	hydrator               oathkeeper.ValidationHydrator
+	caCertificate          *x509.Certificate
+}
+
+type options struct {
+	certificateValidity time.Duration
+	renewalWindow       time.Duration
+	renewalOverlap      time.Duration
+}
+
+type Option func(*options)
+
+// WithCertificateValidity sets the validity of issued client certificates, 24h by default
+func WithCertificateValidity(validity time.Duration) Option {
+	return func(o *options) {
+		o.certificateValidity = validity
+	}
+}
+
+// WithRenewal sets the certificate renewal window and the overlap period, 720h and 1h by default
+func WithRenewal(window, overlap time.Duration) Option {
+	return func(o *options) {
+		o.renewalWindow = window
+		o.renewalOverlap = overlap
+	}
+}
+
+// NewConnector starts the fake Connector. It must be closed after use.
+func NewConnector(opts ...Option) (*Connector, error) {
+	o := &options{
+		certificateValidity: 24 * time.Hour,
+		renewalWindow:       720 * time.Hour,
+		renewalOverlap:      time.Hour,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	caCertificate, caCertificatePEM, caKeyPEM, err := newCA()
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating CA")
+	}
+
+	certsCache := certificates.NewCertificateCache()
+	certsCache.Put(caSecretName, map[string][]byte{
+		caCertificateKey: caCertificatePEM,
+		caKeyKey:         caKeyPEM,
+	})
+	certificateService := certificates.NewCertificateService(certsCache, certificates.NewCertificateUtility(), caSecretName, "", caCertificateKey, caKeyKey, "")
+
+	certificateProfiles := certificates.NewProfiles(certificates.Profile{
+		Subject:     subject,
+		Validity:    o.certificateValidity,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	tokenService := tokens.NewTokenService(tokens.NewTokenCache(tokenTTL, tokenTTL, tokenTTL), tokens.NewTokenGenerator(tokenLength))
+	revokedCertsRepository := newRevokedCertificatesRepository()
+	renewalService := renewal.NewService(newRenewalRepository(), revokedCertsRepository, o.renewalWindow, o.renewalOverlap)
+	guard := ratelimit.NewGuard(ratelimit.NewLimiter(ratelimit.Config{}), ratelimit.NewLimiter(ratelimit.Config{}), metrics.NewCollector())
+
+	connector := &Connector{
+		tokenService:           tokenService,
+		revokedCertsRepository: revokedCertsRepository,
+		hydrator:               oathkeeper.NewValidationHydrator(tokenService, oathkeeper.NewHeaderParser(certificateDataHeader, certificateProfiles), revokedCertsRepository, renewalService, guard, 0),
+		caCertificate:          caCertificate,
+	}
+
+	connector.server = httptest.NewUnstartedServer(nil)
+	connector.server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+
+	url := connector.URL()
+	certificateResolver := api.NewCertificateResolver(
+		authentication.NewAuthenticator(),
+		tokenService,
+		certificateService,
+		certificateProfiles,
+		url,
+		url,
+		revokedCertsRepository,
+		renewalService,
+		guard)
+
+	executableSchema := externalschema.NewExecutableSchema(externalschema.Config{
+		Resolvers: &api.ExternalResolver{CertificateResolver: certificateResolver},
+	})
+
+	router := mux.NewRouter()
+	router.HandleFunc(apiEndpoint, handler.GraphQL(executableSchema))
+	router.Use(connector.authenticate, authentication.NewAuthenticationContextMiddleware().PropagateAuthentication)
+
+	connector.server.Config.Handler = router
+	connector.server.StartTLS()
+
+	return connector, nil
+}
+
+// URL returns the URL of the GraphQL API, which is both the token and the certificate secured Connector URL
+func (c *Connector) URL() string {
+	return fmt.Sprintf("https://%s%s", c.server.Listener.Addr().String(), apiEndpoint)
+}
+
+// IssueToken issues a one-time token for a Runtime with given id, like the Director does when pairing is requested
+func (c *Connector) IssueToken(clientId string) (string, error) {
+	return c.tokenService.CreateToken(context.Background(), clientId, tokens.RuntimeToken, tokens.Binding{Tenant: tenant})
+}
+
+// IssueEncodedToken issues a one-time token in the rawEncoded form, containing the Connector URL
+func (c *Connector) IssueEncodedToken(clientId string) (string, error) {
+	token, err := c.IssueToken(clientId)
+	if err != nil {
+		return "", err
+	}
+
+	rawJSON, err := json.Marshal(map[string]string{
+		"token":        token,
+		"connectorURL": c.URL(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(rawJSON), nil
+}
+
+func (c *Connector) IsRevoked(certificate *x509.Certificate) bool {
+	return c.revokedCertsRepository.Contains(certificateHash(certificate))
+}
+
+func (c *Connector) CACertificate() *x509.Certificate {
+	return c.caCertificate
+}
+
+func (c *Connector) Close() {
+	c.server.Close()
+}
+
+// authenticate strips the authentication headers like the Gateway does and sets them using the hydrator like Oathkeeper does
+func (c *Connector) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, header := range []string{
+			oathkeeper.ClientIdFromTokenHeader,
+			oathkeeper.ClientIdFromCertificateHeader,
+			oathkeeper.ClientCertificateHashHeader,
+			oathkeeper.ConsumerTypeFromTokenHeader,
+			oathkeeper.ConsumerTypeFromCertificateHeader,
+			oathkeeper.RenewedCertificateHashFromTokenHeader,
+			oathkeeper.TokenBindingFromTokenHeader,
+			oathkeeper.ClientSourceIPHeader,
+			certificateDataHeader,
+		} {
+			r.Header.Del(header)
+		}
+
+		if certificate, ok := c.verifiedCertificate(r); ok {
+			r.Header.Set(certificateDataHeader, fmt.Sprintf(`Hash=%s;Subject="%s"`, certificateHash(certificate), subjectString(certificate.Subject)))
+			c.hydrate(r, c.hydrator.ResolveIstioCertHeader)
+		}
+
+		c.hydrate(r, c.hydrator.ResolveConnectorTokenHeader)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *Connector) verifiedCertificate(r *http.Request) (*x509.Certificate, bool) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, false
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(c.caCertificate)
+
+	certificate := r.TLS.PeerCertificates[0]
+	_, err := certificate.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	return certificate, err == nil
+}
+
+func (c *Connector) hydrate(r *http.Request, resolve http.HandlerFunc) {
+	marshalledSession, err := json.Marshal(oathkeeper.AuthenticationSession{})
+	if err != nil {
+		return
+	}
+
+	hydratorRequest := httptest.NewRequest(http.MethodPost, r.URL.String(), bytes.NewReader(marshalledSession)).WithContext(r.Context())
+	hydratorRequest.Header = r.Header.Clone()
+	hydratorRequest.RemoteAddr = r.RemoteAddr
+
+	recorder := httptest.NewRecorder()
+	resolve(recorder, hydratorRequest)
+
+	var authSession oathkeeper.AuthenticationSession
+	if err := json.NewDecoder(recorder.Body).Decode(&authSession); err != nil {
+		return
+	}
+
+	for header, values := range authSession.Header {
+		r.Header[header] = values
+	}
+}
+
+func certificateHash(certificate *x509.Certificate) string {
+	hash := sha256.Sum256(certificate.Raw)
+	return hex.EncodeToString(hash[:])
+}
+
+func subjectString(name pkix.Name) string {
+	return fmt.Sprintf("CN=%s,OU=%s,O=%s,L=%s,ST=%s,C=%s",
+		name.CommonName, first(name.OrganizationalUnit), first(name.Organization), first(name.Locality), first(name.Province), first(name.Country))
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func newCA() (*x509.Certificate, []byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake Connector CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * 365 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	certificate, err := x509.ParseCertificate(rawCertificate)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rawCertificate})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return certificate, certificatePEM, keyPEM, nil
+}
@@ -0,0 +1,69 @@
+package fake
+
+import (
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+)
+
+type revokedCertificatesRepository struct {
+	mutex  sync.RWMutex
+	hashes map[string]struct{}
+}
+
+func newRevokedCertificatesRepository() *revokedCertificatesRepository {
+	return &revokedCertificatesRepository{
+		hashes: map[string]struct{}{},
+	}
+}
+
+func (r *revokedCertificatesRepository) Insert(hash string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.hashes[hash] = struct{}{}
+	return nil
+}
+
+func (r *revokedCertificatesRepository) Contains(hash string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	_, found := r.hashes[hash]
+	return found
+}
+
+type renewalRepository struct {
+	mutex        sync.RWMutex
+	certificates map[string]renewal.Certificate
+}
+
+func newRenewalRepository() *renewalRepository {
+	return &renewalRepository{
+		certificates: map[string]renewal.Certificate{},
+	}
+}
+
+func (r *renewalRepository) Get(hash string) (renewal.Certificate, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	certificate, found := r.certificates[hash]
+	return certificate, found
+}
+
+func (r *renewalRepository) Upsert(hash string, certificate renewal.Certificate) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.certificates[hash] = certificate
+	return nil
+}
+
+func (r *renewalRepository) Delete(hash string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.certificates, hash)
+	return nil
+}
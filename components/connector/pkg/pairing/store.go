@@ -0,0 +1,272 @@
+package pairing
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	currentCredentialsLink  = "current"
+	credentialsDirPrefix    = "credentials-"
+	privateKeyFile          = "client.key"
+	certificateChainFile    = "client.crt"
+	caCertificatesFile      = "ca.crt"
+	managementPlaneInfoFile = "info.json"
+)
+
+var ErrCredentialsNotFound = errors.New("Credentials not found")
+
+// Store persists the credentials between restarts. Load returns ErrCredentialsNotFound if there are no credentials.
+type Store interface {
+	Load() (Credentials, error)
+	Save(credentials Credentials) error
+	Delete() error
+}
+
+type memoryStore struct {
+	mutex       sync.RWMutex
+	credentials *Credentials
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{}
+}
+
+func (s *memoryStore) Load() (Credentials, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.credentials == nil {
+		return Credentials{}, ErrCredentialsNotFound
+	}
+
+	return *s.credentials, nil
+}
+
+func (s *memoryStore) Save(credentials Credentials) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.credentials = &credentials
+	return nil
+}
+
+func (s *memoryStore) Delete() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.credentials = nil
+	return nil
+}
+
+type fileStore struct {
+	mutex     sync.Mutex
+	directory string
+}
+
+// NewFileStore creates a store keeping the private key, the certificates and the management plane info as PEM and JSON files
+// in a versioned subdirectory of given directory. The current version is selected with a symbolic link, which is replaced
+// atomically, so that Load never finds partially saved credentials, or a private key not matching the certificate.
+func NewFileStore(directory string) Store {
+	return &fileStore{
+		directory: directory,
+	}
+}
+
+func (s *fileStore) Load() (Credentials, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := os.Lstat(s.path(currentCredentialsLink)); os.IsNotExist(err) {
+		return Credentials{}, ErrCredentialsNotFound
+	}
+
+	pemCertificateChain, err := ioutil.ReadFile(s.currentPath(certificateChainFile))
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while reading certificate chain")
+	}
+
+	certificateChain, err := parseCertificates(pemCertificateChain)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while parsing certificate chain")
+	}
+
+	pemCACertificates, err := ioutil.ReadFile(s.currentPath(caCertificatesFile))
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while reading CA certificates")
+	}
+
+	caCertificates, err := parseCertificates(pemCACertificates)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while parsing CA certificates")
+	}
+
+	pemPrivateKey, err := ioutil.ReadFile(s.currentPath(privateKeyFile))
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while reading private key")
+	}
+
+	block, _ := pem.Decode(pemPrivateKey)
+	if block == nil {
+		return Credentials{}, errors.New("Private key not found in the pem block")
+	}
+
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while parsing private key")
+	}
+
+	marshalledInfo, err := ioutil.ReadFile(s.currentPath(managementPlaneInfoFile))
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "while reading management plane info")
+	}
+
+	var info ManagementPlaneInfo
+	if err := json.Unmarshal(marshalledInfo, &info); err != nil {
+		return Credentials{}, errors.Wrap(err, "while unmarshalling management plane info")
+	}
+
+	return Credentials{
+		PrivateKey:          privateKey,
+		CertificateChain:    certificateChain,
+		CACertificates:      caCertificates,
+		ManagementPlaneInfo: info,
+	}, nil
+}
+
+// Save writes the credentials to a new directory, and then switches the current credentials link to it
+func (s *fileStore) Save(credentials Credentials) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.MkdirAll(s.directory, 0700); err != nil {
+		return errors.Wrap(err, "while creating credentials directory")
+	}
+
+	marshalledInfo, err := json.Marshal(credentials.ManagementPlaneInfo)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling management plane info")
+	}
+
+	pemPrivateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(credentials.PrivateKey)})
+
+	files := []struct {
+		name string
+		data []byte
+		mode os.FileMode
+	}{
+		{name: privateKeyFile, data: pemPrivateKey, mode: 0600},
+		{name: caCertificatesFile, data: encodeCertificates(credentials.CACertificates), mode: 0644},
+		{name: managementPlaneInfoFile, data: marshalledInfo, mode: 0644},
+		{name: certificateChainFile, data: encodeCertificates(credentials.CertificateChain), mode: 0644},
+	}
+
+	versionDir, err := ioutil.TempDir(s.directory, credentialsDirPrefix)
+	if err != nil {
+		return errors.Wrap(err, "while creating credentials version directory")
+	}
+
+	for _, file := range files {
+		if err := writeFile(filepath.Join(versionDir, file.name), file.data, file.mode); err != nil {
+			os.RemoveAll(versionDir)
+			return errors.Wrapf(err, "while writing %s", file.name)
+		}
+	}
+
+	if err := syncPath(versionDir); err != nil {
+		os.RemoveAll(versionDir)
+		return errors.Wrap(err, "while syncing credentials version directory")
+	}
+
+	tmpLink := s.path(currentCredentialsLink + ".tmp")
+	if err := os.Remove(tmpLink); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "while removing temporary credentials link")
+	}
+	if err := os.Symlink(filepath.Base(versionDir), tmpLink); err != nil {
+		os.RemoveAll(versionDir)
+		return errors.Wrap(err, "while creating credentials link")
+	}
+	if err := os.Rename(tmpLink, s.path(currentCredentialsLink)); err != nil {
+		os.RemoveAll(versionDir)
+		return errors.Wrap(err, "while switching credentials link")
+	}
+	if err := syncPath(s.directory); err != nil {
+		return errors.Wrap(err, "while syncing credentials directory")
+	}
+
+	return s.removeVersionsExcept(filepath.Base(versionDir))
+}
+
+// Delete removes the current credentials link first, so that Load does not find partially deleted credentials
+func (s *fileStore) Delete() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.Remove(s.path(currentCredentialsLink)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "while removing credentials link")
+	}
+
+	return s.removeVersionsExcept("")
+}
+
+// removeVersionsExcept removes the credentials directories left by previous, or interrupted saves
+func (s *fileStore) removeVersionsExcept(current string) error {
+	versionDirs, err := filepath.Glob(s.path(credentialsDirPrefix + "*"))
+	if err != nil {
+		return errors.Wrap(err, "while listing credentials version directories")
+	}
+
+	for _, versionDir := range versionDirs {
+		if filepath.Base(versionDir) == current {
+			continue
+		}
+		if err := os.RemoveAll(versionDir); err != nil {
+			return errors.Wrapf(err, "while removing %s", versionDir)
+		}
+	}
+
+	return nil
+}
+
+func (s *fileStore) path(name string) string {
+	return filepath.Join(s.directory, name)
+}
+
+func (s *fileStore) currentPath(name string) string {
+	return filepath.Join(s.directory, currentCredentialsLink, name)
+}
+
+func writeFile(path string, data []byte, mode os.FileMode) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func syncPath(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return file.Sync()
+}
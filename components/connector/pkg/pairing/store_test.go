@@ -0,0 +1,160 @@
+package pairing
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	credentials := newTestCredentials(t)
+
+	t.Run("should save and load credentials", func(t *testing.T) {
+		// given
+		directory, err := ioutil.TempDir("", "credentials")
+		require.NoError(t, err)
+		defer os.RemoveAll(directory)
+
+		store := NewFileStore(filepath.Join(directory, "pairing"))
+
+		// when
+		err = store.Save(credentials)
+		require.NoError(t, err)
+
+		loaded, err := store.Load()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, credentials.PrivateKey.D, loaded.PrivateKey.D)
+		assert.Equal(t, credentials.ClientCertificate().Raw, loaded.ClientCertificate().Raw)
+		assert.Len(t, loaded.CACertificates, 1)
+		assert.Equal(t, credentials.ManagementPlaneInfo, loaded.ManagementPlaneInfo)
+
+		info, err := os.Stat(filepath.Join(directory, "pairing", currentCredentialsLink, privateKeyFile))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+
+	t.Run("should replace saved credentials and remove previous version", func(t *testing.T) {
+		// given
+		directory, err := ioutil.TempDir("", "credentials")
+		require.NoError(t, err)
+		defer os.RemoveAll(directory)
+
+		store := NewFileStore(directory)
+		require.NoError(t, store.Save(newTestCredentials(t)))
+
+		// when
+		err = store.Save(credentials)
+		require.NoError(t, err)
+
+		loaded, err := store.Load()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, credentials.PrivateKey.D, loaded.PrivateKey.D)
+		assert.Equal(t, credentials.ClientCertificate().Raw, loaded.ClientCertificate().Raw)
+
+		versionDirs, err := filepath.Glob(filepath.Join(directory, credentialsDirPrefix+"*"))
+		require.NoError(t, err)
+		assert.Len(t, versionDirs, 1)
+	})
+
+	t.Run("should load previous credentials if save was interrupted", func(t *testing.T) {
+		// given
+		directory, err := ioutil.TempDir("", "credentials")
+		require.NoError(t, err)
+		defer os.RemoveAll(directory)
+
+		store := NewFileStore(directory)
+		require.NoError(t, store.Save(credentials))
+
+		interruptedDir, err := ioutil.TempDir(directory, credentialsDirPrefix)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(interruptedDir, privateKeyFile), []byte("partial"), 0600))
+
+		// when
+		loaded, err := store.Load()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, credentials.PrivateKey.D, loaded.PrivateKey.D)
+		assert.Equal(t, credentials.ClientCertificate().Raw, loaded.ClientCertificate().Raw)
+	})
+
+	t.Run("should return not found error after credentials are deleted", func(t *testing.T) {
+		// given
+		directory, err := ioutil.TempDir("", "credentials")
+		require.NoError(t, err)
+		defer os.RemoveAll(directory)
+
+		store := NewFileStore(directory)
+		require.NoError(t, store.Save(credentials))
+
+		// when
+		err = store.Delete()
+		require.NoError(t, err)
+
+		_, err = store.Load()
+
+		// then
+		assert.Equal(t, ErrCredentialsNotFound, err)
+	})
+}
+
+func TestParseToken(t *testing.T) {
+
+	t.Run("should parse rawEncoded token", func(t *testing.T) {
+		// when
+		token := ParseToken("eyJ0b2tlbiI6InRva2VuIiwiY29ubmVjdG9yVVJMIjoiaHR0cHM6Ly9jb25uZWN0b3IifQ==")
+
+		// then
+		assert.Equal(t, Token{Token: "token", ConnectorURL: "https://connector"}, token)
+	})
+
+	t.Run("should return raw token", func(t *testing.T) {
+		// when
+		token := ParseToken("abcd")
+
+		// then
+		assert.Equal(t, Token{Token: "abcd"}, token)
+	})
+}
+
+func newTestCredentials(t *testing.T) Credentials {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(rawCertificate)
+	require.NoError(t, err)
+
+	return Credentials{
+		PrivateKey:       key,
+		CertificateChain: []*x509.Certificate{certificate, certificate},
+		CACertificates:   []*x509.Certificate{certificate},
+		ManagementPlaneInfo: ManagementPlaneInfo{
+			DirectorURL:                    "https://director",
+			CertificateSecuredConnectorURL: "https://connector",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package pairing
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Token is the one-time token issued by the Director for pairing with Compass
+type Token struct {
+	Token        string `json:"token"`
+	ConnectorURL string `json:"connectorURL"`
+}
+
+// ParseToken accepts both the raw and the rawEncoded form of the one-time token.
+// The Connector URL is known only for the rawEncoded form.
+func ParseToken(token string) Token {
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return Token{Token: token}
+	}
+
+	var encodedToken Token
+	if err := json.Unmarshal(decoded, &encodedToken); err != nil || encodedToken.Token == "" {
+		return Token{Token: token}
+	}
+
+	return encodedToken
+}
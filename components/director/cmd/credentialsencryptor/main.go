@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+type config struct {
+	Database   persistence.DatabaseConfig
+	Encryption encryption.Config
+
+	RotateDataKeys bool `envconfig:"default=false"`
+	BatchSize      int  `envconfig:"default=100"`
+}
+
+type keyRotator interface {
+	RewrapDataKeys(ctx context.Context) (int, error)
+	RotateDataKeys(ctx context.Context) error
+	ReencryptBatch(ctx context.Context, column encryption.Column, afterID string, batchSize int) (string, int, error)
+}
+
+func main() {
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Error while loading app config")
+
+	configureLogger()
+
+	if cfg.Encryption.MasterKeysFile == "" {
+		log.Fatal("Master keys file has to be configured")
+	}
+
+	masterKeys, err := encryption.NewFileMasterKeyProvider(cfg.Encryption.MasterKeysFile)
+	exitOnError(err, "Error while loading master keys")
+
+	transact, closeFunc, err := persistence.Configure(log.StandardLogger(), cfg.Database)
+	exitOnError(err, "Error while establishing the connection to the database")
+
+	defer func() {
+		err := closeFunc()
+		exitOnError(err, "Error while closing the connection to the database")
+	}()
+
+	repo := encryption.NewRepository()
+	encryptor := encryption.NewEncryptor(masterKeys, repo, uid.NewService())
+	rotator := encryption.NewKeyRotator(masterKeys, repo, encryptor)
+
+	err = inTransaction(transact, func(ctx context.Context) error {
+		count, err := rotator.RewrapDataKeys(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("Wrapped %d data keys with master key %s", count, masterKeys.CurrentKeyID())
+		return nil
+	})
+	exitOnError(err, "Error while wrapping data keys with current master key")
+
+	if cfg.RotateDataKeys {
+		err = inTransaction(transact, rotator.RotateDataKeys)
+		exitOnError(err, "Error while rotating data keys")
+		log.Info("Deactivated all data keys")
+	}
+
+	for _, column := range encryption.Columns {
+		err := reencryptColumn(transact, rotator, column, cfg.BatchSize)
+		exitOnError(err, "Error while re-encrypting stored credentials")
+	}
+
+	log.Info("Stored credentials were successfully encrypted")
+}
+
+func reencryptColumn(transact persistence.Transactioner, rotator keyRotator, column encryption.Column, batchSize int) error {
+	lastID := encryption.FirstID
+	for {
+		var processed int
+		err := inTransaction(transact, func(ctx context.Context) error {
+			var err error
+			lastID, processed, err = rotator.ReencryptBatch(ctx, column, lastID, batchSize)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "while re-encrypting %s.%s", column.Table, column.ValueColumn)
+		}
+
+		if processed < batchSize {
+			return nil
+		}
+	}
+}
+
+func inTransaction(transact persistence.Transactioner, fn func(ctx context.Context) error) error {
+	tx, err := transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while beginning db transaction")
+	}
+	defer transact.RollbackUnlessCommitted(tx)
+
+	ctx := persistence.SaveToContext(context.Background(), tx)
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.Fatal(wrappedError)
+	}
+}
+
+func configureLogger() {
+	log.SetFormatter(&log.TextFormatter{
+		FullTimestamp: true,
+	})
+	log.SetReportCaller(true)
+}
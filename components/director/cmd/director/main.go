@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
@@ -14,6 +15,7 @@ import (
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/scenario"
@@ -37,6 +39,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
@@ -44,12 +47,14 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/features"
 	"github.com/kyma-incubator/compass/components/director/internal/healthz"
 	"github.com/kyma-incubator/compass/components/director/internal/oathkeeper"
+	"github.com/kyma-incubator/compass/components/director/internal/persistedquery"
 	"github.com/kyma-incubator/compass/components/director/internal/runtimemapping"
 	"github.com/kyma-incubator/compass/components/director/internal/statusupdate"
 	"github.com/kyma-incubator/compass/components/director/internal/tenantmapping"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/scope"
@@ -86,14 +91,22 @@ type config struct {
 
 	RuntimeJWKSCachePeriod time.Duration `envconfig:"default=5m"`
 
+	SystemAuthExpiryCheckPeriod time.Duration `envconfig:"default=5m"`
+
 	StaticUsersSrc    string `envconfig:"default=/data/static-users.yaml"`
 	StaticGroupsSrc   string `envconfig:"default=/data/static-groups.yaml"`
 	PairingAdapterSrc string `envconfig:"optional"`
 
+	PersistedQueries persistedquery.Config
+
 	OneTimeToken onetimetoken.Config
 	OAuth20      oauth20.Config
 
 	Features features.Config
+
+	Encryption encryption.Config
+
+	StatusUpdate statusupdate.Config
 }
 
 func main() {
@@ -108,6 +121,9 @@ func main() {
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "Error while loading app config")
 
+	err = cfg.StatusUpdate.Validate()
+	exitOnError(err, "Error while validating status update config")
+
 	configureLogger()
 
 	transact, closeFunc, err := persistence.Configure(log.StandardLogger(), cfg.Database)
@@ -128,19 +144,32 @@ func main() {
 	pairingAdapters, err := getPairingAdaptersMapping(cfg.PairingAdapterSrc)
 	exitOnError(err, "Error while reading Pairing Adapters Configuration")
 
+	encryptor, err := createEncryptor(cfg.Encryption)
+	exitOnError(err, "Error while configuring encryption of stored credentials")
+
+	oAuth20HTTPClient := &http.Client{
+		Timeout:   cfg.OAuth20.HTTPClientTimeout,
+		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+	}
+	metricsCollector.InstrumentOAuth20HTTPClient(oAuth20HTTPClient)
+
+	oAuth20Registry, err := oauth20.NewClientRegistry(cfg.OAuth20, oAuth20HTTPClient, transact, encryptor)
+	exitOnError(err, "Error while configuring OAuth 2.0 client registry")
+
 	gqlCfg := graphql.Config{
 		Resolvers: domain.NewRootResolver(
 			transact,
 			cfgProvider,
 			cfg.OneTimeToken,
 			cfg.OAuth20,
+			oAuth20Registry,
 			pairingAdapters,
 			cfg.Features,
-			metricsCollector,
 			cfg.ClientTimeout,
+			encryptor,
 		),
 		Directives: graphql.DirectiveRoot{
-			HasScenario: scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), defaultPackageRepo(), defaultPackageInstanceAuthRepo()).HasScenario,
+			HasScenario: scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), defaultPackageRepo(encryptor), defaultPackageInstanceAuthRepo(encryptor)).HasScenario,
 			HasScopes:   scope.NewDirective(cfgProvider).VerifyScopes,
 			Validate:    inputvalidation.NewDirective().Validate,
 		},
@@ -148,6 +177,9 @@ func main() {
 
 	executableSchema := graphql.NewExecutableSchema(gqlCfg)
 
+	persistedQueries, err := persistedquery.LoadRegistry(cfg.PersistedQueries.Src, cfg.PersistedQueries.CacheSize)
+	exitOnError(err, "Error while loading persisted queries")
+
 	log.Infof("Registering GraphQL endpoint on %s...", cfg.APIEndpoint)
 	authMiddleware := authenticator.New(cfg.JWKSEndpoint, cfg.AllowJWTSigningNone)
 
@@ -162,7 +194,17 @@ func main() {
 		go periodicExecutor.Run(ctx)
 	}
 
-	statusMiddleware := statusupdate.New(transact, statusupdate.NewRepository(), log.New())
+	if cfg.SystemAuthExpiryCheckPeriod != 0 {
+		log.Infof("Expired System Auths cleanup enabled. Check period: %v", cfg.SystemAuthExpiryCheckPeriod)
+		runSystemAuthExpirationEnforcer(ctx, transact, cfgProvider, cfg.OAuth20, oAuth20Registry, cfg.SystemAuthExpiryCheckPeriod, encryptor)
+	}
+
+	if cfg.StatusUpdate.CheckPeriod != 0 {
+		log.Infof("Automatic status transitions of silent Applications and Runtimes enabled. Check period: %v", cfg.StatusUpdate.CheckPeriod)
+		runInactivityEnforcer(ctx, transact, cfg.StatusUpdate)
+	}
+
+	statusMiddleware := statusupdate.New(transact, statusupdate.NewRepository(), cfg.StatusUpdate.LastSeenUpdateInterval, log.New())
 
 	mainRouter := mux.NewRouter()
 	mainRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
@@ -174,12 +216,14 @@ func main() {
 	gqlAPIRouter := mainRouter.PathPrefix(cfg.APIEndpoint).Subrouter()
 	gqlAPIRouter.Use(authMiddleware.Handler())
 	gqlAPIRouter.Use(statusMiddleware.Handler())
+	gqlAPIRouter.Use(persistedquery.NewHandler(persistedQueries, cfg.PersistedQueries).Handler())
 	gqlAPIRouter.HandleFunc("", metricsCollector.GraphQLHandlerWithInstrumentation(handler.GraphQL(executableSchema,
 		handler.ErrorPresenter(presenter.Do),
-		handler.RecoverFunc(panic_handler.RecoverFn))))
+		handler.RecoverFunc(panic_handler.RecoverFn),
+		handler.EnablePersistedQueryCache(persistedQueries))))
 
 	log.Infof("Registering Tenant Mapping endpoint on %s...", cfg.TenantMappingEndpoint)
-	tenantMappingHandlerFunc, err := getTenantMappingHandlerFunc(transact, cfg.StaticUsersSrc, cfg.StaticGroupsSrc, cfgProvider)
+	tenantMappingHandlerFunc, err := getTenantMappingHandlerFunc(transact, cfg.StaticUsersSrc, cfg.StaticGroupsSrc, cfgProvider, encryptor)
 	exitOnError(err, "Error while configuring tenant mapping handler")
 
 	mainRouter.HandleFunc(cfg.TenantMappingEndpoint, tenantMappingHandlerFunc)
@@ -242,6 +286,21 @@ func getPairingAdaptersMapping(filePath string) (map[string]string, error) {
 	return out, nil
 }
 
+func createEncryptor(cfg encryption.Config) (encryption.Encryptor, error) {
+	if cfg.MasterKeysFile == "" {
+		log.Warnf("No master keys file configured, stored credentials will not be encrypted")
+		return encryption.NewNoopEncryptor(), nil
+	}
+
+	masterKeys, err := encryption.NewFileMasterKeyProvider(cfg.MasterKeysFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading master keys")
+	}
+
+	log.Infof("Stored credentials will be encrypted with data keys wrapped by master key %s", masterKeys.CurrentKeyID())
+	return encryption.NewEncryptor(masterKeys, encryption.NewRepository(), uid.NewService()), nil
+}
+
 func createAndRunConfigProvider(ctx context.Context, cfg config) *configprovider.Provider {
 	provider := configprovider.NewProvider(cfg.ConfigurationFile)
 	err := provider.Load()
@@ -271,12 +330,46 @@ func configureLogger() {
 	log.SetReportCaller(true)
 }
 
-func getTenantMappingHandlerFunc(transact persistence.Transactioner, staticUsersSrc string, staticGroupsSrc string, cfgProvider *configprovider.Provider) (func(writer http.ResponseWriter, request *http.Request), error) {
+func runSystemAuthExpirationEnforcer(ctx context.Context, transact persistence.Transactioner, cfgProvider *configprovider.Provider, oAuth20Cfg oauth20.Config, oAuth20Registry oauth20.OAuthClientRegistry, period time.Duration, encryptor encryption.Encryptor) {
+	oAuth20Svc := oauth20.NewService(cfgProvider, uid.NewService(), oAuth20Cfg, oAuth20Registry)
+	systemAuthRepo := systemauth.NewRepository(systemauth.NewConverter(auth.NewConverter()), encryptor)
+	enforcer := systemauth.NewExpirationEnforcer(transact, systemAuthRepo, oAuth20Svc)
+
+	executor.NewPeriodic(period, func(ctx context.Context) {
+		deleted, err := enforcer.DeleteExpired(ctx)
+		if err != nil {
+			log.Error(errors.Wrap(err, "while deleting expired System Auths"))
+			return
+		}
+		if deleted > 0 {
+			log.Infof("Deleted %d expired System Auths", deleted)
+		}
+	}).Run(ctx)
+}
+
+func runInactivityEnforcer(ctx context.Context, transact persistence.Transactioner, cfg statusupdate.Config) {
+	enforcer := statusupdate.NewInactivityEnforcer(transact, statusupdate.NewRepository(), cfg)
+
+	executor.NewPeriodic(cfg.CheckPeriod, func(ctx context.Context) {
+		updated, err := enforcer.MarkInactive(ctx)
+		if err != nil {
+			log.Error(errors.Wrap(err, "while updating status of silent Applications and Runtimes"))
+			return
+		}
+		if updated > 0 {
+			log.Infof("Updated status of %d silent Applications and Runtimes", updated)
+		}
+	}).Run(ctx)
+}
+
+func getTenantMappingHandlerFunc(transact persistence.Transactioner, staticUsersSrc string, staticGroupsSrc string, cfgProvider *configprovider.Provider, encryptor encryption.Encryptor) (func(writer http.ResponseWriter, request *http.Request), error) {
 	uidSvc := uid.NewService()
 	authConverter := auth.NewConverter()
 	systemAuthConverter := systemauth.NewConverter(authConverter)
-	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
-	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
+	systemAuthRepo := systemauth.NewRepository(systemAuthConverter, encryptor)
+	accessRuleRepo := accessrule.NewRepository(accessrule.NewConverter())
+	accessRuleSvc := accessrule.NewService(accessRuleRepo, accessrule.NewObjectRepository(), uidSvc)
+	systemAuthSvc := systemauth.NewService(systemAuthRepo, accessRuleSvc, uidSvc)
 	staticUsersRepo, err := tenantmapping.NewStaticUserRepository(staticUsersSrc)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating StaticUser repository instance")
@@ -290,8 +383,10 @@ func getTenantMappingHandlerFunc(transact persistence.Transactioner, staticUsers
 	tenantConverter := tenant.NewConverter()
 	tenantRepo := tenant.NewRepository(tenantConverter)
 
-	mapperForUser := tenantmapping.NewMapperForUser(staticUsersRepo, staticGroupsRepo, tenantRepo)
-	mapperForSystemAuth := tenantmapping.NewMapperForSystemAuth(systemAuthSvc, cfgProvider, tenantRepo)
+	roleRepo := role.NewRepository(role.NewConverter())
+
+	mapperForUser := tenantmapping.NewMapperForUser(staticUsersRepo, staticGroupsRepo, tenantRepo, roleRepo)
+	mapperForSystemAuth := tenantmapping.NewMapperForSystemAuth(systemAuthSvc, cfgProvider, tenantRepo, roleRepo)
 
 	reqDataParser := oathkeeper.NewReqDataParser()
 
@@ -315,7 +410,10 @@ func getRuntimeMappingHandlerFunc(transact persistence.Transactioner, cachePerio
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(scenarioAssignmentConv)
 	scenarioAssignmentEngine := scenarioassignment.NewEngine(labelUpsertSvc, labelRepo, scenarioAssignmentRepo)
 
-	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine)
+	accessRuleRepo := accessrule.NewRepository(accessrule.NewConverter())
+	accessRuleSvc := accessrule.NewService(accessRuleRepo, accessrule.NewObjectRepository(), uidSvc)
+
+	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine, accessRuleSvc)
 
 	tenantConv := tenant.NewConverter()
 	tenantRepo := tenant.NewRepository(tenantConv)
@@ -367,13 +465,13 @@ func createServer(address string, handler http.Handler, name string, timeout tim
 	return runFn, shutdownFn
 }
 
-func defaultPackageInstanceAuthRepo() packageinstanceauth.Repository {
+func defaultPackageInstanceAuthRepo(encryptor encryption.Encryptor) packageinstanceauth.Repository {
 	authConverter := auth.NewConverter()
 
-	return packageinstanceauth.NewRepository(packageinstanceauth.NewConverter(authConverter))
+	return packageinstanceauth.NewRepository(packageinstanceauth.NewConverter(authConverter), encryptor)
 }
 
-func defaultPackageRepo() mp_package.PackageRepository {
+func defaultPackageRepo(encryptor encryption.Encryptor) mp_package.PackageRepository {
 	authConverter := auth.NewConverter()
 	frConverter := fetchrequest.NewConverter(authConverter)
 	versionConverter := version.NewConverter()
@@ -381,5 +479,5 @@ func defaultPackageRepo() mp_package.PackageRepository {
 	docConverter := document.NewConverter(frConverter)
 	apiConverter := api.NewConverter(frConverter, versionConverter)
 
-	return mp_package.NewRepository(mp_package.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter))
+	return mp_package.NewRepository(mp_package.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter), encryptor)
 }
@@ -11,6 +11,7 @@ type Claims struct {
 	Scopes         string                `json:"scopes"`
 	ConsumerID     string                `json:"consumerID"`
 	ConsumerType   consumer.ConsumerType `json:"consumerType"`
+	ConsumerGroups string                `json:"consumerGroups"`
 	jwt.StandardClaims
 }
 
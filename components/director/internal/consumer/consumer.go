@@ -17,6 +17,8 @@ const (
 type Consumer struct {
 	ConsumerID string
 	ConsumerType
+	// Groups are the groups of the user, for which the consumer is a user
+	Groups []string
 }
 
 func MapSystemAuthToConsumerType(refObj model.SystemAuthReferenceObjectType) (ConsumerType, error) {
@@ -0,0 +1,131 @@
+package accessrule
+
+import (
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.AccessRule) *graphql.AccessRule {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.AccessRule{
+		ID:          in.ID,
+		SubjectType: graphql.AccessRuleSubjectType(in.Subject.Type),
+		Subject:     in.Subject.Name,
+		ObjectType:  graphql.AccessRuleObjectType(in.ObjectType),
+		LabelKey:    in.LabelKey,
+		LabelQuery:  in.LabelQuery,
+		OwnedOnly:   in.OwnedOnly,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.AccessRule) []*graphql.AccessRule {
+	rules := []*graphql.AccessRule{}
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+
+		rules = append(rules, c.ToGraphQL(r))
+	}
+
+	return rules
+}
+
+func (c *converter) InputFromGraphQL(in graphql.AccessRuleInput) model.AccessRuleInput {
+	ownedOnly := false
+	if in.OwnedOnly != nil {
+		ownedOnly = *in.OwnedOnly
+	}
+
+	return model.AccessRuleInput{
+		Subject: model.AccessRuleSubject{
+			Type: model.AccessRuleSubjectType(in.SubjectType),
+			Name: in.Subject,
+		},
+		ObjectType: model.AccessRuleObjectType(in.ObjectType),
+		LabelKey:   in.LabelKey,
+		LabelQuery: in.LabelQuery,
+		OwnedOnly:  ownedOnly,
+	}
+}
+
+func (c *converter) ToEntity(in *model.AccessRule) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &Entity{
+		ID:         in.ID,
+		TenantID:   in.Tenant,
+		ObjectType: string(in.ObjectType),
+		LabelKey:   repo.NewNullableString(in.LabelKey),
+		LabelQuery: repo.NewNullableString(in.LabelQuery),
+		OwnedOnly:  in.OwnedOnly,
+	}
+
+	switch in.Subject.Type {
+	case model.AccessRuleSubjectTypeUser:
+		out.UserName = repo.NewValidNullableString(in.Subject.Name)
+	case model.AccessRuleSubjectTypeGroup:
+		out.GroupName = repo.NewValidNullableString(in.Subject.Name)
+	case model.AccessRuleSubjectTypeIntegrationSystem:
+		out.IntegrationSystemID = repo.NewValidNullableString(in.Subject.Name)
+	default:
+		return nil, apperrors.NewInternalError("unknown access rule subject type: %s", in.Subject.Type)
+	}
+
+	return out, nil
+}
+
+func (c *converter) FromEntity(in *Entity) (*model.AccessRule, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	subject, err := subjectFromEntity(*in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AccessRule{
+		ID:         in.ID,
+		Tenant:     in.TenantID,
+		Subject:    subject,
+		ObjectType: model.AccessRuleObjectType(in.ObjectType),
+		LabelKey:   repo.StringPtrFromNullableString(in.LabelKey),
+		LabelQuery: repo.StringPtrFromNullableString(in.LabelQuery),
+		OwnedOnly:  in.OwnedOnly,
+	}, nil
+}
+
+func subjectFromEntity(in Entity) (model.AccessRuleSubject, error) {
+	subjects := []struct {
+		subjectType model.AccessRuleSubjectType
+		value       sql.NullString
+	}{
+		{subjectType: model.AccessRuleSubjectTypeUser, value: in.UserName},
+		{subjectType: model.AccessRuleSubjectTypeGroup, value: in.GroupName},
+		{subjectType: model.AccessRuleSubjectTypeIntegrationSystem, value: in.IntegrationSystemID},
+	}
+
+	for _, s := range subjects {
+		if s.value.Valid {
+			return model.AccessRuleSubject{Type: s.subjectType, Name: s.value.String}, nil
+		}
+	}
+
+	return model.AccessRuleSubject{}, apperrors.NewInternalError("access rule with id %s has no subject", in.ID)
+}
@@ -0,0 +1,134 @@
+package accessrule_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// when
+		result := accessrule.NewConverter().ToGraphQL(fixModelAccessRule())
+
+		// then
+		assert.Equal(t, fixGQLAccessRule(), result)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// when
+		result := accessrule.NewConverter().ToGraphQL(nil)
+
+		// then
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// given
+	in := []*model.AccessRule{fixModelAccessRule(), nil}
+
+	// when
+	result := accessrule.NewConverter().MultipleToGraphQL(in)
+
+	// then
+	assert.Equal(t, []*graphql.AccessRule{fixGQLAccessRule()}, result)
+}
+
+func TestConverter_InputFromGraphQL(t *testing.T) {
+	t.Run("label rule", func(t *testing.T) {
+		// when
+		result := accessrule.NewConverter().InputFromGraphQL(fixGQLAccessRuleInput())
+
+		// then
+		assert.Equal(t, fixModelAccessRuleInput(), result)
+	})
+
+	t.Run("owned only rule", func(t *testing.T) {
+		// given
+		ownedOnly := true
+		in := graphql.AccessRuleInput{
+			SubjectType: graphql.AccessRuleSubjectTypeUser,
+			Subject:     userName,
+			ObjectType:  graphql.AccessRuleObjectTypeRuntime,
+			OwnedOnly:   &ownedOnly,
+		}
+
+		// when
+		result := accessrule.NewConverter().InputFromGraphQL(in)
+
+		// then
+		assert.Equal(t, model.AccessRuleInput{
+			Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+			ObjectType: model.AccessRuleObjectTypeRuntime,
+			OwnedOnly:  true,
+		}, result)
+	})
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// when
+		result, err := accessrule.NewConverter().ToEntity(fixModelAccessRule())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixEntityAccessRule(), result)
+	})
+
+	t.Run("integration system subject", func(t *testing.T) {
+		// given
+		in := fixModelOwnedOnlyAccessRule()
+		in.Subject = model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeIntegrationSystem, Name: objectID}
+
+		// when
+		result, err := accessrule.NewConverter().ToEntity(in)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, sql.NullString{String: objectID, Valid: true}, result.IntegrationSystemID)
+		assert.False(t, result.UserName.Valid)
+		assert.False(t, result.GroupName.Valid)
+		assert.True(t, result.OwnedOnly)
+	})
+
+	t.Run("error when subject type is unknown", func(t *testing.T) {
+		// given
+		in := fixModelAccessRule()
+		in.Subject.Type = "UNKNOWN"
+
+		// when
+		_, err := accessrule.NewConverter().ToEntity(in)
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: unknown access rule subject type: UNKNOWN")
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// when
+		result, err := accessrule.NewConverter().FromEntity(fixEntityAccessRule())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixModelAccessRule(), result)
+	})
+
+	t.Run("error when entity has no subject", func(t *testing.T) {
+		// given
+		in := fixEntityAccessRule()
+		in.GroupName = sql.NullString{}
+
+		// when
+		_, err := accessrule.NewConverter().FromEntity(in)
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: access rule with id "+ruleID+" has no subject")
+	})
+}
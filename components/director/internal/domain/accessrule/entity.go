@@ -0,0 +1,29 @@
+package accessrule
+
+import "database/sql"
+
+type Entity struct {
+	ID                  string         `db:"id"`
+	TenantID            string         `db:"tenant_id"`
+	UserName            sql.NullString `db:"user_name"`
+	GroupName           sql.NullString `db:"group_name"`
+	IntegrationSystemID sql.NullString `db:"integration_system_id"`
+	ObjectType          string         `db:"object_type"`
+	LabelKey            sql.NullString `db:"label_key"`
+	LabelQuery          sql.NullString `db:"label_query"`
+	OwnedOnly           bool           `db:"owned_only"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
+
+type OwnerEntity struct {
+	TenantID            string         `db:"tenant_id"`
+	AppID               sql.NullString `db:"app_id"`
+	RuntimeID           sql.NullString `db:"runtime_id"`
+	UserName            sql.NullString `db:"user_name"`
+	IntegrationSystemID sql.NullString `db:"integration_system_id"`
+}
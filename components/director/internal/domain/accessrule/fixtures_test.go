@@ -0,0 +1,99 @@
+package accessrule_test
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	ruleID    = "5d1b8c3e-2f4a-4b6c-8d9e-0a1b2c3d4e5f"
+	tenantID  = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	objectID  = "0f9e8d7c-6b5a-4c3d-2e1f-0a9b8c7d6e5f"
+	userName  = "john"
+	groupName = "team-a"
+	labelKey  = "team"
+	labelQry  = `"a"`
+)
+
+var accessRuleColumns = []string{"id", "tenant_id", "user_name", "group_name", "integration_system_id", "object_type", "label_key", "label_query", "owned_only"}
+
+func fixModelAccessRule() *model.AccessRule {
+	return &model.AccessRule{
+		ID:         ruleID,
+		Tenant:     tenantID,
+		Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: groupName},
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		LabelKey:   str.Ptr(labelKey),
+		LabelQuery: str.Ptr(labelQry),
+	}
+}
+
+func fixModelOwnedOnlyAccessRule() *model.AccessRule {
+	return &model.AccessRule{
+		ID:         ruleID,
+		Tenant:     tenantID,
+		Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		OwnedOnly:  true,
+	}
+}
+
+func fixModelAccessRuleInput() model.AccessRuleInput {
+	return model.AccessRuleInput{
+		Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: groupName},
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		LabelKey:   str.Ptr(labelKey),
+		LabelQuery: str.Ptr(labelQry),
+	}
+}
+
+func fixEntityAccessRule() *accessrule.Entity {
+	return &accessrule.Entity{
+		ID:         ruleID,
+		TenantID:   tenantID,
+		GroupName:  sql.NullString{String: groupName, Valid: true},
+		ObjectType: string(model.AccessRuleObjectTypeApplication),
+		LabelKey:   sql.NullString{String: labelKey, Valid: true},
+		LabelQuery: sql.NullString{String: labelQry, Valid: true},
+	}
+}
+
+func fixGQLAccessRule() *graphql.AccessRule {
+	return &graphql.AccessRule{
+		ID:          ruleID,
+		SubjectType: graphql.AccessRuleSubjectTypeGroup,
+		Subject:     groupName,
+		ObjectType:  graphql.AccessRuleObjectTypeApplication,
+		LabelKey:    str.Ptr(labelKey),
+		LabelQuery:  str.Ptr(labelQry),
+	}
+}
+
+func fixGQLAccessRuleInput() graphql.AccessRuleInput {
+	return graphql.AccessRuleInput{
+		SubjectType: graphql.AccessRuleSubjectTypeGroup,
+		Subject:     groupName,
+		ObjectType:  graphql.AccessRuleObjectTypeApplication,
+		LabelKey:    str.Ptr(labelKey),
+		LabelQuery:  str.Ptr(labelQry),
+	}
+}
+
+func fixUserContext() context.Context {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, "external")
+	return consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: userName, ConsumerType: consumer.User, Groups: []string{groupName}})
+}
+
+func fixUserSubjects() []model.AccessRuleSubject {
+	return []model.AccessRuleSubject{
+		{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+		{Type: model.AccessRuleSubjectTypeGroup, Name: groupName},
+	}
+}
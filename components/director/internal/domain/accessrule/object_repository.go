@@ -0,0 +1,151 @@
+package accessrule
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+var objectOwnerColumns = []string{"tenant_id", "app_id", "runtime_id", "user_name", "integration_system_id"}
+
+// nestedObjectParent describes how to find the parent of a nested object. The parent is either an Application or a Runtime,
+// or another nested object, such as the Package of an API Definition.
+type nestedObjectParent struct {
+	getter       repo.SingleGetter
+	column       string
+	parentType   model.AccessRuleObjectType
+	parentNested model.AccessRuleNestedObjectType
+}
+
+type objectRepository struct {
+	ownerCreator  repo.Creator
+	existQueriers map[model.AccessRuleObjectType]repo.ExistQuerier
+	parents       map[model.AccessRuleNestedObjectType]nestedObjectParent
+}
+
+func NewObjectRepository() *objectRepository {
+	return &objectRepository{
+		ownerCreator: repo.NewCreator(resource.AccessRule, objectOwnersTable, objectOwnerColumns),
+		existQueriers: map[model.AccessRuleObjectType]repo.ExistQuerier{
+			model.AccessRuleObjectTypeApplication: repo.NewExistQuerier(resource.Application, "public.applications", tenantColumn),
+			model.AccessRuleObjectTypeRuntime:     repo.NewExistQuerier(resource.Runtime, "public.runtimes", tenantColumn),
+		},
+		parents: map[model.AccessRuleNestedObjectType]nestedObjectParent{
+			model.AccessRuleNestedObjectTypePackage: {
+				getter:     repo.NewSingleGetter(resource.Package, "public.packages", tenantColumn, []string{"app_id"}),
+				column:     "app_id",
+				parentType: model.AccessRuleObjectTypeApplication,
+			},
+			model.AccessRuleNestedObjectTypeAPIDefinition: {
+				getter:       repo.NewSingleGetter(resource.API, `"public"."api_definitions"`, tenantColumn, []string{"package_id"}),
+				column:       "package_id",
+				parentNested: model.AccessRuleNestedObjectTypePackage,
+			},
+			model.AccessRuleNestedObjectTypeEventDefinition: {
+				getter:       repo.NewSingleGetter(resource.EventDefinition, `"public"."event_api_definitions"`, tenantColumn, []string{"package_id"}),
+				column:       "package_id",
+				parentNested: model.AccessRuleNestedObjectTypePackage,
+			},
+			model.AccessRuleNestedObjectTypeDocument: {
+				getter:       repo.NewSingleGetter(resource.Document, "public.documents", tenantColumn, []string{"package_id"}),
+				column:       "package_id",
+				parentNested: model.AccessRuleNestedObjectTypePackage,
+			},
+			model.AccessRuleNestedObjectTypeWebhook: {
+				getter:     repo.NewSingleGetter(resource.Webhook, "public.webhooks", tenantColumn, []string{"app_id"}),
+				column:     "app_id",
+				parentType: model.AccessRuleObjectTypeApplication,
+			},
+			model.AccessRuleNestedObjectTypePackageInstanceAuth: {
+				getter:       repo.NewSingleGetter(resource.PackageInstanceAuth, "public.package_instance_auths", tenantColumn, []string{"package_id"}),
+				column:       "package_id",
+				parentNested: model.AccessRuleNestedObjectTypePackage,
+			},
+			model.AccessRuleNestedObjectTypeRuntimeContext: {
+				getter:     repo.NewSingleGetter(resource.RuntimeContext, "public.runtime_contexts", tenantColumn, []string{"runtime_id"}),
+				column:     "runtime_id",
+				parentType: model.AccessRuleObjectTypeRuntime,
+			},
+		},
+	}
+}
+
+// SetOwner stores the subject which created the object
+func (r *objectRepository) SetOwner(ctx context.Context, tenant string, objType model.AccessRuleObjectType, objectID string, owner model.AccessRuleSubject) error {
+	entity := OwnerEntity{TenantID: tenant}
+
+	switch objType {
+	case model.AccessRuleObjectTypeApplication:
+		entity.AppID = repo.NewValidNullableString(objectID)
+	case model.AccessRuleObjectTypeRuntime:
+		entity.RuntimeID = repo.NewValidNullableString(objectID)
+	default:
+		return apperrors.NewInternalError("unknown access rule object type: %s", objType)
+	}
+
+	switch owner.Type {
+	case model.AccessRuleSubjectTypeUser:
+		entity.UserName = repo.NewValidNullableString(owner.Name)
+	case model.AccessRuleSubjectTypeIntegrationSystem:
+		entity.IntegrationSystemID = repo.NewValidNullableString(owner.Name)
+	default:
+		return apperrors.NewInternalError("subject of type %s cannot own objects", owner.Type)
+	}
+
+	return r.ownerCreator.Create(ctx, entity)
+}
+
+// IsAccessible checks whether the object exists and can be accessed with the given restriction
+func (r *objectRepository) IsAccessible(ctx context.Context, tenant string, restriction *model.AccessRestriction, objectID string) (bool, error) {
+	if restriction == nil {
+		return true, nil
+	}
+
+	existQuerier, ok := r.existQueriers[restriction.ObjectType]
+	if !ok {
+		return false, apperrors.NewInternalError("unknown access rule object type: %s", restriction.ObjectType)
+	}
+
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil {
+		return false, errors.Wrap(err, "while parsing tenant as UUID")
+	}
+
+	subquery, args, err := FilterQuery(restriction, tenantID)
+	if err != nil {
+		return false, errors.Wrap(err, "while building access restriction query")
+	}
+
+	return existQuerier.Exists(ctx, tenant, repo.Conditions{
+		repo.NewEqualCondition("id", objectID),
+		repo.NewInConditionForSubQuery("id", subquery, args),
+	})
+}
+
+// GetParent returns the type and the ID of the Application or the Runtime to which the nested object belongs
+func (r *objectRepository) GetParent(ctx context.Context, tenant string, nestedType model.AccessRuleNestedObjectType, nestedID string) (model.AccessRuleObjectType, string, error) {
+	parent, ok := r.parents[nestedType]
+	if !ok {
+		return "", "", apperrors.NewInternalError("unknown nested object type: %s", nestedType)
+	}
+
+	var parentID sql.NullString
+	if err := parent.getter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", nestedID)}, repo.NoOrderBy, &parentID); err != nil {
+		return "", "", err
+	}
+	if !parentID.Valid {
+		return "", "", apperrors.NewInternalError("%s with ID %s has no %s", nestedType, nestedID, parent.column)
+	}
+
+	if parent.parentNested != "" {
+		return r.GetParent(ctx, tenant, parent.parentNested, parentID.String)
+	}
+
+	return parent.parentType, parentID.String, nil
+}
@@ -0,0 +1,197 @@
+package accessrule_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectRepository_SetOwner(t *testing.T) {
+	t.Run("success for application owned by user", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.object_owners ( tenant_id, app_id, runtime_id, user_name, integration_system_id ) VALUES ( ?, ?, ?, ?, ? )")).
+			WithArgs(tenantID, objectID, nil, userName, nil).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		err := repo.SetOwner(ctx, tenantID, model.AccessRuleObjectTypeApplication, objectID, model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("success for runtime owned by integration system", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.object_owners ( tenant_id, app_id, runtime_id, user_name, integration_system_id ) VALUES ( ?, ?, ?, ?, ? )")).
+			WithArgs(tenantID, nil, objectID, nil, ruleID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		err := repo.SetOwner(ctx, tenantID, model.AccessRuleObjectTypeRuntime, objectID, model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeIntegrationSystem, Name: ruleID})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when subject cannot own objects", func(t *testing.T) {
+		// given
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		err := repo.SetOwner(context.TODO(), tenantID, model.AccessRuleObjectTypeApplication, objectID, model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: groupName})
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: subject of type GROUP cannot own objects")
+	})
+}
+
+func TestObjectRepository_IsAccessible(t *testing.T) {
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		Rules:      []*model.AccessRule{fixModelOwnedOnlyAccessRule()},
+		Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.applications WHERE tenant_id = $1 AND id = $2 AND id IN (SELECT "app_id" FROM public.object_owners WHERE "app_id" IS NOT NULL AND "tenant_id" = $3 AND "user_name" = $4)`)).
+			WithArgs(tenantID, objectID, tenantID, userName).
+			WillReturnRows(testdb.RowWhenObjectExist())
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		accessible, err := repo.IsAccessible(ctx, tenantID, restriction, objectID)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, accessible)
+	})
+
+	t.Run("success when object is not accessible", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.applications WHERE tenant_id = $1 AND id = $2 AND id IN`)).
+			WithArgs(tenantID, objectID, tenantID, userName).
+			WillReturnRows(testdb.RowWhenObjectDoesNotExist())
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		accessible, err := repo.IsAccessible(ctx, tenantID, restriction, objectID)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, accessible)
+	})
+
+	t.Run("success when there is no restriction", func(t *testing.T) {
+		// given
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		accessible, err := repo.IsAccessible(context.TODO(), tenantID, nil, objectID)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, accessible)
+	})
+}
+
+func TestObjectRepository_GetParent(t *testing.T) {
+	apiDefID := "9c8b7a6f-5e4d-3c2b-1a0f-9e8d7c6b5a4f"
+	packageID := "1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d"
+
+	t.Run("success for API Definition", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT package_id FROM "public"."api_definitions" WHERE tenant_id = $1 AND id = $2`)).
+			WithArgs(tenantID, apiDefID).
+			WillReturnRows(sqlmock.NewRows([]string{"package_id"}).AddRow(packageID))
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id FROM public.packages WHERE tenant_id = $1 AND id = $2`)).
+			WithArgs(tenantID, packageID).
+			WillReturnRows(sqlmock.NewRows([]string{"app_id"}).AddRow(objectID))
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		parentType, parentID, err := repo.GetParent(ctx, tenantID, model.AccessRuleNestedObjectTypeAPIDefinition, apiDefID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.AccessRuleObjectTypeApplication, parentType)
+		assert.Equal(t, objectID, parentID)
+	})
+
+	t.Run("success for Runtime Context", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT runtime_id FROM public.runtime_contexts WHERE tenant_id = $1 AND id = $2`)).
+			WithArgs(tenantID, apiDefID).
+			WillReturnRows(sqlmock.NewRows([]string{"runtime_id"}).AddRow(objectID))
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		parentType, parentID, err := repo.GetParent(ctx, tenantID, model.AccessRuleNestedObjectTypeRuntimeContext, apiDefID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.AccessRuleObjectTypeRuntime, parentType)
+		assert.Equal(t, objectID, parentID)
+	})
+
+	t.Run("error when nested object does not exist", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id FROM public.webhooks WHERE tenant_id = $1 AND id = $2`)).
+			WithArgs(tenantID, apiDefID).
+			WillReturnRows(sqlmock.NewRows([]string{"app_id"}))
+
+		repo := accessrule.NewObjectRepository()
+
+		// when
+		_, _, err := repo.GetParent(ctx, tenantID, model.AccessRuleNestedObjectTypeWebhook, apiDefID)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
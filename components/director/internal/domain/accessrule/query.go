@@ -0,0 +1,85 @@
+package accessrule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const ownedObjectsStmtFormat = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = ? AND "%s" = ?`
+
+type objectType struct {
+	labelableObject model.LabelableObject
+	column          string
+}
+
+var (
+	objectTypes = map[model.AccessRuleObjectType]objectType{
+		model.AccessRuleObjectTypeApplication: {labelableObject: model.ApplicationLabelableObject, column: "app_id"},
+		model.AccessRuleObjectTypeRuntime:     {labelableObject: model.RuntimeLabelableObject, column: "runtime_id"},
+	}
+	ownerColumns = map[model.AccessRuleSubjectType]string{
+		model.AccessRuleSubjectTypeUser:              "user_name",
+		model.AccessRuleSubjectTypeIntegrationSystem: "integration_system_id",
+	}
+)
+
+// FilterQuery builds select query for the IDs of the objects, which can be accessed with the given restriction
+//
+// The objects matched by the label rules are combined with the objects created by the owner of the restriction,
+// if any of the rules is limited to the owned objects. An empty query is returned for a nil restriction.
+func FilterQuery(restriction *model.AccessRestriction, tenant uuid.UUID) (string, []interface{}, error) {
+	if restriction == nil {
+		return "", nil, nil
+	}
+
+	objType, ok := objectTypes[restriction.ObjectType]
+	if !ok {
+		return "", nil, apperrors.NewInternalError("unknown access rule object type: %s", restriction.ObjectType)
+	}
+
+	var filters []*labelfilter.LabelFilter
+	ownedOnly := false
+	for _, rule := range restriction.Rules {
+		if rule.OwnedOnly {
+			ownedOnly = true
+			continue
+		}
+		if rule.LabelKey != nil {
+			filters = append(filters, &labelfilter.LabelFilter{Key: *rule.LabelKey, Query: rule.LabelQuery})
+		}
+	}
+
+	var queries []string
+	var args []interface{}
+	if len(filters) > 0 {
+		labelsQuery, labelsArgs, err := label.FilterQuery(objType.labelableObject, label.UnionSet, tenant, filters)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "while building label filter query")
+		}
+		queries = append(queries, labelsQuery)
+		args = append(args, labelsArgs...)
+	}
+
+	if ownedOnly && restriction.Owner != nil {
+		ownerColumn, ok := ownerColumns[restriction.Owner.Type]
+		if !ok {
+			return "", nil, apperrors.NewInternalError("subject of type %s cannot own objects", restriction.Owner.Type)
+		}
+		queries = append(queries, fmt.Sprintf(ownedObjectsStmtFormat, objType.column, objectOwnersTable, objType.column, ownerColumn))
+		args = append(args, tenant, restriction.Owner.Name)
+	}
+
+	if len(queries) == 0 {
+		// none of the rules can match any object
+		return fmt.Sprintf(`SELECT "%s" FROM %s WHERE FALSE`, objType.column, objectOwnersTable), nil, nil
+	}
+
+	return strings.Join(queries, fmt.Sprintf(" %s ", label.UnionSet)), args, nil
+}
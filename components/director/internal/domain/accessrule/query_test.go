@@ -0,0 +1,106 @@
+package accessrule_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterQuery(t *testing.T) {
+	tenant := uuid.MustParse(tenantID)
+	owner := &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName}
+	labelRule := &model.AccessRule{LabelKey: str.Ptr(labelKey), LabelQuery: str.Ptr(labelQry)}
+	ownedOnlyRule := &model.AccessRule{OwnedOnly: true}
+
+	testCases := []struct {
+		Name          string
+		Restriction   *model.AccessRestriction
+		ExpectedQuery string
+		ExpectedArgs  []interface{}
+		ExpectedErr   string
+	}{
+		{
+			Name: "Label rules",
+			Restriction: &model.AccessRestriction{
+				ObjectType: model.AccessRuleObjectTypeApplication,
+				Rules:      []*model.AccessRule{labelRule, {LabelKey: str.Ptr("env")}},
+				Owner:      owner,
+			},
+			ExpectedQuery: `SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "tenant_id" = ? AND "key" = ? AND "value" @> ?` +
+				` UNION SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "tenant_id" = ? AND "key" = ?`,
+			ExpectedArgs: []interface{}{tenant, labelKey, labelQry, tenant, "env"},
+		},
+		{
+			Name: "Owned only rule",
+			Restriction: &model.AccessRestriction{
+				ObjectType: model.AccessRuleObjectTypeRuntime,
+				Rules:      []*model.AccessRule{ownedOnlyRule},
+				Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeIntegrationSystem, Name: objectID},
+			},
+			ExpectedQuery: `SELECT "runtime_id" FROM public.object_owners WHERE "runtime_id" IS NOT NULL AND "tenant_id" = ? AND "integration_system_id" = ?`,
+			ExpectedArgs:  []interface{}{tenant, objectID},
+		},
+		{
+			Name: "Label and owned only rules",
+			Restriction: &model.AccessRestriction{
+				ObjectType: model.AccessRuleObjectTypeApplication,
+				Rules:      []*model.AccessRule{ownedOnlyRule, labelRule},
+				Owner:      owner,
+			},
+			ExpectedQuery: `SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "tenant_id" = ? AND "key" = ? AND "value" @> ?` +
+				` UNION SELECT "app_id" FROM public.object_owners WHERE "app_id" IS NOT NULL AND "tenant_id" = ? AND "user_name" = ?`,
+			ExpectedArgs: []interface{}{tenant, labelKey, labelQry, tenant, userName},
+		},
+		{
+			Name: "Owned only rule without owner",
+			Restriction: &model.AccessRestriction{
+				ObjectType: model.AccessRuleObjectTypeApplication,
+				Rules:      []*model.AccessRule{ownedOnlyRule},
+			},
+			ExpectedQuery: `SELECT "app_id" FROM public.object_owners WHERE FALSE`,
+		},
+		{
+			Name:          "Nil restriction",
+			Restriction:   nil,
+			ExpectedQuery: "",
+		},
+		{
+			Name: "Error when object type is unknown",
+			Restriction: &model.AccessRestriction{
+				ObjectType: "UNKNOWN",
+				Rules:      []*model.AccessRule{labelRule},
+			},
+			ExpectedErr: "Internal Server Error: unknown access rule object type: UNKNOWN",
+		},
+		{
+			Name: "Error when owner cannot own objects",
+			Restriction: &model.AccessRestriction{
+				ObjectType: model.AccessRuleObjectTypeApplication,
+				Rules:      []*model.AccessRule{ownedOnlyRule},
+				Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: groupName},
+			},
+			ExpectedErr: "Internal Server Error: subject of type GROUP cannot own objects",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			query, args, err := accessrule.FilterQuery(testCase.Restriction, tenant)
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.EqualError(t, err, testCase.ExpectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedQuery, query)
+			assert.Equal(t, testCase.ExpectedArgs, args)
+		})
+	}
+}
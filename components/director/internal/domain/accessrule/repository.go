@@ -0,0 +1,132 @@
+package accessrule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	accessRulesTable  = `public.access_rules`
+	objectOwnersTable = `public.object_owners`
+	tenantColumn      = "tenant_id"
+)
+
+var (
+	accessRuleColumns = []string{"id", "tenant_id", "user_name", "group_name", "integration_system_id", "object_type", "label_key", "label_query", "owned_only"}
+	subjectColumns    = map[model.AccessRuleSubjectType]string{
+		model.AccessRuleSubjectTypeUser:              "user_name",
+		model.AccessRuleSubjectTypeGroup:             "group_name",
+		model.AccessRuleSubjectTypeIntegrationSystem: "integration_system_id",
+	}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.AccessRule) (*Entity, error)
+	FromEntity(in *Entity) (*model.AccessRule, error)
+}
+
+type repository struct {
+	conv         EntityConverter
+	creator      repo.Creator
+	singleGetter repo.SingleGetter
+	lister       repo.Lister
+	deleter      repo.Deleter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		conv:         conv,
+		creator:      repo.NewCreator(resource.AccessRule, accessRulesTable, accessRuleColumns),
+		singleGetter: repo.NewSingleGetter(resource.AccessRule, accessRulesTable, tenantColumn, accessRuleColumns),
+		lister:       repo.NewLister(resource.AccessRule, accessRulesTable, tenantColumn, accessRuleColumns),
+		deleter:      repo.NewDeleter(resource.AccessRule, accessRulesTable, tenantColumn),
+	}
+}
+
+func (r *repository) Create(ctx context.Context, item *model.AccessRule) error {
+	if item == nil {
+		return apperrors.NewInternalError("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Access Rule to entity")
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+func (r *repository) GetByID(ctx context.Context, tenant, id string) (*model.AccessRule, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	rule, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Access Rule from entity")
+	}
+
+	return rule, nil
+}
+
+func (r *repository) List(ctx context.Context, tenant string) ([]*model.AccessRule, error) {
+	var entities EntityCollection
+	if err := r.lister.List(ctx, tenant, &entities); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+// ListForSubjects returns the Access Rules which apply to any of the given subjects in the tenant
+func (r *repository) ListForSubjects(ctx context.Context, tenant string, subjects []model.AccessRuleSubject) ([]*model.AccessRule, error) {
+	if len(subjects) == 0 {
+		return []*model.AccessRule{}, nil
+	}
+
+	subjectConditions := make([]string, 0, len(subjects))
+	args := make([]interface{}, 0, len(subjects))
+	for _, subject := range subjects {
+		column, ok := subjectColumns[subject.Type]
+		if !ok {
+			return nil, apperrors.NewInternalError("unknown access rule subject type: %s", subject.Type)
+		}
+		subjectConditions = append(subjectConditions, fmt.Sprintf("%s = ?", column))
+		args = append(args, subject.Name)
+	}
+
+	subquery := fmt.Sprintf("SELECT id FROM %s WHERE %s", accessRulesTable, strings.Join(subjectConditions, " OR "))
+
+	var entities EntityCollection
+	if err := r.lister.List(ctx, tenant, &entities, repo.NewInConditionForSubQuery("id", subquery, args)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *repository) multipleFromEntities(entities EntityCollection) ([]*model.AccessRule, error) {
+	items := make([]*model.AccessRule, 0, len(entities))
+	for _, entity := range entities {
+		rule, err := r.conv.FromEntity(&entity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting Access Rule with id %s from entity", entity.ID)
+		}
+		items = append(items, rule)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,211 @@
+package accessrule_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("ToEntity", fixModelAccessRule()).Return(fixEntityAccessRule(), nil).Once()
+
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.access_rules ( id, tenant_id, user_name, group_name, integration_system_id, object_type, label_key, label_query, owned_only ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")).
+			WithArgs(ruleID, tenantID, nil, groupName, nil, "APPLICATION", labelKey, labelQry, false).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := accessrule.NewRepository(convMock)
+
+		// when
+		err := repo.Create(ctx, fixModelAccessRule())
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when conversion fails", func(t *testing.T) {
+		// given
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("ToEntity", fixModelAccessRule()).Return(nil, errors.New("test")).Once()
+
+		repo := accessrule.NewRepository(convMock)
+
+		// when
+		err := repo.Create(context.TODO(), fixModelAccessRule())
+
+		// then
+		require.EqualError(t, err, "while converting Access Rule to entity: test")
+	})
+
+	t.Run("error when item is nil", func(t *testing.T) {
+		// given
+		repo := accessrule.NewRepository(nil)
+
+		// when
+		err := repo.Create(context.TODO(), nil)
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: item cannot be nil")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("FromEntity", fixEntityAccessRule()).Return(fixModelAccessRule(), nil).Once()
+
+		rows := sqlmock.NewRows(accessRuleColumns).AddRow(ruleID, tenantID, nil, groupName, nil, "APPLICATION", labelKey, labelQry, false)
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, user_name, group_name, integration_system_id, object_type, label_key, label_query, owned_only FROM public.access_rules WHERE tenant_id = $1 AND id = $2")).
+			WithArgs(tenantID, ruleID).
+			WillReturnRows(rows)
+
+		repo := accessrule.NewRepository(convMock)
+
+		// when
+		result, err := repo.GetByID(ctx, tenantID, ruleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixModelAccessRule(), result)
+	})
+
+	t.Run("error when conversion fails", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("FromEntity", fixEntityAccessRule()).Return(nil, errors.New("test")).Once()
+
+		rows := sqlmock.NewRows(accessRuleColumns).AddRow(ruleID, tenantID, nil, groupName, nil, "APPLICATION", labelKey, labelQry, false)
+		dbMock.ExpectQuery("SELECT .* FROM public.access_rules").WillReturnRows(rows)
+
+		repo := accessrule.NewRepository(convMock)
+
+		// when
+		_, err := repo.GetByID(ctx, tenantID, ruleID)
+
+		// then
+		require.EqualError(t, err, "while converting Access Rule from entity: test")
+	})
+}
+
+func TestRepository_List(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	convMock := &automock.EntityConverter{}
+	defer convMock.AssertExpectations(t)
+	convMock.On("FromEntity", fixEntityAccessRule()).Return(fixModelAccessRule(), nil).Once()
+
+	rows := sqlmock.NewRows(accessRuleColumns).AddRow(ruleID, tenantID, nil, groupName, nil, "APPLICATION", labelKey, labelQry, false)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, user_name, group_name, integration_system_id, object_type, label_key, label_query, owned_only FROM public.access_rules WHERE tenant_id = $1")).
+		WithArgs(tenantID).
+		WillReturnRows(rows)
+
+	repo := accessrule.NewRepository(convMock)
+
+	// when
+	result, err := repo.List(ctx, tenantID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AccessRule{fixModelAccessRule()}, result)
+}
+
+func TestRepository_ListForSubjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("FromEntity", fixEntityAccessRule()).Return(fixModelAccessRule(), nil).Once()
+
+		rows := sqlmock.NewRows(accessRuleColumns).AddRow(ruleID, tenantID, nil, groupName, nil, "APPLICATION", labelKey, labelQry, false)
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, user_name, group_name, integration_system_id, object_type, label_key, label_query, owned_only FROM public.access_rules WHERE tenant_id = $1 AND id IN (SELECT id FROM public.access_rules WHERE user_name = $2 OR group_name = $3)")).
+			WithArgs(tenantID, userName, groupName).
+			WillReturnRows(rows)
+
+		repo := accessrule.NewRepository(convMock)
+
+		// when
+		result, err := repo.ListForSubjects(ctx, tenantID, fixUserSubjects())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.AccessRule{fixModelAccessRule()}, result)
+	})
+
+	t.Run("success when there are no subjects", func(t *testing.T) {
+		// given
+		repo := accessrule.NewRepository(nil)
+
+		// when
+		result, err := repo.ListForSubjects(context.TODO(), tenantID, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("error when subject type is unknown", func(t *testing.T) {
+		// given
+		repo := accessrule.NewRepository(nil)
+
+		// when
+		_, err := repo.ListForSubjects(context.TODO(), tenantID, []model.AccessRuleSubject{{Type: "UNKNOWN", Name: userName}})
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: unknown access rule subject type: UNKNOWN")
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.access_rules WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(tenantID, ruleID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	repo := accessrule.NewRepository(nil)
+
+	// when
+	err := repo.Delete(ctx, tenantID, ruleID)
+
+	// then
+	require.NoError(t, err)
+}
@@ -0,0 +1,112 @@
+package accessrule
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	Create(ctx context.Context, in model.AccessRuleInput) (string, error)
+	Get(ctx context.Context, id string) (*model.AccessRule, error)
+	List(ctx context.Context) ([]*model.AccessRule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=AccessRuleConverter -output=automock -outpkg=automock -case=underscore
+type AccessRuleConverter interface {
+	ToGraphQL(in *model.AccessRule) *graphql.AccessRule
+	MultipleToGraphQL(in []*model.AccessRule) []*graphql.AccessRule
+	InputFromGraphQL(in graphql.AccessRuleInput) model.AccessRuleInput
+}
+
+type Resolver struct {
+	transact  persistence.Transactioner
+	svc       AccessRuleService
+	converter AccessRuleConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc AccessRuleService, conv AccessRuleConverter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		svc:       svc,
+		converter: conv,
+	}
+}
+
+func (r *Resolver) AccessRules(ctx context.Context) ([]*graphql.AccessRule, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	rules, err := r.svc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.MultipleToGraphQL(rules), nil
+}
+
+func (r *Resolver) CreateAccessRule(ctx context.Context, in graphql.AccessRuleInput) (*graphql.AccessRule, error) {
+	convertedIn := r.converter.InputFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.Create(ctx, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(rule), nil
+}
+
+func (r *Resolver) DeleteAccessRule(ctx context.Context, id string) (*graphql.AccessRule, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	rule, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.svc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(rule), nil
+}
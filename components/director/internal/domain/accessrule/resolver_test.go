@@ -0,0 +1,205 @@
+package accessrule_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_AccessRules(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+	modelRules := []*model.AccessRule{fixModelAccessRule()}
+	gqlRules := []*graphql.AccessRule{fixGQLAccessRule()}
+
+	t.Run("success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(modelRules, nil).Once()
+		conv := &automock.AccessRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("MultipleToGraphQL", modelRules).Return(gqlRules).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.AccessRules(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlRules, result)
+	})
+
+	t.Run("error when listing Access Rules fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.AccessRules(context.TODO())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("error when transaction cannot be started", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		resolver := accessrule.NewResolver(transact, nil, nil)
+
+		// when
+		_, err := resolver.AccessRules(context.TODO())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestResolver_CreateAccessRule(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+
+	t.Run("success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), fixModelAccessRuleInput()).Return(ruleID, nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), ruleID).Return(fixModelAccessRule(), nil).Once()
+		conv := &automock.AccessRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", fixGQLAccessRuleInput()).Return(fixModelAccessRuleInput()).Once()
+		conv.On("ToGraphQL", fixModelAccessRule()).Return(fixGQLAccessRule()).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.CreateAccessRule(context.TODO(), fixGQLAccessRuleInput())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLAccessRule(), result)
+	})
+
+	t.Run("error when creating Access Rule fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), fixModelAccessRuleInput()).Return("", testErr).Once()
+		conv := &automock.AccessRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", fixGQLAccessRuleInput()).Return(fixModelAccessRuleInput()).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, conv)
+
+		// when
+		_, err := resolver.CreateAccessRule(context.TODO(), fixGQLAccessRuleInput())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("error when getting created Access Rule fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), fixModelAccessRuleInput()).Return(ruleID, nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), ruleID).Return(nil, testErr).Once()
+		conv := &automock.AccessRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", fixGQLAccessRuleInput()).Return(fixModelAccessRuleInput()).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, conv)
+
+		// when
+		_, err := resolver.CreateAccessRule(context.TODO(), fixGQLAccessRuleInput())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestResolver_DeleteAccessRule(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+
+	t.Run("success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), ruleID).Return(fixModelAccessRule(), nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), ruleID).Return(nil).Once()
+		conv := &automock.AccessRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", fixModelAccessRule()).Return(fixGQLAccessRule()).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.DeleteAccessRule(context.TODO(), ruleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLAccessRule(), result)
+	})
+
+	t.Run("error when deleting Access Rule fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), ruleID).Return(fixModelAccessRule(), nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), ruleID).Return(testErr).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.DeleteAccessRule(context.TODO(), ruleID)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("error when getting Access Rule fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.AccessRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), ruleID).Return(nil, testErr).Once()
+
+		resolver := accessrule.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.DeleteAccessRule(context.TODO(), ruleID)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
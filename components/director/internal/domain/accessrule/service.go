@@ -0,0 +1,284 @@
+package accessrule
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=AccessRuleRepository -output=automock -outpkg=automock -case=underscore
+type AccessRuleRepository interface {
+	Create(ctx context.Context, item *model.AccessRule) error
+	GetByID(ctx context.Context, tenant, id string) (*model.AccessRule, error)
+	List(ctx context.Context, tenant string) ([]*model.AccessRule, error)
+	ListForSubjects(ctx context.Context, tenant string, subjects []model.AccessRuleSubject) ([]*model.AccessRule, error)
+	Delete(ctx context.Context, tenant, id string) error
+}
+
+//go:generate mockery -name=ObjectRepository -output=automock -outpkg=automock -case=underscore
+type ObjectRepository interface {
+	SetOwner(ctx context.Context, tenant string, objType model.AccessRuleObjectType, objectID string, owner model.AccessRuleSubject) error
+	IsAccessible(ctx context.Context, tenant string, restriction *model.AccessRestriction, objectID string) (bool, error)
+	GetParent(ctx context.Context, tenant string, nestedType model.AccessRuleNestedObjectType, nestedID string) (model.AccessRuleObjectType, string, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo       AccessRuleRepository
+	objectRepo ObjectRepository
+	uidService UIDService
+}
+
+func NewService(repo AccessRuleRepository, objectRepo ObjectRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		objectRepo: objectRepo,
+		uidService: uidService,
+	}
+}
+
+func (s *service) Create(ctx context.Context, in model.AccessRuleInput) (string, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while loading tenant from context")
+	}
+
+	if err := s.ensureCallerIsNotRestricted(ctx, tnt); err != nil {
+		return "", err
+	}
+
+	id := s.uidService.Generate()
+	if err := s.repo.Create(ctx, in.ToAccessRule(id, tnt)); err != nil {
+		return "", errors.Wrap(err, "while creating Access Rule")
+	}
+
+	return id, nil
+}
+
+func (s *service) Get(ctx context.Context, id string) (*model.AccessRule, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	rule, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Access Rule with ID %s", id)
+	}
+
+	return rule, nil
+}
+
+func (s *service) List(ctx context.Context) ([]*model.AccessRule, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	rules, err := s.repo.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Access Rules")
+	}
+
+	return rules, nil
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	if err := s.ensureCallerIsNotRestricted(ctx, tnt); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, tnt, id); err != nil {
+		return errors.Wrapf(err, "while deleting Access Rule with ID %s", id)
+	}
+
+	return nil
+}
+
+// GetRestriction returns the restriction of the caller for the objects of the given type,
+// or nil if the caller is not restricted by any Access Rule
+func (s *service) GetRestriction(ctx context.Context, objType model.AccessRuleObjectType) (*model.AccessRestriction, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	return s.getRestriction(ctx, tnt, objType)
+}
+
+// HasAccess checks whether the object exists and the caller can access it
+func (s *service) HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	restriction, err := s.getRestriction(ctx, tnt, objType)
+	if err != nil {
+		return false, err
+	}
+
+	if restriction == nil {
+		return true, nil
+	}
+
+	accessible, err := s.objectRepo.IsAccessible(ctx, tnt, restriction, objectID)
+	if err != nil {
+		return false, errors.Wrapf(err, "while checking access to object with ID %s", objectID)
+	}
+
+	return accessible, nil
+}
+
+// HasAccessToParent checks whether the nested object exists and the caller can access the Application or the Runtime it belongs to
+func (s *service) HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	restriction, err := s.getRestriction(ctx, tnt, nestedType.ParentType())
+	if err != nil {
+		return false, err
+	}
+
+	if restriction == nil {
+		return true, nil
+	}
+
+	_, parentID, err := s.objectRepo.GetParent(ctx, tnt, nestedType, nestedID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "while getting parent of %s with ID %s", nestedType, nestedID)
+	}
+
+	accessible, err := s.objectRepo.IsAccessible(ctx, tnt, restriction, parentID)
+	if err != nil {
+		return false, errors.Wrapf(err, "while checking access to object with ID %s", parentID)
+	}
+
+	return accessible, nil
+}
+
+// SetOwner stores the caller as the owner of the created object, if the caller is a user or an integration system
+func (s *service) SetOwner(ctx context.Context, objType model.AccessRuleObjectType, objectID string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		// objects created internally, not on behalf of a consumer, have no owner
+		return nil
+	}
+
+	_, owner := subjectsForConsumer(consumerInfo)
+	if owner == nil {
+		return nil
+	}
+
+	if err := s.objectRepo.SetOwner(ctx, tnt, objType, objectID, *owner); err != nil {
+		return errors.Wrapf(err, "while setting owner of object with ID %s", objectID)
+	}
+
+	return nil
+}
+
+func (s *service) getRestriction(ctx context.Context, tnt string, objType model.AccessRuleObjectType) (*model.AccessRestriction, error) {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		// internal operations, which are not performed on behalf of a consumer, are not restricted
+		return nil, nil
+	}
+
+	subjects, owner := subjectsForConsumer(consumerInfo)
+	rules, err := s.listRulesForSubjects(ctx, tnt, subjects)
+	if err != nil {
+		return nil, err
+	}
+
+	var objRules []*model.AccessRule
+	for _, rule := range rules {
+		if rule.ObjectType == objType {
+			objRules = append(objRules, rule)
+		}
+	}
+
+	if len(objRules) == 0 {
+		return nil, nil
+	}
+
+	return &model.AccessRestriction{
+		ObjectType: objType,
+		Rules:      objRules,
+		Owner:      owner,
+	}, nil
+}
+
+// ensureCallerIsNotRestricted prevents the callers restricted by Access Rules from lifting their restrictions
+func (s *service) ensureCallerIsNotRestricted(ctx context.Context, tnt string) error {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	subjects, _ := subjectsForConsumer(consumerInfo)
+	rules, err := s.listRulesForSubjects(ctx, tnt, subjects)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) > 0 {
+		return apperrors.NewInvalidOperationError("access rules cannot be managed by a caller restricted by access rules")
+	}
+
+	return nil
+}
+
+func (s *service) listRulesForSubjects(ctx context.Context, tnt string, subjects []model.AccessRuleSubject) ([]*model.AccessRule, error) {
+	if len(subjects) == 0 {
+		return nil, nil
+	}
+
+	rules, err := s.repo.ListForSubjects(ctx, tnt, subjects)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Access Rules of the caller")
+	}
+
+	return rules, nil
+}
+
+// subjectsForConsumer returns the subjects to which the Access Rules of the consumer apply,
+// and the subject which owns the objects created by the consumer
+func subjectsForConsumer(consumerInfo consumer.Consumer) ([]model.AccessRuleSubject, *model.AccessRuleSubject) {
+	switch consumerInfo.ConsumerType {
+	case consumer.User:
+		owner := model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: consumerInfo.ConsumerID}
+		subjects := []model.AccessRuleSubject{owner}
+		for _, group := range consumerInfo.Groups {
+			subjects = append(subjects, model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: group})
+		}
+		return subjects, &owner
+	case consumer.IntegrationSystem:
+		owner := model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeIntegrationSystem, Name: consumerInfo.ConsumerID}
+		return []model.AccessRuleSubject{owner}, &owner
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,479 @@
+package accessrule_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		RepoFn             func() *automock.AccessRuleRepository
+		UIDServiceFn       func() *automock.UIDService
+		ExpectedErrMessage string
+	}{
+		{
+			Name:    "Success",
+			Context: fixUserContext(),
+			RepoFn: func() *automock.AccessRuleRepository {
+				repo := fixRepositoryThatListsRulesForUser(nil)
+				repo.On("Create", mock.Anything, fixModelAccessRule()).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: fixUIDServiceThatGenerates(ruleID),
+		},
+		{
+			Name:    "Error when caller is restricted by access rules",
+			Context: fixUserContext(),
+			RepoFn: func() *automock.AccessRuleRepository {
+				return fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelOwnedOnlyAccessRule()})
+			},
+			UIDServiceFn:       fixEmptyUIDService,
+			ExpectedErrMessage: "access rules cannot be managed by a caller restricted by access rules",
+		},
+		{
+			Name:    "Error when listing access rules of the caller fails",
+			Context: fixUserContext(),
+			RepoFn: func() *automock.AccessRuleRepository {
+				repo := &automock.AccessRuleRepository{}
+				repo.On("ListForSubjects", mock.Anything, tenantID, fixUserSubjects()).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn:       fixEmptyUIDService,
+			ExpectedErrMessage: "while listing Access Rules of the caller: test",
+		},
+		{
+			Name:    "Error when creating Access Rule fails",
+			Context: fixUserContext(),
+			RepoFn: func() *automock.AccessRuleRepository {
+				repo := fixRepositoryThatListsRulesForUser(nil)
+				repo.On("Create", mock.Anything, fixModelAccessRule()).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn:       fixUIDServiceThatGenerates(ruleID),
+			ExpectedErrMessage: "while creating Access Rule: test",
+		},
+		{
+			Name:    "Error when consumer is missing in context",
+			Context: tenant.SaveToContext(context.TODO(), tenantID, "external"),
+			RepoFn: func() *automock.AccessRuleRepository {
+				return &automock.AccessRuleRepository{}
+			},
+			UIDServiceFn:       fixEmptyUIDService,
+			ExpectedErrMessage: "cannot read consumer from context",
+		},
+		{
+			Name:    "Error when tenant is missing in context",
+			Context: context.TODO(),
+			RepoFn: func() *automock.AccessRuleRepository {
+				return &automock.AccessRuleRepository{}
+			},
+			UIDServiceFn:       fixEmptyUIDService,
+			ExpectedErrMessage: "while loading tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := testCase.UIDServiceFn()
+			svc := accessrule.NewService(repo, nil, uidSvc)
+
+			// when
+			id, err := svc.Create(testCase.Context, fixModelAccessRuleInput())
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, ruleID, id)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidSvc)
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		repo := &automock.AccessRuleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, ruleID).Return(fixModelAccessRule(), nil).Once()
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		result, err := svc.Get(fixUserContext(), ruleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixModelAccessRule(), result)
+	})
+
+	t.Run("error when getting Access Rule fails", func(t *testing.T) {
+		// given
+		repo := &automock.AccessRuleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, ruleID).Return(nil, errors.New("test")).Once()
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		_, err := svc.Get(fixUserContext(), ruleID)
+
+		// then
+		require.EqualError(t, err, "while getting Access Rule with ID "+ruleID+": test")
+	})
+}
+
+func TestService_List(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		rules := []*model.AccessRule{fixModelAccessRule()}
+		repo := &automock.AccessRuleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("List", mock.Anything, tenantID).Return(rules, nil).Once()
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		result, err := svc.List(fixUserContext())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, rules, result)
+	})
+
+	t.Run("error when tenant is missing in context", func(t *testing.T) {
+		// given
+		svc := accessrule.NewService(nil, nil, nil)
+
+		// when
+		_, err := svc.List(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		repo := fixRepositoryThatListsRulesForUser(nil)
+		defer repo.AssertExpectations(t)
+		repo.On("Delete", mock.Anything, tenantID, ruleID).Return(nil).Once()
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		err := svc.Delete(fixUserContext(), ruleID)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when caller is restricted by access rules", func(t *testing.T) {
+		// given
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule()})
+		defer repo.AssertExpectations(t)
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		err := svc.Delete(fixUserContext(), ruleID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InvalidOperation, apperrors.ErrorCode(err))
+	})
+
+	t.Run("error when deleting Access Rule fails", func(t *testing.T) {
+		// given
+		repo := fixRepositoryThatListsRulesForUser(nil)
+		defer repo.AssertExpectations(t)
+		repo.On("Delete", mock.Anything, tenantID, ruleID).Return(errors.New("test")).Once()
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		err := svc.Delete(fixUserContext(), ruleID)
+
+		// then
+		require.EqualError(t, err, "while deleting Access Rule with ID "+ruleID+": test")
+	})
+}
+
+func TestService_GetRestriction(t *testing.T) {
+	// given
+	runtimeRule := fixModelOwnedOnlyAccessRule()
+	runtimeRule.ObjectType = model.AccessRuleObjectTypeRuntime
+
+	t.Run("success", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule(), runtimeRule})
+		defer repo.AssertExpectations(t)
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		result, err := svc.GetRestriction(fixUserContext(), model.AccessRuleObjectTypeApplication)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &model.AccessRestriction{
+			ObjectType: model.AccessRuleObjectTypeApplication,
+			Rules:      []*model.AccessRule{fixModelAccessRule()},
+			Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+		}, result)
+	})
+
+	t.Run("success when there are no rules for the object type", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{runtimeRule})
+		defer repo.AssertExpectations(t)
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		result, err := svc.GetRestriction(fixUserContext(), model.AccessRuleObjectTypeApplication)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("success for integration system", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), tenantID, "external")
+		ctx = consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: objectID, ConsumerType: consumer.IntegrationSystem})
+		subject := model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeIntegrationSystem, Name: objectID}
+		rule := fixModelOwnedOnlyAccessRule()
+		rule.Subject = subject
+		repo := &automock.AccessRuleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListForSubjects", mock.Anything, tenantID, []model.AccessRuleSubject{subject}).Return([]*model.AccessRule{rule}, nil).Once()
+		svc := accessrule.NewService(repo, nil, nil)
+
+		// when
+		result, err := svc.GetRestriction(ctx, model.AccessRuleObjectTypeApplication)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, &subject, result.Owner)
+	})
+
+	t.Run("success for consumer which is not restricted by access rules", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), tenantID, "external")
+		ctx = consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: objectID, ConsumerType: consumer.Runtime})
+		svc := accessrule.NewService(nil, nil, nil)
+
+		// when
+		result, err := svc.GetRestriction(ctx, model.AccessRuleObjectTypeApplication)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("success when consumer is missing in context", func(t *testing.T) {
+		svc := accessrule.NewService(nil, nil, nil)
+
+		// when
+		result, err := svc.GetRestriction(tenant.SaveToContext(context.TODO(), tenantID, "external"), model.AccessRuleObjectTypeApplication)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestService_HasAccess(t *testing.T) {
+	// given
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		Rules:      []*model.AccessRule{fixModelAccessRule()},
+		Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule()})
+		objectRepo := &automock.ObjectRepository{}
+		objectRepo.On("IsAccessible", mock.Anything, tenantID, restriction, objectID).Return(false, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		result, err := svc.HasAccess(fixUserContext(), model.AccessRuleObjectTypeApplication, objectID)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("success when caller is not restricted", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser(nil)
+		objectRepo := &automock.ObjectRepository{}
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		result, err := svc.HasAccess(fixUserContext(), model.AccessRuleObjectTypeApplication, objectID)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("error when checking access fails", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule()})
+		objectRepo := &automock.ObjectRepository{}
+		objectRepo.On("IsAccessible", mock.Anything, tenantID, restriction, objectID).Return(false, errors.New("test")).Once()
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		_, err := svc.HasAccess(fixUserContext(), model.AccessRuleObjectTypeApplication, objectID)
+
+		// then
+		require.EqualError(t, err, "while checking access to object with ID "+objectID+": test")
+	})
+}
+
+func TestService_HasAccessToParent(t *testing.T) {
+	// given
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		Rules:      []*model.AccessRule{fixModelAccessRule()},
+		Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName},
+	}
+	packageID := "1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d"
+
+	t.Run("success", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule()})
+		objectRepo := &automock.ObjectRepository{}
+		objectRepo.On("GetParent", mock.Anything, tenantID, model.AccessRuleNestedObjectTypePackage, packageID).Return(model.AccessRuleObjectTypeApplication, objectID, nil).Once()
+		objectRepo.On("IsAccessible", mock.Anything, tenantID, restriction, objectID).Return(true, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		result, err := svc.HasAccessToParent(fixUserContext(), model.AccessRuleNestedObjectTypePackage, packageID)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("success when caller is not restricted", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser(nil)
+		objectRepo := &automock.ObjectRepository{}
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		result, err := svc.HasAccessToParent(fixUserContext(), model.AccessRuleNestedObjectTypePackage, packageID)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("success when nested object does not exist", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule()})
+		objectRepo := &automock.ObjectRepository{}
+		objectRepo.On("GetParent", mock.Anything, tenantID, model.AccessRuleNestedObjectTypePackage, packageID).Return(model.AccessRuleObjectType(""), "", apperrors.NewNotFoundError(resource.Package, packageID)).Once()
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		result, err := svc.HasAccessToParent(fixUserContext(), model.AccessRuleNestedObjectTypePackage, packageID)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("error when getting parent fails", func(t *testing.T) {
+		repo := fixRepositoryThatListsRulesForUser([]*model.AccessRule{fixModelAccessRule()})
+		objectRepo := &automock.ObjectRepository{}
+		objectRepo.On("GetParent", mock.Anything, tenantID, model.AccessRuleNestedObjectTypePackage, packageID).Return(model.AccessRuleObjectType(""), "", errors.New("test")).Once()
+		defer mock.AssertExpectationsForObjects(t, repo, objectRepo)
+		svc := accessrule.NewService(repo, objectRepo, nil)
+
+		// when
+		_, err := svc.HasAccessToParent(fixUserContext(), model.AccessRuleNestedObjectTypePackage, packageID)
+
+		// then
+		require.EqualError(t, err, "while getting parent of PACKAGE with ID "+packageID+": test")
+	})
+}
+
+func TestService_SetOwner(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		objectRepo := &automock.ObjectRepository{}
+		defer objectRepo.AssertExpectations(t)
+		objectRepo.On("SetOwner", mock.Anything, tenantID, model.AccessRuleObjectTypeRuntime, objectID, model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: userName}).Return(nil).Once()
+		svc := accessrule.NewService(nil, objectRepo, nil)
+
+		// when
+		err := svc.SetOwner(fixUserContext(), model.AccessRuleObjectTypeRuntime, objectID)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("success for consumer which cannot own objects", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), tenantID, "external")
+		ctx = consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: objectID, ConsumerType: consumer.Application})
+		objectRepo := &automock.ObjectRepository{}
+		defer objectRepo.AssertExpectations(t)
+		svc := accessrule.NewService(nil, objectRepo, nil)
+
+		// when
+		err := svc.SetOwner(ctx, model.AccessRuleObjectTypeRuntime, objectID)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when setting owner fails", func(t *testing.T) {
+		objectRepo := &automock.ObjectRepository{}
+		defer objectRepo.AssertExpectations(t)
+		objectRepo.On("SetOwner", mock.Anything, tenantID, model.AccessRuleObjectTypeRuntime, objectID, mock.Anything).Return(errors.New("test")).Once()
+		svc := accessrule.NewService(nil, objectRepo, nil)
+
+		// when
+		err := svc.SetOwner(fixUserContext(), model.AccessRuleObjectTypeRuntime, objectID)
+
+		// then
+		require.EqualError(t, err, "while setting owner of object with ID "+objectID+": test")
+	})
+}
+
+func fixRepositoryThatListsRulesForUser(rules []*model.AccessRule) *automock.AccessRuleRepository {
+	repo := &automock.AccessRuleRepository{}
+	repo.On("ListForSubjects", mock.Anything, tenantID, fixUserSubjects()).Return(rules, nil).Once()
+	return repo
+}
+
+func fixUIDServiceThatGenerates(id string) func() *automock.UIDService {
+	return func() *automock.UIDService {
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(id).Once()
+		return uidSvc
+	}
+}
+
+func fixEmptyUIDService() *automock.UIDService {
+	return &automock.UIDService{}
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/api/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -341,3 +343,15 @@ func fixGQLFetchRequest(url string, timestamp time.Time) *graphql.FetchRequest {
 		},
 	}
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return svc
+}
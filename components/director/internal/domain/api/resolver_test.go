@@ -13,8 +13,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -221,6 +223,120 @@ func TestResolver_AddAPIToPackage(t *testing.T) {
 	}
 }
 
+func TestResolver_APIDefinition(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "bar"
+	modelAPIDefinition := fixAPIDefinitionModel(id, "1", "foo", "bar")
+	gqlAPIDefinition := fixGQLAPIDefinition(id, "1", "foo", "bar")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.APIService
+		ConverterFn     func() *automock.APIConverter
+		ExpectedResult  *graphql.APIDefinition
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelAPIDefinition, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				conv := &automock.APIConverter{}
+				conv.On("ToGraphQL", modelAPIDefinition).Return(gqlAPIDefinition).Once()
+				return conv
+			},
+			ExpectedResult: gqlAPIDefinition,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns null when API definition not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, apperrors.NewNotFoundError(resource.API, id)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when API definition retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.APIService {
+				return &automock.APIService{}
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelAPIDefinition, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, converter, nil)
+
+			// when
+			result, err := resolver.APIDefinition(context.TODO(), id)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_DeleteAPI(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
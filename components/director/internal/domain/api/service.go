@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -31,6 +32,11 @@ type FetchRequestRepository interface {
 	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -44,14 +50,16 @@ type FetchRequestService interface {
 type service struct {
 	repo                APIRepository
 	fetchRequestRepo    FetchRequestRepository
+	accessRuleService   AccessRuleService
 	uidService          UIDService
 	fetchRequestService FetchRequestService
 	timestampGen        timestamp.Generator
 }
 
-func NewService(repo APIRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService) *service {
+func NewService(repo APIRepository, fetchRequestRepo FetchRequestRepository, accessRuleService AccessRuleService, uidService UIDService, fetchRequestService FetchRequestService) *service {
 	return &service{repo: repo,
 		fetchRequestRepo:    fetchRequestRepo,
+		accessRuleService:   accessRuleService,
 		uidService:          uidService,
 		fetchRequestService: fetchRequestService,
 		timestampGen:        timestamp.DefaultGenerator(),
@@ -105,6 +113,10 @@ func (s *service) CreateInPackage(ctx context.Context, packageID string, in mode
 		return "", err
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypePackage, packageID, resource.Package); err != nil {
+		return "", err
+	}
+
 	id := s.uidService.Generate()
 	api := in.ToAPIDefinitionWithinPackage(id, packageID, tnt)
 
@@ -135,6 +147,10 @@ func (s *service) Update(ctx context.Context, id string, in model.APIDefinitionI
 		return err
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeAPIDefinition, id, resource.API); err != nil {
+		return err
+	}
+
 	api, err := s.Get(ctx, id)
 	if err != nil {
 		return err
@@ -170,6 +186,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeAPIDefinition, id, resource.API); err != nil {
+		return err
+	}
+
 	err = s.repo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting APIDefinition with ID %s", id)
@@ -184,6 +204,10 @@ func (s *service) RefetchAPISpec(ctx context.Context, id string) (*model.APISpec
 		return nil, err
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeAPIDefinition, id, resource.API); err != nil {
+		return nil, err
+	}
+
 	api, err := s.repo.GetByID(ctx, tnt, id)
 	if err != nil {
 		return nil, err
@@ -231,6 +255,19 @@ func (s *service) GetFetchRequest(ctx context.Context, apiDefID string) (*model.
 	return fetchRequest, nil
 }
 
+// ensureAccess hides the API Definitions of the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, nestedType model.AccessRuleNestedObjectType, id string, resourceType resource.Type) error {
+	hasAccess, err := s.accessRuleService.HasAccessToParent(ctx, nestedType, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to %s with id %s", resourceType, id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resourceType, id)
+	}
+
+	return nil
+}
+
 func (s *service) createFetchRequest(ctx context.Context, tenant string, in model.FetchRequestInput, parentObjectID string) (*model.FetchRequest, error) {
 	id := s.uidService.Generate()
 	fr := in.ToFetchRequest(s.timestampGen(), id, tenant, model.APIFetchRequestReference, parentObjectID)
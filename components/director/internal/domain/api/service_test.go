@@ -72,7 +72,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := api.NewService(repo, nil, nil, nil)
+			svc := api.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.Get(ctx, testCase.InputID)
@@ -90,7 +90,7 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Get(context.TODO(), "")
 		// THEN
@@ -151,7 +151,7 @@ func TestService_GetForPackage(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := api.NewService(repo, nil, nil, nil)
+			svc := api.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			api, err := svc.GetForPackage(ctx, testCase.InputID, testCase.PackageID)
@@ -169,7 +169,7 @@ func TestService_GetForPackage(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForPackage(context.TODO(), "", "")
 		// THEN
@@ -262,7 +262,7 @@ func TestService_ListForPackage(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := api.NewService(repo, nil, nil, nil)
+			svc := api.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			docs, err := svc.ListForPackage(ctx, packageID, testCase.PageSize, after)
@@ -280,7 +280,7 @@ func TestService_ListForPackage(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.ListForPackage(context.TODO(), "", 5, "")
 		// THEN
@@ -520,7 +520,8 @@ func TestService_CreateToPackage(t *testing.T) {
 			uidService := testCase.UIDServiceFn()
 			fetchRequestService := testCase.FetchRequestServiceFn()
 
-			svc := api.NewService(repo, fetchRequestRepo, uidService, fetchRequestService)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := api.NewService(repo, fetchRequestRepo, accessRuleSvc, uidService, fetchRequestService)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -539,8 +540,22 @@ func TestService_CreateToPackage(t *testing.T) {
 			uidService.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.APIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := api.NewService(repo, nil, accessRuleSvc, nil, nil)
+
+		// when
+		_, err := svc.CreateInPackage(ctx, packageID, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.CreateInPackage(context.TODO(), "", model.APIDefinitionInput{})
 		// THEN
@@ -765,7 +780,8 @@ func TestService_Update(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			fetchRequestSvc := testCase.FetchRequestServiceFn()
 
-			svc := api.NewService(repo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := api.NewService(repo, fetchRequestRepo, accessRuleSvc, uidSvc, fetchRequestSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -784,8 +800,22 @@ func TestService_Update(t *testing.T) {
 			uidSvc.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.APIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := api.NewService(repo, nil, accessRuleSvc, nil, nil)
+
+		// when
+		err := svc.Update(ctx, id, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Update(context.TODO(), "", model.APIDefinitionInput{})
 		// THEN
@@ -837,7 +867,8 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := api.NewService(repo, nil, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := api.NewService(repo, nil, accessRuleSvc, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -853,8 +884,22 @@ func TestService_Delete(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.APIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := api.NewService(repo, nil, accessRuleSvc, nil, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -1004,7 +1049,8 @@ func TestService_RefetchAPISpec(t *testing.T) {
 			frRepo := testCase.FetchRequestRepoFn()
 			frSvc := testCase.FetchRequestSvcFn()
 
-			svc := api.NewService(repo, frRepo, nil, frSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := api.NewService(repo, frRepo, accessRuleSvc, nil, frSvc)
 
 			// when
 			result, err := svc.RefetchAPISpec(ctx, apiID)
@@ -1020,8 +1066,22 @@ func TestService_RefetchAPISpec(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.APIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := api.NewService(repo, nil, accessRuleSvc, nil, nil)
+
+		// when
+		_, err := svc.RefetchAPISpec(ctx, apiID)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.RefetchAPISpec(context.TODO(), "")
 		// THEN
@@ -1135,7 +1195,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := api.NewService(repo, fetchRequestRepo, nil, nil)
+			svc := api.NewService(repo, fetchRequestRepo, nil, nil, nil)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, testCase.InputAPIDefID)
@@ -1155,7 +1215,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.GetFetchRequest(context.TODO(), "dd")
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -85,13 +85,13 @@ func (_m *ApplicationRepository) GetByID(ctx context.Context, tenant string, id
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, restriction, pageSize, cursor
+func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, restriction *model.AccessRestriction, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, tenant, filter, restriction, pageSize, cursor)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, *model.AccessRestriction, int, string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, tenant, filter, restriction, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -99,8 +99,8 @@ func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, *model.AccessRestriction, int, string) error); ok {
+		r1 = rf(ctx, tenant, filter, restriction, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,13 +108,13 @@ func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter
 	return r0, r1
 }
 
-// ListByScenarios provides a mock function with given fields: ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors
-func (_m *ApplicationRepository) ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors)
+// ListByScenarios provides a mock function with given fields: ctx, tenantID, scenarios, restriction, pageSize, cursor, hidingSelectors
+func (_m *ApplicationRepository) ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, restriction *model.AccessRestriction, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, tenantID, scenarios, restriction, pageSize, cursor, hidingSelectors)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string, int, string, map[string][]string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string, *model.AccessRestriction, int, string, map[string][]string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, tenantID, scenarios, restriction, pageSize, cursor, hidingSelectors)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -122,8 +122,8 @@ func (_m *ApplicationRepository) ListByScenarios(ctx context.Context, tenantID u
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string, int, string, map[string][]string) error); ok {
-		r1 = rf(ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string, *model.AccessRestriction, int, string, map[string][]string) error); ok {
+		r1 = rf(ctx, tenantID, scenarios, restriction, pageSize, cursor, hidingSelectors)
 	} else {
 		r1 = ret.Error(1)
 	}
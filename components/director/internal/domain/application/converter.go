@@ -40,6 +40,7 @@ func (c *converter) ToEntity(in *model.Application) (*Entity, error) {
 		Description:         repo.NewNullableString(in.Description),
 		StatusCondition:     string(in.Status.Condition),
 		StatusTimestamp:     in.Status.Timestamp,
+		LastSeenTimestamp:   in.Status.LastSeen,
 		HealthCheckURL:      repo.NewNullableString(in.HealthCheckURL),
 		IntegrationSystemID: repo.NewNullableString(in.IntegrationSystemID),
 		ProviderName:        repo.NewNullableString(in.ProviderName),
@@ -59,6 +60,7 @@ func (c *converter) FromEntity(entity *Entity) *model.Application {
 		Status: &model.ApplicationStatus{
 			Condition: model.ApplicationStatusCondition(entity.StatusCondition),
 			Timestamp: entity.StatusTimestamp,
+			LastSeen:  entity.LastSeenTimestamp,
 		},
 		IntegrationSystemID: repo.StringPtrFromNullableString(entity.IntegrationSystemID),
 		HealthCheckURL:      repo.StringPtrFromNullableString(entity.HealthCheckURL),
@@ -186,13 +188,22 @@ func (c *converter) statusToGraphQL(in *model.ApplicationStatus) *graphql.Applic
 		condition = graphql.ApplicationStatusConditionFailed
 	case model.ApplicationStatusConditionConnected:
 		condition = graphql.ApplicationStatusConditionConnected
+	case model.ApplicationStatusConditionDisconnected:
+		condition = graphql.ApplicationStatusConditionDisconnected
 	default:
 		condition = graphql.ApplicationStatusConditionInitial
 	}
 
+	var lastSeen *graphql.Timestamp
+	if in.LastSeen != nil {
+		timestamp := graphql.Timestamp(*in.LastSeen)
+		lastSeen = &timestamp
+	}
+
 	return &graphql.ApplicationStatus{
 		Condition: condition,
 		Timestamp: graphql.Timestamp(in.Timestamp),
+		LastSeen:  lastSeen,
 	}
 }
 
@@ -210,12 +221,22 @@ func (c *converter) statusToModel(in *graphql.ApplicationStatus) *model.Applicat
 		condition = model.ApplicationStatusConditionFailed
 	case graphql.ApplicationStatusConditionConnected:
 		condition = model.ApplicationStatusConditionConnected
+	case graphql.ApplicationStatusConditionDisconnected:
+		condition = model.ApplicationStatusConditionDisconnected
 	default:
 		condition = model.ApplicationStatusConditionInitial
 	}
+
+	var lastSeen *time.Time
+	if in.LastSeen != nil {
+		timestamp := time.Time(*in.LastSeen)
+		lastSeen = &timestamp
+	}
+
 	return &model.ApplicationStatus{
 		Condition: condition,
 		Timestamp: time.Time(in.Timestamp),
+		LastSeen:  lastSeen,
 	}
 }
 
@@ -228,6 +249,8 @@ func (c *converter) statusConditionToModel(in *graphql.ApplicationStatusConditio
 	switch *in {
 	case graphql.ApplicationStatusConditionConnected:
 		condition = model.ApplicationStatusConditionConnected
+	case graphql.ApplicationStatusConditionDisconnected:
+		condition = model.ApplicationStatusConditionDisconnected
 	case graphql.ApplicationStatusConditionFailed:
 		condition = model.ApplicationStatusConditionFailed
 	case graphql.ApplicationStatusConditionInitial:
@@ -28,6 +28,23 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixDetailedModelApplication(t, givenID(), givenTenant(), "Foo", "Lorem ipsum"),
 			Expected: fixDetailedGQLApplication(t, givenID(), "Foo", "Lorem ipsum"),
 		},
+		{
+			Name: "Disconnected with last seen timestamp",
+			Input: &model.Application{
+				Status: &model.ApplicationStatus{
+					Condition: model.ApplicationStatusConditionDisconnected,
+					Timestamp: testTimestamp,
+					LastSeen:  &testLastSeen,
+				},
+			},
+			Expected: &graphql.Application{
+				Status: &graphql.ApplicationStatus{
+					Condition: graphql.ApplicationStatusConditionDisconnected,
+					Timestamp: graphql.Timestamp(testTimestamp),
+					LastSeen:  timestampPtr(graphql.Timestamp(testLastSeen)),
+				},
+			},
+		},
 		{
 			Name:  "Empty",
 			Input: &model.Application{},
@@ -158,6 +175,11 @@ func TestConverter_UpdateInputFromGraphQL_StatusCondition(t *testing.T) {
 			CondtionGQL:    graphql.ApplicationStatusConditionConnected,
 			ConditionModel: model.ApplicationStatusConditionConnected,
 		},
+		{
+			Name:           "When status condition is DISCONNECTED",
+			CondtionGQL:    graphql.ApplicationStatusConditionDisconnected,
+			ConditionModel: model.ApplicationStatusConditionDisconnected,
+		},
 		{
 			Name:           "When status condition is INITIAL",
 			CondtionGQL:    graphql.ApplicationStatusConditionInitial,
@@ -10,16 +10,20 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 var (
-	testURL      = "https://foo.bar"
-	intSysID     = "iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii"
-	providerName = "provider name"
+	testURL       = "https://foo.bar"
+	intSysID      = "iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii"
+	providerName  = "provider name"
+	testTimestamp = time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+	testLastSeen  = time.Date(2020, 12, 15, 10, 0, 0, 0, time.UTC)
 )
 
 func fixApplicationPage(applications []*model.Application) *model.ApplicationPage {
@@ -444,3 +448,22 @@ func fixPackagePage(packages []*model.Package) *model.PackagePage {
 		TotalCount: len(packages),
 	}
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("GetRestriction", mock.Anything, model.AccessRuleObjectTypeApplication).Return(nil, nil).Maybe()
+	svc.On("HasAccess", mock.Anything, model.AccessRuleObjectTypeApplication, mock.Anything).Return(true, nil).Maybe()
+	svc.On("SetOwner", mock.Anything, model.AccessRuleObjectTypeApplication, mock.Anything).Return(nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc(objectID string) *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, model.AccessRuleObjectTypeApplication, objectID).Return(false, nil).Maybe()
+	svc.On("SetOwner", mock.Anything, model.AccessRuleObjectTypeApplication, objectID).Return(nil).Maybe()
+	return svc
+}
+
+func timestampPtr(timestamp graphql.Timestamp) *graphql.Timestamp {
+	return &timestamp
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -20,7 +21,7 @@ import (
 const applicationTable string = `public.applications`
 
 var (
-	applicationColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}
+	applicationColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "last_seen_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}
 	tenantColumn       = "tenant_id"
 )
 
@@ -71,7 +72,7 @@ func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.A
 	return appModel, nil
 }
 
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, restriction *model.AccessRestriction, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
@@ -87,6 +88,14 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
+	restrictionSubquery, restrictionArgs, err := accessrule.FilterQuery(restriction, tenantID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building access restriction query")
+	}
+	if restrictionSubquery != "" {
+		conditions = append(conditions, repo.NewInConditionForSubQuery("id", restrictionSubquery, restrictionArgs))
+	}
+
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &appsCollection, conditions...)
 
 	if err != nil {
@@ -105,7 +114,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		PageInfo:   page}, nil
 }
 
-func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error) {
+func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, scenarios []string, restriction *model.AccessRestriction, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
 
 	// Scenarios query part
@@ -142,6 +151,14 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", combinedQuery, combinedArgs))
 	}
 
+	restrictionSubquery, restrictionArgs, err := accessrule.FilterQuery(restriction, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building access restriction query")
+	}
+	if restrictionSubquery != "" {
+		conditions = append(conditions, repo.NewInConditionForSubQuery("id", restrictionSubquery, restrictionArgs))
+	}
+
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant.String(), pageSize, cursor, "id", &appsCollection, conditions...)
 
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -96,8 +97,8 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.applications ( id, tenant_id, name, description, status_condition, status_timestamp, healthcheck_url, integration_system_id, provider_name ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
-			WithArgs(givenID(), givenTenant(), appModel.Name, appModel.Description, appModel.Status.Condition, appModel.Status.Timestamp, appModel.HealthCheckURL, appModel.IntegrationSystemID, appModel.ProviderName).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.applications ( id, tenant_id, name, description, status_condition, status_timestamp, last_seen_timestamp, healthcheck_url, integration_system_id, provider_name ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(givenID(), givenTenant(), appModel.Name, appModel.Description, appModel.Status.Condition, appModel.Status.Timestamp, appModel.Status.LastSeen, appModel.HealthCheckURL, appModel.IntegrationSystemID, appModel.ProviderName).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -315,7 +316,7 @@ func TestPgRepository_List(t *testing.T) {
 		pgRepository := application.NewRepository(conv)
 
 		// when
-		modelApp, err := pgRepository.List(ctx, givenTenant(), nil, inputPageSize, inputCursor)
+		modelApp, err := pgRepository.List(ctx, givenTenant(), nil, nil, inputPageSize, inputCursor)
 
 		// then
 		require.NoError(t, err)
@@ -326,6 +327,46 @@ func TestPgRepository_List(t *testing.T) {
 		assert.Equal(t, totalCount, modelApp.TotalCount)
 	})
 
+	t.Run("Success with access restriction", func(t *testing.T) {
+		// given
+		restriction := &model.AccessRestriction{
+			ObjectType: model.AccessRuleObjectTypeApplication,
+			Rules:      []*model.AccessRule{{OwnedOnly: true}},
+			Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: "john"},
+		}
+		restrictedPageableQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 AND id IN \(SELECT "app_id" FROM public\.object_owners WHERE "app_id" IS NOT NULL AND "tenant_id" = \$2 AND "user_name" = \$3\) ORDER BY id LIMIT %d OFFSET %d$`
+		restrictedCountQuery := `SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id = \$1 AND id IN \(SELECT "app_id" FROM public\.object_owners WHERE "app_id" IS NOT NULL AND "tenant_id" = \$2 AND "user_name" = \$3\)`
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}).
+			AddRow(appEntity1.ID, appEntity1.TenantID, appEntity1.Name, appEntity1.Description, appEntity1.StatusCondition, appEntity1.StatusTimestamp, appEntity1.HealthCheckURL, appEntity1.IntegrationSystemID, appEntity1.ProviderName)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+
+		sqlMock.ExpectQuery(fmt.Sprintf(restrictedPageableQuery, inputPageSize, 0)).
+			WithArgs(givenTenant(), givenTenant(), "john").
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(restrictedCountQuery).
+			WithArgs(givenTenant(), givenTenant(), "john").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", appEntity1).Return(appModel1).Once()
+		defer conv.AssertExpectations(t)
+
+		pgRepository := application.NewRepository(conv)
+
+		// when
+		modelApp, err := pgRepository.List(ctx, givenTenant(), nil, restriction, inputPageSize, inputCursor)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, modelApp.Data, 1)
+		assert.Equal(t, appEntity1.ID, modelApp.Data[0].ID)
+		assert.Equal(t, 1, modelApp.TotalCount)
+	})
+
 	t.Run("DB Error", func(t *testing.T) {
 		// given
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
@@ -342,7 +383,7 @@ func TestPgRepository_List(t *testing.T) {
 		pgRepository := application.NewRepository(conv)
 
 		// when
-		_, err := pgRepository.List(ctx, givenTenant(), nil, inputPageSize, inputCursor)
+		_, err := pgRepository.List(ctx, givenTenant(), nil, nil, inputPageSize, inputCursor)
 
 		//then
 		require.Error(t, err)
@@ -387,9 +428,22 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 		pageSize,
 		0)
 
+	restrictionQuery := regexp.QuoteMeta(fmt.Sprintf(`%s) AND id IN (SELECT "app_id" FROM public.object_owners WHERE "app_id" IS NOT NULL AND "tenant_id" = $11 AND "user_name" = $12`, scenariosQuery))
+	pageableQueryWithRestriction := fmt.Sprintf(pageableQueryRegex,
+		restrictionQuery,
+		pageSize,
+		0)
+
 	countQueryRegex := `SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id = \$1 AND id IN \(%s\)$`
 	countQuery := fmt.Sprintf(countQueryRegex, applicationScenarioQuery)
 	countQueryWithHidingSelectors := fmt.Sprintf(countQueryRegex, applicationScenarioQueryWithHidingSelectors)
+	countQueryWithRestriction := fmt.Sprintf(countQueryRegex, restrictionQuery)
+
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		Rules:      []*model.AccessRule{{OwnedOnly: true}},
+		Owner:      &model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: "john"},
+	}
 
 	conv := application.NewConverter(nil, nil)
 	intSysID := repo.NewValidNullableString("iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii")
@@ -397,6 +451,7 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 	testCases := []struct {
 		Name                     string
 		InputHidingSelectors     map[string][]string
+		InputRestriction         *model.AccessRestriction
 		ExpectedPageableQuery    string
 		ExpectedCountQuery       string
 		ExpectedQueriesInputArgs []driver.Value
@@ -430,6 +485,17 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 			TotalCount:    2,
 			ExpectedError: nil,
 		},
+		{
+			Name:                     "Success with access restriction",
+			InputRestriction:         restriction,
+			ExpectedPageableQuery:    pageableQueryWithRestriction,
+			ExpectedCountQuery:       countQueryWithRestriction,
+			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir", tenantID, "john"},
+			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}).
+				AddRow(app1ID, tenantID, "App ABC", "Description for application ABC", "INITIAL", timestamp, "http://domain.local/app1", intSysID),
+			TotalCount:    1,
+			ExpectedError: nil,
+		},
 		{
 			Name:                     "Return empty page when no application match",
 			InputHidingSelectors:     nil,
@@ -461,7 +527,7 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 			ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 
 			//WHEN
-			page, err := repository.ListByScenarios(ctx, tenantID, runtimeScenarios, pageSize, cursor, testCase.InputHidingSelectors)
+			page, err := repository.ListByScenarios(ctx, tenantID, runtimeScenarios, testCase.InputRestriction, pageSize, cursor, testCase.InputHidingSelectors)
 
 			//THEN
 			if testCase.ExpectedError != nil {
@@ -30,8 +30,8 @@ const (
 type ApplicationRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Application, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
-	ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, restriction *model.AccessRestriction, pageSize int, cursor string) (*model.ApplicationPage, error)
+	ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, restriction *model.AccessRestriction, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error)
 	Create(ctx context.Context, item *model.Application) error
 	Update(ctx context.Context, item *model.Application) error
 	Delete(ctx context.Context, tenant, id string) error
@@ -77,6 +77,13 @@ type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	GetRestriction(ctx context.Context, objType model.AccessRuleObjectType) (*model.AccessRestriction, error)
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
+	SetOwner(ctx context.Context, objType model.AccessRuleObjectType, objectID string) error
+}
+
 //go:generate mockery -name=ApplicationHideCfgProvider -output=automock -outpkg=automock -case=underscore
 type ApplicationHideCfgProvider interface {
 	GetApplicationHideSelectors() (map[string][]string, error)
@@ -95,10 +102,11 @@ type service struct {
 	scenariosService   ScenariosService
 	uidService         UIDService
 	pkgService         PackageService
+	accessRuleService  AccessRuleService
 	timestampGen       timestamp.Generator
 }
 
-func NewService(appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, pkgService PackageService, uidService UIDService) *service {
+func NewService(appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, pkgService PackageService, accessRuleService AccessRuleService, uidService UIDService) *service {
 	return &service{
 		appHideCfgProvider: appHideCfgProvider,
 		appRepo:            app,
@@ -109,6 +117,7 @@ func NewService(appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRe
 		labelUpsertService: labelUpsertService,
 		scenariosService:   scenariosService,
 		pkgService:         pkgService,
+		accessRuleService:  accessRuleService,
 		uidService:         uidService,
 		timestampGen:       timestamp.DefaultGenerator(),
 	}
@@ -124,7 +133,12 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 100")
 	}
 
-	return s.appRepo.List(ctx, appTenant, filter, pageSize, cursor)
+	restriction, err := s.accessRuleService.GetRestriction(ctx, model.AccessRuleObjectTypeApplication)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting access restriction")
+	}
+
+	return s.appRepo.List(ctx, appTenant, filter, restriction, pageSize, cursor)
 }
 
 func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error) {
@@ -181,7 +195,12 @@ func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, page
 		return nil, errors.Wrap(err, "while getting application hide selectors from config")
 	}
 
-	return s.appRepo.ListByScenarios(ctx, tenantUUID, scenarios, pageSize, cursor, hidingSelectors)
+	restriction, err := s.accessRuleService.GetRestriction(ctx, model.AccessRuleObjectTypeApplication)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting access restriction")
+	}
+
+	return s.appRepo.ListByScenarios(ctx, tenantUUID, scenarios, restriction, pageSize, cursor, hidingSelectors)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.Application, error) {
@@ -195,6 +214,10 @@ func (s *service) Get(ctx context.Context, id string) (*model.Application, error
 		return nil, errors.Wrapf(err, "while getting Application with id %s", id)
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
 	return app, nil
 }
 
@@ -204,7 +227,7 @@ func (s *service) Exist(ctx context.Context, id string) (bool, error) {
 		return false, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	exist, err := s.appRepo.Exists(ctx, appTenant, id)
+	exist, err := s.exists(ctx, appTenant, id)
 	if err != nil {
 		return false, errors.Wrapf(err, "while getting Application with ID %s", id)
 	}
@@ -272,6 +295,19 @@ func (s *service) Create(ctx context.Context, in model.ApplicationRegisterInput)
 		}
 	}
 
+	err = s.accessRuleService.SetOwner(ctx, model.AccessRuleObjectTypeApplication, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while setting owner of Application with id %s", id)
+	}
+
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeApplication, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while checking access to Application with id %s", id)
+	}
+	if !hasAccess {
+		return "", apperrors.NewInvalidOperationError("created Application would not be accessible with the access rules of the caller")
+	}
+
 	return id, nil
 }
 
@@ -321,6 +357,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	err = s.appRepo.Delete(ctx, appTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Application with id %s", id)
@@ -335,7 +375,7 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	appExists, err := s.appRepo.Exists(ctx, appTenant, labelInput.ObjectID)
+	appExists, err := s.exists(ctx, appTenant, labelInput.ObjectID)
 	if err != nil {
 		return errors.Wrap(err, "while checking Application existence")
 	}
@@ -348,7 +388,7 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return errors.Wrapf(err, "while creating label for Application")
 	}
 
-	return nil
+	return s.ensureAccessRetained(ctx, labelInput.ObjectID)
 }
 
 func (s *service) GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error) {
@@ -357,7 +397,7 @@ func (s *service) GetLabel(ctx context.Context, applicationID string, key string
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	appExists, err := s.appRepo.Exists(ctx, appTenant, applicationID)
+	appExists, err := s.exists(ctx, appTenant, applicationID)
 	if err != nil {
 		return nil, errors.Wrap(err, "while checking Application existence")
 	}
@@ -379,7 +419,7 @@ func (s *service) ListLabels(ctx context.Context, applicationID string) (map[str
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	appExists, err := s.appRepo.Exists(ctx, appTenant, applicationID)
+	appExists, err := s.exists(ctx, appTenant, applicationID)
 	if err != nil {
 		return nil, errors.Wrap(err, "while checking Application existence")
 	}
@@ -402,7 +442,7 @@ func (s *service) DeleteLabel(ctx context.Context, applicationID string, key str
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	appExists, err := s.appRepo.Exists(ctx, appTenant, applicationID)
+	appExists, err := s.exists(ctx, appTenant, applicationID)
 	if err != nil {
 		return errors.Wrap(err, "while checking Application existence")
 	}
@@ -415,7 +455,7 @@ func (s *service) DeleteLabel(ctx context.Context, applicationID string, key str
 		return errors.Wrapf(err, "while deleting Application label")
 	}
 
-	return nil
+	return s.ensureAccessRetained(ctx, applicationID)
 }
 
 func (s *service) createRelatedResources(ctx context.Context, in model.ApplicationRegisterInput, tenant string, applicationID string) error {
@@ -458,3 +498,38 @@ func (s *service) ensureIntSysExists(ctx context.Context, id *string) (bool, err
 	log.Infof("Integration System with id %s exists", *id)
 	return true, nil
 }
+
+func (s *service) exists(ctx context.Context, tenant, id string) (bool, error) {
+	exists, err := s.appRepo.Exists(ctx, tenant, id)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	return s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeApplication, id)
+}
+
+// ensureAccess hides the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, id string) error {
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeApplication, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Application with id %s", id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.Application, id)
+	}
+
+	return nil
+}
+
+// ensureAccessRetained prevents the caller from modifying the Application in a way, which would make it inaccessible to them
+func (s *service) ensureAccessRetained(ctx context.Context, id string) error {
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeApplication, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Application with id %s", id)
+	}
+	if !hasAccess {
+		return apperrors.NewInvalidOperationError("the Application would not be accessible with the access rules of the caller after the change")
+	}
+
+	return nil
+}
@@ -530,7 +530,7 @@ func TestService_Create(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			pkgSvc := testCase.PackageServiceFn()
-			svc := application.NewService(nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, pkgSvc, uidSvc)
+			svc := application.NewService(nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, pkgSvc, fixUnrestrictedAccessRuleSvc(), uidSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -555,7 +555,7 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -809,7 +809,7 @@ func TestService_Update(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			lblUpsrtSvc := testCase.LabelUpsertSvcFn()
-			svc := application.NewService(nil, appRepo, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, nil)
+			svc := application.NewService(nil, appRepo, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 			svc.SetTimestampGen(timestampGenFunc)
 
 			// when
@@ -880,7 +880,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
-			svc := application.NewService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -896,6 +896,21 @@ func TestService_Delete(t *testing.T) {
 			appRepo.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns not found error when application is not accessible", func(t *testing.T) {
+		// given
+		appRepo := &automock.ApplicationRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc(id)
+		svc := application.NewService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		mock.AssertExpectationsForObjects(t, appRepo, accessRuleSvc)
+	})
 }
 
 func TestService_Get(t *testing.T) {
@@ -954,7 +969,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, repo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, repo, nil, nil, nil, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// when
 			app, err := svc.Get(ctx, testCase.InputID)
@@ -971,6 +986,22 @@ func TestService_Get(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns not found error when application is not accessible", func(t *testing.T) {
+		// given
+		repo := &automock.ApplicationRepository{}
+		repo.On("GetByID", ctx, tnt, id).Return(applicationModel, nil).Once()
+		accessRuleSvc := fixRestrictedAccessRuleSvc(id)
+		svc := application.NewService(nil, repo, nil, nil, nil, nil, nil, nil, nil, accessRuleSvc, nil)
+
+		// when
+		_, err := svc.Get(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		mock.AssertExpectationsForObjects(t, repo, accessRuleSvc)
+	})
 }
 
 func TestService_List(t *testing.T) {
@@ -998,24 +1029,35 @@ func TestService_List(t *testing.T) {
 	tnt := "tenant"
 	externalTnt := "external-tnt"
 
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		Rules:      []*model.AccessRule{{ID: "rule", OwnedOnly: true}},
+	}
+
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.ApplicationRepository
-		InputLabelFilters  []*labelfilter.LabelFilter
-		InputPageSize      int
-		ExpectedResult     *model.ApplicationPage
-		ExpectedErrMessage string
+		Name                string
+		RepositoryFn        func() *automock.ApplicationRepository
+		AccessRuleServiceFn func() *automock.AccessRuleService
+		InputLabelFilters   []*labelfilter.LabelFilter
+		InputPageSize       int
+		ExpectedResult      *model.ApplicationPage
+		ExpectedErrMessage  string
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(applicationPage, nil).Once()
+				repo.On("List", ctx, tnt, filter, restriction, first, after).Return(applicationPage, nil).Once()
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				svc := &automock.AccessRuleService{}
+				svc.On("GetRestriction", ctx, model.AccessRuleObjectTypeApplication).Return(restriction, nil).Once()
+				return svc
+			},
 			InputPageSize:      first,
 			InputLabelFilters:  filter,
 			ExpectedResult:     applicationPage,
@@ -1025,9 +1067,30 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when application listing failed",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, restriction, first, after).Return(nil, testErr).Once()
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				svc := &automock.AccessRuleService{}
+				svc.On("GetRestriction", ctx, model.AccessRuleObjectTypeApplication).Return(restriction, nil).Once()
+				return svc
+			},
+			InputPageSize:      first,
+			InputLabelFilters:  filter,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when getting access restriction failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				return repo
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				svc := &automock.AccessRuleService{}
+				svc.On("GetRestriction", ctx, model.AccessRuleObjectTypeApplication).Return(nil, testErr).Once()
+				return svc
+			},
 			InputPageSize:      first,
 			InputLabelFilters:  filter,
 			ExpectedResult:     nil,
@@ -1039,6 +1102,9 @@ func TestService_List(t *testing.T) {
 				repo := &automock.ApplicationRepository{}
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				return &automock.AccessRuleService{}
+			},
 			InputPageSize:      0,
 			InputLabelFilters:  filter,
 			ExpectedResult:     nil,
@@ -1050,6 +1116,9 @@ func TestService_List(t *testing.T) {
 				repo := &automock.ApplicationRepository{}
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				return &automock.AccessRuleService{}
+			},
 			InputPageSize:      101,
 			InputLabelFilters:  filter,
 			ExpectedResult:     nil,
@@ -1060,8 +1129,9 @@ func TestService_List(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
+			accessRuleSvc := testCase.AccessRuleServiceFn()
 
-			svc := application.NewService(nil, repo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, repo, nil, nil, nil, nil, nil, nil, nil, accessRuleSvc, nil)
 
 			// when
 			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, after)
@@ -1097,6 +1167,10 @@ func TestService_ListByRuntimeID(t *testing.T) {
 		Value: scenarios,
 	}
 	hidingSelectors := map[string][]string{"foo": {"bar", "baz"}}
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeApplication,
+		Rules:      []*model.AccessRule{{ID: "rule", OwnedOnly: true}},
+	}
 
 	applications := []*model.Application{
 		fixModelApplication("test1", "tenant-foo", "test1", "test1"),
@@ -1135,7 +1209,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			},
 			AppRepositoryFn: func() *automock.ApplicationRepository {
 				appRepository := &automock.ApplicationRepository{}
-				appRepository.On("ListByScenarios", ctx, tenantUUID, convertToStringArray(t, scenarios), first, cursor, hidingSelectors).
+				appRepository.On("ListByScenarios", ctx, tenantUUID, convertToStringArray(t, scenarios), restriction, first, cursor, hidingSelectors).
 					Return(applicationPage, nil).Once()
 				return appRepository
 			},
@@ -1268,7 +1342,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			},
 			AppRepositoryFn: func() *automock.ApplicationRepository {
 				appRepository := &automock.ApplicationRepository{}
-				appRepository.On("ListByScenarios", ctx, tenantUUID, convertToStringArray(t, scenarios), first, cursor, hidingSelectors).
+				appRepository.On("ListByScenarios", ctx, tenantUUID, convertToStringArray(t, scenarios), restriction, first, cursor, hidingSelectors).
 					Return(nil, testError).Once()
 				return appRepository
 			},
@@ -1342,7 +1416,9 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			labelRepository := testCase.LabelRepositoryFn()
 			appRepository := testCase.AppRepositoryFn()
 			cfgProvider := testCase.ConfigProviderFn()
-			svc := application.NewService(cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil)
+			accessRuleSvc := &automock.AccessRuleService{}
+			accessRuleSvc.On("GetRestriction", ctx, model.AccessRuleObjectTypeApplication).Return(restriction, nil).Maybe()
+			svc := application.NewService(cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, accessRuleSvc, nil)
 
 			//WHEN
 			results, err := svc.ListByRuntimeID(ctx, testCase.Input, first, cursor)
@@ -1362,6 +1438,29 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			cfgProvider.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns error when getting access restriction failed", func(t *testing.T) {
+		//GIVEN
+		runtimeRepository := &automock.RuntimeRepository{}
+		runtimeRepository.On("Exists", ctx, tenantUUID.String(), runtimeUUID.String()).Return(true, nil).Once()
+		labelRepository := &automock.LabelRepository{}
+		labelRepository.On("GetByKey", ctx, tenantUUID.String(), model.RuntimeLabelableObject, runtimeUUID.String(), model.ScenariosKey).
+			Return(&scenarioLabel, nil).Once()
+		appRepository := &automock.ApplicationRepository{}
+		cfgProvider := &automock.ApplicationHideCfgProvider{}
+		cfgProvider.On("GetApplicationHideSelectors").Return(hidingSelectors, nil).Once()
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("GetRestriction", ctx, model.AccessRuleObjectTypeApplication).Return(nil, testError).Once()
+		defer mock.AssertExpectationsForObjects(t, runtimeRepository, labelRepository, appRepository, cfgProvider, accessRuleSvc)
+		svc := application.NewService(cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, accessRuleSvc, nil)
+
+		//WHEN
+		_, err := svc.ListByRuntimeID(ctx, runtimeUUID, first, cursor)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
 }
 
 func TestService_Exist(t *testing.T) {
@@ -1420,7 +1519,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputApplicationID)
@@ -1437,6 +1536,22 @@ func TestService_Exist(t *testing.T) {
 			appRepo.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Application not accessible", func(t *testing.T) {
+		//GIVEN
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("Exists", ctx, tnt, applicationID).Return(true, nil).Once()
+		accessRuleSvc := fixRestrictedAccessRuleSvc(applicationID)
+		svc := application.NewService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, accessRuleSvc, nil)
+
+		// WHEN
+		value, err := svc.Exist(ctx, applicationID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, value)
+		mock.AssertExpectationsForObjects(t, appRepo, accessRuleSvc)
+	})
 }
 
 func TestService_SetLabel(t *testing.T) {
@@ -1522,7 +1637,7 @@ func TestService_SetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelSvc := testCase.LabelServiceFn()
-			svc := application.NewService(nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, nil)
+			svc := application.NewService(nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// when
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -1538,6 +1653,26 @@ func TestService_SetLabel(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns error when application would not be accessible after setting label", func(t *testing.T) {
+		// given
+		repo := &automock.ApplicationRepository{}
+		repo.On("Exists", ctx, tnt, applicationID).Return(true, nil).Once()
+		labelSvc := &automock.LabelUpsertService{}
+		labelSvc.On("UpsertLabel", ctx, tnt, label).Return(nil).Once()
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeApplication, applicationID).Return(true, nil).Once()
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeApplication, applicationID).Return(false, nil).Once()
+		svc := application.NewService(nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.SetLabel(ctx, label)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "would not be accessible")
+		mock.AssertExpectationsForObjects(t, repo, labelSvc, accessRuleSvc)
+	})
 }
 
 func TestService_GetLabel(t *testing.T) {
@@ -1636,7 +1771,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// when
 			l, err := svc.GetLabel(ctx, testCase.InputApplicationID, testCase.InputLabel.Key)
@@ -1752,7 +1887,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputApplicationID)
@@ -1846,7 +1981,7 @@ func TestService_DeleteLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc(), nil)
 
 			// when
 			err := svc.DeleteLabel(ctx, testCase.InputApplicationID, testCase.InputKey)
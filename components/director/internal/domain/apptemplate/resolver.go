@@ -224,6 +224,11 @@ func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graph
 		return nil, errors.Wrap(err, "while converting ApplicationFromTemplate input")
 	}
 
+	if appCreateInputModel.Labels == nil {
+		appCreateInputModel.Labels = map[string]interface{}{}
+	}
+	appCreateInputModel.Labels[model.ApplicationTemplateNameKey] = appTemplate.Name
+
 	log.Infof("Creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
 	id, err := r.appSvc.Create(ctx, appCreateInputModel)
 	if err != nil {
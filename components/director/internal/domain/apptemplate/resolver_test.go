@@ -450,6 +450,9 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 
 	modelAppTemplate := fixModelAppTemplateWithAppInputJSON(testID, testName, jsonAppCreateInput)
 
+	modelAppCreateInputWithTemplateLabel := fixModelApplicationCreateInput(testName)
+	modelAppCreateInputWithTemplateLabel.Labels = map[string]interface{}{model.ApplicationTemplateNameKey: testName}
+
 	modelApplication := fixModelApplication(testID, testName)
 	gqlApplication := fixGQLApplication(testID, testName)
 
@@ -482,7 +485,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			},
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplateLabel).Return(testID, nil).Once()
 				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(&modelApplication, nil).Once()
 				return appSvc
 			},
@@ -636,7 +639,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			},
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return("", testError).Once()
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplateLabel).Return("", testError).Once()
 				return appSvc
 			},
 			AppConvFn: func() *automock.ApplicationConverter {
@@ -664,7 +667,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			},
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplateLabel).Return(testID, nil).Once()
 				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
 				return appSvc
 			},
@@ -693,7 +696,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			},
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplateLabel).Return(testID, nil).Once()
 				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(&modelApplication, nil).Once()
 				return appSvc
 			},
@@ -210,6 +210,20 @@ func (c *converter) credentialToGraphQL(in model.CredentialData) graphql.Credent
 			ClientID:     in.Oauth.ClientID,
 			ClientSecret: in.Oauth.ClientSecret,
 		}
+	} else if in.PrivateKeyJWT != nil {
+		credential = graphql.PrivateKeyJWTCredentialData{
+			URL:      in.PrivateKeyJWT.URL,
+			ClientID: in.PrivateKeyJWT.ClientID,
+			Jwks:     in.PrivateKeyJWT.JWKS,
+			JwksURI:  in.PrivateKeyJWT.JWKSURI,
+		}
+	} else if in.Certificate != nil {
+		credential = graphql.CertificateCredentialData{
+			URL:        in.Certificate.URL,
+			ClientID:   in.Certificate.ClientID,
+			Subject:    in.Certificate.Subject,
+			Thumbprint: in.Certificate.Thumbprint,
+		}
 	}
 
 	return credential
@@ -11,6 +11,7 @@ import (
 
 func TestConverter_ToGraphQL(t *testing.T) {
 	// given
+	jwksURI := "https://foo.bar/jwks"
 	testCases := []struct {
 		Name     string
 		Input    *model.Auth
@@ -22,6 +23,34 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixDetailedAuth(),
 			Expected: fixDetailedGQLAuth(),
 		},
+		{
+			Name: "Private key JWT credential",
+			Input: &model.Auth{Credential: model.CredentialData{PrivateKeyJWT: &model.PrivateKeyJWTCredentialData{
+				ClientID: "client",
+				JWKSURI:  &jwksURI,
+				URL:      "https://foo.bar/token",
+			}}},
+			Expected: &graphql.Auth{Credential: graphql.PrivateKeyJWTCredentialData{
+				ClientID: "client",
+				JwksURI:  &jwksURI,
+				URL:      "https://foo.bar/token",
+			}},
+		},
+		{
+			Name: "Certificate credential",
+			Input: &model.Auth{Credential: model.CredentialData{Certificate: &model.CertificateCredentialData{
+				ClientID:   "client",
+				Subject:    "CN=foo",
+				Thumbprint: "thumbprint",
+				URL:        "https://foo.bar/token",
+			}}},
+			Expected: &graphql.Auth{Credential: graphql.CertificateCredentialData{
+				ClientID:   "client",
+				Subject:    "CN=foo",
+				Thumbprint: "thumbprint",
+				URL:        "https://foo.bar/token",
+			}},
+		},
 		{
 			Name:     "Empty",
 			Input:    &model.Auth{},
@@ -6,8 +6,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/document/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -126,3 +128,15 @@ func fixGQLDocumentInput(id string) *graphql.DocumentInput {
 		Data:        &docCLOB,
 	}
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return svc
+}
@@ -64,6 +64,31 @@ func NewResolver(transact persistence.Transactioner, svc DocumentService, appSvc
 	}
 }
 
+func (r *Resolver) Document(ctx context.Context, id string) (*graphql.Document, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	document, err := r.svc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(document), nil
+}
+
 func (r *Resolver) AddDocumentToPackage(ctx context.Context, packageID string, in graphql.DocumentInput) (*graphql.Document, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -13,8 +13,11 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -237,6 +240,121 @@ func TestResolver_AddDocumentToPackage(t *testing.T) {
 	}
 }
 
+func TestResolver_Document(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "bar"
+	modelDocument := fixModelDocument(id, "foo")
+	gqlDocument := fixGQLDocument(id, "foo")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.DocumentService
+		ConverterFn     func() *automock.DocumentConverter
+		ExpectedResult  *graphql.Document
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelDocument, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.DocumentConverter {
+				conv := &automock.DocumentConverter{}
+				conv.On("ToGraphQL", modelDocument).Return(gqlDocument).Once()
+				return conv
+			},
+			ExpectedResult: gqlDocument,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns null when document not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, apperrors.NewNotFoundError(resource.Document, id)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.DocumentConverter {
+				return &automock.DocumentConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when document retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.DocumentConverter {
+				return &automock.DocumentConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.DocumentService {
+				return &automock.DocumentService{}
+			},
+			ConverterFn: func() *automock.DocumentConverter {
+				return &automock.DocumentConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelDocument, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.DocumentConverter {
+				return &automock.DocumentConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := document.NewResolver(transact, svc, nil, nil, nil)
+			resolver.SetConverter(converter)
+
+			// when
+			result, err := resolver.Document(context.TODO(), id)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_DeleteDocument(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
@@ -30,24 +31,31 @@ type FetchRequestRepository interface {
 	Delete(ctx context.Context, tenant, id string) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
 type service struct {
-	repo             DocumentRepository
-	fetchRequestRepo FetchRequestRepository
-	uidService       UIDService
-	timestampGen     timestamp.Generator
+	repo              DocumentRepository
+	fetchRequestRepo  FetchRequestRepository
+	accessRuleService AccessRuleService
+	uidService        UIDService
+	timestampGen      timestamp.Generator
 }
 
-func NewService(repo DocumentRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService) *service {
+func NewService(repo DocumentRepository, fetchRequestRepo FetchRequestRepository, accessRuleService AccessRuleService, uidService UIDService) *service {
 	return &service{
-		repo:             repo,
-		fetchRequestRepo: fetchRequestRepo,
-		uidService:       uidService,
-		timestampGen:     timestamp.DefaultGenerator(),
+		repo:              repo,
+		fetchRequestRepo:  fetchRequestRepo,
+		accessRuleService: accessRuleService,
+		uidService:        uidService,
+		timestampGen:      timestamp.DefaultGenerator(),
 	}
 }
 
@@ -94,6 +102,10 @@ func (s *service) CreateInPackage(ctx context.Context, packageID string, in mode
 		return "", err
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypePackage, packageID, resource.Package); err != nil {
+		return "", err
+	}
+
 	id := s.uidService.Generate()
 
 	document := in.ToDocumentWithinPackage(id, tnt, packageID)
@@ -121,6 +133,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeDocument, id, resource.Document); err != nil {
+		return err
+	}
+
 	err = s.repo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Document with ID %s", id)
@@ -153,3 +169,16 @@ func (s *service) GetFetchRequest(ctx context.Context, documentID string) (*mode
 
 	return fetchRequest, nil
 }
+
+// ensureAccess hides the Documents of the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, nestedType model.AccessRuleNestedObjectType, id string, resourceType resource.Type) error {
+	hasAccess, err := s.accessRuleService.HasAccessToParent(ctx, nestedType, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to %s with id %s", resourceType, id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resourceType, id)
+	}
+
+	return nil
+}
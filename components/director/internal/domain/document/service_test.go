@@ -68,7 +68,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := document.NewService(repo, nil, nil)
+			svc := document.NewService(repo, nil, nil, nil)
 
 			// when
 			doc, err := svc.Get(ctx, testCase.InputID)
@@ -140,7 +140,7 @@ func TestService_GetForPackage(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := document.NewService(repo, nil, nil)
+			svc := document.NewService(repo, nil, nil, nil)
 
 			// when
 			eventAPIDefinition, err := svc.GetForPackage(ctx, testCase.InputID, testCase.PackageID)
@@ -157,7 +157,7 @@ func TestService_GetForPackage(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := document.NewService(nil, nil, nil)
+		svc := document.NewService(nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForPackage(context.TODO(), "", "")
 		// THEN
@@ -227,7 +227,7 @@ func TestService_ListForPackage(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := document.NewService(repo, nil, nil)
+			svc := document.NewService(repo, nil, nil, nil)
 
 			// when
 			docs, err := svc.ListForPackage(ctx, packageID, first, after)
@@ -339,7 +339,8 @@ func TestService_CreateToPackage(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			idSvc := testCase.UIDServiceFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := document.NewService(repo, fetchRequestRepo, idSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := document.NewService(repo, fetchRequestRepo, accessRuleSvc, idSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -361,11 +362,24 @@ func TestService_CreateToPackage(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := document.NewService(nil, nil, nil)
+		svc := document.NewService(nil, nil, nil, nil)
 		// when
 		_, err := svc.CreateInPackage(context.TODO(), "Dd", model.DocumentInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
 	})
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.DocumentRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := document.NewService(repo, nil, accessRuleSvc, nil)
+
+		// when
+		_, err := svc.CreateInPackage(ctx, packageID, *modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 func TestService_Delete(t *testing.T) {
 	// given
@@ -414,7 +428,8 @@ func TestService_Delete(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := document.NewService(repo, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := document.NewService(repo, nil, accessRuleSvc, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -430,6 +445,19 @@ func TestService_Delete(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.DocumentRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := document.NewService(repo, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestService_GetFetchRequest(t *testing.T) {
@@ -522,7 +550,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := document.NewService(repo, fetchRequestRepo, nil)
+			svc := document.NewService(repo, fetchRequestRepo, nil, nil)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, refID)
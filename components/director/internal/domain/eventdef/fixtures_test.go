@@ -7,10 +7,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -233,3 +235,15 @@ func fixGQLFetchRequest(url string, timestamp time.Time) *graphql.FetchRequest {
 		},
 	}
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return svc
+}
@@ -15,8 +15,10 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -223,6 +225,120 @@ func TestResolver_AddEventAPIToPackage(t *testing.T) {
 	}
 }
 
+func TestResolver_EventDefinition(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "bar"
+	modelEventDefinition := fixMinModelEventAPIDefinition(id, "placeholder")
+	gqlEventDefinition := fixGQLEventDefinition(id, "placeholder")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.EventDefService
+		ConverterFn     func() *automock.EventDefConverter
+		ExpectedResult  *graphql.EventDefinition
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EventDefService {
+				svc := &automock.EventDefService{}
+				svc.On("Get", contextParam, id).Return(modelEventDefinition, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EventDefConverter {
+				conv := &automock.EventDefConverter{}
+				conv.On("ToGraphQL", modelEventDefinition).Return(gqlEventDefinition).Once()
+				return conv
+			},
+			ExpectedResult: gqlEventDefinition,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns null when event definition not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EventDefService {
+				svc := &automock.EventDefService{}
+				svc.On("Get", contextParam, id).Return(nil, apperrors.NewNotFoundError(resource.EventDefinition, id)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EventDefConverter {
+				return &automock.EventDefConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when event definition retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.EventDefService {
+				svc := &automock.EventDefService{}
+				svc.On("Get", contextParam, id).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EventDefConverter {
+				return &automock.EventDefConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.EventDefService {
+				return &automock.EventDefService{}
+			},
+			ConverterFn: func() *automock.EventDefConverter {
+				return &automock.EventDefConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.EventDefService {
+				svc := &automock.EventDefService{}
+				svc.On("Get", contextParam, id).Return(modelEventDefinition, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EventDefConverter {
+				return &automock.EventDefConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := eventdef.NewResolver(transact, svc, nil, nil, converter, nil)
+
+			// when
+			result, err := resolver.EventDefinition(context.TODO(), id)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_DeleteEventAPI(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
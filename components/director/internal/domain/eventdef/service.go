@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
@@ -32,23 +33,30 @@ type FetchRequestRepository interface {
 	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
 type service struct {
-	eventAPIRepo     EventAPIRepository
-	fetchRequestRepo FetchRequestRepository
-	uidService       UIDService
-	timestampGen     timestamp.Generator
+	eventAPIRepo      EventAPIRepository
+	fetchRequestRepo  FetchRequestRepository
+	accessRuleService AccessRuleService
+	uidService        UIDService
+	timestampGen      timestamp.Generator
 }
 
-func NewService(eventAPIRepo EventAPIRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService) *service {
+func NewService(eventAPIRepo EventAPIRepository, fetchRequestRepo FetchRequestRepository, accessRuleService AccessRuleService, uidService UIDService) *service {
 	return &service{eventAPIRepo: eventAPIRepo,
-		fetchRequestRepo: fetchRequestRepo,
-		uidService:       uidService,
-		timestampGen:     timestamp.DefaultGenerator(),
+		fetchRequestRepo:  fetchRequestRepo,
+		accessRuleService: accessRuleService,
+		uidService:        uidService,
+		timestampGen:      timestamp.DefaultGenerator(),
 	}
 }
 
@@ -99,6 +107,10 @@ func (s *service) CreateInPackage(ctx context.Context, packageID string, in mode
 		return "", errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypePackage, packageID, resource.Package); err != nil {
+		return "", err
+	}
+
 	id := s.uidService.Generate()
 
 	eventAPI := in.ToEventDefinitionWithinPackage(id, packageID, tnt)
@@ -124,6 +136,10 @@ func (s *service) Update(ctx context.Context, id string, in model.EventDefinitio
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeEventDefinition, id, resource.EventDefinition); err != nil {
+		return err
+	}
+
 	eventAPI, err := s.Get(ctx, id)
 	if err != nil {
 		return err
@@ -157,6 +173,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeEventDefinition, id, resource.EventDefinition); err != nil {
+		return err
+	}
+
 	err = s.eventAPIRepo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting EventDefinition with id %s", id)
@@ -171,6 +191,10 @@ func (s *service) RefetchAPISpec(ctx context.Context, id string) (*model.EventSp
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, model.AccessRuleNestedObjectTypeEventDefinition, id, resource.EventDefinition); err != nil {
+		return nil, err
+	}
+
 	eventAPI, err := s.eventAPIRepo.GetByID(ctx, tnt, id)
 	if err != nil {
 		return nil, err
@@ -204,6 +228,19 @@ func (s *service) GetFetchRequest(ctx context.Context, eventAPIDefID string) (*m
 	return fetchRequest, nil
 }
 
+// ensureAccess hides the Event Definitions of the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, nestedType model.AccessRuleNestedObjectType, id string, resourceType resource.Type) error {
+	hasAccess, err := s.accessRuleService.HasAccessToParent(ctx, nestedType, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to %s with id %s", resourceType, id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resourceType, id)
+	}
+
+	return nil
+}
+
 func (s *service) createFetchRequest(ctx context.Context, tenant string, in *model.FetchRequestInput, parentObjectID string) (*string, error) {
 	if in == nil {
 		return nil, nil
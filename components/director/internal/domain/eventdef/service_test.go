@@ -68,7 +68,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := eventdef.NewService(repo, nil, nil)
+			svc := eventdef.NewService(repo, nil, nil, nil)
 
 			// when
 			eventAPIDefinition, err := svc.Get(ctx, testCase.InputID)
@@ -85,7 +85,7 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Get(context.TODO(), "")
 		// THEN
@@ -143,7 +143,7 @@ func TestService_GetForPackage(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := eventdef.NewService(repo, nil, nil)
+			svc := eventdef.NewService(repo, nil, nil, nil)
 
 			// when
 			eventAPIDefinition, err := svc.GetForPackage(ctx, testCase.InputID, testCase.PackageID)
@@ -160,7 +160,7 @@ func TestService_GetForPackage(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForPackage(context.TODO(), "", "")
 		// THEN
@@ -257,7 +257,7 @@ func TestService_ListForPackage(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := eventdef.NewService(repo, nil, nil)
+			svc := eventdef.NewService(repo, nil, nil, nil)
 
 			// when
 			docs, err := svc.ListForPackage(ctx, packageID, testCase.InputPageSize, testCase.InputCursor)
@@ -274,7 +274,7 @@ func TestService_ListForPackage(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.ListForPackage(context.TODO(), "", 5, "")
 		// THEN
@@ -395,7 +395,8 @@ func TestService_CreateToPackage(t *testing.T) {
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
 			uidSvc := testCase.UIDServiceFn()
 
-			svc := eventdef.NewService(repo, fetchRequestRepo, uidSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := eventdef.NewService(repo, fetchRequestRepo, accessRuleSvc, uidSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -414,8 +415,22 @@ func TestService_CreateToPackage(t *testing.T) {
 			uidSvc.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.EventAPIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := eventdef.NewService(repo, nil, accessRuleSvc, nil)
+
+		// when
+		_, err := svc.CreateInPackage(ctx, packageID, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.CreateInPackage(context.TODO(), "", model.EventDefinitionInput{})
 		// THEN
@@ -541,7 +556,8 @@ func TestService_Update(t *testing.T) {
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
 			uidSvc := testCase.UIDServiceFn()
 
-			svc := eventdef.NewService(repo, fetchRequestRepo, uidSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := eventdef.NewService(repo, fetchRequestRepo, accessRuleSvc, uidSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -560,8 +576,22 @@ func TestService_Update(t *testing.T) {
 			uidSvc.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.EventAPIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := eventdef.NewService(repo, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.Update(ctx, id, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		err := svc.Update(context.TODO(), "", model.EventDefinitionInput{})
 		// THEN
@@ -613,7 +643,8 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := eventdef.NewService(repo, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := eventdef.NewService(repo, nil, accessRuleSvc, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -628,8 +659,22 @@ func TestService_Delete(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.EventAPIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := eventdef.NewService(repo, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -689,7 +734,8 @@ func TestService_RefetchAPISpec(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := eventdef.NewService(repo, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := eventdef.NewService(repo, nil, accessRuleSvc, nil)
 
 			// when
 			result, err := svc.RefetchAPISpec(ctx, apiID)
@@ -701,8 +747,22 @@ func TestService_RefetchAPISpec(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.EventAPIRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := eventdef.NewService(repo, nil, accessRuleSvc, nil)
+
+		// when
+		_, err := svc.RefetchAPISpec(ctx, apiID)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.RefetchAPISpec(context.TODO(), "")
 		// THEN
@@ -799,7 +859,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := eventdef.NewService(repo, fetchRequestRepo, nil)
+			svc := eventdef.NewService(repo, fetchRequestRepo, nil, nil)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, refID)
@@ -819,7 +879,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := eventdef.NewService(nil, nil, nil)
+		svc := eventdef.NewService(nil, nil, nil, nil)
 		// when
 		_, err := svc.GetFetchRequest(context.TODO(), "dd")
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -77,7 +77,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -104,7 +104,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -146,7 +146,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some-error"))
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(runtimeRepo, labelRepo)
@@ -166,7 +166,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(runtimeRepo, labelRepo)
@@ -186,7 +186,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(errors.New("some-error"))
@@ -208,7 +208,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(nil)
@@ -232,7 +232,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -256,7 +256,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -282,7 +282,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
@@ -308,7 +308,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -335,7 +335,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -367,7 +367,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 
 		svc := NewService(runtimeRepo, nil)
 
@@ -386,7 +386,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -423,7 +423,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some-error"))
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(nil, errors.New("some-error"))
 
 		svc := NewService(runtimeRepo, nil)
 
@@ -442,7 +442,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePage(), nil)
 
 		svc := NewService(runtimeRepo, nil)
 
@@ -461,7 +461,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(errors.New("some-error"))
@@ -483,7 +483,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(nil, errors.New("some error"))
@@ -511,7 +511,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -537,7 +537,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -563,7 +563,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -587,7 +587,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -608,7 +608,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
@@ -649,7 +649,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -675,7 +675,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, errors.New("some error"))
 		labelRepo := &automock.LabelRepository{}
@@ -698,7 +698,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		scenariosLabel := fixApplicationScenariosLabel()
 		scenariosLabel.Value = "abc"
@@ -721,7 +721,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
@@ -742,7 +742,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some error"))
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(nil, errors.New("some error"))
 		svc := NewService(runtimeRepo, nil)
 
 		// WHEN
@@ -760,7 +760,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(runtimeRepo, labelRepo)
@@ -780,7 +780,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
@@ -802,7 +802,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -824,7 +824,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
@@ -848,7 +848,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -874,7 +874,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*model.AccessRestriction)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
@@ -35,15 +36,17 @@ type repository struct {
 	deleter      repo.Deleter
 	updater      repo.Updater
 	conv         Converter
+	encryptor    encryption.Encryptor
 }
 
-func NewRepository(conv Converter) *repository {
+func NewRepository(conv Converter, encryptor encryption.Encryptor) *repository {
 	return &repository{
 		creator:      repo.NewCreator(resource.FetchRequest, fetchRequestTable, fetchRequestColumns),
 		singleGetter: repo.NewSingleGetter(resource.FetchRequest, fetchRequestTable, tenantColumn, fetchRequestColumns),
 		deleter:      repo.NewDeleter(resource.FetchRequest, fetchRequestTable, tenantColumn),
 		updater:      repo.NewUpdater(resource.FetchRequest, fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp"}, tenantColumn, []string{"id"}),
 		conv:         conv,
+		encryptor:    encryptor,
 	}
 }
 
@@ -57,6 +60,11 @@ func (r *repository) Create(ctx context.Context, item *model.FetchRequest) error
 		return errors.Wrap(err, "while creating FetchRequest entity from model")
 	}
 
+	entity.Auth, err = r.encryptor.Encrypt(ctx, authLocation(entity), entity.Auth)
+	if err != nil {
+		return errors.Wrap(err, "while encrypting FetchRequest auth")
+	}
+
 	return r.creator.Create(ctx, entity)
 }
 
@@ -71,6 +79,11 @@ func (r *repository) GetByReferenceObjectID(ctx context.Context, tenant string,
 		return nil, err
 	}
 
+	entity.Auth, err = r.encryptor.Decrypt(ctx, authLocation(entity), entity.Auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decrypting FetchRequest auth")
+	}
+
 	frModel, err := r.conv.FromEntity(entity)
 	if err != nil {
 		return nil, errors.Wrap(err, "while getting FetchRequest model from entity")
@@ -113,3 +126,7 @@ func (r *repository) referenceObjectFieldName(objectType model.FetchRequestRefer
 
 	return "", apperrors.NewInternalError("Invalid type of the Fetch Request reference object")
 }
+
+func authLocation(entity Entity) encryption.Location {
+	return encryption.Location{TenantID: entity.TenantID, Column: encryption.FetchRequestAuthColumn, RowID: entity.ID}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -40,13 +42,33 @@ func TestRepository_Create(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(mockConverter)
+		repo := fetchrequest.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.Create(ctx, &frModel)
 		// THEN
 		require.NoError(t, err)
 	})
 
+	t.Run("Error - Encryptor", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		frModel := fixFullFetchRequestModel(givenID(), timestamp)
+		frEntity := fixFullFetchRequestEntity(t, givenID(), timestamp)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", frModel).Return(frEntity, nil)
+
+		encryptorMock := &encryptionautomock.Encryptor{}
+		defer encryptorMock.AssertExpectations(t)
+		encryptorMock.On("Encrypt", context.TODO(), encryption.Location{TenantID: givenTenant(), Column: encryption.FetchRequestAuthColumn, RowID: givenID()}, frEntity.Auth).Return(sql.NullString{}, givenError())
+
+		repo := fetchrequest.NewRepository(mockConverter, encryptorMock)
+		// WHEN
+		err := repo.Create(context.TODO(), &frModel)
+		// THEN
+		require.EqualError(t, err, "while encrypting FetchRequest auth: some error")
+	})
+
 	t.Run("Error - DB", func(t *testing.T) {
 		// GIVEN
 		timestamp := time.Now()
@@ -62,7 +84,7 @@ func TestRepository_Create(t *testing.T) {
 		dbMock.ExpectExec("INSERT INTO .*").WillReturnError(givenError())
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(mockConverter)
+		repo := fetchrequest.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.Create(ctx, &frModel)
 		// THEN
@@ -77,7 +99,7 @@ func TestRepository_Create(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		mockConverter.On("ToEntity", frModel).Return(fetchrequest.Entity{}, givenError())
 
-		repo := fetchrequest.NewRepository(mockConverter)
+		repo := fetchrequest.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.Create(context.TODO(), &frModel)
 		// THEN
@@ -110,7 +132,7 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 			mockConverter := &automock.Converter{}
 			mockConverter.On("FromEntity", frEntity).Return(frModel, nil).Once()
 
-			repo := fetchrequest.NewRepository(mockConverter)
+			repo := fetchrequest.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 			db, dbMock := testdb.MockDatabase(t)
 
 			rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp"}).
@@ -142,7 +164,7 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		mockConverter.On("FromEntity", frEntity).Return(model.FetchRequest{}, givenError())
 
-		repo := fetchrequest.NewRepository(mockConverter)
+		repo := fetchrequest.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -161,7 +183,7 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 
 	t.Run("Error - DB", func(t *testing.T) {
 		// GIVEN
-		repo := fetchrequest.NewRepository(nil)
+		repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -181,7 +203,7 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(nil)
+		repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		_, err := repo.GetByReferenceObjectID(ctx, givenTenant(), "test", givenID())
 		// THEN
@@ -200,7 +222,7 @@ func TestRepository_Delete(t *testing.T) {
 			givenTenant(), givenID()).WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(nil)
+		repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.Delete(ctx, givenTenant(), givenID())
 		// THEN
@@ -216,7 +238,7 @@ func TestRepository_Delete(t *testing.T) {
 			givenTenant(), givenID()).WillReturnError(givenError())
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(nil)
+		repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.Delete(ctx, givenTenant(), givenID())
 		// THEN
@@ -249,7 +271,7 @@ func TestRepository_DeleteByReferenceObjectID(t *testing.T) {
 				givenTenant(), givenID()).WillReturnResult(sqlmock.NewResult(-1, 1))
 
 			ctx := persistence.SaveToContext(context.TODO(), db)
-			repo := fetchrequest.NewRepository(nil)
+			repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 			// WHEN
 			err := repo.DeleteByReferenceObjectID(ctx, givenTenant(), testCase.ObjectType, givenID())
 			// THEN
@@ -263,7 +285,7 @@ func TestRepository_DeleteByReferenceObjectID(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(nil)
+		repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteByReferenceObjectID(ctx, givenTenant(), "test", givenID())
 		// THEN
@@ -279,7 +301,7 @@ func TestRepository_DeleteByReferenceObjectID(t *testing.T) {
 			givenTenant(), givenID()).WillReturnError(givenError())
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := fetchrequest.NewRepository(nil)
+		repo := fetchrequest.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteByReferenceObjectID(ctx, givenTenant(), model.APIFetchRequestReference, givenID())
 		// THEN
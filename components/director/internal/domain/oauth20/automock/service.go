@@ -2,9 +2,12 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Service is an autogenerated mock type for the Service type
 type Service struct {
@@ -47,3 +50,26 @@ func (_m *Service) DeleteClientCredentials(ctx context.Context, clientID string)
 
 	return r0
 }
+
+// RegisterClient provides a mock function with given fields: ctx, objectType, in
+func (_m *Service) RegisterClient(ctx context.Context, objectType model.SystemAuthReferenceObjectType, in model.CredentialDataInput) (*model.CredentialDataInput, error) {
+	ret := _m.Called(ctx, objectType, in)
+
+	var r0 *model.CredentialDataInput
+	if rf, ok := ret.Get(0).(func(context.Context, model.SystemAuthReferenceObjectType, model.CredentialDataInput) *model.CredentialDataInput); ok {
+		r0 = rf(ctx, objectType, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CredentialDataInput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SystemAuthReferenceObjectType, model.CredentialDataInput) error); ok {
+		r1 = rf(ctx, objectType, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
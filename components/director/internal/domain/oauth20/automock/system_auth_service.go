@@ -2,29 +2,34 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // SystemAuthService is an autogenerated mock type for the SystemAuthService type
 type SystemAuthService struct {
 	mock.Mock
 }
 
-// CreateWithCustomID provides a mock function with given fields: ctx, id, objectType, objectID, authInput
-func (_m *SystemAuthService) CreateWithCustomID(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput) (string, error) {
-	ret := _m.Called(ctx, id, objectType, objectID, authInput)
+// CreateWithCustomID provides a mock function with given fields: ctx, id, objectType, objectID, authInput, expiresAt
+func (_m *SystemAuthService) CreateWithCustomID(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput, expiresAt *time.Time) (string, error) {
+	ret := _m.Called(ctx, id, objectType, objectID, authInput, expiresAt)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.SystemAuthReferenceObjectType, string, *model.AuthInput) string); ok {
-		r0 = rf(ctx, id, objectType, objectID, authInput)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SystemAuthReferenceObjectType, string, *model.AuthInput, *time.Time) string); ok {
+		r0 = rf(ctx, id, objectType, objectID, authInput, expiresAt)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, model.SystemAuthReferenceObjectType, string, *model.AuthInput) error); ok {
-		r1 = rf(ctx, id, objectType, objectID, authInput)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SystemAuthReferenceObjectType, string, *model.AuthInput, *time.Time) error); ok {
+		r1 = rf(ctx, id, objectType, objectID, authInput, expiresAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,3 +59,17 @@ func (_m *SystemAuthService) GetByIDForObject(ctx context.Context, objectType mo
 
 	return r0, r1
 }
+
+// UpdateExpiresAt provides a mock function with given fields: ctx, id, expiresAt
+func (_m *SystemAuthService) UpdateExpiresAt(ctx context.Context, id string, expiresAt *time.Time) error {
+	ret := _m.Called(ctx, id, expiresAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(ctx, id, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
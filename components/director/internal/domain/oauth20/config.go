@@ -3,7 +3,11 @@ package oauth20
 import "time"
 
 type Config struct {
+	ClientRegistry            string        `envconfig:"default=hydra,APP_OAUTH20_CLIENT_REGISTRY"`
 	ClientEndpoint            string        `envconfig:"APP_OAUTH20_CLIENT_ENDPOINT"`
+	InitialAccessToken        string        `envconfig:"optional,APP_OAUTH20_INITIAL_ACCESS_TOKEN"`
 	PublicAccessTokenEndpoint string        `envconfig:"APP_OAUTH20_PUBLIC_ACCESS_TOKEN_ENDPOINT"`
 	HTTPClientTimeout         time.Duration `envconfig:"default=105s,APP_OAUTH20_HTTP_CLIENT_TIMEOUT"`
+	CredentialsValidity       time.Duration `envconfig:"default=0s,APP_OAUTH20_CLIENT_CREDENTIALS_VALIDITY"`
+	RotationGracePeriod       time.Duration `envconfig:"default=24h,APP_OAUTH20_CLIENT_CREDENTIALS_ROTATION_GRACE_PERIOD"`
 }
@@ -0,0 +1,103 @@
+package oauth20
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+const (
+	clientSecretLength = 32
+
+	createClientQuery = `INSERT INTO public.oauth_clients (client_id, secret_hash, scopes, grant_types, token_endpoint_auth_method, jwks, jwks_uri, tls_client_auth_subject_dn) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	deleteClientQuery = `DELETE FROM public.oauth_clients WHERE client_id = $1`
+)
+
+// databaseClientRegistry stores clients in the Director database. It does not issue access tokens,
+// so it is meant only for development and tests, where no identity provider is available.
+// Clients are stored in their own transactions, similarly to the clients registered in an identity provider.
+type databaseClientRegistry struct {
+	transact persistence.Transactioner
+}
+
+// NewDatabaseClientRegistry creates a registry which stores clients in the Director database.
+func NewDatabaseClientRegistry(transact persistence.Transactioner) *databaseClientRegistry {
+	return &databaseClientRegistry{transact: transact}
+}
+
+func (r *databaseClientRegistry) RegisterClient(ctx context.Context, clientID string, scopes []string, auth ClientAuthentication) (*ClientCredentials, error) {
+	var secret string
+	var secretHash sql.NullString
+	if auth.AuthMethod() == ClientSecretBasicAuthMethod {
+		var err error
+		secret, err = generateClientSecret()
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256([]byte(secret))
+		secretHash = sql.NullString{String: hex.EncodeToString(hash[:]), Valid: true}
+	}
+
+	err := r.exec(createClientQuery, clientID, secretHash, strings.Join(scopes, " "), strings.Join(defaultGrantTypes, " "),
+		auth.AuthMethod(), nullString(string(auth.JWKS)), nullString(auth.JWKSURI), nullString(auth.SubjectDN))
+	if err != nil {
+		return nil, errors.Wrap(err, "while inserting OAuth 2.0 client to DB")
+	}
+
+	return &ClientCredentials{
+		ClientID:     clientID,
+		ClientSecret: secret,
+	}, nil
+}
+
+func (r *databaseClientRegistry) UnregisterClient(ctx context.Context, clientID string) error {
+	err := r.exec(deleteClientQuery, clientID)
+	if err != nil {
+		return errors.Wrap(err, "while deleting OAuth 2.0 client from DB")
+	}
+
+	return nil
+}
+
+func (r *databaseClientRegistry) exec(query string, args ...interface{}) error {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "while checking affected rows")
+	}
+	if affected != 1 {
+		return errors.Errorf("should affect single row, but affected %d rows", affected)
+	}
+
+	return tx.Commit()
+}
+
+func generateClientSecret() (string, error) {
+	secret := make([]byte, clientSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrap(err, "while generating client secret")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
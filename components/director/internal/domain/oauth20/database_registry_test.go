@@ -0,0 +1,173 @@
+package oauth20_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	createClientQuery = `INSERT INTO public.oauth_clients (client_id, secret_hash, scopes, grant_types, token_endpoint_auth_method, jwks, jwks_uri, tls_client_auth_subject_dn) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	deleteClientQuery = `DELETE FROM public.oauth_clients WHERE client_id = $1`
+)
+
+func TestDatabaseClientRegistry_RegisterClient(t *testing.T) {
+	// given
+	id := "foo"
+	scopes := []string{"foo", "bar"}
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		var secretHash sql.NullString
+		persist.On("Exec", createClientQuery, id, mock.AnythingOfType("sql.NullString"), "foo bar", "client_credentials", "client_secret_basic", sql.NullString{}, sql.NullString{}, sql.NullString{}).Run(func(args mock.Arguments) {
+			secretHash = args.Get(2).(sql.NullString)
+		}).Return(sqlmock.NewResult(-1, 1), nil).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		result, err := registry.RegisterClient(context.TODO(), id, scopes, oauth20.ClientAuthentication{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, id, result.ClientID)
+		assert.NotEmpty(t, result.ClientSecret)
+		expectedHash := sha256.Sum256([]byte(result.ClientSecret))
+		assert.Equal(t, sql.NullString{String: hex.EncodeToString(expectedHash[:]), Valid: true}, secretHash)
+	})
+
+	t.Run("Success - private key JWT", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		jwks := `{"keys":[]}`
+		persist.On("Exec", createClientQuery, id, sql.NullString{}, "foo bar", "client_credentials", "private_key_jwt", sql.NullString{String: jwks, Valid: true}, sql.NullString{}, sql.NullString{}).Return(sqlmock.NewResult(-1, 1), nil).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		result, err := registry.RegisterClient(context.TODO(), id, scopes, oauth20.ClientAuthentication{Method: oauth20.PrivateKeyJWTAuthMethod, JWKS: []byte(jwks)})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &oauth20.ClientCredentials{ClientID: id}, result)
+	})
+
+	t.Run("Success - certificate", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		persist.On("Exec", createClientQuery, id, sql.NullString{}, "foo bar", "client_credentials", "tls_client_auth", sql.NullString{}, sql.NullString{}, sql.NullString{String: "CN=foo", Valid: true}).Return(sqlmock.NewResult(-1, 1), nil).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		result, err := registry.RegisterClient(context.TODO(), id, scopes, oauth20.ClientAuthentication{Method: oauth20.TLSClientAuthMethod, SubjectDN: "CN=foo"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &oauth20.ClientCredentials{ClientID: id}, result)
+	})
+
+	t.Run("Error - Inserting client", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		persist.On("Exec", createClientQuery, id, mock.AnythingOfType("sql.NullString"), "foo bar", "client_credentials", "client_secret_basic", sql.NullString{}, sql.NullString{}, sql.NullString{}).Return(nil, testErr).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		_, err := registry.RegisterClient(context.TODO(), id, scopes, oauth20.ClientAuthentication{})
+
+		// then
+		require.EqualError(t, err, "while inserting OAuth 2.0 client to DB: test error")
+	})
+
+	t.Run("Error - Transaction Begin", func(t *testing.T) {
+		persist, transact := txGen.ThatFailsOnBegin()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		_, err := registry.RegisterClient(context.TODO(), id, scopes, oauth20.ClientAuthentication{})
+
+		// then
+		require.EqualError(t, err, "while inserting OAuth 2.0 client to DB: while opening the transaction: test error")
+	})
+}
+
+func TestDatabaseClientRegistry_UnregisterClient(t *testing.T) {
+	// given
+	id := "foo"
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		persist.On("Exec", deleteClientQuery, id).Return(sqlmock.NewResult(-1, 1), nil).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		err := registry.UnregisterClient(context.TODO(), id)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Error - Client does not exist", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		persist.On("Exec", deleteClientQuery, id).Return(sqlmock.NewResult(-1, 0), nil).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		err := registry.UnregisterClient(context.TODO(), id)
+
+		// then
+		require.EqualError(t, err, "while deleting OAuth 2.0 client from DB: should affect single row, but affected 0 rows")
+	})
+
+	t.Run("Error - Transaction Commit", func(t *testing.T) {
+		persist, transact := txGen.ThatFailsOnCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		persist.On("Exec", deleteClientQuery, id).Return(sqlmock.NewResult(-1, 1), nil).Once()
+
+		registry := oauth20.NewDatabaseClientRegistry(transact)
+
+		// when
+		err := registry.UnregisterClient(context.TODO(), id)
+
+		// then
+		require.EqualError(t, err, "while deleting OAuth 2.0 client from DB: test error")
+	})
+}
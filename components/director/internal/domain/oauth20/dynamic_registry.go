@@ -0,0 +1,219 @@
+package oauth20
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=RegistrationRepository -output=automock -outpkg=automock -case=underscore
+type RegistrationRepository interface {
+	Create(ctx context.Context, item ClientRegistration) error
+	GetByClientID(ctx context.Context, clientID string) (*ClientRegistration, error)
+	Delete(ctx context.Context, clientID string) error
+}
+
+// dynamicClientRegistry manages clients with the OAuth 2.0 Dynamic Client Registration Protocol (RFC 7591)
+// and its Management Protocol (RFC 7592). The registration access tokens required to delete the clients
+// are stored in their own transactions, so that they are not lost when the transaction of the caller is rolled back.
+type dynamicClientRegistry struct {
+	registrationEndpoint string
+	initialAccessToken   string
+	httpCli              *http.Client
+	transact             persistence.Transactioner
+	repo                 RegistrationRepository
+	logger               *logrus.Logger
+}
+
+// NewDynamicClientRegistry creates a registry which manages clients in an identity provider supporting dynamic client registration.
+func NewDynamicClientRegistry(registrationEndpoint, initialAccessToken string, httpCli *http.Client, transact persistence.Transactioner, repo RegistrationRepository) *dynamicClientRegistry {
+	return &dynamicClientRegistry{
+		registrationEndpoint: registrationEndpoint,
+		initialAccessToken:   initialAccessToken,
+		httpCli:              httpCli,
+		transact:             transact,
+		repo:                 repo,
+		logger:               logrus.New(),
+	}
+}
+
+type dynamicRegistrationRequest struct {
+	ClientID                              string          `json:"client_id,omitempty"`
+	GrantTypes                            []string        `json:"grant_types"`
+	Scope                                 string          `json:"scope,omitempty"`
+	TokenEndpointAuthMethod               string          `json:"token_endpoint_auth_method"`
+	JWKS                                  json.RawMessage `json:"jwks,omitempty"`
+	JWKSURI                               string          `json:"jwks_uri,omitempty"`
+	TLSClientAuthSubjectDN                string          `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientCertificateBoundAccessTokens bool            `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+}
+
+type dynamicRegistrationResponse struct {
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret"`
+	RegistrationAccessToken string `json:"registration_access_token"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+}
+
+// RegisterClient registers the client at the registration endpoint. Clients using tls_client_auth
+// request certificate-bound access tokens (RFC 8705), so that the tokens cannot be used without the client certificate.
+func (r *dynamicClientRegistry) RegisterClient(ctx context.Context, clientID string, scopes []string, auth ClientAuthentication) (*ClientCredentials, error) {
+	r.logger.Debugf("Registering client with requested client_id %s and %s authentication at %s with scopes: %s", clientID, auth.AuthMethod(), r.registrationEndpoint, scopes)
+	reqBody := &dynamicRegistrationRequest{
+		ClientID:                              clientID,
+		GrantTypes:                            defaultGrantTypes,
+		Scope:                                 strings.Join(scopes, " "),
+		TokenEndpointAuthMethod:               auth.AuthMethod(),
+		JWKS:                                  auth.JWKS,
+		JWKSURI:                               auth.JWKSURI,
+		TLSClientAuthSubjectDN:                auth.SubjectDN,
+		TLSClientCertificateBoundAccessTokens: auth.AuthMethod() == TLSClientAuthMethod,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(&reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "while encoding body")
+	}
+
+	resp, closeBody, err := doJSONRequest(ctx, r.httpCli, r.logger, http.MethodPost, r.registrationEndpoint, r.initialAccessToken, buffer)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	var registrationResp dynamicRegistrationResponse
+	err = json.NewDecoder(resp.Body).Decode(&registrationResp)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding response body")
+	}
+
+	registration := ClientRegistration{
+		ClientID:                registrationResp.ClientID,
+		RegistrationClientURI:   registrationResp.RegistrationClientURI,
+		RegistrationAccessToken: registrationResp.RegistrationAccessToken,
+	}
+	if registration.RegistrationClientURI == "" {
+		registration.RegistrationClientURI = fmt.Sprintf("%s/%s", r.registrationEndpoint, registration.ClientID)
+	}
+
+	if err := validateRegistration(registrationResp, auth); err != nil {
+		return nil, r.cleanup(ctx, registration, err)
+	}
+
+	if err := r.storeRegistration(ctx, registration); err != nil {
+		return nil, r.cleanup(ctx, registration, err)
+	}
+
+	r.logger.Debugf("client_id %s successfully registered at %s", registration.ClientID, r.registrationEndpoint)
+	return &ClientCredentials{
+		ClientID:     registrationResp.ClientID,
+		ClientSecret: registrationResp.ClientSecret,
+	}, nil
+}
+
+func (r *dynamicClientRegistry) UnregisterClient(ctx context.Context, clientID string) error {
+	r.logger.Debugf("Unregistering client_id %s", clientID)
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	registration, err := r.repo.GetByClientID(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.deleteClient(ctx, *registration); err != nil {
+		return err
+	}
+
+	if err := r.repo.Delete(ctx, clientID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing the transaction")
+	}
+
+	r.logger.Debugf("client_id %s and client_secret successfully unregistered", clientID)
+	return nil
+}
+
+func (r *dynamicClientRegistry) storeRegistration(ctx context.Context, registration ClientRegistration) error {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := r.repo.Create(ctx, registration); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing the transaction")
+	}
+
+	return nil
+}
+
+func (r *dynamicClientRegistry) deleteClient(ctx context.Context, registration ClientRegistration) error {
+	resp, closeBody, err := doJSONRequest(ctx, r.httpCli, r.logger, http.MethodDelete, registration.RegistrationClientURI, registration.RegistrationAccessToken, nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusNoContent)
+	}
+
+	return nil
+}
+
+func (r *dynamicClientRegistry) cleanup(ctx context.Context, registration ClientRegistration, originalErr error) error {
+	if registration.RegistrationAccessToken == "" {
+		return originalErr
+	}
+
+	if err := r.deleteClient(ctx, registration); err != nil {
+		return multierror.Append(originalErr, errors.Wrapf(err, "while deleting client with ID %s", registration.ClientID))
+	}
+
+	return originalErr
+}
+
+// validateRegistration checks if the issued client can be used by Compass. System auths are identified
+// by the client IDs, so the identity provider has to issue client IDs which are UUIDs.
+func validateRegistration(resp dynamicRegistrationResponse, auth ClientAuthentication) error {
+	if _, err := uuid.Parse(resp.ClientID); err != nil {
+		return errors.Wrapf(err, "while parsing issued client ID %q as UUID", resp.ClientID)
+	}
+	if auth.AuthMethod() == ClientSecretBasicAuthMethod && resp.ClientSecret == "" {
+		return errors.Errorf("client secret not issued for client with ID %s", resp.ClientID)
+	}
+	if resp.RegistrationAccessToken == "" {
+		return errors.Errorf("registration access token not issued for client with ID %s", resp.ClientID)
+	}
+
+	return nil
+}
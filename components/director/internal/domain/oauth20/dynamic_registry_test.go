@@ -0,0 +1,348 @@
+package oauth20_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	dynamicClientID           = "4a7c3f8e-2b8d-4f0e-9d1a-6c5e3b2a1f00"
+	initialAccessToken        = "initial-token"
+	registrationAccessToken   = "registration-token"
+	registrationEndpointPath  = "/register"
+	registrationClientURIPath = "/register/" + dynamicClientID
+)
+
+func TestDynamicClientRegistry_RegisterClient(t *testing.T) {
+	// given
+	scopes := []string{"foo", "bar"}
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	expectedReqBody := map[string]interface{}{
+		"client_id":                  dynamicClientID,
+		"grant_types":                []interface{}{"client_credentials"},
+		"scope":                      "foo bar",
+		"token_endpoint_auth_method": "client_secret_basic",
+	}
+	expectedCertificateReqBody := map[string]interface{}{
+		"client_id":                  dynamicClientID,
+		"grant_types":                []interface{}{"client_credentials"},
+		"scope":                      "foo bar",
+		"token_endpoint_auth_method": "tls_client_auth",
+		"tls_client_auth_subject_dn": "CN=foo",
+		"tls_client_certificate_bound_access_tokens": true,
+	}
+	fixResponseWithoutSecret := func() map[string]interface{} {
+		res := fixDynamicRegistrationResponse(dynamicClientID, true)
+		delete(res, "client_secret")
+		return res
+	}
+
+	testCases := []struct {
+		Name            string
+		Auth            oauth20.ClientAuthentication
+		ExpectedReqBody map[string]interface{}
+		Response        map[string]interface{}
+		ResponseStatus  int
+		ExpectCleanup   bool
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepositoryFn    func(serverURL string) *automock.RegistrationRepository
+		ExpectedResult  *oauth20.ClientCredentials
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			Response:        fixDynamicRegistrationResponse(dynamicClientID, true),
+			ResponseStatus:  http.StatusCreated,
+			TransactionerFn: txGen.ThatSucceeds,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				repo := &automock.RegistrationRepository{}
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixClientRegistration(serverURL+registrationClientURIPath)).Return(nil).Once()
+				return repo
+			},
+			ExpectedResult: &oauth20.ClientCredentials{ClientID: dynamicClientID, ClientSecret: "c-secret"},
+		},
+		{
+			Name:            "Success - registration client URI is not issued",
+			Response:        fixDynamicRegistrationResponse(dynamicClientID, false),
+			ResponseStatus:  http.StatusCreated,
+			TransactionerFn: txGen.ThatSucceeds,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				repo := &automock.RegistrationRepository{}
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixClientRegistration(serverURL+registrationClientURIPath)).Return(nil).Once()
+				return repo
+			},
+			ExpectedResult: &oauth20.ClientCredentials{ClientID: dynamicClientID, ClientSecret: "c-secret"},
+		},
+		{
+			Name:            "Success - certificate",
+			Auth:            oauth20.ClientAuthentication{Method: oauth20.TLSClientAuthMethod, SubjectDN: "CN=foo"},
+			ExpectedReqBody: expectedCertificateReqBody,
+			Response:        fixResponseWithoutSecret(),
+			ResponseStatus:  http.StatusCreated,
+			TransactionerFn: txGen.ThatSucceeds,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				repo := &automock.RegistrationRepository{}
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixClientRegistration(serverURL+registrationClientURIPath)).Return(nil).Once()
+				return repo
+			},
+			ExpectedResult: &oauth20.ClientCredentials{ClientID: dynamicClientID},
+		},
+		{
+			Name:            "Error - Client secret is not issued",
+			Response:        fixResponseWithoutSecret(),
+			ResponseStatus:  http.StatusCreated,
+			ExpectCleanup:   true,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				return &automock.RegistrationRepository{}
+			},
+			ExpectedError: errors.New("client secret not issued for client with ID " + dynamicClientID),
+		},
+		{
+			Name:            "Error - Response Status Code",
+			ResponseStatus:  http.StatusBadRequest,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				return &automock.RegistrationRepository{}
+			},
+			ExpectedError: errors.New("invalid HTTP status code: received: 400, expected 201"),
+		},
+		{
+			Name:            "Error - Issued client ID is not UUID",
+			Response:        fixDynamicRegistrationResponse("foo", true),
+			ResponseStatus:  http.StatusCreated,
+			ExpectCleanup:   true,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				return &automock.RegistrationRepository{}
+			},
+			ExpectedError: errors.New(`while parsing issued client ID "foo" as UUID`),
+		},
+		{
+			Name:            "Error - Storing registration",
+			Response:        fixDynamicRegistrationResponse(dynamicClientID, true),
+			ResponseStatus:  http.StatusCreated,
+			ExpectCleanup:   true,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				repo := &automock.RegistrationRepository{}
+				repo.On("Create", txtest.CtxWithDBMatcher(), mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Transaction Commit",
+			Response:        fixDynamicRegistrationResponse(dynamicClientID, true),
+			ResponseStatus:  http.StatusCreated,
+			ExpectCleanup:   true,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				repo := &automock.RegistrationRepository{}
+				repo.On("Create", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			cleanedUp := false
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() {
+					err := r.Body.Close()
+					assert.NoError(t, err)
+				}()
+
+				if r.Method == http.MethodDelete {
+					assert.Equal(t, registrationClientURIPath, r.URL.Path)
+					assert.Equal(t, "Bearer "+registrationAccessToken, r.Header.Get("Authorization"))
+					cleanedUp = true
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, registrationEndpointPath, r.URL.Path)
+				assert.Equal(t, "Bearer "+initialAccessToken, r.Header.Get("Authorization"))
+
+				var reqBody map[string]interface{}
+				err := json.NewDecoder(r.Body).Decode(&reqBody)
+				require.NoError(t, err)
+				if testCase.ExpectedReqBody != nil {
+					assert.Equal(t, testCase.ExpectedReqBody, reqBody)
+				} else {
+					assert.Equal(t, expectedReqBody, reqBody)
+				}
+
+				res := testCase.Response
+				if uri, ok := res["registration_client_uri"]; ok {
+					res["registration_client_uri"] = "http://" + r.Host + uri.(string)
+				}
+				w.WriteHeader(testCase.ResponseStatus)
+				err = json.NewEncoder(w).Encode(&res)
+				require.NoError(t, err)
+			}))
+			defer httpServer.Close()
+
+			persist, transact := testCase.TransactionerFn()
+			defer persist.AssertExpectations(t)
+			defer transact.AssertExpectations(t)
+
+			repo := testCase.RepositoryFn(httpServer.URL)
+			defer repo.AssertExpectations(t)
+
+			registry := oauth20.NewDynamicClientRegistry(httpServer.URL+registrationEndpointPath, initialAccessToken, &http.Client{}, transact, repo)
+
+			// when
+			result, err := registry.RegisterClient(context.TODO(), dynamicClientID, scopes, testCase.Auth)
+
+			// then
+			if testCase.ExpectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			}
+			assert.Equal(t, testCase.ExpectCleanup, cleanedUp)
+		})
+	}
+}
+
+func TestDynamicClientRegistry_UnregisterClient(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		ResponseStatus  int
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepositoryFn    func(serverURL string) *automock.RegistrationRepository
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			ResponseStatus:  http.StatusNoContent,
+			TransactionerFn: txGen.ThatSucceeds,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				registration := fixClientRegistration(serverURL + registrationClientURIPath)
+				repo := &automock.RegistrationRepository{}
+				repo.On("GetByClientID", txtest.CtxWithDBMatcher(), dynamicClientID).Return(&registration, nil).Once()
+				repo.On("Delete", txtest.CtxWithDBMatcher(), dynamicClientID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:            "Error - Registration not found",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				repo := &automock.RegistrationRepository{}
+				repo.On("GetByClientID", txtest.CtxWithDBMatcher(), dynamicClientID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Response Status Code",
+			ResponseStatus:  http.StatusUnauthorized,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				registration := fixClientRegistration(serverURL + registrationClientURIPath)
+				repo := &automock.RegistrationRepository{}
+				repo.On("GetByClientID", txtest.CtxWithDBMatcher(), dynamicClientID).Return(&registration, nil).Once()
+				return repo
+			},
+			ExpectedError: errors.New("invalid HTTP status code: received: 401, expected 204"),
+		},
+		{
+			Name:            "Error - Deleting registration",
+			ResponseStatus:  http.StatusNoContent,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				registration := fixClientRegistration(serverURL + registrationClientURIPath)
+				repo := &automock.RegistrationRepository{}
+				repo.On("GetByClientID", txtest.CtxWithDBMatcher(), dynamicClientID).Return(&registration, nil).Once()
+				repo.On("Delete", txtest.CtxWithDBMatcher(), dynamicClientID).Return(testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Transaction Begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			RepositoryFn: func(serverURL string) *automock.RegistrationRepository {
+				return &automock.RegistrationRepository{}
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, registrationClientURIPath, r.URL.Path)
+				assert.Equal(t, "Bearer "+registrationAccessToken, r.Header.Get("Authorization"))
+				w.WriteHeader(testCase.ResponseStatus)
+			}))
+			defer httpServer.Close()
+
+			persist, transact := testCase.TransactionerFn()
+			defer persist.AssertExpectations(t)
+			defer transact.AssertExpectations(t)
+
+			repo := testCase.RepositoryFn(httpServer.URL)
+			defer repo.AssertExpectations(t)
+
+			registry := oauth20.NewDynamicClientRegistry(httpServer.URL+registrationEndpointPath, initialAccessToken, &http.Client{}, transact, repo)
+
+			// when
+			err := registry.UnregisterClient(context.TODO(), dynamicClientID)
+
+			// then
+			if testCase.ExpectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			}
+		})
+	}
+}
+
+func fixDynamicRegistrationResponse(clientID string, withClientURI bool) map[string]interface{} {
+	res := map[string]interface{}{
+		"client_id":                 clientID,
+		"client_secret":             "c-secret",
+		"registration_access_token": registrationAccessToken,
+	}
+	if withClientURI {
+		res["registration_client_uri"] = registrationClientURIPath
+	}
+	return res
+}
+
+func fixClientRegistration(clientURI string) oauth20.ClientRegistration {
+	return oauth20.ClientRegistration{
+		ClientID:                dynamicClientID,
+		RegistrationClientURI:   clientURI,
+		RegistrationAccessToken: registrationAccessToken,
+	}
+}
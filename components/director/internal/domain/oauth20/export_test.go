@@ -0,0 +1,7 @@
+package oauth20
+
+import "time"
+
+func (r *Resolver) SetTimestampGen(timestampGen func() time.Time) {
+	r.timestampGen = timestampGen
+}
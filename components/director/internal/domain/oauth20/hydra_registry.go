@@ -0,0 +1,144 @@
+package oauth20
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const applicationJSONType = "application/json"
+
+type hydraClientRegistry struct {
+	clientEndpoint string
+	httpCli        *http.Client
+	logger         *logrus.Logger
+}
+
+// NewHydraClientRegistry creates a registry which manages clients with the ORY Hydra admin API.
+func NewHydraClientRegistry(clientEndpoint string, httpCli *http.Client) *hydraClientRegistry {
+	return &hydraClientRegistry{
+		clientEndpoint: clientEndpoint,
+		httpCli:        httpCli,
+		logger:         logrus.New(),
+	}
+}
+
+type clientCredentialsRegistrationBody struct {
+	GrantTypes              []string        `json:"grant_types"`
+	ClientID                string          `json:"client_id"`
+	Scope                   string          `json:"scope"`
+	TokenEndpointAuthMethod string          `json:"token_endpoint_auth_method"`
+	JWKS                    json.RawMessage `json:"jwks,omitempty"`
+	JWKSURI                 string          `json:"jwks_uri,omitempty"`
+}
+
+type clientCredentialsRegistrationResponse struct {
+	ClientSecret string `json:"client_secret"`
+}
+
+// RegisterClient registers the client in Hydra. Hydra does not support mutual-TLS client authentication,
+// so only clients using client_secret_basic or private_key_jwt can be registered.
+func (r *hydraClientRegistry) RegisterClient(ctx context.Context, clientID string, scopes []string, auth ClientAuthentication) (*ClientCredentials, error) {
+	if auth.AuthMethod() == TLSClientAuthMethod {
+		return nil, errors.Errorf("token endpoint authentication method %s is not supported by Hydra", TLSClientAuthMethod)
+	}
+
+	r.logger.Debugf("Registering client_id %s with %s authentication in Hydra with scopes: %s", clientID, auth.AuthMethod(), scopes)
+	reqBody := &clientCredentialsRegistrationBody{
+		GrantTypes:              defaultGrantTypes,
+		ClientID:                clientID,
+		Scope:                   strings.Join(scopes, " "),
+		TokenEndpointAuthMethod: auth.AuthMethod(),
+		JWKS:                    auth.JWKS,
+		JWKSURI:                 auth.JWKSURI,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(&reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "while encoding body")
+	}
+
+	resp, closeBody, err := doJSONRequest(ctx, r.httpCli, r.logger, http.MethodPost, r.clientEndpoint, "", buffer)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	var registrationResp clientCredentialsRegistrationResponse
+	err = json.NewDecoder(resp.Body).Decode(&registrationResp)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding response body")
+	}
+
+	r.logger.Debugf("client_id %s successfully registered in Hydra", clientID)
+	return &ClientCredentials{
+		ClientID:     clientID,
+		ClientSecret: registrationResp.ClientSecret,
+	}, nil
+}
+
+func (r *hydraClientRegistry) UnregisterClient(ctx context.Context, clientID string) error {
+	r.logger.Debugf("Unregistering client_id %s and client_secret in Hydra", clientID)
+	endpoint := fmt.Sprintf("%s/%s", r.clientEndpoint, clientID)
+
+	resp, closeBody, err := doJSONRequest(ctx, r.httpCli, r.logger, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusNoContent)
+	}
+
+	r.logger.Debugf("client_id %s and client_secret successfully unregistered in Hydra", clientID)
+	return nil
+}
+
+func doJSONRequest(ctx context.Context, httpCli *http.Client, logger *logrus.Logger, method, endpoint, bearerToken string, body io.Reader) (*http.Response, func(body io.ReadCloser), error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while creating new request")
+	}
+
+	req.Header.Set("Accept", applicationJSONType)
+	req.Header.Set("Content-Type", applicationJSONType)
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	resp, err := httpCli.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while doing request to %s", endpoint)
+	}
+
+	closeBodyFn := func(body io.ReadCloser) {
+		if body == nil {
+			return
+		}
+		_, err := io.Copy(ioutil.Discard, body)
+		if err != nil {
+			logger.Error(err)
+		}
+
+		err = body.Close()
+		if err != nil {
+			logger.Error(err)
+		}
+	}
+
+	return resp, closeBodyFn, nil
+}
@@ -0,0 +1,208 @@
+package oauth20_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHydraClientRegistry_RegisterClient(t *testing.T) {
+	// given
+	id := "foo"
+	scopes := []string{"foo", "bar", "baz"}
+	expectedReqBody := map[string]interface{}{
+		"grant_types":                []interface{}{"client_credentials"},
+		"client_id":                  "foo",
+		"scope":                      "foo bar baz",
+		"token_endpoint_auth_method": "client_secret_basic",
+	}
+	expectedPrivateKeyJWTReqBody := map[string]interface{}{
+		"grant_types":                []interface{}{"client_credentials"},
+		"client_id":                  "foo",
+		"scope":                      "foo bar baz",
+		"token_endpoint_auth_method": "private_key_jwt",
+		"jwks_uri":                   "http://foo.bar/jwks",
+	}
+
+	testCases := []struct {
+		Name           string
+		Auth           oauth20.ClientAuthentication
+		ExpectedResult *oauth20.ClientCredentials
+		ExpectedError  error
+		HTTPServerFn   func(t *testing.T) *httptest.Server
+	}{
+		{
+			Name:           "Success",
+			ExpectedResult: &oauth20.ClientCredentials{ClientID: id, ClientSecret: "c-secret"},
+			HTTPServerFn:   fixSuccessCreateClientHTTPServer(expectedReqBody),
+		},
+		{
+			Name:           "Success - private key JWT",
+			Auth:           oauth20.ClientAuthentication{Method: oauth20.PrivateKeyJWTAuthMethod, JWKSURI: "http://foo.bar/jwks"},
+			ExpectedResult: &oauth20.ClientCredentials{ClientID: id, ClientSecret: "c-secret"},
+			HTTPServerFn:   fixSuccessCreateClientHTTPServer(expectedPrivateKeyJWTReqBody),
+		},
+		{
+			Name:          "Error - Certificate",
+			Auth:          oauth20.ClientAuthentication{Method: oauth20.TLSClientAuthMethod, SubjectDN: "CN=foo"},
+			ExpectedError: errors.New("token endpoint authentication method tls_client_auth is not supported by Hydra"),
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					t.Error("unexpected request")
+				}))
+			},
+		},
+		{
+			Name:          "Error - Response Status Code",
+			ExpectedError: errors.New("invalid HTTP status code: received: 500, expected 201"),
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+				return tc
+			},
+		},
+		{
+			Name:          "Error - Invalid body",
+			ExpectedError: errors.New("while decoding response body: invalid character 'D' looking for beginning of value"),
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusCreated)
+					_, err := w.Write([]byte("Dd"))
+					assert.NoError(t, err)
+				}))
+				return tc
+			},
+		},
+		{
+			Name:          "Error - HTTP call error",
+			ExpectedError: errors.New("connect: connection refused"),
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+				tc.Close()
+				return tc
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			httpServer := testCase.HTTPServerFn(t)
+			defer httpServer.Close()
+
+			registry := oauth20.NewHydraClientRegistry(httpServer.URL, &http.Client{})
+
+			// when
+			result, err := registry.RegisterClient(context.TODO(), id, scopes, testCase.Auth)
+
+			// then
+			if testCase.ExpectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			}
+		})
+	}
+}
+
+func TestHydraClientRegistry_UnregisterClient(t *testing.T) {
+	// given
+	id := "foo"
+	testCases := []struct {
+		Name          string
+		ExpectedError error
+		HTTPServerFn  func(t *testing.T) *httptest.Server
+	}{
+		{
+			Name:          "Success",
+			ExpectedError: nil,
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/foo", r.URL.Path)
+					defer func() {
+						err := r.Body.Close()
+						assert.NoError(t, err)
+					}()
+					assert.Equal(t, http.MethodDelete, r.Method)
+					assert.Equal(t, "application/json", r.Header.Get("Accept"))
+					w.WriteHeader(http.StatusNoContent)
+				}))
+				return tc
+			},
+		},
+		{
+			Name:          "Error - Response Status Code",
+			ExpectedError: errors.New("invalid HTTP status code: received: 500, expected 204"),
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+				return tc
+			},
+		},
+		{
+			Name:          "Error - HTTP call error",
+			ExpectedError: errors.New("connect: connection refused"),
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+				tc.Close()
+				return tc
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			httpServer := testCase.HTTPServerFn(t)
+			defer httpServer.Close()
+
+			registry := oauth20.NewHydraClientRegistry(httpServer.URL, &http.Client{})
+
+			// when
+			err := registry.UnregisterClient(context.TODO(), id)
+
+			// then
+			if testCase.ExpectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			}
+		})
+	}
+}
+
+func fixSuccessCreateClientHTTPServer(expectedReqBody map[string]interface{}) func(t *testing.T) *httptest.Server {
+	return func(t *testing.T) *httptest.Server {
+		tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				err := r.Body.Close()
+				assert.NoError(t, err)
+			}()
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			var reqBody map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&reqBody)
+			require.NoError(t, err)
+			assert.Equal(t, expectedReqBody, reqBody)
+
+			res := map[string]interface{}{
+				"client_secret": "c-secret",
+			}
+			w.WriteHeader(http.StatusCreated)
+			err = json.NewEncoder(w).Encode(&res)
+			require.NoError(t, err)
+		}))
+		return tc
+	}
+}
@@ -0,0 +1,93 @@
+package oauth20
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+const (
+	createRegistrationQuery = `INSERT INTO public.oauth_client_registrations (client_id, registration_client_uri, registration_access_token) VALUES (:client_id, :registration_client_uri, :registration_access_token)`
+	getRegistrationQuery    = `SELECT client_id, registration_client_uri, registration_access_token FROM public.oauth_client_registrations WHERE client_id = $1`
+	deleteRegistrationQuery = `DELETE FROM public.oauth_client_registrations WHERE client_id = $1`
+)
+
+// ClientRegistration holds the data required to manage a dynamically registered client, as described in RFC 7592.
+type ClientRegistration struct {
+	ClientID                string `db:"client_id"`
+	RegistrationClientURI   string `db:"registration_client_uri"`
+	RegistrationAccessToken string `db:"registration_access_token"`
+}
+
+type registrationRepository struct {
+	encryptor encryption.Encryptor
+}
+
+func NewRegistrationRepository(encryptor encryption.Encryptor) *registrationRepository {
+	return &registrationRepository{encryptor: encryptor}
+}
+
+func (r *registrationRepository) Create(ctx context.Context, item ClientRegistration) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	token, err := r.encryptor.Encrypt(ctx, registrationAccessTokenLocation(item.ClientID), sql.NullString{String: item.RegistrationAccessToken, Valid: true})
+	if err != nil {
+		return errors.Wrap(err, "while encrypting registration access token")
+	}
+	item.RegistrationAccessToken = token.String
+
+	_, err = persist.NamedExec(createRegistrationQuery, item)
+	if err != nil {
+		return errors.Wrap(err, "while inserting client registration to DB")
+	}
+
+	return nil
+}
+
+func (r *registrationRepository) GetByClientID(ctx context.Context, clientID string) (*ClientRegistration, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var item ClientRegistration
+	err = persist.Get(&item, getRegistrationQuery, clientID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("registration of client with ID %s not found", clientID)
+		}
+		return nil, errors.Wrap(err, "while getting client registration from DB")
+	}
+
+	token, err := r.encryptor.Decrypt(ctx, registrationAccessTokenLocation(item.ClientID), sql.NullString{String: item.RegistrationAccessToken, Valid: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "while decrypting registration access token")
+	}
+	item.RegistrationAccessToken = token.String
+
+	return &item, nil
+}
+
+func (r *registrationRepository) Delete(ctx context.Context, clientID string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	_, err = persist.Exec(deleteRegistrationQuery, clientID)
+	if err != nil {
+		return errors.Wrap(err, "while deleting client registration from DB")
+	}
+
+	return nil
+}
+
+func registrationAccessTokenLocation(clientID string) encryption.Location {
+	return encryption.Location{Column: encryption.ClientRegistrationAccessTokenColumn, RowID: clientID}
+}
@@ -0,0 +1,186 @@
+package oauth20_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationRepository_Create(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO public.oauth_client_registrations (client_id, registration_client_uri, registration_access_token) VALUES (?, ?, ?)`)
+	registration := fixClientRegistration("http://foo.bar/register/" + dynamicClientID)
+	registrationLocation := encryption.Location{Column: encryption.ClientRegistrationAccessTokenColumn, RowID: dynamicClientID}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(query).
+			WithArgs(dynamicClientID, registration.RegistrationClientURI, "encrypted").
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		encryptor := &encryptionautomock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Encrypt", ctx, registrationLocation, sql.NullString{String: registrationAccessToken, Valid: true}).Return(sql.NullString{String: "encrypted", Valid: true}, nil).Once()
+
+		repo := oauth20.NewRegistrationRepository(encryptor)
+		// WHEN
+		err := repo.Create(ctx, registration)
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when encryption fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		encryptor := &encryptionautomock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Encrypt", ctx, registrationLocation, mock.Anything).Return(sql.NullString{}, errors.New("test error")).Once()
+
+		repo := oauth20.NewRegistrationRepository(encryptor)
+		// WHEN
+		err := repo.Create(ctx, registration)
+		// THEN
+		require.EqualError(t, err, "while encrypting registration access token: test error")
+	})
+
+	t.Run("Error when inserting fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(query).WillReturnError(errors.New("test error"))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		repo := oauth20.NewRegistrationRepository(encryption.NewNoopEncryptor())
+		// WHEN
+		err := repo.Create(ctx, registration)
+		// THEN
+		require.EqualError(t, err, "while inserting client registration to DB: test error")
+	})
+
+	t.Run("Error when persistence is missing in context", func(t *testing.T) {
+		// GIVEN
+		repo := oauth20.NewRegistrationRepository(encryption.NewNoopEncryptor())
+		// WHEN
+		err := repo.Create(context.TODO(), registration)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRegistrationRepository_GetByClientID(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT client_id, registration_client_uri, registration_access_token FROM public.oauth_client_registrations WHERE client_id = $1`)
+	columns := []string{"client_id", "registration_client_uri", "registration_access_token"}
+	registration := fixClientRegistration("http://foo.bar/register/" + dynamicClientID)
+	registrationLocation := encryption.Location{Column: encryption.ClientRegistrationAccessTokenColumn, RowID: dynamicClientID}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(query).
+			WithArgs(dynamicClientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(dynamicClientID, registration.RegistrationClientURI, "encrypted"))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		encryptor := &encryptionautomock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Decrypt", ctx, registrationLocation, sql.NullString{String: "encrypted", Valid: true}).Return(sql.NullString{String: registrationAccessToken, Valid: true}, nil).Once()
+
+		repo := oauth20.NewRegistrationRepository(encryptor)
+		// WHEN
+		result, err := repo.GetByClientID(ctx, dynamicClientID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &registration, result)
+	})
+
+	t.Run("Error when registration does not exist", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(query).
+			WithArgs(dynamicClientID).
+			WillReturnRows(sqlmock.NewRows(columns))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		repo := oauth20.NewRegistrationRepository(encryption.NewNoopEncryptor())
+		// WHEN
+		_, err := repo.GetByClientID(ctx, dynamicClientID)
+		// THEN
+		require.EqualError(t, err, "registration of client with ID "+dynamicClientID+" not found")
+	})
+
+	t.Run("Error when decryption fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(query).
+			WithArgs(dynamicClientID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(dynamicClientID, registration.RegistrationClientURI, "encrypted"))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		encryptor := &encryptionautomock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Decrypt", ctx, registrationLocation, mock.Anything).Return(sql.NullString{}, errors.New("test error")).Once()
+
+		repo := oauth20.NewRegistrationRepository(encryptor)
+		// WHEN
+		_, err := repo.GetByClientID(ctx, dynamicClientID)
+		// THEN
+		require.EqualError(t, err, "while decrypting registration access token: test error")
+	})
+}
+
+func TestRegistrationRepository_Delete(t *testing.T) {
+	query := regexp.QuoteMeta(`DELETE FROM public.oauth_client_registrations WHERE client_id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(query).
+			WithArgs(dynamicClientID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		repo := oauth20.NewRegistrationRepository(encryption.NewNoopEncryptor())
+		// WHEN
+		err := repo.Delete(ctx, dynamicClientID)
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(query).
+			WithArgs(dynamicClientID).
+			WillReturnError(errors.New("test error"))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		repo := oauth20.NewRegistrationRepository(encryption.NewNoopEncryptor())
+		// WHEN
+		err := repo.Delete(ctx, dynamicClientID)
+		// THEN
+		require.EqualError(t, err, "while deleting client registration from DB: test error")
+	})
+}
@@ -0,0 +1,74 @@
+package oauth20
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+const (
+	HydraClientRegistry    = "hydra"
+	DynamicClientRegistry  = "dynamic"
+	DatabaseClientRegistry = "database"
+)
+
+// Methods which clients use to authenticate at the token endpoint, as defined in RFC 7591 and RFC 8705.
+const (
+	ClientSecretBasicAuthMethod = "client_secret_basic"
+	PrivateKeyJWTAuthMethod     = "private_key_jwt"
+	TLSClientAuthMethod         = "tls_client_auth"
+)
+
+var defaultGrantTypes = []string{"client_credentials"}
+
+// ClientCredentials are the credentials of an OAuth 2.0 client issued by a client registry.
+// The secret is issued only for clients authenticating with client_secret_basic.
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// ClientAuthentication describes how an OAuth 2.0 client authenticates at the token endpoint.
+// The zero value stands for client_secret_basic. Clients using private_key_jwt register either their JWKS or JWKS URI,
+// and clients using tls_client_auth register the subject distinguished name of their certificate.
+type ClientAuthentication struct {
+	Method    string
+	JWKS      json.RawMessage
+	JWKSURI   string
+	SubjectDN string
+}
+
+// AuthMethod returns the token endpoint authentication method of the client.
+func (a ClientAuthentication) AuthMethod() string {
+	if a.Method == "" {
+		return ClientSecretBasicAuthMethod
+	}
+
+	return a.Method
+}
+
+//go:generate mockery -name=OAuthClientRegistry -output=automock -outpkg=automock -case=underscore
+
+// OAuthClientRegistry manages OAuth 2.0 clients in an identity provider.
+type OAuthClientRegistry interface {
+	RegisterClient(ctx context.Context, clientID string, scopes []string, auth ClientAuthentication) (*ClientCredentials, error)
+	UnregisterClient(ctx context.Context, clientID string) error
+}
+
+// NewClientRegistry creates the client registry selected in the configuration.
+func NewClientRegistry(cfg Config, httpCli *http.Client, transact persistence.Transactioner, encryptor encryption.Encryptor) (OAuthClientRegistry, error) {
+	switch cfg.ClientRegistry {
+	case HydraClientRegistry:
+		return NewHydraClientRegistry(cfg.ClientEndpoint, httpCli), nil
+	case DynamicClientRegistry:
+		return NewDynamicClientRegistry(cfg.ClientEndpoint, cfg.InitialAccessToken, httpCli, transact, NewRegistrationRepository(encryptor)), nil
+	case DatabaseClientRegistry:
+		return NewDatabaseClientRegistry(transact), nil
+	default:
+		return nil, errors.Errorf("unknown OAuth 2.0 client registry %q, expected one of: %s, %s, %s", cfg.ClientRegistry, HydraClientRegistry, DynamicClientRegistry, DatabaseClientRegistry)
+	}
+}
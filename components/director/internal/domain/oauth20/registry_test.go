@@ -0,0 +1,30 @@
+package oauth20_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientRegistry(t *testing.T) {
+	for _, registryType := range []string{oauth20.HydraClientRegistry, oauth20.DynamicClientRegistry, oauth20.DatabaseClientRegistry} {
+		t.Run(registryType, func(t *testing.T) {
+			// WHEN
+			registry, err := oauth20.NewClientRegistry(oauth20.Config{ClientRegistry: registryType}, &http.Client{}, nil, encryption.NewNoopEncryptor())
+			// THEN
+			require.NoError(t, err)
+			assert.NotNil(t, registry)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		// WHEN
+		_, err := oauth20.NewClientRegistry(oauth20.Config{ClientRegistry: "foo"}, &http.Client{}, nil, nil)
+		// THEN
+		require.EqualError(t, err, `unknown OAuth 2.0 client registry "foo", expected one of: hydra, dynamic, database`)
+	})
+}
@@ -3,14 +3,17 @@ package oauth20
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
@@ -18,8 +21,9 @@ import (
 
 //go:generate mockery -name=SystemAuthService -output=automock -outpkg=automock -case=underscore
 type SystemAuthService interface {
-	CreateWithCustomID(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput) (string, error)
+	CreateWithCustomID(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput, expiresAt *time.Time) (string, error)
 	GetByIDForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, authID string) (*model.SystemAuth, error)
+	UpdateExpiresAt(ctx context.Context, id string, expiresAt *time.Time) error
 }
 
 //go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
@@ -37,6 +41,11 @@ type IntegrationSystemService interface {
 	Exists(ctx context.Context, id string) (bool, error)
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
+}
+
 //go:generate mockery -name=SystemAuthConverter -output=automock -outpkg=automock -case=underscore
 type SystemAuthConverter interface {
 	ToGraphQL(model *model.SystemAuth) (*graphql.SystemAuth, error)
@@ -45,22 +54,40 @@ type SystemAuthConverter interface {
 //go:generate mockery -name=Service -output=automock -outpkg=automock -case=underscore
 type Service interface {
 	CreateClientCredentials(ctx context.Context, objectType model.SystemAuthReferenceObjectType) (*model.OAuthCredentialDataInput, error)
+	RegisterClient(ctx context.Context, objectType model.SystemAuthReferenceObjectType, in model.CredentialDataInput) (*model.CredentialDataInput, error)
 	DeleteClientCredentials(ctx context.Context, clientID string) error
 }
 
 type Resolver struct {
-	transact       persistence.Transactioner
-	svc            Service
-	systemAuthSvc  SystemAuthService
-	systemAuthConv SystemAuthConverter
-	appSvc         ApplicationService
-	rtmSvc         RuntimeService
-	isSvc          IntegrationSystemService
-	logger         *logrus.Logger
+	transact            persistence.Transactioner
+	svc                 Service
+	systemAuthSvc       SystemAuthService
+	systemAuthConv      SystemAuthConverter
+	appSvc              ApplicationService
+	rtmSvc              RuntimeService
+	isSvc               IntegrationSystemService
+	accessRuleSvc       AccessRuleService
+	credentialsValidity time.Duration
+	rotationGracePeriod time.Duration
+	timestampGen        timestamp.Generator
+	logger              *logrus.Logger
 }
 
-func NewResolver(transactioner persistence.Transactioner, svc Service, appSvc ApplicationService, rtmSvc RuntimeService, isSvc IntegrationSystemService, systemAuthSvc SystemAuthService, systemAuthConv SystemAuthConverter) *Resolver {
-	return &Resolver{transact: transactioner, svc: svc, appSvc: appSvc, rtmSvc: rtmSvc, systemAuthSvc: systemAuthSvc, isSvc: isSvc, systemAuthConv: systemAuthConv, logger: logrus.New()}
+func NewResolver(transactioner persistence.Transactioner, svc Service, appSvc ApplicationService, rtmSvc RuntimeService, isSvc IntegrationSystemService, accessRuleSvc AccessRuleService, systemAuthSvc SystemAuthService, systemAuthConv SystemAuthConverter, cfg Config) *Resolver {
+	return &Resolver{
+		transact:            transactioner,
+		svc:                 svc,
+		appSvc:              appSvc,
+		rtmSvc:              rtmSvc,
+		systemAuthSvc:       systemAuthSvc,
+		isSvc:               isSvc,
+		accessRuleSvc:       accessRuleSvc,
+		systemAuthConv:      systemAuthConv,
+		credentialsValidity: cfg.CredentialsValidity,
+		rotationGracePeriod: cfg.RotationGracePeriod,
+		timestampGen:        timestamp.DefaultGenerator(),
+		logger:              logrus.New(),
+	}
 }
 
 func (r *Resolver) RequestClientCredentialsForRuntime(ctx context.Context, id string) (*graphql.SystemAuth, error) {
@@ -75,6 +102,30 @@ func (r *Resolver) RequestClientCredentialsForIntegrationSystem(ctx context.Cont
 	return r.generateClientCredentials(ctx, model.IntegrationSystemReference, id)
 }
 
+func (r *Resolver) RotateClientCredentialsForRuntime(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	return r.rotateClientCredentials(ctx, model.RuntimeReference, authID)
+}
+
+func (r *Resolver) RotateClientCredentialsForApplication(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	return r.rotateClientCredentials(ctx, model.ApplicationReference, authID)
+}
+
+func (r *Resolver) RotateClientCredentialsForIntegrationSystem(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	return r.rotateClientCredentials(ctx, model.IntegrationSystemReference, authID)
+}
+
+func (r *Resolver) RegisterSystemAuthForRuntime(ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	return r.registerSystemAuth(ctx, model.RuntimeReference, id, in)
+}
+
+func (r *Resolver) RegisterSystemAuthForApplication(ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	return r.registerSystemAuth(ctx, model.ApplicationReference, id, in)
+}
+
+func (r *Resolver) RegisterSystemAuthForIntegrationSystem(ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	return r.registerSystemAuth(ctx, model.IntegrationSystemReference, id, in)
+}
+
 func (r *Resolver) generateClientCredentials(ctx context.Context, objType model.SystemAuthReferenceObjectType, objID string) (*graphql.SystemAuth, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -93,51 +144,216 @@ func (r *Resolver) generateClientCredentials(ctx context.Context, objType model.
 		return nil, fmt.Errorf("%s with ID '%s' not found", objType, objID)
 	}
 
-	r.logger.Debugf("Generating client credentials for %s with id %s by Director", objType, objID)
-	clientCreds, err := r.svc.CreateClientCredentials(ctx, objType)
+	sysAuth, cleanupOnError, err := r.createClientCredentials(ctx, objType, objID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		finalErr := cleanupOnError(err)
+		return nil, finalErr
+	}
+
+	r.logger.Infof("Successfully created client credentials with client_id %s for %s with id %s", sysAuth.ID, objType, objID)
+	return r.systemAuthConv.ToGraphQL(sysAuth)
+}
+
+// registerSystemAuth registers a client which authenticates with the public credential provided by the system consumer,
+// so that no shared secret is issued for it.
+func (r *Resolver) registerSystemAuth(ctx context.Context, objType model.SystemAuthReferenceObjectType, objID string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	credential := credentialInputFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	r.logger.Infof("Requesting registration of client for %s with id %s", objType, objID)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	exists, err := r.checkObjectExist(ctx, objType, objID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error occurred while checking if %s with ID '%s' exists", objType, objID)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s with ID '%s' not found", objType, objID)
+	}
+
+	sysAuth, cleanupOnError, err := r.createSystemAuth(ctx, objType, objID, func(ctx context.Context) (*model.CredentialDataInput, error) {
+		registered, err := r.svc.RegisterClient(ctx, objType, credential)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error occurred while registering client for %s with id %s", objType, objID)
+		}
+		if registered == nil {
+			return nil, apperrors.NewInvalidDataError("registered client credential cannot be empty")
+		}
+
+		return registered, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		finalErr := cleanupOnError(err)
+		return nil, finalErr
+	}
+
+	r.logger.Infof("Successfully registered client with client_id %s for %s with id %s", sysAuth.ID, objType, objID)
+	return r.systemAuthConv.ToGraphQL(sysAuth)
+}
+
+// rotateClientCredentials creates new client credentials for the object which owns the given SystemAuth.
+// The rotated SystemAuth is kept until the grace period ends, so that both client credentials can be used in the meantime.
+func (r *Resolver) rotateClientCredentials(ctx context.Context, objType model.SystemAuthReferenceObjectType, authID string) (*graphql.SystemAuth, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	rotatedSysAuth, err := r.systemAuthSvc.GetByIDForObject(ctx, objType, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	refObjType, err := rotatedSysAuth.GetReferenceObjectType()
+	if err != nil {
+		return nil, err
+	}
+	if refObjType != objType {
+		return nil, apperrors.NewNotFoundError(resource.SystemAuth, authID)
+	}
+
+	if rotatedSysAuth.Value == nil || rotatedSysAuth.Value.Credential.Oauth == nil {
+		return nil, apperrors.NewInvalidDataError("only client credentials can be rotated")
+	}
+
+	objID, err := rotatedSysAuth.GetReferenceObjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.ensureAccess(ctx, objType, objID, authID); err != nil {
+		return nil, err
+	}
+
+	exists, err := r.checkObjectExist(ctx, objType, objID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error occurred while checking if %s with ID '%s' exists", objType, objID)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s with ID '%s' not found", objType, objID)
+	}
+
+	r.logger.Infof("Requesting rotation of client credentials with client_id %s for %s with id %s", authID, objType, objID)
+	sysAuth, cleanupOnError, err := r.createClientCredentials(ctx, objType, objID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error occurred while creating client credentials for %s with id %s", objType, objID)
+		return nil, err
 	}
-	if clientCreds == nil {
-		return nil, apperrors.NewInvalidDataError("client credentials cannot be empty")
+
+	gracePeriodEnd := r.timestampGen().Add(r.rotationGracePeriod)
+	if rotatedSysAuth.ExpiresAt == nil || gracePeriodEnd.Before(*rotatedSysAuth.ExpiresAt) {
+		r.logger.Debugf("Setting expiration of rotated client credentials with client_id %s to %s", authID, gracePeriodEnd)
+		err = r.systemAuthSvc.UpdateExpiresAt(ctx, authID, &gracePeriodEnd)
+		if err != nil {
+			finalErr := cleanupOnError(err)
+			return nil, errors.Wrapf(finalErr, "error occurred while updating expiration of SystemAuth with id %s", authID)
+		}
 	}
-	r.logger.Debugf("Client credentials for %s with id %s are successfully generated by Director", objType, objID)
+
+	err = tx.Commit()
+	if err != nil {
+		finalErr := cleanupOnError(err)
+		return nil, finalErr
+	}
+
+	r.logger.Infof("Successfully rotated client credentials with client_id %s to client_id %s for %s with id %s", authID, sysAuth.ID, objType, objID)
+	return r.systemAuthConv.ToGraphQL(sysAuth)
+}
+
+// createClientCredentials registers new client credentials and stores them as SystemAuth for the given object.
+// The returned function removes the registered client credentials if the transaction cannot be completed.
+func (r *Resolver) createClientCredentials(ctx context.Context, objType model.SystemAuthReferenceObjectType, objID string) (*model.SystemAuth, func(error) error, error) {
+	return r.createSystemAuth(ctx, objType, objID, func(ctx context.Context) (*model.CredentialDataInput, error) {
+		r.logger.Debugf("Generating client credentials for %s with id %s by Director", objType, objID)
+		clientCreds, err := r.svc.CreateClientCredentials(ctx, objType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error occurred while creating client credentials for %s with id %s", objType, objID)
+		}
+		if clientCreds == nil {
+			return nil, apperrors.NewInvalidDataError("client credentials cannot be empty")
+		}
+		r.logger.Debugf("Client credentials for %s with id %s are successfully generated by Director", objType, objID)
+
+		return &model.CredentialDataInput{Oauth: clientCreds}, nil
+	})
+}
+
+// createSystemAuth registers a client with the given function and stores its credential as SystemAuth for the given object.
+// The returned function removes the registered client if the transaction cannot be completed.
+func (r *Resolver) createSystemAuth(ctx context.Context, objType model.SystemAuthReferenceObjectType, objID string, registerFn func(ctx context.Context) (*model.CredentialDataInput, error)) (*model.SystemAuth, func(error) error, error) {
+	credential, err := registerFn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := credential.ToCredentialData().OAuthClientID()
 	cleanupOnError := func(originalErr error) error {
-		cleanupErr := r.svc.DeleteClientCredentials(ctx, clientCreds.ClientID)
+		cleanupErr := r.svc.DeleteClientCredentials(ctx, id)
 		if cleanupErr != nil {
-			return multierror.Append(err, cleanupErr)
+			return multierror.Append(originalErr, cleanupErr)
 		}
 
 		return originalErr
 	}
 
-	id := clientCreds.ClientID
+	var expiresAt *time.Time
+	if r.credentialsValidity > 0 {
+		validUntil := r.timestampGen().Add(r.credentialsValidity)
+		expiresAt = &validUntil
+	}
+
 	r.logger.Debugf("Creating SystemAuth for the client credentials for %s with id %s", objType, objID)
 	_, err = r.systemAuthSvc.CreateWithCustomID(ctx, id, objType, objID, &model.AuthInput{
-		Credential: &model.CredentialDataInput{
-			Oauth: clientCreds,
-		},
-	})
+		Credential: credential,
+	}, expiresAt)
 	if err != nil {
 		finalErr := cleanupOnError(err)
-		return nil, errors.Wrapf(finalErr, "error occurred while creating SystemAuth for %s with id %s", objType, objID)
+		return nil, nil, errors.Wrapf(finalErr, "error occurred while creating SystemAuth for %s with id %s", objType, objID)
 	}
 	r.logger.Debugf("Successfully created SystemAuth for the client credentials for %s with id %s", objType, objID)
 
 	sysAuth, err := r.systemAuthSvc.GetByIDForObject(ctx, objType, id)
 	if err != nil {
 		finalErr := cleanupOnError(err)
-		return nil, finalErr
+		return nil, nil, finalErr
 	}
 
-	err = tx.Commit()
+	return sysAuth, cleanupOnError, nil
+}
+
+// ensureAccess hides the System Auths of the Applications and Runtimes, which cannot be accessed by the caller, as if they did not exist
+func (r *Resolver) ensureAccess(ctx context.Context, objType model.SystemAuthReferenceObjectType, objID, authID string) error {
+	accessRuleObjType, restricted := objType.AccessRuleObjectType()
+	if !restricted {
+		return nil
+	}
+
+	hasAccess, err := r.accessRuleSvc.HasAccess(ctx, accessRuleObjType, objID)
 	if err != nil {
-		finalErr := cleanupOnError(err)
-		return nil, finalErr
+		return errors.Wrapf(err, "while checking access to %s with id %s", objType, objID)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.SystemAuth, authID)
 	}
 
-	r.logger.Infof("Successfully created client credentials with client_id %s for %s with id %s", id, objType, objID)
-	return r.systemAuthConv.ToGraphQL(sysAuth)
+	return nil
 }
 
 func (r *Resolver) checkObjectExist(ctx context.Context, objType model.SystemAuthReferenceObjectType, objID string) (bool, error) {
@@ -152,3 +368,21 @@ func (r *Resolver) checkObjectExist(ctx context.Context, objType model.SystemAut
 
 	return false, fmt.Errorf("invalid object type %s", objType)
 }
+
+func credentialInputFromGraphQL(in graphql.SystemAuthCredentialInput) model.CredentialDataInput {
+	var credential model.CredentialDataInput
+	if in.PrivateKeyJwt != nil {
+		credential.PrivateKeyJWT = &model.PrivateKeyJWTCredentialDataInput{
+			JWKS:    in.PrivateKeyJwt.Jwks,
+			JWKSURI: in.PrivateKeyJwt.JwksURI,
+		}
+	}
+	if in.Certificate != nil {
+		credential.Certificate = &model.CertificateCredentialDataInput{
+			Subject:    in.Certificate.Subject,
+			Thumbprint: in.Certificate.Thumbprint,
+		}
+	}
+
+	return credential
+}
@@ -3,6 +3,7 @@ package oauth20_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
@@ -128,7 +129,7 @@ func TestResolver_CommonRequestClientCredentialsSuccess(t *testing.T) {
 			defer isSvc.AssertExpectations(t)
 
 			systemAuthSvc := &automock.SystemAuthService{}
-			systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, testCase.ObjType, id, authInput).Return(clientID, nil).Once()
+			systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, testCase.ObjType, id, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
 			systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), testCase.ObjType, clientID).Return(modelSystemAuth, nil).Once()
 			defer systemAuthSvc.AssertExpectations(t)
 
@@ -136,7 +137,7 @@ func TestResolver_CommonRequestClientCredentialsSuccess(t *testing.T) {
 			systemAuthConv.On("ToGraphQL", modelSystemAuth).Return(expectedResult, nil).Once()
 			defer systemAuthConv.AssertExpectations(t)
 
-			resolver := oauth20.NewResolver(transact, svc, appSvc, rtmSvc, isSvc, systemAuthSvc, systemAuthConv)
+			resolver := oauth20.NewResolver(transact, svc, appSvc, rtmSvc, isSvc, nil, systemAuthSvc, systemAuthConv, oauth20.Config{})
 
 			// When
 			result, err := testCase.Method(resolver, context.TODO(), id)
@@ -188,7 +189,7 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 			},
 			SystemAuthServiceFn: func() *automock.SystemAuthService {
 				systemAuthSvc := &automock.SystemAuthService{}
-				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput).Return(clientID, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
 				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), objType, clientID).Return(modelSystemAuth, nil).Once()
 				return systemAuthSvc
 			},
@@ -210,7 +211,7 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 			},
 			SystemAuthServiceFn: func() *automock.SystemAuthService {
 				systemAuthSvc := &automock.SystemAuthService{}
-				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput).Return(clientID, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
 				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), objType, clientID).Return(nil, testErr).Once()
 				return systemAuthSvc
 			},
@@ -232,7 +233,7 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 			},
 			SystemAuthServiceFn: func() *automock.SystemAuthService {
 				systemAuthSvc := &automock.SystemAuthService{}
-				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput).Return("", testErr).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput, (*time.Time)(nil)).Return("", testErr).Once()
 				return systemAuthSvc
 			},
 		},
@@ -253,7 +254,7 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 			},
 			SystemAuthServiceFn: func() *automock.SystemAuthService {
 				systemAuthSvc := &automock.SystemAuthService{}
-				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput).Return("", testErr).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, objType, id, authInput, (*time.Time)(nil)).Return("", testErr).Once()
 				return systemAuthSvc
 			},
 		},
@@ -365,7 +366,7 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 			systemAuthSvc := testCase.SystemAuthServiceFn()
 			defer systemAuthSvc.AssertExpectations(t)
 
-			resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, systemAuthSvc, nil)
+			resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, nil, systemAuthSvc, nil, oauth20.Config{})
 
 			// When
 			_, err := resolver.RequestClientCredentialsForRuntime(context.TODO(), id)
@@ -377,6 +378,663 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 	}
 }
 
+func TestResolver_RequestClientCredentialsWithValidity(t *testing.T) {
+	// Given
+	id := "foo"
+	clientID := "clientid"
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(90 * 24 * time.Hour)
+	txGen := txtest.NewTransactionContextGenerator(nil)
+	expectedResult := fixGQLSystemAuth(clientID)
+	modelSystemAuth := fixModelSystemAuth(clientID, &id, nil, nil)
+	credsData := &model.OAuthCredentialDataInput{
+		ClientID:     "clientid",
+		ClientSecret: "secret",
+		URL:          "url",
+	}
+	authInput := &model.AuthInput{Credential: &model.CredentialDataInput{Oauth: credsData}}
+
+	persist, transact := txGen.ThatSucceeds()
+	defer persist.AssertExpectations(t)
+	defer transact.AssertExpectations(t)
+
+	rtmSvc := &automock.RuntimeService{}
+	rtmSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+	defer rtmSvc.AssertExpectations(t)
+
+	svc := &automock.Service{}
+	svc.On("CreateClientCredentials", txtest.CtxWithDBMatcher(), model.RuntimeReference).Return(credsData, nil).Once()
+	defer svc.AssertExpectations(t)
+
+	systemAuthSvc := &automock.SystemAuthService{}
+	systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.RuntimeReference, id, authInput, &expiresAt).Return(clientID, nil).Once()
+	systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.RuntimeReference, clientID).Return(modelSystemAuth, nil).Once()
+	defer systemAuthSvc.AssertExpectations(t)
+
+	systemAuthConv := &automock.SystemAuthConverter{}
+	systemAuthConv.On("ToGraphQL", modelSystemAuth).Return(expectedResult, nil).Once()
+	defer systemAuthConv.AssertExpectations(t)
+
+	resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, nil, systemAuthSvc, systemAuthConv, oauth20.Config{CredentialsValidity: 90 * 24 * time.Hour})
+	resolver.SetTimestampGen(func() time.Time { return now })
+
+	// When
+	result, err := resolver.RequestClientCredentialsForRuntime(context.TODO(), id)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestResolver_RotateClientCredentials(t *testing.T) {
+	// Given
+	objID := "foo"
+	rotatedClientID := "rotated-clientid"
+	clientID := "clientid"
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	gracePeriod := 24 * time.Hour
+	gracePeriodEnd := now.Add(gracePeriod)
+	laterExpiresAt := now.Add(48 * time.Hour)
+	earlierExpiresAt := now.Add(time.Hour)
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	credsData := &model.OAuthCredentialDataInput{
+		ClientID:     clientID,
+		ClientSecret: "secret",
+		URL:          "url",
+	}
+	authInput := &model.AuthInput{Credential: &model.CredentialDataInput{Oauth: credsData}}
+	rotatedSystemAuth := fixModelSystemAuth(rotatedClientID, nil, &objID, nil)
+	newSystemAuth := fixModelSystemAuth(clientID, nil, &objID, nil)
+	expectedResult := fixGQLSystemAuth(clientID)
+
+	rotatedExpiringLater := fixModelSystemAuth(rotatedClientID, nil, &objID, nil)
+	rotatedExpiringLater.ExpiresAt = &laterExpiresAt
+	rotatedExpiringEarlier := fixModelSystemAuth(rotatedClientID, nil, &objID, nil)
+	rotatedExpiringEarlier.ExpiresAt = &earlierExpiresAt
+	basicSystemAuth := &model.SystemAuth{
+		ID:    rotatedClientID,
+		AppID: &objID,
+		Value: &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "foo", Password: "bar"}}},
+	}
+
+	testCases := []struct {
+		Name                string
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.Service
+		SystemAuthServiceFn func() *automock.SystemAuthService
+		SystemAuthConvFn    func() *automock.SystemAuthConverter
+		AccessRuleServiceFn func() *automock.AccessRuleService
+		AppServiceFn        func() *automock.ApplicationService
+		ExpectedResult      *graphql.SystemAuth
+		ExpectedError       error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("CreateClientCredentials", txtest.CtxWithDBMatcher(), model.ApplicationReference).Return(credsData, nil).Once()
+				return svc
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.ApplicationReference, objID, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, clientID).Return(newSystemAuth, nil).Once()
+				systemAuthSvc.On("UpdateExpiresAt", txtest.CtxWithDBMatcher(), rotatedClientID, &gracePeriodEnd).Return(nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				conv := &automock.SystemAuthConverter{}
+				conv.On("ToGraphQL", newSystemAuth).Return(expectedResult, nil).Once()
+				return conv
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(true, nil).Once()
+				return appSvc
+			},
+			ExpectedResult: expectedResult,
+		},
+		{
+			Name:            "Success - rotated credentials expire later than grace period",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("CreateClientCredentials", txtest.CtxWithDBMatcher(), model.ApplicationReference).Return(credsData, nil).Once()
+				return svc
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedExpiringLater, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.ApplicationReference, objID, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, clientID).Return(newSystemAuth, nil).Once()
+				systemAuthSvc.On("UpdateExpiresAt", txtest.CtxWithDBMatcher(), rotatedClientID, &gracePeriodEnd).Return(nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				conv := &automock.SystemAuthConverter{}
+				conv.On("ToGraphQL", newSystemAuth).Return(expectedResult, nil).Once()
+				return conv
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(true, nil).Once()
+				return appSvc
+			},
+			ExpectedResult: expectedResult,
+		},
+		{
+			Name:            "Success - rotated credentials expire before grace period ends",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("CreateClientCredentials", txtest.CtxWithDBMatcher(), model.ApplicationReference).Return(credsData, nil).Once()
+				return svc
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedExpiringEarlier, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.ApplicationReference, objID, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, clientID).Return(newSystemAuth, nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				conv := &automock.SystemAuthConverter{}
+				conv.On("ToGraphQL", newSystemAuth).Return(expectedResult, nil).Once()
+				return conv
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(true, nil).Once()
+				return appSvc
+			},
+			ExpectedResult: expectedResult,
+		},
+		{
+			Name:            "Error - Get rotated System Auth",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(nil, testErr).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - System Auth belongs to different object type",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(fixModelSystemAuth(rotatedClientID, &objID, nil, nil), nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedError: errors.New("Object not found"),
+		},
+		{
+			Name:            "Error - System Auth without client credentials",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(basicSystemAuth, nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedError: errors.New("only client credentials can be rotated"),
+		},
+		{
+			Name:            "Error - Caller has no access to the Application",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(false, nil).Once()
+				return accessRuleSvc
+			},
+			ExpectedError: errors.New("Object not found"),
+		},
+		{
+			Name:            "Error - Check access to the Application",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(false, testErr).Once()
+				return accessRuleSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Application does not exist",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(false, nil).Once()
+				return appSvc
+			},
+			ExpectedError: errors.New("Application with ID 'foo' not found"),
+		},
+		{
+			Name:            "Error - Check if Application exists",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(false, testErr).Once()
+				return appSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Update expiration of rotated System Auth",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("CreateClientCredentials", txtest.CtxWithDBMatcher(), model.ApplicationReference).Return(credsData, nil).Once()
+				svc.On("DeleteClientCredentials", txtest.CtxWithDBMatcher(), clientID).Return(nil).Once()
+				return svc
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.ApplicationReference, objID, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, clientID).Return(newSystemAuth, nil).Once()
+				systemAuthSvc.On("UpdateExpiresAt", txtest.CtxWithDBMatcher(), rotatedClientID, &gracePeriodEnd).Return(testErr).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(true, nil).Once()
+				return appSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Transaction Commit",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("CreateClientCredentials", txtest.CtxWithDBMatcher(), model.ApplicationReference).Return(credsData, nil).Once()
+				svc.On("DeleteClientCredentials", txtest.CtxWithDBMatcher(), clientID).Return(nil).Once()
+				return svc
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, rotatedClientID).Return(rotatedSystemAuth, nil).Once()
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.ApplicationReference, objID, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, clientID).Return(newSystemAuth, nil).Once()
+				systemAuthSvc.On("UpdateExpiresAt", txtest.CtxWithDBMatcher(), rotatedClientID, &gracePeriodEnd).Return(nil).Once()
+				return systemAuthSvc
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", txtest.CtxWithDBMatcher(), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), objID).Return(true, nil).Once()
+				return appSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error - Transaction Begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			SystemAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			SystemAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			defer persist.AssertExpectations(t)
+			defer transact.AssertExpectations(t)
+
+			svc := testCase.ServiceFn()
+			defer svc.AssertExpectations(t)
+
+			systemAuthSvc := testCase.SystemAuthServiceFn()
+			defer systemAuthSvc.AssertExpectations(t)
+
+			systemAuthConv := testCase.SystemAuthConvFn()
+			defer systemAuthConv.AssertExpectations(t)
+
+			accessRuleSvc := &automock.AccessRuleService{}
+			if testCase.AccessRuleServiceFn != nil {
+				accessRuleSvc = testCase.AccessRuleServiceFn()
+			}
+			defer accessRuleSvc.AssertExpectations(t)
+
+			appSvc := &automock.ApplicationService{}
+			if testCase.AppServiceFn != nil {
+				appSvc = testCase.AppServiceFn()
+			}
+			defer appSvc.AssertExpectations(t)
+
+			resolver := oauth20.NewResolver(transact, svc, appSvc, nil, nil, accessRuleSvc, systemAuthSvc, systemAuthConv, oauth20.Config{RotationGracePeriod: gracePeriod})
+			resolver.SetTimestampGen(func() time.Time { return now })
+
+			// When
+			result, err := resolver.RotateClientCredentialsForApplication(context.TODO(), rotatedClientID)
+
+			// Then
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+		})
+	}
+}
+
+func TestResolver_RegisterSystemAuth(t *testing.T) {
+	// Given
+	id := "foo"
+	clientID := "clientid"
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	jwksURI := "http://foo.bar/jwks"
+	gqlInput := graphql.SystemAuthCredentialInput{PrivateKeyJwt: &graphql.PrivateKeyJWTCredentialInput{JwksURI: &jwksURI}}
+	credentialInput := model.CredentialDataInput{PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{JWKSURI: &jwksURI}}
+	registeredCredential := &model.CredentialDataInput{PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{
+		ClientID: clientID,
+		JWKSURI:  &jwksURI,
+		URL:      "url",
+	}}
+	authInput := &model.AuthInput{Credential: registeredCredential}
+	modelSystemAuth := &model.SystemAuth{
+		ID:        clientID,
+		RuntimeID: &id,
+		Value:     &model.Auth{Credential: *registeredCredential.ToCredentialData()},
+	}
+	expectedResult := &graphql.SystemAuth{
+		ID: clientID,
+		Auth: &graphql.Auth{Credential: graphql.PrivateKeyJWTCredentialData{
+			ClientID: clientID,
+			JwksURI:  &jwksURI,
+			URL:      "url",
+		}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		testCases := []struct {
+			Name    string
+			ObjType model.SystemAuthReferenceObjectType
+			Method  func(resolver *oauth20.Resolver, ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error)
+		}{
+			{
+				Name:    "Runtime",
+				ObjType: model.RuntimeReference,
+				Method: func(resolver *oauth20.Resolver, ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+					return resolver.RegisterSystemAuthForRuntime(ctx, id, in)
+				},
+			},
+			{
+				Name:    "Application",
+				ObjType: model.ApplicationReference,
+				Method: func(resolver *oauth20.Resolver, ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+					return resolver.RegisterSystemAuthForApplication(ctx, id, in)
+				},
+			},
+			{
+				Name:    "Integration System",
+				ObjType: model.IntegrationSystemReference,
+				Method: func(resolver *oauth20.Resolver, ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+					return resolver.RegisterSystemAuthForIntegrationSystem(ctx, id, in)
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				persist, transact := txGen.ThatSucceeds()
+				defer persist.AssertExpectations(t)
+				defer transact.AssertExpectations(t)
+
+				rtmSvc := &automock.RuntimeService{}
+				defer rtmSvc.AssertExpectations(t)
+				appSvc := &automock.ApplicationService{}
+				defer appSvc.AssertExpectations(t)
+				isSvc := &automock.IntegrationSystemService{}
+				defer isSvc.AssertExpectations(t)
+				switch testCase.ObjType {
+				case model.RuntimeReference:
+					rtmSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+				case model.ApplicationReference:
+					appSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+				case model.IntegrationSystemReference:
+					isSvc.On("Exists", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+				}
+
+				svc := &automock.Service{}
+				svc.On("RegisterClient", txtest.CtxWithDBMatcher(), testCase.ObjType, credentialInput).Return(registeredCredential, nil).Once()
+				defer svc.AssertExpectations(t)
+
+				systemAuthSvc := &automock.SystemAuthService{}
+				systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, testCase.ObjType, id, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+				systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), testCase.ObjType, clientID).Return(modelSystemAuth, nil).Once()
+				defer systemAuthSvc.AssertExpectations(t)
+
+				systemAuthConv := &automock.SystemAuthConverter{}
+				systemAuthConv.On("ToGraphQL", modelSystemAuth).Return(expectedResult, nil).Once()
+				defer systemAuthConv.AssertExpectations(t)
+
+				resolver := oauth20.NewResolver(transact, svc, appSvc, rtmSvc, isSvc, nil, systemAuthSvc, systemAuthConv, oauth20.Config{})
+
+				// When
+				result, err := testCase.Method(resolver, context.TODO(), id, gqlInput)
+
+				// Then
+				require.NoError(t, err)
+				assert.Equal(t, expectedResult, result)
+			})
+		}
+	})
+
+	t.Run("Error - Runtime does not exist", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		rtmSvc := &automock.RuntimeService{}
+		rtmSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(false, nil).Once()
+		defer rtmSvc.AssertExpectations(t)
+
+		resolver := oauth20.NewResolver(transact, nil, nil, rtmSvc, nil, nil, nil, nil, oauth20.Config{})
+
+		// When
+		_, err := resolver.RegisterSystemAuthForRuntime(context.TODO(), id, gqlInput)
+
+		// Then
+		require.EqualError(t, err, "Runtime with ID 'foo' not found")
+	})
+
+	t.Run("Error - Client registration", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		rtmSvc := &automock.RuntimeService{}
+		rtmSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+		defer rtmSvc.AssertExpectations(t)
+
+		svc := &automock.Service{}
+		svc.On("RegisterClient", txtest.CtxWithDBMatcher(), model.RuntimeReference, credentialInput).Return(nil, testErr).Once()
+		defer svc.AssertExpectations(t)
+
+		resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, nil, nil, nil, oauth20.Config{})
+
+		// When
+		_, err := resolver.RegisterSystemAuthForRuntime(context.TODO(), id, gqlInput)
+
+		// Then
+		require.EqualError(t, err, "error occurred while registering client for Runtime with id foo: test error")
+	})
+
+	t.Run("Error - Creating SystemAuth removes registered client", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		rtmSvc := &automock.RuntimeService{}
+		rtmSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+		defer rtmSvc.AssertExpectations(t)
+
+		svc := &automock.Service{}
+		svc.On("RegisterClient", txtest.CtxWithDBMatcher(), model.RuntimeReference, credentialInput).Return(registeredCredential, nil).Once()
+		svc.On("DeleteClientCredentials", txtest.CtxWithDBMatcher(), clientID).Return(nil).Once()
+		defer svc.AssertExpectations(t)
+
+		systemAuthSvc := &automock.SystemAuthService{}
+		systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.RuntimeReference, id, authInput, (*time.Time)(nil)).Return("", testErr).Once()
+		defer systemAuthSvc.AssertExpectations(t)
+
+		resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, nil, systemAuthSvc, nil, oauth20.Config{})
+
+		// When
+		_, err := resolver.RegisterSystemAuthForRuntime(context.TODO(), id, gqlInput)
+
+		// Then
+		require.EqualError(t, err, "error occurred while creating SystemAuth for Runtime with id foo: test error")
+	})
+
+	t.Run("Error - Transaction Commit removes registered client", func(t *testing.T) {
+		persist, transact := txGen.ThatFailsOnCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		rtmSvc := &automock.RuntimeService{}
+		rtmSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+		defer rtmSvc.AssertExpectations(t)
+
+		svc := &automock.Service{}
+		svc.On("RegisterClient", txtest.CtxWithDBMatcher(), model.RuntimeReference, credentialInput).Return(registeredCredential, nil).Once()
+		svc.On("DeleteClientCredentials", txtest.CtxWithDBMatcher(), clientID).Return(nil).Once()
+		defer svc.AssertExpectations(t)
+
+		systemAuthSvc := &automock.SystemAuthService{}
+		systemAuthSvc.On("CreateWithCustomID", txtest.CtxWithDBMatcher(), clientID, model.RuntimeReference, id, authInput, (*time.Time)(nil)).Return(clientID, nil).Once()
+		systemAuthSvc.On("GetByIDForObject", txtest.CtxWithDBMatcher(), model.RuntimeReference, clientID).Return(modelSystemAuth, nil).Once()
+		defer systemAuthSvc.AssertExpectations(t)
+
+		resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, nil, systemAuthSvc, nil, oauth20.Config{})
+
+		// When
+		_, err := resolver.RegisterSystemAuthForRuntime(context.TODO(), id, gqlInput)
+
+		// Then
+		require.EqualError(t, err, "test error")
+	})
+}
+
 func fixModelSystemAuth(clientID string, rtmID, appID, isID *string) *model.SystemAuth {
 	return &model.SystemAuth{
 		ID:                  clientID,
@@ -1,24 +1,18 @@
 package oauth20
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 const clientCredentialScopesPrefix = "clientCredentialsRegistrationScopes"
-const applicationJSONType = "application/json"
-
-var defaultGrantTypes = []string{"client_credentials"}
 
 //go:generate mockery -name=ScopeCfgProvider -output=automock -outpkg=automock -case=underscore
 type ScopeCfgProvider interface {
@@ -31,170 +25,126 @@ type UIDService interface {
 }
 
 type service struct {
-	clientEndpoint            string
 	publicAccessTokenEndpoint string
 	scopeCfgProvider          ScopeCfgProvider
-	httpCli                   *http.Client
+	registry                  OAuthClientRegistry
 	uidService                UIDService
 	logger                    *logrus.Logger
 }
 
-func NewService(scopeCfgProvider ScopeCfgProvider, uidService UIDService, cfg Config, httpCli *http.Client) *service {
+func NewService(scopeCfgProvider ScopeCfgProvider, uidService UIDService, cfg Config, registry OAuthClientRegistry) *service {
 	return &service{
 		scopeCfgProvider:          scopeCfgProvider,
-		clientEndpoint:            cfg.ClientEndpoint,
 		publicAccessTokenEndpoint: cfg.PublicAccessTokenEndpoint,
-		httpCli:                   httpCli,
+		registry:                  registry,
 		uidService:                uidService,
 		logger:                    logrus.New(),
 	}
 }
 
 func (s *service) CreateClientCredentials(ctx context.Context, objectType model.SystemAuthReferenceObjectType) (*model.OAuthCredentialDataInput, error) {
-	scopes, err := s.getClientCredentialScopes(objectType)
-	if err != nil {
-		if !model.IsIntegrationSystemNoTenantFlow(err, objectType) {
-			return nil, err
-		}
-	}
-	s.logger.Debugf("Fetched client credential scopes: %s for %s", scopes, objectType)
-
-	clientID := s.uidService.Generate()
-	clientSecret, err := s.registerClient(ctx, clientID, scopes)
+	clientCreds, err := s.registerClient(ctx, objectType, ClientAuthentication{Method: ClientSecretBasicAuthMethod})
 	if err != nil {
-		return nil, errors.Wrap(err, "while registering client credentials in Hydra")
+		return nil, errors.Wrap(err, "while registering client credentials")
 	}
 
 	credentialData := &model.OAuthCredentialDataInput{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
+		ClientID:     clientCreds.ClientID,
+		ClientSecret: clientCreds.ClientSecret,
 		URL:          s.publicAccessTokenEndpoint,
 	}
 
 	return credentialData, nil
 }
 
-func (s *service) DeleteClientCredentials(ctx context.Context, clientID string) error {
-	return s.unregisterClient(ctx, clientID)
-}
-
-func (s *service) DeleteMultipleClientCredentials(ctx context.Context, auths []model.SystemAuth) error {
-	for _, auth := range auths {
-		if auth.Value == nil {
-			continue
-		}
-		if auth.Value.Credential.Oauth == nil {
-			continue
+// RegisterClient registers an OAuth 2.0 client which authenticates with the given public credential instead of a client secret.
+// It returns the given credential completed with the issued client ID and the token endpoint.
+func (s *service) RegisterClient(ctx context.Context, objectType model.SystemAuthReferenceObjectType, in model.CredentialDataInput) (*model.CredentialDataInput, error) {
+	var auth ClientAuthentication
+	switch {
+	case in.PrivateKeyJWT != nil:
+		auth.Method = PrivateKeyJWTAuthMethod
+		if in.PrivateKeyJWT.JWKS != nil {
+			auth.JWKS = json.RawMessage(*in.PrivateKeyJWT.JWKS)
 		}
-		err := s.DeleteClientCredentials(ctx, auth.Value.Credential.Oauth.ClientID)
-		if err != nil {
-			return errors.Wrap(err, "while deleting OAuth 2.0 credentials")
+		if in.PrivateKeyJWT.JWKSURI != nil {
+			auth.JWKSURI = *in.PrivateKeyJWT.JWKSURI
 		}
+	case in.Certificate != nil:
+		auth.Method = TLSClientAuthMethod
+		auth.SubjectDN = in.Certificate.Subject
+	default:
+		return nil, apperrors.NewInvalidDataError("private key JWT or certificate credential has to be provided")
 	}
-	return nil
-}
 
-func (s *service) getClientCredentialScopes(objType model.SystemAuthReferenceObjectType) ([]string, error) {
-	scopes, err := s.scopeCfgProvider.GetRequiredScopes(s.buildPath(objType))
+	clientCreds, err := s.registerClient(ctx, objectType, auth)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while getting scopes for registering Client Credentials for %s", objType)
+		return nil, errors.Wrapf(err, "while registering client with %s authentication", auth.Method)
 	}
 
-	return scopes, nil
-}
-
-type clientCredentialsRegistrationBody struct {
-	GrantTypes []string `json:"grant_types"`
-	ClientID   string   `json:"client_id"`
-	Scope      string   `json:"scope"`
-}
-
-type clientCredentialsRegistrationResponse struct {
-	ClientSecret string `json:"client_secret"`
-}
-
-func (s *service) registerClient(ctx context.Context, clientID string, scopes []string) (string, error) {
-	s.logger.Debugf("Registering client_id %s and client_secret in Hydra with scopes: %s", clientID, scopes)
-	reqBody := &clientCredentialsRegistrationBody{
-		GrantTypes: defaultGrantTypes,
-		ClientID:   clientID,
-		Scope:      strings.Join(scopes, " "),
+	credential := in
+	if in.PrivateKeyJWT != nil {
+		privateKeyJWT := *in.PrivateKeyJWT
+		privateKeyJWT.ClientID = clientCreds.ClientID
+		privateKeyJWT.URL = s.publicAccessTokenEndpoint
+		credential.PrivateKeyJWT = &privateKeyJWT
 	}
-
-	buffer := &bytes.Buffer{}
-	err := json.NewEncoder(buffer).Encode(&reqBody)
-	if err != nil {
-		return "", errors.Wrap(err, "while encoding body")
-	}
-
-	resp, closeBody, err := s.doRequest(ctx, http.MethodPost, s.clientEndpoint, buffer)
-	if err != nil {
-		return "", err
+	if in.Certificate != nil {
+		certificate := *in.Certificate
+		certificate.ClientID = clientCreds.ClientID
+		certificate.URL = s.publicAccessTokenEndpoint
+		credential.Certificate = &certificate
 	}
-	defer closeBody(resp.Body)
 
-	if resp.StatusCode != http.StatusCreated {
-		return "", fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusCreated)
-	}
+	return &credential, nil
+}
 
-	var registrationResp clientCredentialsRegistrationResponse
-	err = json.NewDecoder(resp.Body).Decode(&registrationResp)
+func (s *service) DeleteClientCredentials(ctx context.Context, clientID string) error {
+	err := s.registry.UnregisterClient(ctx, clientID)
 	if err != nil {
-		return "", errors.Wrap(err, "while decoding response body")
+		return errors.Wrapf(err, "while unregistering client credentials with client ID %s", clientID)
 	}
 
-	s.logger.Debugf("client_id %s and client_secret successfully registered in Hydra", clientID)
-	return registrationResp.ClientSecret, nil
+	return nil
 }
 
-func (s *service) unregisterClient(ctx context.Context, clientID string) error {
-	s.logger.Debugf("Unregistering client_id %s and client_secret in Hydra", clientID)
-	endpoint := fmt.Sprintf("%s/%s", s.clientEndpoint, clientID)
-
-	resp, closeBody, err := s.doRequest(ctx, http.MethodDelete, endpoint, nil)
-	if err != nil {
-		return err
-	}
-	defer closeBody(resp.Body)
-
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusNoContent)
+func (s *service) DeleteMultipleClientCredentials(ctx context.Context, auths []model.SystemAuth) error {
+	for _, auth := range auths {
+		if auth.Value == nil {
+			continue
+		}
+		clientID := auth.Value.Credential.OAuthClientID()
+		if clientID == "" {
+			continue
+		}
+		err := s.DeleteClientCredentials(ctx, clientID)
+		if err != nil {
+			return errors.Wrap(err, "while deleting OAuth 2.0 credentials")
+		}
 	}
-
-	s.logger.Debugf("client_id %s and client_secret successfully unregistered in Hydra", clientID)
 	return nil
 }
 
-func (s *service) doRequest(ctx context.Context, method string, endpoint string, body io.Reader) (*http.Response, func(body io.ReadCloser), error) {
-	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+func (s *service) registerClient(ctx context.Context, objectType model.SystemAuthReferenceObjectType, auth ClientAuthentication) (*ClientCredentials, error) {
+	scopes, err := s.getClientCredentialScopes(objectType)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "while creating new request")
+		if !model.IsIntegrationSystemNoTenantFlow(err, objectType) {
+			return nil, err
+		}
 	}
+	s.logger.Debugf("Fetched client credential scopes: %s for %s", scopes, objectType)
 
-	req.Header.Set("Accept", applicationJSONType)
-	req.Header.Set("Content-Type", applicationJSONType)
+	clientID := s.uidService.Generate()
+	return s.registry.RegisterClient(ctx, clientID, scopes, auth)
+}
 
-	resp, err := s.httpCli.Do(req)
+func (s *service) getClientCredentialScopes(objType model.SystemAuthReferenceObjectType) ([]string, error) {
+	scopes, err := s.scopeCfgProvider.GetRequiredScopes(s.buildPath(objType))
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "while doing request to %s", s.clientEndpoint)
-	}
-
-	closeBodyFn := func(body io.ReadCloser) {
-		if body == nil {
-			return
-		}
-		_, err = io.Copy(ioutil.Discard, resp.Body)
-		if err != nil {
-			s.logger.Error(err)
-		}
-
-		err := body.Close()
-		if err != nil {
-			s.logger.Error(err)
-		}
+		return nil, errors.Wrapf(err, "while getting scopes for registering Client Credentials for %s", objType)
 	}
 
-	return resp, closeBodyFn, nil
+	return scopes, nil
 }
 
 func (s *service) buildPath(objType model.SystemAuthReferenceObjectType) string {
@@ -2,11 +2,8 @@ package oauth20_test
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
-	"net/http/httptest"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
@@ -28,11 +25,6 @@ func TestService_CreateClient(t *testing.T) {
 		ClientSecret: "c-secret",
 		URL:          publicEndpoint,
 	}
-	expectedReqBody := map[string]interface{}{
-		"grant_types": []interface{}{"client_credentials"},
-		"client_id":   "foo",
-		"scope":       "foo bar baz",
-	}
 	testErr := errors.New("test err")
 
 	testCases := []struct {
@@ -41,10 +33,7 @@ func TestService_CreateClient(t *testing.T) {
 		ExpectedError      error
 		ScopeCfgProviderFn func() *automock.ScopeCfgProvider
 		UIDServiceFn       func() *automock.UIDService
-		HTTPServerFn       func(t *testing.T) *httptest.Server
-		Config             oauth20.Config
-		Request            *http.Request
-		Response           *http.Response
+		RegistryFn         func() *automock.OAuthClientRegistry
 	}{
 		{
 			Name:           "Success",
@@ -60,31 +49,15 @@ func TestService_CreateClient(t *testing.T) {
 				scopeCfgProvider.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.integration_system").Return(scopes, nil).Once()
 				return scopeCfgProvider
 			},
-			HTTPServerFn: fixSuccessCreateClientHTTPServer(expectedReqBody),
-		},
-		{
-			Name:          "Error - Response Status Code",
-			ExpectedError: errors.New("invalid HTTP status code: received: 500, expected 201"),
-			ScopeCfgProviderFn: func() *automock.ScopeCfgProvider {
-				scopeCfgProvider := &automock.ScopeCfgProvider{}
-				scopeCfgProvider.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.integration_system").Return(scopes, nil).Once()
-				return scopeCfgProvider
-			},
-			UIDServiceFn: func() *automock.UIDService {
-				uidSvc := &automock.UIDService{}
-				uidSvc.On("Generate").Return(id).Once()
-				return uidSvc
-			},
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					w.WriteHeader(http.StatusInternalServerError)
-				}))
-				return tc
+			RegistryFn: func() *automock.OAuthClientRegistry {
+				registry := &automock.OAuthClientRegistry{}
+				registry.On("RegisterClient", context.TODO(), id, scopes, oauth20.ClientAuthentication{Method: oauth20.ClientSecretBasicAuthMethod}).Return(&oauth20.ClientCredentials{ClientID: id, ClientSecret: "c-secret"}, nil).Once()
+				return registry
 			},
 		},
 		{
-			Name:          "Error - Invalid body",
-			ExpectedError: errors.New("while decoding response body: invalid character 'D' looking for beginning of value"),
+			Name:          "Error - Client registration",
+			ExpectedError: errors.New("while registering client credentials: test err"),
 			ScopeCfgProviderFn: func() *automock.ScopeCfgProvider {
 				scopeCfgProvider := &automock.ScopeCfgProvider{}
 				scopeCfgProvider.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.integration_system").Return(scopes, nil).Once()
@@ -95,32 +68,10 @@ func TestService_CreateClient(t *testing.T) {
 				uidSvc.On("Generate").Return(id).Once()
 				return uidSvc
 			},
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					w.WriteHeader(http.StatusCreated)
-					_, err := w.Write([]byte("Dd"))
-					assert.NoError(t, err)
-				}))
-				return tc
-			},
-		},
-		{
-			Name:          "Error - HTTP call error",
-			ExpectedError: errors.New("connect: connection refused"),
-			ScopeCfgProviderFn: func() *automock.ScopeCfgProvider {
-				scopeCfgProvider := &automock.ScopeCfgProvider{}
-				scopeCfgProvider.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.integration_system").Return(scopes, nil).Once()
-				return scopeCfgProvider
-			},
-			UIDServiceFn: func() *automock.UIDService {
-				uidSvc := &automock.UIDService{}
-				uidSvc.On("Generate").Return(id).Once()
-				return uidSvc
-			},
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
-				tc.Close()
-				return tc
+			RegistryFn: func() *automock.OAuthClientRegistry {
+				registry := &automock.OAuthClientRegistry{}
+				registry.On("RegisterClient", context.TODO(), id, scopes, oauth20.ClientAuthentication{Method: oauth20.ClientSecretBasicAuthMethod}).Return(nil, testErr).Once()
+				return registry
 			},
 		},
 		{
@@ -135,8 +86,8 @@ func TestService_CreateClient(t *testing.T) {
 				uidSvc := &automock.UIDService{}
 				return uidSvc
 			},
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				return nil
+			RegistryFn: func() *automock.OAuthClientRegistry {
+				return &automock.OAuthClientRegistry{}
 			},
 		},
 	}
@@ -148,20 +99,10 @@ func TestService_CreateClient(t *testing.T) {
 			defer scopeCfgProvider.AssertExpectations(t)
 			uidService := testCase.UIDServiceFn()
 			defer uidService.AssertExpectations(t)
-			httpServer := testCase.HTTPServerFn(t)
-			defer func() {
-				if httpServer == nil {
-					return
-				}
-
-				httpServer.Close()
-			}()
-
-			var url string
-			if httpServer != nil {
-				url = httpServer.URL
-			}
-			svc := oauth20.NewService(scopeCfgProvider, uidService, oauth20.Config{ClientEndpoint: url, PublicAccessTokenEndpoint: publicEndpoint}, &http.Client{})
+			registry := testCase.RegistryFn()
+			defer registry.AssertExpectations(t)
+
+			svc := oauth20.NewService(scopeCfgProvider, uidService, oauth20.Config{PublicAccessTokenEndpoint: publicEndpoint}, registry)
 
 			// when
 			oauthData, err := svc.CreateClientCredentials(ctx, objType)
@@ -179,51 +120,135 @@ func TestService_CreateClient(t *testing.T) {
 
 }
 
+func TestService_RegisterClient(t *testing.T) {
+	// given
+	publicEndpoint := "accessTokenURL"
+	id := "foo"
+	objType := model.ApplicationReference
+	scopes := []string{"foo", "bar"}
+	jwks := `{"keys":[]}`
+	jwksURI := "http://foo.bar/jwks"
+	testErr := errors.New("test err")
+
+	testCases := []struct {
+		Name           string
+		Input          model.CredentialDataInput
+		ExpectedAuth   oauth20.ClientAuthentication
+		RegistryErr    error
+		ExpectedResult *model.CredentialDataInput
+		ExpectedError  error
+	}{
+		{
+			Name:         "Success - private key JWT with JWKS",
+			Input:        model.CredentialDataInput{PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{JWKS: &jwks}},
+			ExpectedAuth: oauth20.ClientAuthentication{Method: oauth20.PrivateKeyJWTAuthMethod, JWKS: []byte(jwks)},
+			ExpectedResult: &model.CredentialDataInput{PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{
+				ClientID: id,
+				JWKS:     &jwks,
+				URL:      publicEndpoint,
+			}},
+		},
+		{
+			Name:         "Success - private key JWT with JWKS URI",
+			Input:        model.CredentialDataInput{PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{JWKSURI: &jwksURI}},
+			ExpectedAuth: oauth20.ClientAuthentication{Method: oauth20.PrivateKeyJWTAuthMethod, JWKSURI: jwksURI},
+			ExpectedResult: &model.CredentialDataInput{PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{
+				ClientID: id,
+				JWKSURI:  &jwksURI,
+				URL:      publicEndpoint,
+			}},
+		},
+		{
+			Name:         "Success - certificate",
+			Input:        model.CredentialDataInput{Certificate: &model.CertificateCredentialDataInput{Subject: "CN=foo", Thumbprint: "thumbprint"}},
+			ExpectedAuth: oauth20.ClientAuthentication{Method: oauth20.TLSClientAuthMethod, SubjectDN: "CN=foo"},
+			ExpectedResult: &model.CredentialDataInput{Certificate: &model.CertificateCredentialDataInput{
+				ClientID:   id,
+				Subject:    "CN=foo",
+				Thumbprint: "thumbprint",
+				URL:        publicEndpoint,
+			}},
+		},
+		{
+			Name:          "Error - Client registration",
+			Input:         model.CredentialDataInput{Certificate: &model.CertificateCredentialDataInput{Subject: "CN=foo", Thumbprint: "thumbprint"}},
+			ExpectedAuth:  oauth20.ClientAuthentication{Method: oauth20.TLSClientAuthMethod, SubjectDN: "CN=foo"},
+			RegistryErr:   testErr,
+			ExpectedError: errors.New("while registering client with tls_client_auth authentication: test err"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := context.TODO()
+			scopeCfgProvider := &automock.ScopeCfgProvider{}
+			defer scopeCfgProvider.AssertExpectations(t)
+			scopeCfgProvider.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return(scopes, nil).Once()
+			uidService := &automock.UIDService{}
+			defer uidService.AssertExpectations(t)
+			uidService.On("Generate").Return(id).Once()
+			registry := &automock.OAuthClientRegistry{}
+			defer registry.AssertExpectations(t)
+			if testCase.RegistryErr != nil {
+				registry.On("RegisterClient", ctx, id, scopes, testCase.ExpectedAuth).Return(nil, testCase.RegistryErr).Once()
+			} else {
+				registry.On("RegisterClient", ctx, id, scopes, testCase.ExpectedAuth).Return(&oauth20.ClientCredentials{ClientID: id}, nil).Once()
+			}
+
+			svc := oauth20.NewService(scopeCfgProvider, uidService, oauth20.Config{PublicAccessTokenEndpoint: publicEndpoint}, registry)
+
+			// when
+			result, err := svc.RegisterClient(ctx, objType, testCase.Input)
+
+			// then
+			if testCase.ExpectedError == nil {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			}
+		})
+	}
+
+	t.Run("Error - No credential", func(t *testing.T) {
+		svc := oauth20.NewService(&automock.ScopeCfgProvider{}, &automock.UIDService{}, oauth20.Config{}, &automock.OAuthClientRegistry{})
+
+		// when
+		_, err := svc.RegisterClient(context.TODO(), objType, model.CredentialDataInput{})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "private key JWT or certificate credential has to be provided")
+	})
+}
+
 func TestService_DeleteClientCredentials(t *testing.T) {
 	// given
 	id := "foo"
+	testErr := errors.New("test err")
+
 	testCases := []struct {
 		Name          string
 		ExpectedError error
-		HTTPServerFn  func(t *testing.T) *httptest.Server
-		Config        oauth20.Config
-		Request       *http.Request
-		Response      *http.Response
+		RegistryFn    func() *automock.OAuthClientRegistry
 	}{
 		{
 			Name:          "Success",
 			ExpectedError: nil,
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					assert.Equal(t, "/foo", r.URL.Path)
-					defer func() {
-						err := r.Body.Close()
-						assert.NoError(t, err)
-					}()
-					assert.Equal(t, http.MethodDelete, r.Method)
-					assert.Equal(t, "application/json", r.Header.Get("Accept"))
-					w.WriteHeader(http.StatusNoContent)
-				}))
-				return tc
+			RegistryFn: func() *automock.OAuthClientRegistry {
+				registry := &automock.OAuthClientRegistry{}
+				registry.On("UnregisterClient", context.TODO(), id).Return(nil).Once()
+				return registry
 			},
 		},
 		{
-			Name:          "Error - Response Status Code",
-			ExpectedError: errors.New("invalid HTTP status code: received: 500, expected 204"),
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					w.WriteHeader(http.StatusInternalServerError)
-				}))
-				return tc
-			},
-		},
-		{
-			Name:          "Error - HTTP call error",
-			ExpectedError: errors.New("connect: connection refused"),
-			HTTPServerFn: func(t *testing.T) *httptest.Server {
-				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
-				tc.Close()
-				return tc
+			Name:          "Error - Client unregistration",
+			ExpectedError: errors.New("while unregistering client credentials with client ID foo: test err"),
+			RegistryFn: func() *automock.OAuthClientRegistry {
+				registry := &automock.OAuthClientRegistry{}
+				registry.On("UnregisterClient", context.TODO(), id).Return(testErr).Once()
+				return registry
 			},
 		},
 	}
@@ -231,14 +256,10 @@ func TestService_DeleteClientCredentials(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			ctx := context.TODO()
-			httpServer := testCase.HTTPServerFn(t)
-			defer httpServer.Close()
+			registry := testCase.RegistryFn()
+			defer registry.AssertExpectations(t)
 
-			var url string
-			if httpServer != nil {
-				url = httpServer.URL
-			}
-			svc := oauth20.NewService(nil, nil, oauth20.Config{ClientEndpoint: url}, &http.Client{})
+			svc := oauth20.NewService(nil, nil, oauth20.Config{}, registry)
 
 			// when
 			err := svc.DeleteClientCredentials(ctx, id)
@@ -255,28 +276,43 @@ func TestService_DeleteClientCredentials(t *testing.T) {
 	}
 }
 
-func fixSuccessCreateClientHTTPServer(expectedReqBody map[string]interface{}) func(t *testing.T) *httptest.Server {
-	return func(t *testing.T) *httptest.Server {
-		tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				err := r.Body.Close()
-				assert.NoError(t, err)
-			}()
-			assert.Equal(t, http.MethodPost, r.Method)
-			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
-
-			var reqBody map[string]interface{}
-			err := json.NewDecoder(r.Body).Decode(&reqBody)
-			require.NoError(t, err)
-			assert.Equal(t, expectedReqBody, reqBody)
-
-			res := map[string]interface{}{
-				"client_secret": "c-secret",
-			}
-			w.WriteHeader(http.StatusCreated)
-			err = json.NewEncoder(w).Encode(&res)
-			require.NoError(t, err)
-		}))
-		return tc
+func TestService_DeleteMultipleClientCredentials(t *testing.T) {
+	// given
+	ctx := context.TODO()
+	auths := []model.SystemAuth{
+		{ID: "foo", Value: &model.Auth{Credential: model.CredentialData{Oauth: &model.OAuthCredentialData{ClientID: "foo"}}}},
+		{ID: "bar", Value: &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "bar"}}}},
+		{ID: "baz"},
+		{ID: "qux", Value: &model.Auth{Credential: model.CredentialData{Certificate: &model.CertificateCredentialData{ClientID: "qux"}}}},
 	}
+
+	t.Run("Success", func(t *testing.T) {
+		registry := &automock.OAuthClientRegistry{}
+		registry.On("UnregisterClient", ctx, "foo").Return(nil).Once()
+		registry.On("UnregisterClient", ctx, "qux").Return(nil).Once()
+		defer registry.AssertExpectations(t)
+
+		svc := oauth20.NewService(nil, nil, oauth20.Config{}, registry)
+
+		// when
+		err := svc.DeleteMultipleClientCredentials(ctx, auths)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Error - Client unregistration", func(t *testing.T) {
+		registry := &automock.OAuthClientRegistry{}
+		registry.On("UnregisterClient", ctx, "foo").Return(errors.New("test err")).Once()
+		defer registry.AssertExpectations(t)
+
+		svc := oauth20.NewService(nil, nil, oauth20.Config{}, registry)
+
+		// when
+		err := svc.DeleteMultipleClientCredentials(ctx, auths)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while deleting OAuth 2.0 credentials")
+	})
 }
@@ -11,20 +11,20 @@ type TokenService struct {
 	mock.Mock
 }
 
-// GenerateOneTimeToken provides a mock function with given fields: ctx, runtimeID, tokenType
-func (_m *TokenService) GenerateOneTimeToken(ctx context.Context, runtimeID string, tokenType model.SystemAuthReferenceObjectType) (model.OneTimeToken, error) {
-	ret := _m.Called(ctx, runtimeID, tokenType)
+// GenerateOneTimeToken provides a mock function with given fields: ctx, runtimeID, tokenType, allowedSourceCIDRs
+func (_m *TokenService) GenerateOneTimeToken(ctx context.Context, runtimeID string, tokenType model.SystemAuthReferenceObjectType, allowedSourceCIDRs []string) (model.OneTimeToken, error) {
+	ret := _m.Called(ctx, runtimeID, tokenType, allowedSourceCIDRs)
 
 	var r0 model.OneTimeToken
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.SystemAuthReferenceObjectType) model.OneTimeToken); ok {
-		r0 = rf(ctx, runtimeID, tokenType)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SystemAuthReferenceObjectType, []string) model.OneTimeToken); ok {
+		r0 = rf(ctx, runtimeID, tokenType, allowedSourceCIDRs)
 	} else {
 		r0 = ret.Get(0).(model.OneTimeToken)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, model.SystemAuthReferenceObjectType) error); ok {
-		r1 = rf(ctx, runtimeID, tokenType)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SystemAuthReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, runtimeID, tokenType, allowedSourceCIDRs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,11 +37,19 @@ func (c converter) ToGraphQLForApplication(model model.OneTimeToken) (graphql.On
 	}
 	legacyConnectorURL.RawQuery += fmt.Sprintf("token=%s", model.Token)
 
+	var expiresAt *graphql.Timestamp
+	if model.ExpiresAt != nil {
+		timestamp := graphql.Timestamp(*model.ExpiresAt)
+		expiresAt = &timestamp
+	}
+
 	return graphql.OneTimeTokenForApplication{
 		TokenWithURL: graphql.TokenWithURL{
 			Token:        model.Token,
 			ConnectorURL: model.ConnectorURL,
 		},
 		LegacyConnectorURL: legacyConnectorURL.String(),
+		ExpiresAt:          expiresAt,
+		Metadata:           model.Metadata,
 	}, nil
 }
@@ -3,10 +3,13 @@ package onetimetoken_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -41,6 +44,21 @@ func TestConverter_ToGraphQLForApplication(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%s?token=%s", validLegacyConnectorURL, token), graphqlToken.LegacyConnectorURL)
 }
 
+func TestConverter_ToGraphQLForApplication_WithExpiryAndMetadata(t *testing.T) {
+	//GIVEN
+	expiresAt := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	metadata := map[string]interface{}{"system": "ticketing"}
+	tokenModel := model.OneTimeToken{Token: token, ConnectorURL: validConnectorURL, ExpiresAt: &expiresAt, Metadata: metadata}
+	conv := onetimetoken.NewConverter(validLegacyConnectorURL)
+	//WHEN
+	graphqlToken, err := conv.ToGraphQLForApplication(tokenModel)
+	//THEN
+	assert.NoError(t, err)
+	require.NotNil(t, graphqlToken.ExpiresAt)
+	assert.Equal(t, expiresAt, time.Time(*graphqlToken.ExpiresAt))
+	assert.Equal(t, graphql.Labels(metadata), graphqlToken.Metadata)
+}
+
 func TestConverter_ToGraphQLForApplication_WithLegacyURLWithQueryParam(t *testing.T) {
 	//GIVEN
 	tokenModel := model.OneTimeToken{Token: token, ConnectorURL: validConnectorURL}
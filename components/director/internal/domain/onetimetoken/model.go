@@ -20,3 +20,10 @@ func (t *ConnectorTokenModel) Token(tokenType model.SystemAuthReferenceObjectTyp
 	}
 	return ""
 }
+
+// TokenBinding is the context the token is requested in. The Connector embeds it in the issued client certificate.
+type TokenBinding struct {
+	Tenant             string   `json:"tenant,omitempty"`
+	CreatedBy          string   `json:"createdBy,omitempty"`
+	AllowedSourceCIDRs []string `json:"allowedSourceCIDRs,omitempty"`
+}
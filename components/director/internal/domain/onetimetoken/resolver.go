@@ -15,7 +15,7 @@ import (
 
 //go:generate mockery -name=TokenService -output=automock -outpkg=automock -case=underscore
 type TokenService interface {
-	GenerateOneTimeToken(ctx context.Context, runtimeID string, tokenType model.SystemAuthReferenceObjectType) (model.OneTimeToken, error)
+	GenerateOneTimeToken(ctx context.Context, runtimeID string, tokenType model.SystemAuthReferenceObjectType, allowedSourceCIDRs []string) (model.OneTimeToken, error)
 }
 
 //go:generate mockery -name=TokenConverter -output=automock -outpkg=automock -case=underscore
@@ -34,7 +34,7 @@ func NewTokenResolver(transact persistence.Transactioner, svc TokenService, conv
 	return &Resolver{transact: transact, svc: svc, conv: conv}
 }
 
-func (r *Resolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string) (*graphql.OneTimeTokenForRuntime, error) {
+func (r *Resolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string, allowedSourceCIDRs []string) (*graphql.OneTimeTokenForRuntime, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -42,7 +42,7 @@ func (r *Resolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string)
 	defer r.transact.RollbackUnlessCommitted(tx)
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	token, err := r.svc.GenerateOneTimeToken(ctx, id, model.RuntimeReference)
+	token, err := r.svc.GenerateOneTimeToken(ctx, id, model.RuntimeReference, allowedSourceCIDRs)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (r *Resolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string)
 	return &gqlToken, nil
 }
 
-func (r *Resolver) RequestOneTimeTokenForApplication(ctx context.Context, id string) (*graphql.OneTimeTokenForApplication, error) {
+func (r *Resolver) RequestOneTimeTokenForApplication(ctx context.Context, id string, allowedSourceCIDRs []string) (*graphql.OneTimeTokenForApplication, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -63,7 +63,7 @@ func (r *Resolver) RequestOneTimeTokenForApplication(ctx context.Context, id str
 	defer r.transact.RollbackUnlessCommitted(tx)
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	token, err := r.svc.GenerateOneTimeToken(ctx, id, model.ApplicationReference)
+	token, err := r.svc.GenerateOneTimeToken(ctx, id, model.ApplicationReference, allowedSourceCIDRs)
 	if err != nil {
 		return nil, err
 	}
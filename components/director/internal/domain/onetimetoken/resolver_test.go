@@ -27,14 +27,14 @@ func TestResolver_GenerateOneTimeTokenForApp(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference).Return(tokenModel, nil)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference, []string(nil)).Return(tokenModel, nil)
 		conv := &automock.TokenConverter{}
 		conv.On("ToGraphQLForApplication", tokenModel).Return(expectedToken, nil)
 		persist, transact := txGen.ThatSucceeds()
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		oneTimeToken, err := r.RequestOneTimeTokenForApplication(ctx, appID)
+		oneTimeToken, err := r.RequestOneTimeTokenForApplication(ctx, appID, nil)
 
 		//THEN
 		require.NoError(t, err)
@@ -46,13 +46,13 @@ func TestResolver_GenerateOneTimeTokenForApp(t *testing.T) {
 	t.Run("Error - transaction commit failed", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference).Return(tokenModel, nil)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference, []string(nil)).Return(tokenModel, nil)
 		persist, transact := txGen.ThatFailsOnCommit()
 		conv := &automock.TokenConverter{}
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForApplication(ctx, appID)
+		_, err := r.RequestOneTimeTokenForApplication(ctx, appID, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -62,13 +62,13 @@ func TestResolver_GenerateOneTimeTokenForApp(t *testing.T) {
 	t.Run("Error - service return error", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference).Return(tokenModel, testErr)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference, []string(nil)).Return(tokenModel, testErr)
 		persist, transact := txGen.ThatDoesntExpectCommit()
 		conv := &automock.TokenConverter{}
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForApplication(ctx, appID)
+		_, err := r.RequestOneTimeTokenForApplication(ctx, appID, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -83,7 +83,7 @@ func TestResolver_GenerateOneTimeTokenForApp(t *testing.T) {
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForApplication(ctx, appID)
+		_, err := r.RequestOneTimeTokenForApplication(ctx, appID, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -93,14 +93,14 @@ func TestResolver_GenerateOneTimeTokenForApp(t *testing.T) {
 	t.Run("Error - converter returns error", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference).Return(tokenModel, nil)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), appID, model.ApplicationReference, []string(nil)).Return(tokenModel, nil)
 		conv := &automock.TokenConverter{}
 		conv.On("ToGraphQLForApplication", tokenModel).Return(graphql.OneTimeTokenForApplication{}, errors.New("some-error"))
 		persist, transact := txGen.ThatSucceeds()
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForApplication(ctx, appID)
+		_, err := r.RequestOneTimeTokenForApplication(ctx, appID, nil)
 
 		//THEN
 		require.EqualError(t, err, "while converting one-time token to graphql: some-error")
@@ -118,14 +118,14 @@ func TestResolver_GenerateOneTimeTokenForRuntime(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeID, model.RuntimeReference).Return(tokenModel, nil)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeID, model.RuntimeReference, []string(nil)).Return(tokenModel, nil)
 		persist, transact := txGen.ThatSucceeds()
 		conv := &automock.TokenConverter{}
 		conv.On("ToGraphQLForRuntime", tokenModel).Return(expectedToken)
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		oneTimeToken, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID)
+		oneTimeToken, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID, nil)
 
 		//THEN
 		require.NoError(t, err)
@@ -140,13 +140,13 @@ func TestResolver_GenerateOneTimeTokenForRuntime(t *testing.T) {
 	t.Run("Error - transaction commit failed", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeID, model.RuntimeReference).Return(tokenModel, nil)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeID, model.RuntimeReference, []string(nil)).Return(tokenModel, nil)
 		persist, transact := txGen.ThatFailsOnCommit()
 		conv := &automock.TokenConverter{}
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID)
+		_, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -159,13 +159,13 @@ func TestResolver_GenerateOneTimeTokenForRuntime(t *testing.T) {
 	t.Run("Error - service return error", func(t *testing.T) {
 		//GIVEN
 		svc := &automock.TokenService{}
-		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeID, model.RuntimeReference).Return(tokenModel, testErr)
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeID, model.RuntimeReference, []string(nil)).Return(tokenModel, testErr)
 		persist, transact := txGen.ThatDoesntExpectCommit()
 		conv := &automock.TokenConverter{}
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID)
+		_, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -183,7 +183,7 @@ func TestResolver_GenerateOneTimeTokenForRuntime(t *testing.T) {
 		r := onetimetoken.NewTokenResolver(transact, svc, conv)
 
 		//WHEN
-		_, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID)
+		_, err := r.RequestOneTimeTokenForRuntime(ctx, runtimeID, nil)
 
 		//THEN
 		require.Error(t, err)
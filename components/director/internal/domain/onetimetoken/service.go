@@ -5,12 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/client"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 
 	"github.com/avast/retry-go"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pairing"
 	gcli "github.com/machinebox/graphql"
@@ -19,14 +23,14 @@ import (
 )
 
 const requestForRuntime = `
-		mutation { generateRuntimeToken (authID:"%s")
+		mutation ($binding: TokenBindingInput) { generateRuntimeToken (authID:"%s", binding: $binding)
 		  {
 			token
 		  }
 		}`
 
 const requestForApplication = `
-		mutation { generateApplicationToken (authID:"%s")
+		mutation ($binding: TokenBindingInput) { generateApplicationToken (authID:"%s", binding: $binding)
 		  {
 			token
 		  }
@@ -50,6 +54,7 @@ type ApplicationConverter interface {
 //go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
 type ApplicationService interface {
 	Get(ctx context.Context, id string) (*model.Application, error)
+	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
 }
 
 //go:generate mockery -name=ExternalTenantsService -output=automock -outpkg=automock -case=underscore
@@ -77,7 +82,11 @@ func NewTokenService(gcli GraphQLClient, sysAuthSvc SystemAuthService, appSvc Ap
 	return &service{cli: gcli, connectorURL: connectorURL, sysAuthSvc: sysAuthSvc, intSystemToAdapterMapping: intSystemToAdapterMapping, appSvc: appSvc, appConverter: appConverter, extTenantsSvc: extTenantsSvc, doer: doer}
 }
 
-func (s service) GenerateOneTimeToken(ctx context.Context, id string, tokenType model.SystemAuthReferenceObjectType) (model.OneTimeToken, error) {
+func (s service) GenerateOneTimeToken(ctx context.Context, id string, tokenType model.SystemAuthReferenceObjectType, allowedSourceCIDRs []string) (model.OneTimeToken, error) {
+	if err := validateSourceCIDRs(allowedSourceCIDRs); err != nil {
+		return model.OneTimeToken{}, err
+	}
+
 	sysAuthID, err := s.sysAuthSvc.Create(ctx, tokenType, id, nil)
 	if err != nil {
 		return model.OneTimeToken{}, errors.Wrap(err, "while creating System Auth")
@@ -92,12 +101,15 @@ func (s service) GenerateOneTimeToken(ctx context.Context, id string, tokenType
 
 		if app.IntegrationSystemID != nil {
 			if adapterURL, ok := s.intSystemToAdapterMapping[*app.IntegrationSystemID]; ok {
+				if len(allowedSourceCIDRs) > 0 {
+					return model.OneTimeToken{}, apperrors.NewInvalidDataError("allowed source CIDRs are not supported for applications paired through integration system [%s]", *app.IntegrationSystemID)
+				}
 				return s.getTokenFromAdapter(ctx, adapterURL, *app)
 			}
 		}
 	}
 
-	token, err := s.getOneTimeToken(ctx, sysAuthID, tokenType)
+	token, err := s.getOneTimeToken(ctx, sysAuthID, tokenType, allowedSourceCIDRs)
 	if err != nil {
 		return model.OneTimeToken{}, errors.Wrapf(err, "while generating onetime token for %s", tokenType)
 	}
@@ -116,11 +128,25 @@ func (s *service) getTokenFromAdapter(ctx context.Context, adapterURL string, ap
 		logrus.Infof("unable to provide client_user for internal tenant [%s] with corresponding external tenant [%s]", app.Tenant, extTenant)
 	}
 
+	appLabels, err := s.appSvc.ListLabels(ctx, app.ID)
+	if err != nil {
+		return model.OneTimeToken{}, errors.Wrapf(err, "while listing labels for application [%s]", app.ID)
+	}
+
+	labels := make(graphql.Labels, len(appLabels))
+	for key, label := range appLabels {
+		labels[key] = label.Value
+	}
+
 	graphqlApp := s.appConverter.ToGraphQL(&app)
 	data := pairing.RequestData{
 		Application: *graphqlApp,
 		Tenant:      extTenant,
 		ClientUser:  clientUser,
+		Labels:      labels,
+	}
+	if templateName, ok := labels[model.ApplicationTemplateNameKey].(string); ok {
+		data.ApplicationTemplateName = templateName
 	}
 
 	asJSON, err := json.Marshal(data)
@@ -128,7 +154,7 @@ func (s *service) getTokenFromAdapter(ctx context.Context, adapterURL string, ap
 		return model.OneTimeToken{}, errors.Wrap(err, "while marshaling data for adapter")
 	}
 
-	var externalToken string
+	var externalToken pairing.ResponseData
 	err = retry.Do(func() error {
 		buf := bytes.NewBuffer(asJSON)
 		req, err := http.NewRequestWithContext(ctx, http.MethodPost, adapterURL, buf)
@@ -157,18 +183,21 @@ func (s *service) getTokenFromAdapter(ctx context.Context, adapterURL string, ap
 			return errors.Wrap(err, "while decoding response from Adapter")
 		}
 
-		externalToken = responseBody.Token
+		externalToken = responseBody
 		return nil
 	}, retry.Attempts(3))
 	if err != nil {
 		return model.OneTimeToken{}, errors.Wrapf(err, "while calling adapter [%s] for application [%s] with integration system [%s]", adapterURL, app.ID, *app.IntegrationSystemID)
 	}
 	return model.OneTimeToken{
-		Token: externalToken,
+		Token:        externalToken.Token,
+		ConnectorURL: externalToken.ConnectorURL,
+		ExpiresAt:    externalToken.ExpiresAt,
+		Metadata:     externalToken.Metadata,
 	}, nil
 }
 
-func (s service) getOneTimeToken(ctx context.Context, id string, tokenType model.SystemAuthReferenceObjectType) (string, error) {
+func (s service) getOneTimeToken(ctx context.Context, id string, tokenType model.SystemAuthReferenceObjectType, allowedSourceCIDRs []string) (string, error) {
 	var req *gcli.Request
 
 	switch tokenType {
@@ -180,6 +209,8 @@ func (s service) getOneTimeToken(ctx context.Context, id string, tokenType model
 		return "", errors.Errorf("cannot generate token for %T", tokenType)
 	}
 
+	req.Var("binding", newTokenBinding(ctx, allowedSourceCIDRs))
+
 	output := ConnectorTokenModel{}
 	err := s.cli.Run(ctx, req, &output)
 	if err != nil {
@@ -188,3 +219,35 @@ func (s service) getOneTimeToken(ctx context.Context, id string, tokenType model
 
 	return output.Token(tokenType), err
 }
+
+func newTokenBinding(ctx context.Context, allowedSourceCIDRs []string) TokenBinding {
+	binding := TokenBinding{
+		AllowedSourceCIDRs: allowedSourceCIDRs,
+	}
+
+	if tnt, err := tenant.LoadFromContext(ctx); err == nil {
+		binding.Tenant = tnt
+	}
+
+	if clientUser, err := client.LoadFromContext(ctx); err == nil {
+		binding.CreatedBy = clientUser
+	} else if consumerInfo, err := consumer.LoadFromContext(ctx); err == nil {
+		binding.CreatedBy = consumerInfo.ConsumerID
+	}
+
+	return binding
+}
+
+// validateSourceCIDRs checks that allowed sources are either IP addresses or CIDRs, as expected by the Connector
+func validateSourceCIDRs(allowedSourceCIDRs []string) error {
+	for _, source := range allowedSourceCIDRs {
+		if _, _, err := net.ParseCIDR(source); err == nil {
+			continue
+		}
+		if net.ParseIP(source) == nil {
+			return apperrors.NewInvalidDataError("allowed source [%s] is neither an IP address nor a CIDR", source)
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/client"
 
@@ -19,6 +20,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	gcli "github.com/machinebox/graphql"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -32,12 +34,17 @@ func TestTokenService_GetOneTimeTokenForRuntime(t *testing.T) {
 	runtimeID := "98cb3b05-0f27-43ea-9249-605ac74a6cf0"
 	authID := "90923fe8-91bd-4070-aa31-f2ebb07a0963"
 
-	expectedRuntimeRequest := gcli.NewRequest(fmt.Sprintf(`
-		mutation { generateRuntimeToken (authID:"%s")
+	newExpectedRuntimeRequest := func(binding onetimetoken.TokenBinding) *gcli.Request {
+		req := gcli.NewRequest(fmt.Sprintf(`
+		mutation ($binding: TokenBindingInput) { generateRuntimeToken (authID:"%s", binding: $binding)
 		  {
 			token
 		  }
 		}`, authID))
+		req.Var("binding", binding)
+		return req
+	}
+	expectedRuntimeRequest := newExpectedRuntimeRequest(onetimetoken.TokenBinding{})
 
 	t.Run("Success - token for runtime", func(t *testing.T) {
 		//GIVEN
@@ -55,7 +62,61 @@ func TestTokenService_GetOneTimeTokenForRuntime(t *testing.T) {
 		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, nil, nil, nil, nil, URL, nil)
 
 		//WHEN
-		authToken, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference)
+		authToken, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference, nil)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, expectedToken, authToken.Token)
+		cli.AssertExpectations(t)
+		sysAuthSvc.AssertExpectations(t)
+	})
+
+	t.Run("Success - token for runtime bound to tenant and client user", func(t *testing.T) {
+		//GIVEN
+		ctx := tenant.SaveToContext(context.TODO(), "internal-tenant", "external-tenant")
+		ctx = client.SaveToContext(ctx, "admin")
+		cli := &automock.GraphQLClient{}
+		expectedToken := "token"
+
+		expected := onetimetoken.ConnectorTokenModel{RuntimeToken: onetimetoken.ConnectorToken{Token: expectedToken}}
+		expectedRequest := newExpectedRuntimeRequest(onetimetoken.TokenBinding{Tenant: "internal-tenant", CreatedBy: "admin"})
+		cli.On("Run", ctx, expectedRequest, &onetimetoken.ConnectorTokenModel{}).
+			Run(generateFakeToken(t, expected)).Return(nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("Create", ctx, model.RuntimeReference, runtimeID, (*model.AuthInput)(nil)).
+			Return(authID, nil)
+		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, nil, nil, nil, nil, URL, nil)
+
+		//WHEN
+		authToken, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference, nil)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, expectedToken, authToken.Token)
+		cli.AssertExpectations(t)
+		sysAuthSvc.AssertExpectations(t)
+	})
+
+	t.Run("Success - token for runtime restricted to source CIDRs", func(t *testing.T) {
+		//GIVEN
+		ctx := context.TODO()
+		cli := &automock.GraphQLClient{}
+		expectedToken := "token"
+		allowedSourceCIDRs := []string{"10.0.0.0/8", "192.168.0.1"}
+
+		expected := onetimetoken.ConnectorTokenModel{RuntimeToken: onetimetoken.ConnectorToken{Token: expectedToken}}
+		expectedRequest := newExpectedRuntimeRequest(onetimetoken.TokenBinding{AllowedSourceCIDRs: allowedSourceCIDRs})
+		cli.On("Run", ctx, expectedRequest, &onetimetoken.ConnectorTokenModel{}).
+			Run(generateFakeToken(t, expected)).Return(nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("Create", ctx, model.RuntimeReference, runtimeID, (*model.AuthInput)(nil)).
+			Return(authID, nil)
+		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, nil, nil, nil, nil, URL, nil)
+
+		//WHEN
+		authToken, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference, allowedSourceCIDRs)
 
 		//THEN
 		require.NoError(t, err)
@@ -64,6 +125,22 @@ func TestTokenService_GetOneTimeTokenForRuntime(t *testing.T) {
 		sysAuthSvc.AssertExpectations(t)
 	})
 
+	t.Run("Error - invalid allowed source CIDR", func(t *testing.T) {
+		ctx := context.TODO()
+		cli := &automock.GraphQLClient{}
+		sysAuthSvc := &automock.SystemAuthService{}
+		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, nil, nil, nil, nil, URL, nil)
+
+		//WHEN
+		_, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference, []string{"10.0.0.0/33"})
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "allowed source [10.0.0.0/33] is neither an IP address nor a CIDR")
+		cli.AssertExpectations(t)
+		sysAuthSvc.AssertExpectations(t)
+	})
+
 	t.Run("Error - generating token failed", func(t *testing.T) {
 		ctx := context.TODO()
 		cli := &automock.GraphQLClient{}
@@ -76,7 +153,7 @@ func TestTokenService_GetOneTimeTokenForRuntime(t *testing.T) {
 		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, nil, nil, nil, nil, URL, nil)
 
 		//WHEN
-		_, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference)
+		_, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -95,7 +172,7 @@ func TestTokenService_GetOneTimeTokenForRuntime(t *testing.T) {
 		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, nil, nil, nil, nil, URL, nil)
 
 		//WHEN
-		_, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference)
+		_, err := svc.GenerateOneTimeToken(ctx, runtimeID, model.RuntimeReference, nil)
 
 		//THEN
 		require.Error(t, err)
@@ -109,11 +186,12 @@ func TestTokenService_GetOneTimeTokenForRuntime(t *testing.T) {
 func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 	authID := "77cabc16-9fb8-4338-b252-7b404f2e6487"
 	expectedRequest := gcli.NewRequest(fmt.Sprintf(`
-		mutation { generateApplicationToken (authID:"%s")
+		mutation ($binding: TokenBindingInput) { generateApplicationToken (authID:"%s", binding: $binding)
 		  {
 			token
 		  }
 		}`, authID))
+	expectedRequest.Var("binding", onetimetoken.TokenBinding{})
 
 	applicationID := "5b560bbe-c45b-49e7-847f-20d63b1ac91d"
 	integrationSystemID := "fabd8d1e-7a13-485a-8176-e3ca4187bf2c"
@@ -135,7 +213,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(mockGraphqlClient, mockSysAuthSvc, mockAppService, nil, nil, nil, URL, nil)
 		defer mock.AssertExpectationsForObjects(t, mockGraphqlClient, mockAppService, mockSysAuthSvc)
 		// WHEN
-		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		require.NoError(t, err)
 		assert.Equal(t, "token", actualToken.Token)
@@ -152,6 +230,10 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppService := &automock.ApplicationService{}
 		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
 		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(map[string]*model.Label{
+			"applicationType":                {Key: "applicationType", Value: "ecommerce"},
+			model.ApplicationTemplateNameKey: {Key: model.ApplicationTemplateNameKey, Value: "ecommerce-template"},
+		}, nil)
 		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
 
 		mockAppConverter := &automock.ApplicationConverter{}
@@ -162,7 +244,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppConverter.On("ToGraphQL", &givenApplication).Return(&givenGraphQLApp)
 
 		respBody := new(bytes.Buffer)
-		respBody.WriteString(`{"token":"external-token"}`)
+		respBody.WriteString(`{"token":"external-token","expiresAt":"2030-01-02T15:04:05Z","connectorURL":"https://connector.example.com","metadata":{"system":"ticketing"}}`)
 		mockHttpClient := &automock.HTTPDoer{}
 		response := &http.Response{
 			StatusCode: http.StatusOK,
@@ -181,9 +263,11 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 			tenantMatches := appData.Tenant == "external-tenant"
 			clientUserMatches := appData.ClientUser == ""
 			appIDMatches := appData.Application.ID == givenGraphQLApp.ID
+			labelsMatch := appData.Labels["applicationType"] == "ecommerce"
+			templateNameMatches := appData.ApplicationTemplateName == "ecommerce-template"
 			urlMatches := req.URL.String() == "https://my-integration-service.url"
 
-			return urlMatches && appIDMatches && tenantMatches && clientUserMatches
+			return urlMatches && appIDMatches && tenantMatches && clientUserMatches && labelsMatch && templateNameMatches
 		})).Return(response, nil)
 
 		mockExtTenants := &automock.ExternalTenantsService{}
@@ -192,10 +276,14 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(nil, mockSysAuthSvc, mockAppService, mockAppConverter, mockExtTenants, mockHttpClient, URL, adaptersMapping)
 		defer mock.AssertExpectationsForObjects(t, mockSysAuthSvc, mockAppService, mockHttpClient, mockExtTenants)
 		// WHEN
-		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		require.NoError(t, err)
 		assert.Equal(t, "external-token", actualToken.Token)
+		assert.Equal(t, "https://connector.example.com", actualToken.ConnectorURL)
+		require.NotNil(t, actualToken.ExpiresAt)
+		assert.Equal(t, time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC), *actualToken.ExpiresAt)
+		assert.Equal(t, map[string]interface{}{"system": "ticketing"}, actualToken.Metadata)
 	})
 
 	t.Run("Success - token for application with integration system that registered pairing adapter and client user is provided", func(t *testing.T) {
@@ -212,6 +300,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppService := &automock.ApplicationService{}
 		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
 		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(map[string]*model.Label{}, nil)
 		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
 
 		mockAppConverter := &automock.ApplicationConverter{}
@@ -252,7 +341,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(nil, mockSysAuthSvc, mockAppService, mockAppConverter, mockExtTenants, mockHttpClient, URL, adaptersMapping)
 		defer mock.AssertExpectationsForObjects(t, mockSysAuthSvc, mockAppService, mockHttpClient, mockExtTenants)
 		// WHEN
-		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		require.NoError(t, err)
 		assert.Equal(t, "external-token", actualToken.Token)
@@ -275,7 +364,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(mockGraphqlClient, mockSysAuthSvc, mockAppService, nil, nil, nil, URL, nil)
 		defer mock.AssertExpectationsForObjects(t, mockGraphqlClient, mockAppService, mockSysAuthSvc)
 		// WHEN
-		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		actualToken, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		require.NoError(t, err)
 		assert.Equal(t, "token", actualToken.Token)
@@ -292,6 +381,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppService := &automock.ApplicationService{}
 		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
 		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(map[string]*model.Label{}, nil)
 		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
 
 		mockAppConverter := &automock.ApplicationConverter{}
@@ -314,11 +404,33 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(nil, mockSysAuthSvc, mockAppService, mockAppConverter, mockExtTenants, mockHttpClient, URL, adaptersMapping)
 		defer mock.AssertExpectationsForObjects(t, mockSysAuthSvc, mockAppService, mockHttpClient, mockExtTenants)
 		// WHEN
-		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		require.EqualError(t, err, "while calling adapter [https://my-integration-service.url] for application [5b560bbe-c45b-49e7-847f-20d63b1ac91d] with integration system [fabd8d1e-7a13-485a-8176-e3ca4187bf2c]: All attempts fail:\n#1: wrong status code, got [500], expected [200]\n#2: wrong status code, got [500], expected [200]\n#3: wrong status code, got [500], expected [200]")
 	})
 
+	t.Run("Error - allowed source CIDRs for application with integration system that registered pairing adapter", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("Create", ctx, model.ApplicationReference, applicationID, (*model.AuthInput)(nil)).
+			Return(authID, nil)
+
+		mockAppService := &automock.ApplicationService{}
+		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
+		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
+
+		svc := onetimetoken.NewTokenService(nil, sysAuthSvc, mockAppService, nil, nil, nil, URL, adaptersMapping)
+		defer mock.AssertExpectationsForObjects(t, sysAuthSvc, mockAppService)
+		// WHEN
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, []string{"10.0.0.0/8"})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "allowed source CIDRs are not supported for applications paired through integration system [fabd8d1e-7a13-485a-8176-e3ca4187bf2c]")
+	})
+
 	t.Run("Error on getting information about application", func(t *testing.T) {
 		// GIVEN
 		ctx := context.TODO()
@@ -333,7 +445,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(nil, sysAuthSvc, mockAppService, nil, nil, nil, URL, nil)
 		defer mock.AssertExpectationsForObjects(t, sysAuthSvc, mockAppService)
 		// WHEN
-		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		assert.EqualError(t, err, "while getting application [id: 5b560bbe-c45b-49e7-847f-20d63b1ac91d]: some error")
 
@@ -357,11 +469,35 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(nil, sysAuthSvc, mockAppService, nil, mockExtTenants, nil, URL, adaptersMapping)
 		defer mock.AssertExpectationsForObjects(t, sysAuthSvc, mockAppService, mockExtTenants)
 		// WHEN
-		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 		// THEN
 		assert.EqualError(t, err, "while getting external tenant for internal tenant [internal-tenant]: some error")
 	})
 
+	t.Run("Error on listing application labels", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("Create", ctx, model.ApplicationReference, applicationID, (*model.AuthInput)(nil)).
+			Return(authID, nil)
+
+		mockAppService := &automock.ApplicationService{}
+		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
+		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(nil, errors.New("some error"))
+		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
+		mockExtTenants := &automock.ExternalTenantsService{}
+		mockExtTenants.On("GetExternalTenant", ctx, "internal-tenant").Return("external-tenant", nil)
+
+		svc := onetimetoken.NewTokenService(nil, sysAuthSvc, mockAppService, nil, mockExtTenants, nil, URL, adaptersMapping)
+		defer mock.AssertExpectationsForObjects(t, sysAuthSvc, mockAppService, mockExtTenants)
+		// WHEN
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
+		// THEN
+		assert.EqualError(t, err, "while listing labels for application [5b560bbe-c45b-49e7-847f-20d63b1ac91d]: some error")
+	})
+
 	t.Run("Error - generating token failed", func(t *testing.T) {
 		ctx := context.TODO()
 		cli := &automock.GraphQLClient{}
@@ -381,7 +517,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		svc := onetimetoken.NewTokenService(cli, sysAuthSvc, appSvc, nil, nil, nil, URL, nil)
 
 		//WHEN
-		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference, nil)
 
 		//THEN
 		require.Error(t, err)
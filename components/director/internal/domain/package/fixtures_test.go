@@ -8,9 +8,11 @@ import (
 
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/mock"
 )
 
 func fixModelAPIDefinition(id string, pkgID string, name, description string, group string) *model.APIDefinition {
@@ -469,3 +471,17 @@ func fixFetchRequestWithCondition(url string, objectType model.FetchRequestRefer
 		ObjectID:   "foo",
 	}
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return svc
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
@@ -43,9 +44,10 @@ type pgRepository struct {
 	creator         repo.Creator
 	updater         repo.Updater
 	conv            EntityConverter
+	encryptor       encryption.Encryptor
 }
 
-func NewRepository(conv EntityConverter) *pgRepository {
+func NewRepository(conv EntityConverter, encryptor encryption.Encryptor) *pgRepository {
 	return &pgRepository{
 		existQuerier:    repo.NewExistQuerier(resource.Package, packageTable, tenantColumn),
 		singleGetter:    repo.NewSingleGetter(resource.Package, packageTable, tenantColumn, packageColumns),
@@ -54,6 +56,7 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		creator:         repo.NewCreator(resource.Package, packageTable, packageColumns),
 		updater:         repo.NewUpdater(resource.Package, packageTable, []string{"name", "description", "instance_auth_request_json_schema", "default_instance_auth"}, tenantColumn, []string{"id"}),
 		conv:            conv,
+		encryptor:       encryptor,
 	}
 }
 
@@ -68,9 +71,9 @@ func (r *pgRepository) Create(ctx context.Context, model *model.Package) error {
 		return apperrors.NewInternalError("model can not be nil")
 	}
 
-	pkgEnt, err := r.conv.ToEntity(model)
+	pkgEnt, err := r.toEntity(ctx, model)
 	if err != nil {
-		return errors.Wrap(err, "while converting to Package entity")
+		return err
 	}
 
 	log.Debugf("Persisting Package entity with id %s to db", model.ID)
@@ -82,10 +85,9 @@ func (r *pgRepository) Update(ctx context.Context, model *model.Package) error {
 		return apperrors.NewInternalError("model can not be nil")
 	}
 
-	pkgEnt, err := r.conv.ToEntity(model)
-
+	pkgEnt, err := r.toEntity(ctx, model)
 	if err != nil {
-		return errors.Wrap(err, "while converting to Package entity")
+		return err
 	}
 
 	return r.updater.UpdateSingle(ctx, pkgEnt)
@@ -105,6 +107,10 @@ func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.P
 		return nil, err
 	}
 
+	if err := r.decryptDefaultInstanceAuth(ctx, &pkgEnt); err != nil {
+		return nil, err
+	}
+
 	pkgModel, err := r.conv.FromEntity(&pkgEnt)
 	if err != nil {
 		return nil, errors.Wrap(err, "while converting Package from Entity")
@@ -124,6 +130,10 @@ func (r *pgRepository) GetForApplication(ctx context.Context, tenant string, id
 		return nil, err
 	}
 
+	if err := r.decryptDefaultInstanceAuth(ctx, &ent); err != nil {
+		return nil, err
+	}
+
 	pkgModel, err := r.conv.FromEntity(&ent)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating Package model from entity")
@@ -153,6 +163,10 @@ func (r *pgRepository) GetByInstanceAuthID(ctx context.Context, tenant string, i
 		return nil, errors.Wrap(err, "while getting Package by Instance Auth ID")
 	}
 
+	if err := r.decryptDefaultInstanceAuth(ctx, &pkgEnt); err != nil {
+		return nil, err
+	}
+
 	pkgModel, err := r.conv.FromEntity(&pkgEnt)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating Package model from entity")
@@ -175,6 +189,10 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string,
 	var items []*model.Package
 
 	for _, pkgEnt := range packageCollection {
+		if err := r.decryptDefaultInstanceAuth(ctx, &pkgEnt); err != nil {
+			return nil, err
+		}
+
 		m, err := r.conv.FromEntity(&pkgEnt)
 		if err != nil {
 			return nil, errors.Wrap(err, "while creating Package model from entity")
@@ -188,3 +206,31 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string,
 		PageInfo:   page,
 	}, nil
 }
+
+func (r *pgRepository) toEntity(ctx context.Context, model *model.Package) (*Entity, error) {
+	pkgEnt, err := r.conv.ToEntity(model)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting to Package entity")
+	}
+
+	pkgEnt.DefaultInstanceAuth, err = r.encryptor.Encrypt(ctx, defaultInstanceAuthLocation(*pkgEnt), pkgEnt.DefaultInstanceAuth)
+	if err != nil {
+		return nil, errors.Wrap(err, "while encrypting default instance auth")
+	}
+
+	return pkgEnt, nil
+}
+
+func (r *pgRepository) decryptDefaultInstanceAuth(ctx context.Context, pkgEnt *Entity) error {
+	var err error
+	pkgEnt.DefaultInstanceAuth, err = r.encryptor.Decrypt(ctx, defaultInstanceAuthLocation(*pkgEnt), pkgEnt.DefaultInstanceAuth)
+	if err != nil {
+		return errors.Wrap(err, "while decrypting default instance auth")
+	}
+
+	return nil
+}
+
+func defaultInstanceAuthLocation(entity Entity) encryption.Location {
+	return encryption.Location{TenantID: entity.TenantID, Column: encryption.PackageDefaultInstanceAuthColumn, RowID: entity.ID}
+}
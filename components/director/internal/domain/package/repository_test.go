@@ -2,12 +2,15 @@ package mp_package_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/stretchr/testify/assert"
@@ -42,7 +45,7 @@ func TestPgRepository_Create(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := automock.EntityConverter{}
 		convMock.On("ToEntity", pkgModel).Return(pkgEntity, nil).Once()
-		pgRepository := mp_package.NewRepository(&convMock)
+		pgRepository := mp_package.NewRepository(&convMock, encryption.NewNoopEncryptor())
 		//WHEN
 		err = pgRepository.Create(ctx, pkgModel)
 		//THEN
@@ -55,7 +58,7 @@ func TestPgRepository_Create(t *testing.T) {
 		ctx := context.TODO()
 		convMock := automock.EntityConverter{}
 		convMock.On("ToEntity", pkgModel).Return(&mp_package.Entity{}, errors.New("test error"))
-		pgRepository := mp_package.NewRepository(&convMock)
+		pgRepository := mp_package.NewRepository(&convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		err := pgRepository.Create(ctx, pkgModel)
 		// THEN
@@ -67,7 +70,7 @@ func TestPgRepository_Create(t *testing.T) {
 	t.Run("returns error when item is nil", func(t *testing.T) {
 		ctx := context.TODO()
 		convMock := automock.EntityConverter{}
-		pgRepository := mp_package.NewRepository(&convMock)
+		pgRepository := mp_package.NewRepository(&convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		err := pgRepository.Create(ctx, nil)
 		// THEN
@@ -93,7 +96,7 @@ func TestPgRepository_Update(t *testing.T) {
 			WithArgs(entity.Name, entity.Description, entity.InstanceAuthRequestJSONSchema, entity.DefaultInstanceAuth, tenantID, entity.ID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		//WHEN
 		err := pgRepository.Update(ctx, pkg)
 		//THEN
@@ -102,13 +105,39 @@ func TestPgRepository_Update(t *testing.T) {
 		sqlMock.AssertExpectations(t)
 	})
 
+	t.Run("success with encrypted default instance auth", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pkg := fixPackageModel(t, "foo", "update")
+		entity := fixEntityPackage(packageID, "foo", "update")
+		defaultInstanceAuth := entity.DefaultInstanceAuth
+		encryptedAuth := sql.NullString{String: `{"envelope":{}}`, Valid: true}
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", pkg).Return(entity, nil)
+		encryptorMock := &encryptionautomock.Encryptor{}
+		encryptorMock.On("Encrypt", ctx, encryption.Location{TenantID: tenantID, Column: encryption.PackageDefaultInstanceAuthColumn, RowID: entity.ID}, defaultInstanceAuth).Return(encryptedAuth, nil).Once()
+		sqlMock.ExpectExec(updateQuery).
+			WithArgs(entity.Name, entity.Description, entity.InstanceAuthRequestJSONSchema, encryptedAuth, tenantID, entity.ID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		pgRepository := mp_package.NewRepository(convMock, encryptorMock)
+		//WHEN
+		err := pgRepository.Update(ctx, pkg)
+		//THEN
+		require.NoError(t, err)
+		convMock.AssertExpectations(t)
+		encryptorMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
 	t.Run("returns error when conversion from model to entity failed", func(t *testing.T) {
 		sqlxDB, _ := testdb.MockDatabase(t)
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pkgModel := &model.Package{}
 		convMock := &automock.EntityConverter{}
 		convMock.On("ToEntity", pkgModel).Return(&mp_package.Entity{}, errors.New("test error")).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		//WHEN
 		err := pgRepository.Update(ctx, pkgModel)
 		//THEN
@@ -121,7 +150,7 @@ func TestPgRepository_Update(t *testing.T) {
 		sqlxDB, _ := testdb.MockDatabase(t)
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		//WHEN
 		err := pgRepository.Update(ctx, nil)
 		//THEN
@@ -138,7 +167,7 @@ func TestPgRepository_Delete(t *testing.T) {
 
 	sqlMock.ExpectExec(deleteQuery).WithArgs(tenantID, packageID).WillReturnResult(sqlmock.NewResult(-1, 1))
 	convMock := &automock.EntityConverter{}
-	pgRepository := mp_package.NewRepository(convMock)
+	pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 	//WHEN
 	err := pgRepository.Delete(ctx, tenantID, packageID)
 	//THEN
@@ -155,7 +184,7 @@ func TestPgRepository_Exists(t *testing.T) {
 
 	sqlMock.ExpectQuery(existQuery).WithArgs(tenantID, packageID).WillReturnRows(testdb.RowWhenObjectExist())
 	convMock := &automock.EntityConverter{}
-	pgRepository := mp_package.NewRepository(convMock)
+	pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 	//WHEN
 	found, err := pgRepository.Exists(ctx, tenantID, packageID)
 	//THEN
@@ -183,7 +212,7 @@ func TestPgRepository_GetByID(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", pkgEntity).Return(&model.Package{ID: packageID, TenantID: tenantID}, nil).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		modelPkg, err := pgRepository.GetByID(ctx, tenantID, packageID)
 		//THEN
@@ -196,7 +225,7 @@ func TestPgRepository_GetByID(t *testing.T) {
 
 	t.Run("DB Error", func(t *testing.T) {
 		// given
-		repo := mp_package.NewRepository(nil)
+		repo := mp_package.NewRepository(nil, encryption.NewNoopEncryptor())
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		testError := errors.New("test error")
 
@@ -215,6 +244,28 @@ func TestPgRepository_GetByID(t *testing.T) {
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
 
+	t.Run("returns error when decryption failed", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testError := errors.New("test error")
+		rows := sqlmock.NewRows(fixPackageColumns()).
+			AddRow(fixPackageRow(packageID, "placeholder")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, packageID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		encryptorMock := &encryptionautomock.Encryptor{}
+		encryptorMock.On("Decrypt", ctx, encryption.Location{TenantID: tenantID, Column: encryption.PackageDefaultInstanceAuthColumn, RowID: packageID}, pkgEntity.DefaultInstanceAuth).Return(sql.NullString{}, testError).Once()
+		pgRepository := mp_package.NewRepository(nil, encryptorMock)
+		// WHEN
+		_, err := pgRepository.GetByID(ctx, tenantID, packageID)
+		//THEN
+		require.EqualError(t, err, "while decrypting default instance auth: test error")
+		sqlMock.AssertExpectations(t)
+		encryptorMock.AssertExpectations(t)
+	})
+
 	t.Run("returns error when conversion failed", func(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		testError := errors.New("test error")
@@ -228,7 +279,7 @@ func TestPgRepository_GetByID(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", pkgEntity).Return(&model.Package{}, testError).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		_, err := pgRepository.GetByID(ctx, tenantID, packageID)
 		//THEN
@@ -258,7 +309,7 @@ func TestPgRepository_GetByInstanceAuthID(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", pkgEntity).Return(&model.Package{ID: packageID, TenantID: tenantID, ApplicationID: appID}, nil).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		modelPkg, err := pgRepository.GetByInstanceAuthID(ctx, tenantID, instanceAuthID)
 		//THEN
@@ -272,7 +323,7 @@ func TestPgRepository_GetByInstanceAuthID(t *testing.T) {
 
 	t.Run("DB Error", func(t *testing.T) {
 		// given
-		repo := mp_package.NewRepository(nil)
+		repo := mp_package.NewRepository(nil, encryption.NewNoopEncryptor())
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		testError := errors.New("test error")
 
@@ -304,7 +355,7 @@ func TestPgRepository_GetByInstanceAuthID(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", pkgEntity).Return(&model.Package{}, testError).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		_, err := pgRepository.GetByInstanceAuthID(ctx, tenantID, instanceAuthID)
 		//THEN
@@ -333,7 +384,7 @@ func TestPgRepository_GetForApplication(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", pkgEntity).Return(&model.Package{ID: packageID, TenantID: tenantID, ApplicationID: appID}, nil).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		modelPkg, err := pgRepository.GetForApplication(ctx, tenantID, packageID, appID)
 		//THEN
@@ -347,7 +398,7 @@ func TestPgRepository_GetForApplication(t *testing.T) {
 
 	t.Run("DB Error", func(t *testing.T) {
 		// given
-		repo := mp_package.NewRepository(nil)
+		repo := mp_package.NewRepository(nil, encryption.NewNoopEncryptor())
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		testError := errors.New("test error")
 
@@ -379,7 +430,7 @@ func TestPgRepository_GetForApplication(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", pkgEntity).Return(&model.Package{}, testError).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		_, err := pgRepository.GetForApplication(ctx, tenantID, packageID, appID)
 		//THEN
@@ -429,7 +480,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", firstPkgEntity).Return(&model.Package{ID: firstPkgID}, nil)
 		convMock.On("FromEntity", secondPkgEntity).Return(&model.Package{ID: secondPkgID}, nil)
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		// WHEN
 		modelPkg, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
 		//THEN
@@ -445,7 +496,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 
 	t.Run("DB Error", func(t *testing.T) {
 		// given
-		repo := mp_package.NewRepository(nil)
+		repo := mp_package.NewRepository(nil, encryption.NewNoopEncryptor())
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		testError := errors.New("test error")
 
@@ -480,7 +531,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", firstPkgEntity).Return(&model.Package{}, testErr).Once()
-		pgRepository := mp_package.NewRepository(convMock)
+		pgRepository := mp_package.NewRepository(convMock, encryption.NewNoopEncryptor())
 		//WHEN
 		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
 		//THEN
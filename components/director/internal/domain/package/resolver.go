@@ -124,6 +124,31 @@ func NewResolver(
 	}
 }
 
+func (r *Resolver) Package(ctx context.Context, id string) (*graphql.Package, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkg, err := r.packageSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.packageConverter.ToGraphQL(pkg)
+}
+
 func (r *Resolver) AddPackage(ctx context.Context, applicationID string, in graphql.PackageCreateInput) (*graphql.Package, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -723,6 +723,120 @@ func TestResolver_Documents(t *testing.T) {
 	}
 }
 
+func TestResolver_Package(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "bar"
+	modelPackage := fixPackageModel(t, "foo", "desc")
+	gqlPackage := fixGQLPackage(id, "foo", "desc")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ConverterFn     func() *automock.PackageConverter
+		ExpectedResult  *graphql.Package
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelPackage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("ToGraphQL", modelPackage).Return(gqlPackage, nil).Once()
+				return conv
+			},
+			ExpectedResult: gqlPackage,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns null when package not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, apperrors.NewNotFoundError(resource.Package, id)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when package retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.PackageService {
+				return &automock.PackageService{}
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelPackage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := mp_package.NewResolver(transact, svc, nil, nil, nil, nil, converter, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Package(context.TODO(), id)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_AddPackage(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
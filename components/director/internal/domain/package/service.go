@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -46,6 +47,12 @@ type FetchRequestRepository interface {
 	Create(ctx context.Context, item *model.FetchRequest) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
+	HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -63,18 +70,20 @@ type service struct {
 	documentRepo     DocumentRepository
 	fetchRequestRepo FetchRequestRepository
 
+	accessRuleService   AccessRuleService
 	uidService          UIDService
 	fetchRequestService FetchRequestService
 	timestampGen        timestamp.Generator
 }
 
-func NewService(pkgRepo PackageRepository, apiRepo APIRepository, eventAPIRepo EventAPIRepository, documentRepo DocumentRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService) *service {
+func NewService(pkgRepo PackageRepository, apiRepo APIRepository, eventAPIRepo EventAPIRepository, documentRepo DocumentRepository, fetchRequestRepo FetchRequestRepository, accessRuleService AccessRuleService, uidService UIDService, fetchRequestService FetchRequestService) *service {
 	return &service{
 		pkgRepo:             pkgRepo,
 		apiRepo:             apiRepo,
 		eventAPIRepo:        eventAPIRepo,
 		documentRepo:        documentRepo,
 		fetchRequestRepo:    fetchRequestRepo,
+		accessRuleService:   accessRuleService,
 		uidService:          uidService,
 		fetchRequestService: fetchRequestService,
 		timestampGen:        timestamp.DefaultGenerator(),
@@ -87,35 +96,35 @@ func (s *service) Create(ctx context.Context, applicationID string, in model.Pac
 		return "", err
 	}
 
-	id := s.uidService.Generate()
-	pkg := in.ToPackage(id, applicationID, tnt)
-
-	err = s.pkgRepo.Create(ctx, pkg)
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeApplication, applicationID)
 	if err != nil {
-		return "", errors.Wrapf(err, "error occurred while creating a Package with id %s and name %s for Application with id %s", id, pkg.Name, applicationID)
+		return "", errors.Wrapf(err, "while checking access to Application with id %s", applicationID)
 	}
-	log.Infof("Successfully created a Package with id %s and name %s for Application with id %s", id, pkg.Name, applicationID)
-
-	log.Infof("Creating related resources in Package with id %s and name %s for Application with id %s", id, pkg.Name, applicationID)
-	err = s.createRelatedResources(ctx, in, tnt, id)
-	if err != nil {
-		return "", errors.Wrapf(err, "while creating related resources for Application with id %s", applicationID)
+	if !hasAccess {
+		return "", apperrors.NewNotFoundError(resource.Application, applicationID)
 	}
 
-	return id, nil
+	return s.create(ctx, tnt, applicationID, in)
 }
 
+// CreateMultiple creates the Packages of an Application, which is being registered.
+// The access to the Application is checked by the Application service once it is created.
 func (s *service) CreateMultiple(ctx context.Context, applicationID string, in []*model.PackageCreateInput) error {
 	if in == nil {
 		return nil
 	}
 
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	for _, pkg := range in {
 		if pkg == nil {
 			continue
 		}
 
-		_, err := s.Create(ctx, applicationID, *pkg)
+		_, err := s.create(ctx, tnt, applicationID, *pkg)
 		if err != nil {
 			return errors.Wrapf(err, "while creating Package for Application with id %s", applicationID)
 		}
@@ -124,12 +133,35 @@ func (s *service) CreateMultiple(ctx context.Context, applicationID string, in [
 	return nil
 }
 
+func (s *service) create(ctx context.Context, tnt, applicationID string, in model.PackageCreateInput) (string, error) {
+	id := s.uidService.Generate()
+	pkg := in.ToPackage(id, applicationID, tnt)
+
+	err := s.pkgRepo.Create(ctx, pkg)
+	if err != nil {
+		return "", errors.Wrapf(err, "error occurred while creating a Package with id %s and name %s for Application with id %s", id, pkg.Name, applicationID)
+	}
+	log.Infof("Successfully created a Package with id %s and name %s for Application with id %s", id, pkg.Name, applicationID)
+
+	log.Infof("Creating related resources in Package with id %s and name %s for Application with id %s", id, pkg.Name, applicationID)
+	err = s.createRelatedResources(ctx, in, tnt, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while creating related resources for Application with id %s", applicationID)
+	}
+
+	return id, nil
+}
+
 func (s *service) Update(ctx context.Context, id string, in model.PackageUpdateInput) error {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	pkg, err := s.pkgRepo.GetByID(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while getting Package with id %s", id)
@@ -150,6 +182,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrap(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	err = s.pkgRepo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Package with id %s", id)
@@ -227,6 +263,19 @@ func (s *service) ListByApplicationID(ctx context.Context, applicationID string,
 	return s.pkgRepo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor)
 }
 
+// ensureAccess hides the Packages of the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, id string) error {
+	hasAccess, err := s.accessRuleService.HasAccessToParent(ctx, model.AccessRuleNestedObjectTypePackage, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Package with id %s", id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.Package, id)
+	}
+
+	return nil
+}
+
 func (s *service) createRelatedResources(ctx context.Context, in model.PackageCreateInput, tenant string, packageID string) error {
 	err := s.createAPIs(ctx, packageID, tenant, in.APIDefinitions)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
@@ -426,7 +427,8 @@ func TestService_Create(t *testing.T) {
 			documentRepo := testCase.DocumentRepoFn()
 			frRepo := testCase.FetchRequestRepoFn()
 			frSvc := testCase.FetchRequestServiceFn()
-			svc := mp_package.NewService(repo, apiRepo, eventRepo, documentRepo, frRepo, uidService, frSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := mp_package.NewService(repo, apiRepo, eventRepo, documentRepo, frRepo, accessRuleSvc, uidService, frSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -443,8 +445,22 @@ func TestService_Create(t *testing.T) {
 			mock.AssertExpectationsForObjects(t, repo, apiRepo, eventRepo, documentRepo, frRepo, uidService)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := mp_package.NewService(repo, nil, nil, nil, nil, accessRuleSvc, nil, nil)
+
+		// when
+		_, err := svc.Create(ctx, applicationID, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Create(context.TODO(), "", model.PackageCreateInput{})
 		// THEN
@@ -534,7 +550,8 @@ func TestService_Update(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, accessRuleSvc, nil, nil)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -550,8 +567,22 @@ func TestService_Update(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := mp_package.NewService(repo, nil, nil, nil, nil, accessRuleSvc, nil, nil)
+
+		// when
+		err := svc.Update(ctx, id, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Update(context.TODO(), "", model.PackageUpdateInput{})
 		// THEN
@@ -603,7 +634,8 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, accessRuleSvc, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -619,8 +651,22 @@ func TestService_Delete(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := mp_package.NewService(repo, nil, nil, nil, nil, accessRuleSvc, nil, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -665,7 +711,7 @@ func TestService_Exist(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			pkgRepo := testCase.RepoFn()
-			svc := mp_package.NewService(pkgRepo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(pkgRepo, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Exist(ctx, id)
@@ -684,7 +730,7 @@ func TestService_Exist(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Exist(context.TODO(), "")
 		// THEN
@@ -741,7 +787,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			pkg, err := svc.Get(ctx, testCase.InputID)
@@ -759,7 +805,7 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Get(context.TODO(), "")
 		// THEN
@@ -820,7 +866,7 @@ func TestService_GetForApplication(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.GetForApplication(ctx, testCase.InputID, testCase.ApplicationID)
@@ -838,7 +884,7 @@ func TestService_GetForApplication(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForApplication(context.TODO(), "", "")
 		// THEN
@@ -895,7 +941,7 @@ func TestService_GetByInstanceAuthID(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.GetByInstanceAuthID(ctx, testCase.InstanceAuthID)
@@ -913,7 +959,7 @@ func TestService_GetByInstanceAuthID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForApplication(context.TODO(), "", "")
 		// THEN
@@ -1005,7 +1051,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			docs, err := svc.ListByApplicationID(ctx, applicationID, testCase.PageSize, after)
@@ -1023,7 +1069,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.ListByApplicationID(context.TODO(), "", 5, "")
 		// THEN
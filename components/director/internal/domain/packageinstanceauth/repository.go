@@ -9,6 +9,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -36,9 +37,10 @@ type repository struct {
 	updater      repo.Updater
 	deleter      repo.Deleter
 	conv         EntityConverter
+	encryptor    encryption.Encryptor
 }
 
-func NewRepository(conv EntityConverter) *repository {
+func NewRepository(conv EntityConverter, encryptor encryption.Encryptor) *repository {
 	return &repository{
 		creator:      repo.NewCreator(resource.PackageInstanceAuth, tableName, tableColumns),
 		singleGetter: repo.NewSingleGetter(resource.PackageInstanceAuth, tableName, tenantColumn, tableColumns),
@@ -46,6 +48,7 @@ func NewRepository(conv EntityConverter) *repository {
 		deleter:      repo.NewDeleter(resource.PackageInstanceAuth, tableName, tenantColumn),
 		updater:      repo.NewUpdater(resource.PackageInstanceAuth, tableName, updatableColumns, tenantColumn, idColumns),
 		conv:         conv,
+		encryptor:    encryptor,
 	}
 }
 
@@ -59,6 +62,10 @@ func (r *repository) Create(ctx context.Context, item *model.PackageInstanceAuth
 		return errors.Wrap(err, "while converting PackageInstanceAuth model to entity")
 	}
 
+	if err := r.encryptAuthValue(ctx, &entity); err != nil {
+		return err
+	}
+
 	log.Debugf("Persisting PackageInstanceAuth entity with id %s to db", item.ID)
 	err = r.creator.Create(ctx, entity)
 	if err != nil {
@@ -74,6 +81,10 @@ func (r *repository) GetByID(ctx context.Context, tenantID string, id string) (*
 		return nil, err
 	}
 
+	if err := r.decryptAuthValue(ctx, &entity); err != nil {
+		return nil, err
+	}
+
 	itemModel, err := r.conv.FromEntity(entity)
 	if err != nil {
 		return nil, errors.Wrap(err, "while converting PackageInstanceAuth entity to model")
@@ -93,6 +104,10 @@ func (r *repository) GetForPackage(ctx context.Context, tenant string, id string
 		return nil, err
 	}
 
+	if err := r.decryptAuthValue(ctx, &ent); err != nil {
+		return nil, err
+	}
+
 	pkgModel, err := r.conv.FromEntity(ent)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating Package model from entity")
@@ -114,7 +129,7 @@ func (r *repository) ListByPackageID(ctx context.Context, tenantID string, packa
 		return nil, err
 	}
 
-	return r.multipleFromEntities(entities)
+	return r.multipleFromEntities(ctx, entities)
 }
 
 func (r *repository) Update(ctx context.Context, item *model.PackageInstanceAuth) error {
@@ -127,6 +142,10 @@ func (r *repository) Update(ctx context.Context, item *model.PackageInstanceAuth
 		return errors.Wrap(err, "while converting model to entity")
 	}
 
+	if err := r.encryptAuthValue(ctx, &entity); err != nil {
+		return err
+	}
+
 	log.Debugf("Updating PackageInstanceAuth entity with id %s in db", item.ID)
 	return r.updater.UpdateSingle(ctx, entity)
 }
@@ -135,9 +154,13 @@ func (r *repository) Delete(ctx context.Context, tenantID string, id string) err
 	return r.deleter.DeleteOne(ctx, tenantID, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
-func (r *repository) multipleFromEntities(entities Collection) ([]*model.PackageInstanceAuth, error) {
+func (r *repository) multipleFromEntities(ctx context.Context, entities Collection) ([]*model.PackageInstanceAuth, error) {
 	var items []*model.PackageInstanceAuth
 	for _, ent := range entities {
+		if err := r.decryptAuthValue(ctx, &ent); err != nil {
+			return nil, err
+		}
+
 		m, err := r.conv.FromEntity(ent)
 		if err != nil {
 			return nil, errors.Wrap(err, "while creating PackageInstanceAuth model from entity")
@@ -146,3 +169,27 @@ func (r *repository) multipleFromEntities(entities Collection) ([]*model.Package
 	}
 	return items, nil
 }
+
+func (r *repository) encryptAuthValue(ctx context.Context, entity *Entity) error {
+	var err error
+	entity.AuthValue, err = r.encryptor.Encrypt(ctx, authValueLocation(*entity), entity.AuthValue)
+	if err != nil {
+		return errors.Wrap(err, "while encrypting auth value")
+	}
+
+	return nil
+}
+
+func (r *repository) decryptAuthValue(ctx context.Context, entity *Entity) error {
+	var err error
+	entity.AuthValue, err = r.encryptor.Decrypt(ctx, authValueLocation(*entity), entity.AuthValue)
+	if err != nil {
+		return errors.Wrap(err, "while decrypting auth value")
+	}
+
+	return nil
+}
+
+func authValueLocation(entity Entity) encryption.Location {
+	return encryption.Location{TenantID: entity.TenantID, Column: encryption.PackageInstanceAuthValueColumn, RowID: entity.ID}
+}
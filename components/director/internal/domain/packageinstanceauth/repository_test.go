@@ -2,6 +2,7 @@ package packageinstanceauth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"regexp"
 	"testing"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -37,7 +40,38 @@ func TestRepository_Create(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
+
+		// when
+		err := repo.Create(ctx, piaModel)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success with encrypted auth value", func(t *testing.T) {
+		// given
+		piaModel := fixModelPackageInstanceAuth(testID, testPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded())
+		piaEntity := fixEntityPackageInstanceAuth(t, testID, testPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded())
+		encryptedEntity := *piaEntity
+		encryptedEntity.AuthValue = sql.NullString{String: `{"envelope":{}}`, Valid: true}
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("ToEntity", *piaModel).Return(*piaEntity, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.package_instance_auths ( id, tenant_id, package_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(fixCreateArgs(encryptedEntity)...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		encryptorMock := &encryptionautomock.Encryptor{}
+		encryptorMock.On("Encrypt", ctx, encryption.Location{TenantID: testTenant, Column: encryption.PackageInstanceAuthValueColumn, RowID: piaEntity.ID}, piaEntity.AuthValue).Return(encryptedEntity.AuthValue, nil).Once()
+		defer encryptorMock.AssertExpectations(t)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryptorMock)
 
 		// when
 		err := repo.Create(ctx, piaModel)
@@ -49,7 +83,7 @@ func TestRepository_Create(t *testing.T) {
 	t.Run("Error when item is nil", func(t *testing.T) {
 		// given
 
-		repo := packageinstanceauth.NewRepository(nil)
+		repo := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Create(context.Background(), nil)
@@ -73,7 +107,7 @@ func TestRepository_Create(t *testing.T) {
 		dbMock.ExpectExec("INSERT INTO .*").WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Create(ctx, piaModel)
@@ -90,7 +124,7 @@ func TestRepository_Create(t *testing.T) {
 		mockConverter.On("ToEntity", *piaModel).Return(packageinstanceauth.Entity{}, testError)
 		defer mockConverter.AssertExpectations(t)
 
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Create(context.TODO(), piaModel)
@@ -110,7 +144,7 @@ func TestRepository_GetByID(t *testing.T) {
 		mockConverter.On("FromEntity", *piaEntity).Return(*piaModel, nil).Once()
 		defer mockConverter.AssertExpectations(t)
 
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -139,7 +173,7 @@ func TestRepository_GetByID(t *testing.T) {
 		mockConverter.On("FromEntity", *piaEntity).Return(model.PackageInstanceAuth{}, testError).Once()
 		defer mockConverter.AssertExpectations(t)
 
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -162,7 +196,7 @@ func TestRepository_GetByID(t *testing.T) {
 
 	t.Run("DB Error", func(t *testing.T) {
 		// given
-		repo := packageinstanceauth.NewRepository(nil)
+		repo := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -198,7 +232,7 @@ func TestRepository_GetForPackage(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		convMock := &automock.EntityConverter{}
 		convMock.On("FromEntity", *piaEntity).Return(*piaModel, nil).Once()
-		repo := packageinstanceauth.NewRepository(convMock)
+		repo := packageinstanceauth.NewRepository(convMock, encryption.NewNoopEncryptor())
 
 		// WHEN
 		actual, err := repo.GetForPackage(ctx, testTenant, testID, testPackageID)
@@ -213,7 +247,7 @@ func TestRepository_GetForPackage(t *testing.T) {
 
 	t.Run("DB Error", func(t *testing.T) {
 		// given
-		repo := packageinstanceauth.NewRepository(nil)
+		repo := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -237,7 +271,7 @@ func TestRepository_GetForPackage(t *testing.T) {
 		mockConverter.On("FromEntity", *piaEntity).Return(model.PackageInstanceAuth{}, testError).Once()
 		defer mockConverter.AssertExpectations(t)
 
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -285,7 +319,7 @@ func TestRepository_ListByPackageID(t *testing.T) {
 		convMock := automock.EntityConverter{}
 		convMock.On("FromEntity", *piaEntities[0]).Return(*piaModels[0], nil).Once()
 		convMock.On("FromEntity", *piaEntities[1]).Return(*piaModels[1], nil).Once()
-		pgRepository := packageinstanceauth.NewRepository(&convMock)
+		pgRepository := packageinstanceauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListByPackageID(ctx, testTenant, testPackageID)
@@ -321,7 +355,7 @@ func TestRepository_ListByPackageID(t *testing.T) {
 		convMock := automock.EntityConverter{}
 		convMock.On("FromEntity", *piaEntities[0]).Return(*piaModels[0], nil).Once()
 		convMock.On("FromEntity", *piaEntities[1]).Return(*piaModels[1], testError).Once()
-		pgRepository := packageinstanceauth.NewRepository(&convMock)
+		pgRepository := packageinstanceauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListByPackageID(ctx, testTenant, testPackageID)
@@ -342,7 +376,7 @@ func TestRepository_ListByPackageID(t *testing.T) {
 			WithArgs(testTenant, testPackageID).
 			WillReturnError(testError)
 
-		pgRepository := packageinstanceauth.NewRepository(nil)
+		pgRepository := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListByPackageID(ctx, testTenant, testPackageID)
@@ -374,7 +408,7 @@ func TestRepository_Update(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Update(ctx, piaModel)
@@ -386,7 +420,7 @@ func TestRepository_Update(t *testing.T) {
 	t.Run("Error when item is nil", func(t *testing.T) {
 		// given
 
-		repo := packageinstanceauth.NewRepository(nil)
+		repo := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Update(context.Background(), nil)
@@ -411,7 +445,7 @@ func TestRepository_Update(t *testing.T) {
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Update(ctx, piaModel)
@@ -427,7 +461,7 @@ func TestRepository_Update(t *testing.T) {
 		mockConverter.On("ToEntity", *piaModel).Return(packageinstanceauth.Entity{}, testError)
 		defer mockConverter.AssertExpectations(t)
 
-		repo := packageinstanceauth.NewRepository(mockConverter)
+		repo := packageinstanceauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Update(context.TODO(), piaModel)
@@ -447,7 +481,7 @@ func TestRepository_Delete(t *testing.T) {
 			testTenant, testID).WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := packageinstanceauth.NewRepository(nil)
+		repo := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Delete(ctx, testTenant, testID)
@@ -465,7 +499,7 @@ func TestRepository_Delete(t *testing.T) {
 			testTenant, testID).WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
-		repo := packageinstanceauth.NewRepository(nil)
+		repo := packageinstanceauth.NewRepository(nil, encryption.NewNoopEncryptor())
 
 		// when
 		err := repo.Delete(ctx, testTenant, testID)
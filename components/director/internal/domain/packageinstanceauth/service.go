@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
@@ -24,22 +25,29 @@ type Repository interface {
 	Delete(ctx context.Context, tenantID string, id string) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
 type service struct {
-	repo         Repository
-	uidService   UIDService
-	timestampGen timestamp.Generator
+	repo              Repository
+	accessRuleService AccessRuleService
+	uidService        UIDService
+	timestampGen      timestamp.Generator
 }
 
-func NewService(repo Repository, uidService UIDService) *service {
+func NewService(repo Repository, accessRuleService AccessRuleService, uidService UIDService) *service {
 	return &service{
-		repo:         repo,
-		uidService:   uidService,
-		timestampGen: timestamp.DefaultGenerator(),
+		repo:              repo,
+		accessRuleService: accessRuleService,
+		uidService:        uidService,
+		timestampGen:      timestamp.DefaultGenerator(),
 	}
 }
 
@@ -78,6 +86,10 @@ func (s *service) Get(ctx context.Context, id string) (*model.PackageInstanceAut
 		return nil, err
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
 	instanceAuth, err := s.repo.GetByID(ctx, tnt, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting PackageInstanceAuth with id %s", id)
@@ -120,6 +132,10 @@ func (s *service) SetAuth(ctx context.Context, id string, in model.PackageInstan
 		return err
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	instanceAuth, err := s.repo.GetByID(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while getting PackageInstanceAuth with id %s", id)
@@ -181,12 +197,29 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	log.Debugf("Deleting PackageInstanceAuth entity with id %s in db", id)
 	err = s.repo.Delete(ctx, tnt, id)
 
 	return errors.Wrapf(err, "while deleting PackageInstanceAuth with id %s", id)
 }
 
+// ensureAccess hides the PackageInstanceAuths of the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, id string) error {
+	hasAccess, err := s.accessRuleService.HasAccessToParent(ctx, model.AccessRuleNestedObjectTypePackageInstanceAuth, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to PackageInstanceAuth with id %s", id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.PackageInstanceAuth, id)
+	}
+
+	return nil
+}
+
 func (s *service) setUpdateAuthAndStatus(instanceAuth *model.PackageInstanceAuth, in model.PackageInstanceAuthSetInput) error {
 	if instanceAuth == nil {
 		return nil
@@ -62,7 +62,9 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.instanceAuthRepoFn()
 
-			svc := packageinstanceauth.NewService(instanceAuthRepo, nil)
+			accessRuleSvc := fixAccessRuleServiceWithAccess(id, true)
+
+			svc := packageinstanceauth.NewService(instanceAuthRepo, accessRuleSvc, nil)
 
 			// WHEN
 			result, err := svc.Get(ctx, id)
@@ -76,12 +78,39 @@ func TestService_Get(t *testing.T) {
 			}
 			assert.Equal(t, testCase.ExpectedOutput, result)
 
-			instanceAuthRepo.AssertExpectations(t)
+			mock.AssertExpectationsForObjects(t, instanceAuthRepo, accessRuleSvc)
 		})
 	}
 
+	t.Run("Error when caller has no access to the Application", func(t *testing.T) {
+		accessRuleSvc := fixAccessRuleServiceWithAccess(id, false)
+		svc := packageinstanceauth.NewService(nil, accessRuleSvc, nil)
+
+		// WHEN
+		_, err := svc.Get(ctx, id)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Object not found")
+		accessRuleSvc.AssertExpectations(t)
+	})
+
+	t.Run("Error when checking access to the Application fails", func(t *testing.T) {
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("HasAccessToParent", ctx, model.AccessRuleNestedObjectTypePackageInstanceAuth, id).Return(false, testErr).Once()
+		svc := packageinstanceauth.NewService(nil, accessRuleSvc, nil)
+
+		// WHEN
+		_, err := svc.Get(ctx, id)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		accessRuleSvc.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 
 		// WHEN
 		_, err := svc.Get(context.TODO(), id)
@@ -139,7 +168,7 @@ func TestService_GetForPackage(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.instanceAuthRepoFn()
 
-			svc := packageinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := packageinstanceauth.NewService(instanceAuthRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.GetForPackage(ctx, id, packageID)
@@ -158,7 +187,7 @@ func TestService_GetForPackage(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 
 		// WHEN
 		_, err := svc.GetForPackage(context.TODO(), id, packageID)
@@ -210,7 +239,9 @@ func TestService_Delete(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.instanceAuthRepoFn()
 
-			svc := packageinstanceauth.NewService(instanceAuthRepo, nil)
+			accessRuleSvc := fixAccessRuleServiceWithAccess(id, true)
+
+			svc := packageinstanceauth.NewService(instanceAuthRepo, accessRuleSvc, nil)
 
 			// WHEN
 			err := svc.Delete(ctx, id)
@@ -223,12 +254,25 @@ func TestService_Delete(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			instanceAuthRepo.AssertExpectations(t)
+			mock.AssertExpectationsForObjects(t, instanceAuthRepo, accessRuleSvc)
 		})
 	}
 
+	t.Run("Error when caller has no access to the Application", func(t *testing.T) {
+		accessRuleSvc := fixAccessRuleServiceWithAccess(id, false)
+		svc := packageinstanceauth.NewService(nil, accessRuleSvc, nil)
+
+		// WHEN
+		err := svc.Delete(ctx, id)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Object not found")
+		accessRuleSvc.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 
 		// WHEN
 		err := svc.Delete(context.TODO(), id)
@@ -360,7 +404,9 @@ func TestService_SetAuth(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.InstanceAuthRepoFn()
 
-			svc := packageinstanceauth.NewService(instanceAuthRepo, nil)
+			accessRuleSvc := fixAccessRuleServiceWithAccess(testID, true)
+
+			svc := packageinstanceauth.NewService(instanceAuthRepo, accessRuleSvc, nil)
 			svc.SetTimestampGen(func() time.Time { return testTime })
 
 			// WHEN
@@ -374,12 +420,25 @@ func TestService_SetAuth(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, instanceAuthRepo)
+			mock.AssertExpectationsForObjects(t, instanceAuthRepo, accessRuleSvc)
 		})
 	}
 
+	t.Run("Error when caller has no access to the Application", func(t *testing.T) {
+		accessRuleSvc := fixAccessRuleServiceWithAccess(testID, false)
+		svc := packageinstanceauth.NewService(nil, accessRuleSvc, nil)
+
+		// WHEN
+		err := svc.SetAuth(ctx, testID, *modelSetInput)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Object not found")
+		accessRuleSvc.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 
 		// WHEN
 		err := svc.SetAuth(context.Background(), testID, model.PackageInstanceAuthSetInput{})
@@ -555,7 +614,7 @@ func TestService_Create(t *testing.T) {
 			instanceAuthRepo := testCase.InstanceAuthRepoFn()
 			uidSvc := testCase.UIDSvcFn()
 
-			svc := packageinstanceauth.NewService(instanceAuthRepo, uidSvc)
+			svc := packageinstanceauth.NewService(instanceAuthRepo, nil, uidSvc)
 			svc.SetTimestampGen(func() time.Time { return testTime })
 
 			// WHEN
@@ -575,7 +634,7 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 
 		// WHEN
 		_, err := svc.Create(context.Background(), testPackageID, model.PackageInstanceAuthRequestInput{}, nil, nil)
@@ -635,7 +694,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := packageinstanceauth.NewService(repo, nil)
+			svc := packageinstanceauth.NewService(repo, nil, nil)
 
 			// when
 			pia, err := svc.List(ctx, id)
@@ -653,7 +712,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 		// WHEN
 		_, err := svc.List(context.TODO(), "")
 		// THEN
@@ -679,6 +738,7 @@ func TestService_RequestDeletion(t *testing.T) {
 		Name                       string
 		PackageDefaultInstanceAuth *model.Auth
 		InstanceAuthRepoFn         func() *automock.Repository
+		AccessRuleServiceFn        func() *automock.AccessRuleService
 
 		ExpectedResult bool
 		ExpectedError  error
@@ -703,6 +763,9 @@ func TestService_RequestDeletion(t *testing.T) {
 				instanceAuthRepo.On("Delete", contextThatHasTenant(tnt), tnt, id).Return(nil).Once()
 				return instanceAuthRepo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				return fixAccessRuleServiceWithAccess(id, true)
+			},
 			ExpectedResult: true,
 			ExpectedError:  nil,
 		},
@@ -725,6 +788,9 @@ func TestService_RequestDeletion(t *testing.T) {
 				instanceAuthRepo.On("Delete", contextThatHasTenant(tnt), tnt, id).Return(testError).Once()
 				return instanceAuthRepo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				return fixAccessRuleServiceWithAccess(id, true)
+			},
 			ExpectedError: testError,
 		},
 	}
@@ -732,8 +798,12 @@ func TestService_RequestDeletion(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.InstanceAuthRepoFn()
+			accessRuleSvc := &automock.AccessRuleService{}
+			if testCase.AccessRuleServiceFn != nil {
+				accessRuleSvc = testCase.AccessRuleServiceFn()
+			}
 
-			svc := packageinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := packageinstanceauth.NewService(instanceAuthRepo, accessRuleSvc, nil)
 			svc.SetTimestampGen(func() time.Time {
 				return timestampNow
 			})
@@ -750,7 +820,7 @@ func TestService_RequestDeletion(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedResult, res)
 			}
 
-			instanceAuthRepo.AssertExpectations(t)
+			mock.AssertExpectationsForObjects(t, instanceAuthRepo, accessRuleSvc)
 		})
 	}
 
@@ -759,7 +829,7 @@ func TestService_RequestDeletion(t *testing.T) {
 		expectedError := errors.New("PackageInstanceAuth is required to request its deletion")
 
 		// WHEN
-		svc := packageinstanceauth.NewService(nil, nil)
+		svc := packageinstanceauth.NewService(nil, nil, nil)
 		_, err := svc.RequestDeletion(ctx, nil, nil)
 
 		// THEN
@@ -768,6 +838,12 @@ func TestService_RequestDeletion(t *testing.T) {
 	})
 }
 
+func fixAccessRuleServiceWithAccess(id string, hasAccess bool) *automock.AccessRuleService {
+	accessRuleSvc := &automock.AccessRuleService{}
+	accessRuleSvc.On("HasAccessToParent", contextThatHasTenant(testTenant), model.AccessRuleNestedObjectTypePackageInstanceAuth, id).Return(hasAccess, nil).Once()
+	return accessRuleSvc
+}
+
 func contextThatHasTenant(expectedTenant string) interface{} {
 	return mock.MatchedBy(func(actual context.Context) bool {
 		actualTenant, err := tenant.LoadFromContext(actual)
@@ -0,0 +1,78 @@
+package role
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+type bindingRepository struct {
+	conv         EntityConverter
+	creator      repo.Creator
+	singleGetter repo.SingleGetter
+	lister       repo.Lister
+	deleter      repo.Deleter
+}
+
+func NewBindingRepository(conv EntityConverter) *bindingRepository {
+	return &bindingRepository{
+		conv:         conv,
+		creator:      repo.NewCreator(resource.RoleBinding, roleBindingsTable, bindingColumns),
+		singleGetter: repo.NewSingleGetter(resource.RoleBinding, roleBindingsTable, tenantColumn, bindingColumns),
+		lister:       repo.NewLister(resource.RoleBinding, roleBindingsTable, tenantColumn, bindingColumns),
+		deleter:      repo.NewDeleter(resource.RoleBinding, roleBindingsTable, tenantColumn),
+	}
+}
+
+func (r *bindingRepository) Create(ctx context.Context, item *model.RoleBinding) error {
+	if item == nil {
+		return apperrors.NewInternalError("item cannot be nil")
+	}
+
+	entity, err := r.conv.BindingToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Role Binding to entity")
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+func (r *bindingRepository) GetByID(ctx context.Context, tenant, id string) (*model.RoleBinding, error) {
+	var entity BindingEntity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	binding, err := r.conv.BindingFromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Role Binding from entity")
+	}
+
+	return binding, nil
+}
+
+func (r *bindingRepository) ListForRole(ctx context.Context, tenant, roleID string) ([]*model.RoleBinding, error) {
+	var entities BindingEntityCollection
+	if err := r.lister.List(ctx, tenant, &entities, repo.NewEqualCondition("role_id", roleID)); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.RoleBinding, 0, len(entities))
+	for _, entity := range entities {
+		binding, err := r.conv.BindingFromEntity(&entity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting Role Binding with id %s from entity", entity.ID)
+		}
+		items = append(items, binding)
+	}
+
+	return items, nil
+}
+
+func (r *bindingRepository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
@@ -0,0 +1,130 @@
+package role_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindingRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		binding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("BindingToEntity", binding).Return(fixEntityRoleBinding(), nil).Once()
+
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.role_bindings ( id, tenant_id, role_id, user_name, group_name, system_auth_id ) VALUES ( ?, ?, ?, ?, ?, ? )")).
+			WithArgs(bindingID, tenantID, roleID, nil, "admins", nil).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := role.NewBindingRepository(convMock)
+
+		// when
+		err := repo.Create(ctx, binding)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when conversion fails", func(t *testing.T) {
+		// given
+		binding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("BindingToEntity", binding).Return(nil, errors.New("test")).Once()
+
+		repo := role.NewBindingRepository(convMock)
+
+		// when
+		err := repo.Create(context.TODO(), binding)
+
+		// then
+		require.EqualError(t, err, "while converting Role Binding to entity: test")
+	})
+}
+
+func TestBindingRepository_GetByID(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	binding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+	convMock := &automock.EntityConverter{}
+	defer convMock.AssertExpectations(t)
+	convMock.On("BindingFromEntity", fixEntityRoleBinding()).Return(binding, nil).Once()
+
+	rows := sqlmock.NewRows(bindingColumns).AddRow(bindingID, tenantID, roleID, nil, "admins", nil)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, role_id, user_name, group_name, system_auth_id FROM public.role_bindings WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(tenantID, bindingID).
+		WillReturnRows(rows)
+
+	repo := role.NewBindingRepository(convMock)
+
+	// when
+	result, err := repo.GetByID(ctx, tenantID, bindingID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, binding, result)
+}
+
+func TestBindingRepository_ListForRole(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	binding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+	convMock := &automock.EntityConverter{}
+	defer convMock.AssertExpectations(t)
+	convMock.On("BindingFromEntity", fixEntityRoleBinding()).Return(binding, nil).Once()
+
+	rows := sqlmock.NewRows(bindingColumns).AddRow(bindingID, tenantID, roleID, nil, "admins", nil)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, role_id, user_name, group_name, system_auth_id FROM public.role_bindings WHERE tenant_id = $1 AND role_id = $2")).
+		WithArgs(tenantID, roleID).
+		WillReturnRows(rows)
+
+	repo := role.NewBindingRepository(convMock)
+
+	// when
+	result, err := repo.ListForRole(ctx, tenantID, roleID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.RoleBinding{binding}, result)
+}
+
+func TestBindingRepository_Delete(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.role_bindings WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(tenantID, bindingID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := role.NewBindingRepository(nil)
+
+	// when
+	err := repo.Delete(ctx, tenantID, bindingID)
+
+	// then
+	require.NoError(t, err)
+}
@@ -0,0 +1,193 @@
+package role
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.Role) *graphql.Role {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Role{
+		ID:          in.ID,
+		Name:        in.Name,
+		Description: in.Description,
+		Scopes:      in.Scopes,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Role) []*graphql.Role {
+	roles := []*graphql.Role{}
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+
+		roles = append(roles, c.ToGraphQL(r))
+	}
+
+	return roles
+}
+
+func (c *converter) InputFromGraphQL(in graphql.RoleInput) model.RoleInput {
+	return model.RoleInput{
+		Name:        in.Name,
+		Description: in.Description,
+		Scopes:      in.Scopes,
+	}
+}
+
+func (c *converter) BindingToGraphQL(in *model.RoleBinding) *graphql.RoleBinding {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.RoleBinding{
+		ID:          in.ID,
+		RoleID:      in.RoleID,
+		SubjectType: graphql.RoleBindingSubjectType(in.Subject.Type),
+		Subject:     in.Subject.Name,
+	}
+}
+
+func (c *converter) MultipleBindingsToGraphQL(in []*model.RoleBinding) []*graphql.RoleBinding {
+	bindings := []*graphql.RoleBinding{}
+	for _, b := range in {
+		if b == nil {
+			continue
+		}
+
+		bindings = append(bindings, c.BindingToGraphQL(b))
+	}
+
+	return bindings
+}
+
+func (c *converter) BindingInputFromGraphQL(in graphql.RoleBindingInput) model.RoleBindingInput {
+	return model.RoleBindingInput{
+		RoleID: in.RoleID,
+		Subject: model.RoleBindingSubject{
+			Type: model.RoleBindingSubjectType(in.SubjectType),
+			Name: in.Subject,
+		},
+	}
+}
+
+func (c *converter) ToEntity(in *model.Role) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	scopes := in.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling scopes to JSON")
+	}
+
+	return &Entity{
+		ID:          in.ID,
+		TenantID:    in.Tenant,
+		Name:        in.Name,
+		Description: repo.NewNullableString(in.Description),
+		ScopesJSON:  string(scopesJSON),
+	}, nil
+}
+
+func (c *converter) FromEntity(in *Entity) (*model.Role, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var scopes []string
+	if err := json.Unmarshal([]byte(in.ScopesJSON), &scopes); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling scopes from JSON")
+	}
+
+	return &model.Role{
+		ID:          in.ID,
+		Tenant:      in.TenantID,
+		Name:        in.Name,
+		Description: repo.StringPtrFromNullableString(in.Description),
+		Scopes:      scopes,
+	}, nil
+}
+
+func (c *converter) BindingToEntity(in *model.RoleBinding) (*BindingEntity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &BindingEntity{
+		ID:       in.ID,
+		TenantID: in.Tenant,
+		RoleID:   in.RoleID,
+	}
+
+	switch in.Subject.Type {
+	case model.RoleBindingSubjectTypeUser:
+		out.UserName = repo.NewValidNullableString(in.Subject.Name)
+	case model.RoleBindingSubjectTypeGroup:
+		out.GroupName = repo.NewValidNullableString(in.Subject.Name)
+	case model.RoleBindingSubjectTypeSystemAuth:
+		out.SystemAuthID = repo.NewValidNullableString(in.Subject.Name)
+	default:
+		return nil, apperrors.NewInternalError("unknown role binding subject type: %s", in.Subject.Type)
+	}
+
+	return out, nil
+}
+
+func (c *converter) BindingFromEntity(in *BindingEntity) (*model.RoleBinding, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	subject, err := subjectFromEntity(*in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RoleBinding{
+		ID:      in.ID,
+		Tenant:  in.TenantID,
+		RoleID:  in.RoleID,
+		Subject: subject,
+	}, nil
+}
+
+func subjectFromEntity(in BindingEntity) (model.RoleBindingSubject, error) {
+	subjects := []struct {
+		subjectType model.RoleBindingSubjectType
+		value       sql.NullString
+	}{
+		{subjectType: model.RoleBindingSubjectTypeUser, value: in.UserName},
+		{subjectType: model.RoleBindingSubjectTypeGroup, value: in.GroupName},
+		{subjectType: model.RoleBindingSubjectTypeSystemAuth, value: in.SystemAuthID},
+	}
+
+	for _, s := range subjects {
+		if s.value.Valid {
+			return model.RoleBindingSubject{Type: s.subjectType, Name: s.value.String}, nil
+		}
+	}
+
+	return model.RoleBindingSubject{}, apperrors.NewInternalError("role binding with id %s has no subject", in.ID)
+}
@@ -0,0 +1,241 @@
+package role_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		result := conv.ToGraphQL(fixModelRole())
+
+		// then
+		assert.Equal(t, fixGQLRole(), result)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		result := conv.ToGraphQL(nil)
+
+		// then
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// given
+	conv := role.NewConverter()
+
+	// when
+	result := conv.MultipleToGraphQL([]*model.Role{fixModelRole(), nil})
+
+	// then
+	assert.Equal(t, []*graphql.Role{fixGQLRole()}, result)
+}
+
+func TestConverter_InputFromGraphQL(t *testing.T) {
+	// given
+	conv := role.NewConverter()
+
+	// when
+	result := conv.InputFromGraphQL(fixGQLRoleInput())
+
+	// then
+	assert.Equal(t, fixModelRoleInput(), result)
+}
+
+func TestConverter_BindingToGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		result := conv.BindingToGraphQL(fixModelRoleBinding(model.RoleBindingSubjectTypeSystemAuth, systemAuthID))
+
+		// then
+		assert.Equal(t, fixGQLRoleBinding(graphql.RoleBindingSubjectTypeSystemAuth, systemAuthID), result)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		result := conv.BindingToGraphQL(nil)
+
+		// then
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_MultipleBindingsToGraphQL(t *testing.T) {
+	// given
+	conv := role.NewConverter()
+
+	// when
+	result := conv.MultipleBindingsToGraphQL([]*model.RoleBinding{fixModelRoleBinding(model.RoleBindingSubjectTypeUser, "admin"), nil})
+
+	// then
+	assert.Equal(t, []*graphql.RoleBinding{fixGQLRoleBinding(graphql.RoleBindingSubjectTypeUser, "admin")}, result)
+}
+
+func TestConverter_BindingInputFromGraphQL(t *testing.T) {
+	// given
+	conv := role.NewConverter()
+
+	// when
+	result := conv.BindingInputFromGraphQL(fixGQLRoleBindingInput(graphql.RoleBindingSubjectTypeGroup, "admins"))
+
+	// then
+	assert.Equal(t, fixModelRoleBindingInput(model.RoleBindingSubjectTypeGroup, "admins"), result)
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		result, err := conv.ToEntity(fixModelRole())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixEntityRole(), result)
+	})
+
+	t.Run("success when scopes are nil", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+		in := fixModelRole()
+		in.Scopes = nil
+
+		// when
+		result, err := conv.ToEntity(in)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "[]", result.ScopesJSON)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		result, err := conv.FromEntity(fixEntityRole())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixModelRole(), result)
+	})
+
+	t.Run("error when scopes are not valid JSON", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+		in := fixEntityRole()
+		in.ScopesJSON = "{"
+
+		// when
+		_, err := conv.FromEntity(in)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling scopes from JSON")
+	})
+}
+
+func TestConverter_BindingToEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.RoleBinding
+		Expected *role.BindingEntity
+	}{
+		{
+			Name:  "User",
+			Input: fixModelRoleBinding(model.RoleBindingSubjectTypeUser, "admin"),
+			Expected: &role.BindingEntity{
+				ID:       bindingID,
+				TenantID: tenantID,
+				RoleID:   roleID,
+				UserName: sql.NullString{String: "admin", Valid: true},
+			},
+		},
+		{
+			Name:     "Group",
+			Input:    fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins"),
+			Expected: fixEntityRoleBinding(),
+		},
+		{
+			Name:  "System Auth",
+			Input: fixModelRoleBinding(model.RoleBindingSubjectTypeSystemAuth, systemAuthID),
+			Expected: &role.BindingEntity{
+				ID:           bindingID,
+				TenantID:     tenantID,
+				RoleID:       roleID,
+				SystemAuthID: sql.NullString{String: systemAuthID, Valid: true},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			conv := role.NewConverter()
+
+			// when
+			result, err := conv.BindingToEntity(testCase.Input)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, result)
+
+			// and when
+			converted, err := conv.BindingFromEntity(result)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Input, converted)
+		})
+	}
+
+	t.Run("error when subject type is unknown", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+
+		// when
+		_, err := conv.BindingToEntity(fixModelRoleBinding("FOO", "bar"))
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: unknown role binding subject type: FOO")
+	})
+}
+
+func TestConverter_BindingFromEntity(t *testing.T) {
+	t.Run("error when subject is missing", func(t *testing.T) {
+		// given
+		conv := role.NewConverter()
+		in := fixEntityRoleBinding()
+		in.GroupName = sql.NullString{}
+
+		// when
+		_, err := conv.BindingFromEntity(in)
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: role binding with id "+bindingID+" has no subject")
+	})
+}
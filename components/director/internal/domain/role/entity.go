@@ -0,0 +1,32 @@
+package role
+
+import "database/sql"
+
+type Entity struct {
+	ID          string         `db:"id"`
+	TenantID    string         `db:"tenant_id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+	ScopesJSON  string         `db:"scopes"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
+
+type BindingEntity struct {
+	ID           string         `db:"id"`
+	TenantID     string         `db:"tenant_id"`
+	RoleID       string         `db:"role_id"`
+	UserName     sql.NullString `db:"user_name"`
+	GroupName    sql.NullString `db:"group_name"`
+	SystemAuthID sql.NullString `db:"system_auth_id"`
+}
+
+type BindingEntityCollection []BindingEntity
+
+func (c BindingEntityCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,115 @@
+package role_test
+
+import (
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	roleID       = "c6d4a8d4-5e8a-4c45-9e3c-2a7b0e1f4d21"
+	bindingID    = "e1b2c3d4-0f1e-4a5b-8c7d-6e5f4a3b2c1d"
+	tenantID     = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	systemAuthID = "4a1f9b3e-7c2d-4e8f-9a6b-5d3c2b1a0f9e"
+	roleName     = "admin"
+	scopesJSON   = `["application:read","application:write"]`
+)
+
+var (
+	roleColumns    = []string{"id", "tenant_id", "name", "description", "scopes"}
+	bindingColumns = []string{"id", "tenant_id", "role_id", "user_name", "group_name", "system_auth_id"}
+)
+
+func fixScopes() []string {
+	return []string{"application:read", "application:write"}
+}
+
+func fixModelRole() *model.Role {
+	return &model.Role{
+		ID:          roleID,
+		Tenant:      tenantID,
+		Name:        roleName,
+		Description: str.Ptr("description"),
+		Scopes:      fixScopes(),
+	}
+}
+
+func fixModelRoleInput() model.RoleInput {
+	return model.RoleInput{
+		Name:        roleName,
+		Description: str.Ptr("description"),
+		Scopes:      fixScopes(),
+	}
+}
+
+func fixEntityRole() *role.Entity {
+	return &role.Entity{
+		ID:          roleID,
+		TenantID:    tenantID,
+		Name:        roleName,
+		Description: sql.NullString{String: "description", Valid: true},
+		ScopesJSON:  scopesJSON,
+	}
+}
+
+func fixGQLRole() *graphql.Role {
+	return &graphql.Role{
+		ID:          roleID,
+		Name:        roleName,
+		Description: str.Ptr("description"),
+		Scopes:      fixScopes(),
+	}
+}
+
+func fixGQLRoleInput() graphql.RoleInput {
+	return graphql.RoleInput{
+		Name:        roleName,
+		Description: str.Ptr("description"),
+		Scopes:      fixScopes(),
+	}
+}
+
+func fixModelRoleBinding(subjectType model.RoleBindingSubjectType, subject string) *model.RoleBinding {
+	return &model.RoleBinding{
+		ID:      bindingID,
+		Tenant:  tenantID,
+		RoleID:  roleID,
+		Subject: model.RoleBindingSubject{Type: subjectType, Name: subject},
+	}
+}
+
+func fixModelRoleBindingInput(subjectType model.RoleBindingSubjectType, subject string) model.RoleBindingInput {
+	return model.RoleBindingInput{
+		RoleID:  roleID,
+		Subject: model.RoleBindingSubject{Type: subjectType, Name: subject},
+	}
+}
+
+func fixGQLRoleBinding(subjectType graphql.RoleBindingSubjectType, subject string) *graphql.RoleBinding {
+	return &graphql.RoleBinding{
+		ID:          bindingID,
+		RoleID:      roleID,
+		SubjectType: subjectType,
+		Subject:     subject,
+	}
+}
+
+func fixGQLRoleBindingInput(subjectType graphql.RoleBindingSubjectType, subject string) graphql.RoleBindingInput {
+	return graphql.RoleBindingInput{
+		RoleID:      roleID,
+		SubjectType: subjectType,
+		Subject:     subject,
+	}
+}
+
+func fixEntityRoleBinding() *role.BindingEntity {
+	return &role.BindingEntity{
+		ID:        bindingID,
+		TenantID:  tenantID,
+		RoleID:    roleID,
+		GroupName: sql.NullString{String: "admins", Valid: true},
+	}
+}
@@ -0,0 +1,157 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	rolesTable        = `public.roles`
+	roleBindingsTable = `public.role_bindings`
+	tenantColumn      = "tenant_id"
+)
+
+var (
+	roleColumns          = []string{"id", "tenant_id", "name", "description", "scopes"}
+	roleUpdatableColumns = []string{"name", "description", "scopes"}
+	bindingColumns       = []string{"id", "tenant_id", "role_id", "user_name", "group_name", "system_auth_id"}
+	subjectColumns       = map[model.RoleBindingSubjectType]string{
+		model.RoleBindingSubjectTypeUser:       "user_name",
+		model.RoleBindingSubjectTypeGroup:      "group_name",
+		model.RoleBindingSubjectTypeSystemAuth: "system_auth_id",
+	}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.Role) (*Entity, error)
+	FromEntity(in *Entity) (*model.Role, error)
+	BindingToEntity(in *model.RoleBinding) (*BindingEntity, error)
+	BindingFromEntity(in *BindingEntity) (*model.RoleBinding, error)
+}
+
+type repository struct {
+	conv         EntityConverter
+	creator      repo.Creator
+	singleGetter repo.SingleGetter
+	existQuerier repo.ExistQuerier
+	lister       repo.Lister
+	updater      repo.Updater
+	deleter      repo.Deleter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		conv:         conv,
+		creator:      repo.NewCreator(resource.Role, rolesTable, roleColumns),
+		singleGetter: repo.NewSingleGetter(resource.Role, rolesTable, tenantColumn, roleColumns),
+		existQuerier: repo.NewExistQuerier(resource.Role, rolesTable, tenantColumn),
+		lister:       repo.NewLister(resource.Role, rolesTable, tenantColumn, roleColumns),
+		updater:      repo.NewUpdater(resource.Role, rolesTable, roleUpdatableColumns, tenantColumn, []string{"id"}),
+		deleter:      repo.NewDeleter(resource.Role, rolesTable, tenantColumn),
+	}
+}
+
+func (r *repository) Create(ctx context.Context, item *model.Role) error {
+	if item == nil {
+		return apperrors.NewInternalError("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Role to entity")
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+func (r *repository) GetByID(ctx context.Context, tenant, id string) (*model.Role, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	role, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Role from entity")
+	}
+
+	return role, nil
+}
+
+func (r *repository) Exists(ctx context.Context, tenant, id string) (bool, error) {
+	return r.existQuerier.Exists(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *repository) List(ctx context.Context, tenant string) ([]*model.Role, error) {
+	var entities EntityCollection
+	if err := r.lister.List(ctx, tenant, &entities); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+// ListForSubjects returns the Roles which are bound to any of the given subjects in the tenant
+func (r *repository) ListForSubjects(ctx context.Context, tenant string, subjects []model.RoleBindingSubject) ([]*model.Role, error) {
+	if len(subjects) == 0 {
+		return []*model.Role{}, nil
+	}
+
+	subjectConditions := make([]string, 0, len(subjects))
+	args := []interface{}{tenant}
+	for _, subject := range subjects {
+		column, ok := subjectColumns[subject.Type]
+		if !ok {
+			return nil, apperrors.NewInternalError("unknown role binding subject type: %s", subject.Type)
+		}
+		subjectConditions = append(subjectConditions, fmt.Sprintf("%s = ?", column))
+		args = append(args, subject.Name)
+	}
+
+	subquery := fmt.Sprintf("SELECT role_id FROM %s WHERE %s = ? AND (%s)", roleBindingsTable, tenantColumn, strings.Join(subjectConditions, " OR "))
+
+	var entities EntityCollection
+	if err := r.lister.List(ctx, tenant, &entities, repo.NewInConditionForSubQuery("id", subquery, args)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) Update(ctx context.Context, item *model.Role) error {
+	if item == nil {
+		return apperrors.NewInternalError("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Role to entity")
+	}
+
+	return r.updater.UpdateSingle(ctx, entity)
+}
+
+func (r *repository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *repository) multipleFromEntities(entities EntityCollection) ([]*model.Role, error) {
+	items := make([]*model.Role, 0, len(entities))
+	for _, entity := range entities {
+		role, err := r.conv.FromEntity(&entity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting Role with id %s from entity", entity.ID)
+		}
+		items = append(items, role)
+	}
+
+	return items, nil
+}
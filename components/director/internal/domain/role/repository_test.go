@@ -0,0 +1,258 @@
+package role_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("ToEntity", fixModelRole()).Return(fixEntityRole(), nil).Once()
+
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.roles ( id, tenant_id, name, description, scopes ) VALUES ( ?, ?, ?, ?, ? )")).
+			WithArgs(roleID, tenantID, roleName, "description", scopesJSON).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := role.NewRepository(convMock)
+
+		// when
+		err := repo.Create(ctx, fixModelRole())
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when conversion fails", func(t *testing.T) {
+		// given
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("ToEntity", fixModelRole()).Return(nil, errors.New("test")).Once()
+
+		repo := role.NewRepository(convMock)
+
+		// when
+		err := repo.Create(context.TODO(), fixModelRole())
+
+		// then
+		require.EqualError(t, err, "while converting Role to entity: test")
+	})
+
+	t.Run("error when item is nil", func(t *testing.T) {
+		// given
+		repo := role.NewRepository(nil)
+
+		// when
+		err := repo.Create(context.TODO(), nil)
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: item cannot be nil")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("FromEntity", fixEntityRole()).Return(fixModelRole(), nil).Once()
+
+		rows := sqlmock.NewRows(roleColumns).AddRow(roleID, tenantID, roleName, "description", scopesJSON)
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name, description, scopes FROM public.roles WHERE tenant_id = $1 AND id = $2")).
+			WithArgs(tenantID, roleID).
+			WillReturnRows(rows)
+
+		repo := role.NewRepository(convMock)
+
+		// when
+		result, err := repo.GetByID(ctx, tenantID, roleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixModelRole(), result)
+	})
+
+	t.Run("error when query fails", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name, description, scopes FROM public.roles WHERE tenant_id = $1 AND id = $2")).
+			WithArgs(tenantID, roleID).
+			WillReturnError(errors.New("test"))
+
+		repo := role.NewRepository(nil)
+
+		// when
+		_, err := repo.GetByID(ctx, tenantID, roleID)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_Exists(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM public.roles WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(tenantID, roleID).
+		WillReturnRows(testdb.RowWhenObjectExist())
+
+	repo := role.NewRepository(nil)
+
+	// when
+	result, err := repo.Exists(ctx, tenantID, roleID)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestRepository_List(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	convMock := &automock.EntityConverter{}
+	defer convMock.AssertExpectations(t)
+	convMock.On("FromEntity", fixEntityRole()).Return(fixModelRole(), nil).Once()
+
+	rows := sqlmock.NewRows(roleColumns).AddRow(roleID, tenantID, roleName, "description", scopesJSON)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name, description, scopes FROM public.roles WHERE tenant_id = $1")).
+		WithArgs(tenantID).
+		WillReturnRows(rows)
+
+	repo := role.NewRepository(convMock)
+
+	// when
+	result, err := repo.List(ctx, tenantID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Role{fixModelRole()}, result)
+}
+
+func TestRepository_ListForSubjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		convMock := &automock.EntityConverter{}
+		defer convMock.AssertExpectations(t)
+		convMock.On("FromEntity", fixEntityRole()).Return(fixModelRole(), nil).Once()
+
+		subjects := []model.RoleBindingSubject{
+			{Type: model.RoleBindingSubjectTypeUser, Name: "admin"},
+			{Type: model.RoleBindingSubjectTypeGroup, Name: "admins"},
+			{Type: model.RoleBindingSubjectTypeSystemAuth, Name: systemAuthID},
+		}
+
+		rows := sqlmock.NewRows(roleColumns).AddRow(roleID, tenantID, roleName, "description", scopesJSON)
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name, description, scopes FROM public.roles WHERE tenant_id = $1 AND id IN (SELECT role_id FROM public.role_bindings WHERE tenant_id = $2 AND (user_name = $3 OR group_name = $4 OR system_auth_id = $5))")).
+			WithArgs(tenantID, tenantID, "admin", "admins", systemAuthID).
+			WillReturnRows(rows)
+
+		repo := role.NewRepository(convMock)
+
+		// when
+		result, err := repo.ListForSubjects(ctx, tenantID, subjects)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Role{fixModelRole()}, result)
+	})
+
+	t.Run("returns empty list when there are no subjects", func(t *testing.T) {
+		// given
+		repo := role.NewRepository(nil)
+
+		// when
+		result, err := repo.ListForSubjects(context.TODO(), tenantID, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("error when subject type is unknown", func(t *testing.T) {
+		// given
+		repo := role.NewRepository(nil)
+
+		// when
+		_, err := repo.ListForSubjects(context.TODO(), tenantID, []model.RoleBindingSubject{{Type: "FOO", Name: "bar"}})
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: unknown role binding subject type: FOO")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	convMock := &automock.EntityConverter{}
+	defer convMock.AssertExpectations(t)
+	convMock.On("ToEntity", fixModelRole()).Return(fixEntityRole(), nil).Once()
+
+	dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.roles SET name = ?, description = ?, scopes = ? WHERE tenant_id = ? AND id = ?")).
+		WithArgs(roleName, "description", scopesJSON, tenantID, roleID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := role.NewRepository(convMock)
+
+	// when
+	err := repo.Update(ctx, fixModelRole())
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_Delete(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.roles WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(tenantID, roleID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := role.NewRepository(nil)
+
+	// when
+	err := repo.Delete(ctx, tenantID, roleID)
+
+	// then
+	require.NoError(t, err)
+}
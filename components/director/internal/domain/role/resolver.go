@@ -0,0 +1,246 @@
+package role
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+//go:generate mockery -name=RoleService -output=automock -outpkg=automock -case=underscore
+type RoleService interface {
+	Create(ctx context.Context, in model.RoleInput) (string, error)
+	Get(ctx context.Context, id string) (*model.Role, error)
+	List(ctx context.Context) ([]*model.Role, error)
+	Update(ctx context.Context, id string, in model.RoleInput) error
+	Delete(ctx context.Context, id string) error
+	CreateBinding(ctx context.Context, in model.RoleBindingInput) (string, error)
+	GetBinding(ctx context.Context, id string) (*model.RoleBinding, error)
+	ListBindings(ctx context.Context, roleID string) ([]*model.RoleBinding, error)
+	DeleteBinding(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=RoleConverter -output=automock -outpkg=automock -case=underscore
+type RoleConverter interface {
+	ToGraphQL(in *model.Role) *graphql.Role
+	MultipleToGraphQL(in []*model.Role) []*graphql.Role
+	InputFromGraphQL(in graphql.RoleInput) model.RoleInput
+	BindingToGraphQL(in *model.RoleBinding) *graphql.RoleBinding
+	MultipleBindingsToGraphQL(in []*model.RoleBinding) []*graphql.RoleBinding
+	BindingInputFromGraphQL(in graphql.RoleBindingInput) model.RoleBindingInput
+}
+
+type Resolver struct {
+	transact  persistence.Transactioner
+	svc       RoleService
+	converter RoleConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc RoleService, conv RoleConverter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		svc:       svc,
+		converter: conv,
+	}
+}
+
+func (r *Resolver) Roles(ctx context.Context) ([]*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	roles, err := r.svc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.MultipleToGraphQL(roles), nil
+}
+
+func (r *Resolver) Role(ctx context.Context, id string) (*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	role, err := r.svc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(role), nil
+}
+
+func (r *Resolver) RoleBindings(ctx context.Context, roleID string) ([]*graphql.RoleBinding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	bindings, err := r.svc.ListBindings(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.MultipleBindingsToGraphQL(bindings), nil
+}
+
+func (r *Resolver) CreateRole(ctx context.Context, in graphql.RoleInput) (*graphql.Role, error) {
+	convertedIn := r.converter.InputFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.Create(ctx, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(role), nil
+}
+
+func (r *Resolver) UpdateRole(ctx context.Context, id string, in graphql.RoleInput) (*graphql.Role, error) {
+	convertedIn := r.converter.InputFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = r.svc.Update(ctx, id, convertedIn); err != nil {
+		return nil, err
+	}
+
+	role, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(role), nil
+}
+
+func (r *Resolver) DeleteRole(ctx context.Context, id string) (*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	role, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.svc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(role), nil
+}
+
+func (r *Resolver) CreateRoleBinding(ctx context.Context, in graphql.RoleBindingInput) (*graphql.RoleBinding, error) {
+	convertedIn := r.converter.BindingInputFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.CreateBinding(ctx, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := r.svc.GetBinding(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.BindingToGraphQL(binding), nil
+}
+
+func (r *Resolver) DeleteRoleBinding(ctx context.Context, id string) (*graphql.RoleBinding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	binding, err := r.svc.GetBinding(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.svc.DeleteBinding(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.BindingToGraphQL(binding), nil
+}
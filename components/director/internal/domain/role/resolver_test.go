@@ -0,0 +1,315 @@
+package role_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Roles(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+	modelRoles := []*model.Role{fixModelRole()}
+	gqlRoles := []*graphql.Role{fixGQLRole()}
+
+	t.Run("success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(modelRoles, nil).Once()
+		conv := &automock.RoleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("MultipleToGraphQL", modelRoles).Return(gqlRoles).Once()
+
+		resolver := role.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.Roles(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlRoles, result)
+	})
+
+	t.Run("error when listing Roles fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+
+		resolver := role.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.Roles(context.TODO())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("error when transaction cannot be started", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		resolver := role.NewResolver(transact, nil, nil)
+
+		// when
+		_, err := resolver.Roles(context.TODO())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestResolver_Role(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), roleID).Return(fixModelRole(), nil).Once()
+		conv := &automock.RoleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", fixModelRole()).Return(fixGQLRole()).Once()
+
+		resolver := role.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.Role(context.TODO(), roleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLRole(), result)
+	})
+
+	t.Run("returns nil when Role does not exist", func(t *testing.T) {
+		// given
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), roleID).Return(nil, apperrors.NewNotFoundError("Role", roleID)).Once()
+
+		resolver := role.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.Role(context.TODO(), roleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_RoleBindings(t *testing.T) {
+	// given
+	modelBindings := []*model.RoleBinding{fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")}
+	gqlBindings := []*graphql.RoleBinding{fixGQLRoleBinding(graphql.RoleBindingSubjectTypeGroup, "admins")}
+
+	persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+	defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+	svc := &automock.RoleService{}
+	defer svc.AssertExpectations(t)
+	svc.On("ListBindings", txtest.CtxWithDBMatcher(), roleID).Return(modelBindings, nil).Once()
+	conv := &automock.RoleConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("MultipleBindingsToGraphQL", modelBindings).Return(gqlBindings).Once()
+
+	resolver := role.NewResolver(transact, svc, conv)
+
+	// when
+	result, err := resolver.RoleBindings(context.TODO(), roleID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, gqlBindings, result)
+}
+
+func TestResolver_CreateRole(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+
+	t.Run("success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), fixModelRoleInput()).Return(roleID, nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), roleID).Return(fixModelRole(), nil).Once()
+		conv := &automock.RoleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", fixGQLRoleInput()).Return(fixModelRoleInput()).Once()
+		conv.On("ToGraphQL", fixModelRole()).Return(fixGQLRole()).Once()
+
+		resolver := role.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.CreateRole(context.TODO(), fixGQLRoleInput())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLRole(), result)
+	})
+
+	t.Run("error when creating Role fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), fixModelRoleInput()).Return("", testErr).Once()
+		conv := &automock.RoleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", fixGQLRoleInput()).Return(fixModelRoleInput()).Once()
+
+		resolver := role.NewResolver(transact, svc, conv)
+
+		// when
+		_, err := resolver.CreateRole(context.TODO(), fixGQLRoleInput())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestResolver_UpdateRole(t *testing.T) {
+	// given
+	persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+	defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+	svc := &automock.RoleService{}
+	defer svc.AssertExpectations(t)
+	svc.On("Update", txtest.CtxWithDBMatcher(), roleID, fixModelRoleInput()).Return(nil).Once()
+	svc.On("Get", txtest.CtxWithDBMatcher(), roleID).Return(fixModelRole(), nil).Once()
+	conv := &automock.RoleConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("InputFromGraphQL", fixGQLRoleInput()).Return(fixModelRoleInput()).Once()
+	conv.On("ToGraphQL", fixModelRole()).Return(fixGQLRole()).Once()
+
+	resolver := role.NewResolver(transact, svc, conv)
+
+	// when
+	result, err := resolver.UpdateRole(context.TODO(), roleID, fixGQLRoleInput())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, fixGQLRole(), result)
+}
+
+func TestResolver_DeleteRole(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+
+	t.Run("success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), roleID).Return(fixModelRole(), nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), roleID).Return(nil).Once()
+		conv := &automock.RoleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", fixModelRole()).Return(fixGQLRole()).Once()
+
+		resolver := role.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.DeleteRole(context.TODO(), roleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLRole(), result)
+	})
+
+	t.Run("error when deleting Role fails", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		svc := &automock.RoleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), roleID).Return(fixModelRole(), nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), roleID).Return(testErr).Once()
+
+		resolver := role.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.DeleteRole(context.TODO(), roleID)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestResolver_CreateRoleBinding(t *testing.T) {
+	// given
+	gqlInput := fixGQLRoleBindingInput(graphql.RoleBindingSubjectTypeGroup, "admins")
+	modelInput := fixModelRoleBindingInput(model.RoleBindingSubjectTypeGroup, "admins")
+	modelBinding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+	gqlBinding := fixGQLRoleBinding(graphql.RoleBindingSubjectTypeGroup, "admins")
+
+	persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+	defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+	svc := &automock.RoleService{}
+	defer svc.AssertExpectations(t)
+	svc.On("CreateBinding", txtest.CtxWithDBMatcher(), modelInput).Return(bindingID, nil).Once()
+	svc.On("GetBinding", txtest.CtxWithDBMatcher(), bindingID).Return(modelBinding, nil).Once()
+	conv := &automock.RoleConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("BindingInputFromGraphQL", gqlInput).Return(modelInput).Once()
+	conv.On("BindingToGraphQL", modelBinding).Return(gqlBinding).Once()
+
+	resolver := role.NewResolver(transact, svc, conv)
+
+	// when
+	result, err := resolver.CreateRoleBinding(context.TODO(), gqlInput)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, gqlBinding, result)
+}
+
+func TestResolver_DeleteRoleBinding(t *testing.T) {
+	// given
+	modelBinding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+	gqlBinding := fixGQLRoleBinding(graphql.RoleBindingSubjectTypeGroup, "admins")
+
+	persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+	defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+	svc := &automock.RoleService{}
+	defer svc.AssertExpectations(t)
+	svc.On("GetBinding", txtest.CtxWithDBMatcher(), bindingID).Return(modelBinding, nil).Once()
+	svc.On("DeleteBinding", txtest.CtxWithDBMatcher(), bindingID).Return(nil).Once()
+	conv := &automock.RoleConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("BindingToGraphQL", modelBinding).Return(gqlBinding).Once()
+
+	resolver := role.NewResolver(transact, svc, conv)
+
+	// when
+	result, err := resolver.DeleteRoleBinding(context.TODO(), bindingID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, gqlBinding, result)
+}
@@ -0,0 +1,275 @@
+package role
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+)
+
+// graphqlScopesPath is the path of the scopes required by GraphQL operations, which can be granted with Roles
+const graphqlScopesPath = "graphql"
+
+//go:generate mockery -name=RoleRepository -output=automock -outpkg=automock -case=underscore
+type RoleRepository interface {
+	Create(ctx context.Context, item *model.Role) error
+	GetByID(ctx context.Context, tenant, id string) (*model.Role, error)
+	Exists(ctx context.Context, tenant, id string) (bool, error)
+	List(ctx context.Context, tenant string) ([]*model.Role, error)
+	Update(ctx context.Context, item *model.Role) error
+	Delete(ctx context.Context, tenant, id string) error
+}
+
+//go:generate mockery -name=RoleBindingRepository -output=automock -outpkg=automock -case=underscore
+type RoleBindingRepository interface {
+	Create(ctx context.Context, item *model.RoleBinding) error
+	GetByID(ctx context.Context, tenant, id string) (*model.RoleBinding, error)
+	ListForRole(ctx context.Context, tenant, roleID string) ([]*model.RoleBinding, error)
+	Delete(ctx context.Context, tenant, id string) error
+}
+
+//go:generate mockery -name=ScopesLister -output=automock -outpkg=automock -case=underscore
+type ScopesLister interface {
+	ListRequiredScopes(path string) ([]string, error)
+}
+
+//go:generate mockery -name=SystemAuthService -output=automock -outpkg=automock -case=underscore
+type SystemAuthService interface {
+	GetGlobal(ctx context.Context, id string) (*model.SystemAuth, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo          RoleRepository
+	bindingRepo   RoleBindingRepository
+	scopesLister  ScopesLister
+	systemAuthSvc SystemAuthService
+	uidService    UIDService
+}
+
+func NewService(repo RoleRepository, bindingRepo RoleBindingRepository, scopesLister ScopesLister, systemAuthSvc SystemAuthService, uidService UIDService) *service {
+	return &service{
+		repo:          repo,
+		bindingRepo:   bindingRepo,
+		scopesLister:  scopesLister,
+		systemAuthSvc: systemAuthSvc,
+		uidService:    uidService,
+	}
+}
+
+func (s *service) Create(ctx context.Context, in model.RoleInput) (string, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while loading tenant from context")
+	}
+
+	if err := s.ensureScopesCanBeGranted(ctx, in.Scopes); err != nil {
+		return "", err
+	}
+
+	id := s.uidService.Generate()
+	if err := s.repo.Create(ctx, in.ToRole(id, tnt)); err != nil {
+		return "", errors.Wrap(err, "while creating Role")
+	}
+
+	return id, nil
+}
+
+func (s *service) Get(ctx context.Context, id string) (*model.Role, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	role, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Role with ID %s", id)
+	}
+
+	return role, nil
+}
+
+func (s *service) List(ctx context.Context) ([]*model.Role, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	roles, err := s.repo.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Roles")
+	}
+
+	return roles, nil
+}
+
+func (s *service) Update(ctx context.Context, id string, in model.RoleInput) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	if _, err := s.repo.GetByID(ctx, tnt, id); err != nil {
+		return errors.Wrapf(err, "while getting Role with ID %s", id)
+	}
+
+	if err := s.ensureScopesCanBeGranted(ctx, in.Scopes); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, in.ToRole(id, tnt)); err != nil {
+		return errors.Wrapf(err, "while updating Role with ID %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	if err := s.repo.Delete(ctx, tnt, id); err != nil {
+		return errors.Wrapf(err, "while deleting Role with ID %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) CreateBinding(ctx context.Context, in model.RoleBindingInput) (string, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while loading tenant from context")
+	}
+
+	role, err := s.repo.GetByID(ctx, tnt, in.RoleID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while getting Role with ID %s", in.RoleID)
+	}
+
+	if err := s.ensureScopesCanBeGranted(ctx, role.Scopes); err != nil {
+		return "", err
+	}
+
+	if in.Subject.Type == model.RoleBindingSubjectTypeSystemAuth {
+		if err := s.ensureSystemAuthBelongsToTenant(ctx, in.Subject.Name, tnt); err != nil {
+			return "", err
+		}
+	}
+
+	id := s.uidService.Generate()
+	if err := s.bindingRepo.Create(ctx, in.ToRoleBinding(id, tnt)); err != nil {
+		return "", errors.Wrap(err, "while creating Role Binding")
+	}
+
+	return id, nil
+}
+
+func (s *service) GetBinding(ctx context.Context, id string) (*model.RoleBinding, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	binding, err := s.bindingRepo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Role Binding with ID %s", id)
+	}
+
+	return binding, nil
+}
+
+func (s *service) ListBindings(ctx context.Context, roleID string) ([]*model.RoleBinding, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	exists, err := s.repo.Exists(ctx, tnt, roleID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while checking if Role with ID %s exists", roleID)
+	}
+	if !exists {
+		return nil, apperrors.NewNotFoundError(resource.Role, roleID)
+	}
+
+	bindings, err := s.bindingRepo.ListForRole(ctx, tnt, roleID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Role Bindings for Role with ID %s", roleID)
+	}
+
+	return bindings, nil
+}
+
+func (s *service) DeleteBinding(ctx context.Context, id string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	if err := s.bindingRepo.Delete(ctx, tnt, id); err != nil {
+		return errors.Wrapf(err, "while deleting Role Binding with ID %s", id)
+	}
+
+	return nil
+}
+
+// ensureScopesCanBeGranted checks that the scopes are required by GraphQL operations
+// and that the caller has all of them, so that nobody can grant more than they have
+func (s *service) ensureScopesCanBeGranted(ctx context.Context, scopes []string) error {
+	knownScopes, err := s.scopesLister.ListRequiredScopes(graphqlScopesPath)
+	if err != nil {
+		return errors.Wrap(err, "while listing scopes required by GraphQL operations")
+	}
+
+	for _, sc := range scopes {
+		if !contains(knownScopes, sc) {
+			return apperrors.NewInvalidDataError("scope %s is not required by any GraphQL operation", sc)
+		}
+	}
+
+	callerScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sc := range scopes {
+		if !contains(callerScopes, sc) {
+			return apperrors.NewInsufficientScopesError(scopes, callerScopes)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) ensureSystemAuthBelongsToTenant(ctx context.Context, id, tnt string) error {
+	sysAuth, err := s.systemAuthSvc.GetGlobal(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting System Auth with ID %s", id)
+	}
+
+	if sysAuth.TenantID != nil && *sysAuth.TenantID != tnt {
+		return apperrors.NewInvalidDataError("system auth with ID %s does not belong to the tenant", id)
+	}
+
+	return nil
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
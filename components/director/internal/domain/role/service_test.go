@@ -0,0 +1,501 @@
+package role_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// given
+	testErr := errors.New("test")
+	knownScopes := []string{"application:read", "application:write", "runtime:read"}
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		RepoFn             func() *automock.RoleRepository
+		ScopesListerFn     func() *automock.ScopesLister
+		UIDServiceFn       func() *automock.UIDService
+		Input              model.RoleInput
+		ExpectedErrMessage string
+	}{
+		{
+			Name:    "Success",
+			Context: fixContext(knownScopes),
+			RepoFn: func() *automock.RoleRepository {
+				repo := &automock.RoleRepository{}
+				repo.On("Create", mock.Anything, fixModelRole()).Return(nil).Once()
+				return repo
+			},
+			ScopesListerFn: fixScopesListerThatReturns(knownScopes),
+			UIDServiceFn:   fixUIDServiceThatGenerates(roleID),
+			Input:          fixModelRoleInput(),
+		},
+		{
+			Name:    "Error when scope is not required by any GraphQL operation",
+			Context: fixContext(knownScopes),
+			RepoFn: func() *automock.RoleRepository {
+				return &automock.RoleRepository{}
+			},
+			ScopesListerFn:     fixScopesListerThatReturns([]string{"runtime:read"}),
+			UIDServiceFn:       fixEmptyUIDService,
+			Input:              fixModelRoleInput(),
+			ExpectedErrMessage: "scope application:read is not required by any GraphQL operation",
+		},
+		{
+			Name:    "Error when caller does not have the scopes",
+			Context: fixContext([]string{"application:read"}),
+			RepoFn: func() *automock.RoleRepository {
+				return &automock.RoleRepository{}
+			},
+			ScopesListerFn:     fixScopesListerThatReturns(knownScopes),
+			UIDServiceFn:       fixEmptyUIDService,
+			Input:              fixModelRoleInput(),
+			ExpectedErrMessage: "insufficient scopes provided",
+		},
+		{
+			Name:    "Error when listing required scopes fails",
+			Context: fixContext(knownScopes),
+			RepoFn: func() *automock.RoleRepository {
+				return &automock.RoleRepository{}
+			},
+			ScopesListerFn: func() *automock.ScopesLister {
+				lister := &automock.ScopesLister{}
+				lister.On("ListRequiredScopes", "graphql").Return(nil, testErr).Once()
+				return lister
+			},
+			UIDServiceFn:       fixEmptyUIDService,
+			Input:              fixModelRoleInput(),
+			ExpectedErrMessage: "while listing scopes required by GraphQL operations: test",
+		},
+		{
+			Name:    "Error when creating Role fails",
+			Context: fixContext(knownScopes),
+			RepoFn: func() *automock.RoleRepository {
+				repo := &automock.RoleRepository{}
+				repo.On("Create", mock.Anything, fixModelRole()).Return(testErr).Once()
+				return repo
+			},
+			ScopesListerFn:     fixScopesListerThatReturns(knownScopes),
+			UIDServiceFn:       fixUIDServiceThatGenerates(roleID),
+			Input:              fixModelRoleInput(),
+			ExpectedErrMessage: "while creating Role: test",
+		},
+		{
+			Name:    "Error when tenant is missing in context",
+			Context: context.TODO(),
+			RepoFn: func() *automock.RoleRepository {
+				return &automock.RoleRepository{}
+			},
+			ScopesListerFn:     fixEmptyScopesLister,
+			UIDServiceFn:       fixEmptyUIDService,
+			Input:              fixModelRoleInput(),
+			ExpectedErrMessage: "while loading tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			scopesLister := testCase.ScopesListerFn()
+			uidService := testCase.UIDServiceFn()
+			svc := role.NewService(repo, nil, scopesLister, nil, uidService)
+
+			// when
+			id, err := svc.Create(testCase.Context, testCase.Input)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, roleID, id)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, scopesLister, uidService)
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, roleID).Return(fixModelRole(), nil).Once()
+
+		svc := role.NewService(repo, nil, nil, nil, nil)
+
+		// when
+		result, err := svc.Get(fixContext(nil), roleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixModelRole(), result)
+	})
+
+	t.Run("error when getting Role fails", func(t *testing.T) {
+		// given
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, roleID).Return(nil, errors.New("test")).Once()
+
+		svc := role.NewService(repo, nil, nil, nil, nil)
+
+		// when
+		_, err := svc.Get(fixContext(nil), roleID)
+
+		// then
+		require.EqualError(t, err, "while getting Role with ID "+roleID+": test")
+	})
+}
+
+func TestService_List(t *testing.T) {
+	// given
+	repo := &automock.RoleRepository{}
+	defer repo.AssertExpectations(t)
+	repo.On("List", mock.Anything, tenantID).Return([]*model.Role{fixModelRole()}, nil).Once()
+
+	svc := role.NewService(repo, nil, nil, nil, nil)
+
+	// when
+	result, err := svc.List(fixContext(nil))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Role{fixModelRole()}, result)
+}
+
+func TestService_Update(t *testing.T) {
+	knownScopes := fixScopes()
+
+	t.Run("success", func(t *testing.T) {
+		// given
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, roleID).Return(fixModelRole(), nil).Once()
+		repo.On("Update", mock.Anything, fixModelRole()).Return(nil).Once()
+		scopesLister := fixScopesListerThatReturns(knownScopes)()
+		defer scopesLister.AssertExpectations(t)
+
+		svc := role.NewService(repo, nil, scopesLister, nil, nil)
+
+		// when
+		err := svc.Update(fixContext(knownScopes), roleID, fixModelRoleInput())
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("error when Role does not exist", func(t *testing.T) {
+		// given
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, roleID).Return(nil, apperrors.NewNotFoundError("Role", roleID)).Once()
+
+		svc := role.NewService(repo, nil, nil, nil, nil)
+
+		// when
+		err := svc.Update(fixContext(knownScopes), roleID, fixModelRoleInput())
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("error when updating Role fails", func(t *testing.T) {
+		// given
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", mock.Anything, tenantID, roleID).Return(fixModelRole(), nil).Once()
+		repo.On("Update", mock.Anything, fixModelRole()).Return(errors.New("test")).Once()
+		scopesLister := fixScopesListerThatReturns(knownScopes)()
+		defer scopesLister.AssertExpectations(t)
+
+		svc := role.NewService(repo, nil, scopesLister, nil, nil)
+
+		// when
+		err := svc.Update(fixContext(knownScopes), roleID, fixModelRoleInput())
+
+		// then
+		require.EqualError(t, err, "while updating Role with ID "+roleID+": test")
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	// given
+	repo := &automock.RoleRepository{}
+	defer repo.AssertExpectations(t)
+	repo.On("Delete", mock.Anything, tenantID, roleID).Return(errors.New("test")).Once()
+
+	svc := role.NewService(repo, nil, nil, nil, nil)
+
+	// when
+	err := svc.Delete(fixContext(nil), roleID)
+
+	// then
+	require.EqualError(t, err, "while deleting Role with ID "+roleID+": test")
+}
+
+func TestService_CreateBinding(t *testing.T) {
+	// given
+	knownScopes := fixScopes()
+	otherTenantID := "a3e1f3b4-6f3c-4d2e-8b1a-0c9d8e7f6a5b"
+
+	testCases := []struct {
+		Name               string
+		RepoFn             func() *automock.RoleRepository
+		BindingRepoFn      func() *automock.RoleBindingRepository
+		SystemAuthSvcFn    func() *automock.SystemAuthService
+		UIDServiceFn       func() *automock.UIDService
+		Input              model.RoleBindingInput
+		ExpectedErrMessage string
+	}{
+		{
+			Name:   "Success for group",
+			RepoFn: fixRoleRepositoryThatReturnsRole,
+			BindingRepoFn: func() *automock.RoleBindingRepository {
+				repo := &automock.RoleBindingRepository{}
+				repo.On("Create", mock.Anything, fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")).Return(nil).Once()
+				return repo
+			},
+			SystemAuthSvcFn: fixEmptySystemAuthService,
+			UIDServiceFn:    fixUIDServiceThatGenerates(bindingID),
+			Input:           fixModelRoleBindingInput(model.RoleBindingSubjectTypeGroup, "admins"),
+		},
+		{
+			Name:   "Success for system auth of the tenant",
+			RepoFn: fixRoleRepositoryThatReturnsRole,
+			BindingRepoFn: func() *automock.RoleBindingRepository {
+				repo := &automock.RoleBindingRepository{}
+				repo.On("Create", mock.Anything, fixModelRoleBinding(model.RoleBindingSubjectTypeSystemAuth, systemAuthID)).Return(nil).Once()
+				return repo
+			},
+			SystemAuthSvcFn: fixSystemAuthServiceThatReturns(&model.SystemAuth{ID: systemAuthID, TenantID: str.Ptr(tenantID)}),
+			UIDServiceFn:    fixUIDServiceThatGenerates(bindingID),
+			Input:           fixModelRoleBindingInput(model.RoleBindingSubjectTypeSystemAuth, systemAuthID),
+		},
+		{
+			Name:   "Success for system auth of integration system",
+			RepoFn: fixRoleRepositoryThatReturnsRole,
+			BindingRepoFn: func() *automock.RoleBindingRepository {
+				repo := &automock.RoleBindingRepository{}
+				repo.On("Create", mock.Anything, fixModelRoleBinding(model.RoleBindingSubjectTypeSystemAuth, systemAuthID)).Return(nil).Once()
+				return repo
+			},
+			SystemAuthSvcFn: fixSystemAuthServiceThatReturns(&model.SystemAuth{ID: systemAuthID}),
+			UIDServiceFn:    fixUIDServiceThatGenerates(bindingID),
+			Input:           fixModelRoleBindingInput(model.RoleBindingSubjectTypeSystemAuth, systemAuthID),
+		},
+		{
+			Name:               "Error when system auth belongs to another tenant",
+			RepoFn:             fixRoleRepositoryThatReturnsRole,
+			BindingRepoFn:      fixEmptyRoleBindingRepository,
+			SystemAuthSvcFn:    fixSystemAuthServiceThatReturns(&model.SystemAuth{ID: systemAuthID, TenantID: str.Ptr(otherTenantID)}),
+			UIDServiceFn:       fixEmptyUIDService,
+			Input:              fixModelRoleBindingInput(model.RoleBindingSubjectTypeSystemAuth, systemAuthID),
+			ExpectedErrMessage: "system auth with ID " + systemAuthID + " does not belong to the tenant",
+		},
+		{
+			Name: "Error when Role does not exist",
+			RepoFn: func() *automock.RoleRepository {
+				repo := &automock.RoleRepository{}
+				repo.On("GetByID", mock.Anything, tenantID, roleID).Return(nil, apperrors.NewNotFoundError("Role", roleID)).Once()
+				return repo
+			},
+			BindingRepoFn:      fixEmptyRoleBindingRepository,
+			SystemAuthSvcFn:    fixEmptySystemAuthService,
+			UIDServiceFn:       fixEmptyUIDService,
+			Input:              fixModelRoleBindingInput(model.RoleBindingSubjectTypeGroup, "admins"),
+			ExpectedErrMessage: "while getting Role with ID " + roleID,
+		},
+		{
+			Name:   "Error when creating Role Binding fails",
+			RepoFn: fixRoleRepositoryThatReturnsRole,
+			BindingRepoFn: func() *automock.RoleBindingRepository {
+				repo := &automock.RoleBindingRepository{}
+				repo.On("Create", mock.Anything, fixModelRoleBinding(model.RoleBindingSubjectTypeUser, "admin")).Return(errors.New("test")).Once()
+				return repo
+			},
+			SystemAuthSvcFn:    fixEmptySystemAuthService,
+			UIDServiceFn:       fixUIDServiceThatGenerates(bindingID),
+			Input:              fixModelRoleBindingInput(model.RoleBindingSubjectTypeUser, "admin"),
+			ExpectedErrMessage: "while creating Role Binding: test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			bindingRepo := testCase.BindingRepoFn()
+			scopesLister := fixScopesListerThatReturns(knownScopes)()
+			systemAuthSvc := testCase.SystemAuthSvcFn()
+			uidService := testCase.UIDServiceFn()
+			svc := role.NewService(repo, bindingRepo, scopesLister, systemAuthSvc, uidService)
+
+			// when
+			id, err := svc.CreateBinding(fixContext(knownScopes), testCase.Input)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, bindingID, id)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, bindingRepo, systemAuthSvc, uidService)
+		})
+	}
+
+	t.Run("Error when caller does not have the scopes of the Role", func(t *testing.T) {
+		// given
+		repo := fixRoleRepositoryThatReturnsRole()
+		defer repo.AssertExpectations(t)
+		scopesLister := fixScopesListerThatReturns(knownScopes)()
+		defer scopesLister.AssertExpectations(t)
+		svc := role.NewService(repo, nil, scopesLister, nil, nil)
+
+		// when
+		_, err := svc.CreateBinding(fixContext([]string{"application:read"}), fixModelRoleBindingInput(model.RoleBindingSubjectTypeGroup, "admins"))
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "insufficient scopes provided")
+	})
+}
+
+func TestService_ListBindings(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		bindings := []*model.RoleBinding{fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")}
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("Exists", mock.Anything, tenantID, roleID).Return(true, nil).Once()
+		bindingRepo := &automock.RoleBindingRepository{}
+		defer bindingRepo.AssertExpectations(t)
+		bindingRepo.On("ListForRole", mock.Anything, tenantID, roleID).Return(bindings, nil).Once()
+
+		svc := role.NewService(repo, bindingRepo, nil, nil, nil)
+
+		// when
+		result, err := svc.ListBindings(fixContext(nil), roleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, bindings, result)
+	})
+
+	t.Run("error when Role does not exist", func(t *testing.T) {
+		// given
+		repo := &automock.RoleRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("Exists", mock.Anything, tenantID, roleID).Return(false, nil).Once()
+
+		svc := role.NewService(repo, nil, nil, nil, nil)
+
+		// when
+		_, err := svc.ListBindings(fixContext(nil), roleID)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
+
+func TestService_GetBinding(t *testing.T) {
+	// given
+	binding := fixModelRoleBinding(model.RoleBindingSubjectTypeGroup, "admins")
+	bindingRepo := &automock.RoleBindingRepository{}
+	defer bindingRepo.AssertExpectations(t)
+	bindingRepo.On("GetByID", mock.Anything, tenantID, bindingID).Return(binding, nil).Once()
+
+	svc := role.NewService(nil, bindingRepo, nil, nil, nil)
+
+	// when
+	result, err := svc.GetBinding(fixContext(nil), bindingID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, binding, result)
+}
+
+func TestService_DeleteBinding(t *testing.T) {
+	// given
+	bindingRepo := &automock.RoleBindingRepository{}
+	defer bindingRepo.AssertExpectations(t)
+	bindingRepo.On("Delete", mock.Anything, tenantID, bindingID).Return(nil).Once()
+
+	svc := role.NewService(nil, bindingRepo, nil, nil, nil)
+
+	// when
+	err := svc.DeleteBinding(fixContext(nil), bindingID)
+
+	// then
+	require.NoError(t, err)
+}
+
+func fixContext(scopes []string) context.Context {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, "external")
+	return scope.SaveToContext(ctx, scopes)
+}
+
+func fixRoleRepositoryThatReturnsRole() *automock.RoleRepository {
+	repo := &automock.RoleRepository{}
+	repo.On("GetByID", mock.Anything, tenantID, roleID).Return(fixModelRole(), nil).Once()
+	return repo
+}
+
+func fixEmptyRoleBindingRepository() *automock.RoleBindingRepository {
+	return &automock.RoleBindingRepository{}
+}
+
+func fixScopesListerThatReturns(scopes []string) func() *automock.ScopesLister {
+	return func() *automock.ScopesLister {
+		lister := &automock.ScopesLister{}
+		lister.On("ListRequiredScopes", "graphql").Return(scopes, nil).Once()
+		return lister
+	}
+}
+
+func fixEmptyScopesLister() *automock.ScopesLister {
+	return &automock.ScopesLister{}
+}
+
+func fixSystemAuthServiceThatReturns(sysAuth *model.SystemAuth) func() *automock.SystemAuthService {
+	return func() *automock.SystemAuthService {
+		svc := &automock.SystemAuthService{}
+		svc.On("GetGlobal", mock.Anything, systemAuthID).Return(sysAuth, nil).Once()
+		return svc
+	}
+}
+
+func fixEmptySystemAuthService() *automock.SystemAuthService {
+	return &automock.SystemAuthService{}
+}
+
+func fixUIDServiceThatGenerates(id string) func() *automock.UIDService {
+	return func() *automock.UIDService {
+		uidService := &automock.UIDService{}
+		uidService.On("Generate").Return(id).Once()
+		return uidService
+	}
+}
+
+func fixEmptyUIDService() *automock.UIDService {
+	return &automock.UIDService{}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
@@ -24,6 +25,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
 	packageutil "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/role"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
@@ -31,9 +33,9 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/viewer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
 	"github.com/kyma-incubator/compass/components/director/internal/graphql_client"
-	"github.com/kyma-incubator/compass/components/director/internal/metrics"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
@@ -67,6 +69,8 @@ type RootResolver struct {
 	mpPackage           *packageutil.Resolver
 	packageInstanceAuth *packageinstanceauth.Resolver
 	scenarioAssignment  *scenarioassignment.Resolver
+	role                *role.Resolver
+	accessRule          *accessrule.Resolver
 }
 
 func NewRootResolver(
@@ -74,17 +78,12 @@ func NewRootResolver(
 	cfgProvider *configprovider.Provider,
 	oneTimeTokenCfg onetimetoken.Config,
 	oAuth20Cfg oauth20.Config,
+	oAuth20Registry oauth20.OAuthClientRegistry,
 	pairingAdaptersMapping map[string]string,
 	featuresConfig features.Config,
-	metricsCollector *metrics.Collector,
 	clientTimeout time.Duration,
+	encryptor encryption.Encryptor,
 ) *RootResolver {
-	oAuth20HTTPClient := &http.Client{
-		Timeout:   oAuth20Cfg.HTTPClientTimeout,
-		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
-	}
-	metricsCollector.InstrumentOAuth20HTTPClient(oAuth20HTTPClient)
-
 	authConverter := auth.NewConverter()
 	runtimeConverter := runtime.NewConverter()
 	runtimeContextConverter := runtime_context.NewConverter()
@@ -105,6 +104,8 @@ func NewRootResolver(
 	appTemplateConverter := apptemplate.NewConverter(appConverter)
 	packageInstanceAuthConv := packageinstanceauth.NewConverter(authConverter)
 	assignmentConv := scenarioassignment.NewConverter()
+	roleConverter := role.NewConverter()
+	accessRuleConverter := accessrule.NewConverter()
 
 	healthcheckRepo := healthcheck.NewRepository()
 	runtimeRepo := runtime.NewRepository()
@@ -117,17 +118,22 @@ func NewRootResolver(
 	apiRepo := api.NewRepository(apiConverter)
 	eventAPIRepo := eventdef.NewRepository(eventAPIConverter)
 	docRepo := document.NewRepository(docConverter)
-	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
-	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
+	fetchRequestRepo := fetchrequest.NewRepository(frConverter, encryptor)
+	systemAuthRepo := systemauth.NewRepository(systemAuthConverter, encryptor)
 	intSysRepo := integrationsystem.NewRepository(intSysConverter)
 	tenantRepo := tenant.NewRepository(tenantConverter)
-	packageRepo := packageutil.NewRepository(packageConverter)
-	packageInstanceAuthRepo := packageinstanceauth.NewRepository(packageInstanceAuthConv)
+	packageRepo := packageutil.NewRepository(packageConverter, encryptor)
+	packageInstanceAuthRepo := packageinstanceauth.NewRepository(packageInstanceAuthConv, encryptor)
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
+	roleRepo := role.NewRepository(roleConverter)
+	roleBindingRepo := role.NewBindingRepository(roleConverter)
+	accessRuleRepo := accessrule.NewRepository(accessRuleConverter)
+	accessRuleObjectRepo := accessrule.NewObjectRepository()
 
 	connectorGCLI := graphql_client.NewGraphQLClient(oneTimeTokenCfg.OneTimeTokenURL, clientTimeout)
 
 	uidSvc := uid.NewService()
+	accessRuleSvc := accessrule.NewService(accessRuleRepo, accessRuleObjectRepo, uidSvc)
 	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
 	scenariosSvc := labeldef.NewScenariosService(labelDefRepo, uidSvc, featuresConfig.DefaultScenarioEnabled)
 	appTemplateSvc := apptemplate.NewService(appTemplateRepo, uidSvc)
@@ -136,25 +142,26 @@ func NewRootResolver(
 		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
 	}
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient, log.StandardLogger())
-	apiSvc := api.NewService(apiRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
-	eventAPISvc := eventdef.NewService(eventAPIRepo, fetchRequestRepo, uidSvc)
-	webhookSvc := webhook.NewService(webhookRepo, uidSvc)
-	docSvc := document.NewService(docRepo, fetchRequestRepo, uidSvc)
+	apiSvc := api.NewService(apiRepo, fetchRequestRepo, accessRuleSvc, uidSvc, fetchRequestSvc)
+	eventAPISvc := eventdef.NewService(eventAPIRepo, fetchRequestRepo, accessRuleSvc, uidSvc)
+	webhookSvc := webhook.NewService(webhookRepo, accessRuleSvc, uidSvc)
+	docSvc := document.NewService(docRepo, fetchRequestRepo, accessRuleSvc, uidSvc)
 	scenarioAssignmentEngine := scenarioassignment.NewEngine(labelUpsertSvc, labelRepo, scenarioAssignmentRepo)
 	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, scenariosSvc, scenarioAssignmentEngine)
-	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine)
-	runtimeCtxSvc := runtime_context.NewService(runtimeContextRepo, labelRepo, labelUpsertSvc, uidSvc)
+	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine, accessRuleSvc)
+	runtimeCtxSvc := runtime_context.NewService(runtimeContextRepo, labelRepo, labelUpsertSvc, accessRuleSvc, uidSvc)
 	healthCheckSvc := healthcheck.NewService(healthcheckRepo)
 	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, scenariosSvc, uidSvc)
-	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
+	systemAuthSvc := systemauth.NewService(systemAuthRepo, accessRuleSvc, uidSvc)
 	tenantSvc := tenant.NewService(tenantRepo, uidSvc)
-	oAuth20Svc := oauth20.NewService(cfgProvider, uidSvc, oAuth20Cfg, oAuth20HTTPClient)
+	oAuth20Svc := oauth20.NewService(cfgProvider, uidSvc, oAuth20Cfg, oAuth20Registry)
 	intSysSvc := integrationsystem.NewService(intSysRepo, uidSvc)
 	eventingSvc := eventing.NewService(runtimeRepo, labelRepo)
-	packageSvc := packageutil.NewService(packageRepo, apiRepo, eventAPIRepo, docRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
-	appSvc := application.NewService(cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelUpsertSvc, scenariosSvc, packageSvc, uidSvc)
+	packageSvc := packageutil.NewService(packageRepo, apiRepo, eventAPIRepo, docRepo, fetchRequestRepo, accessRuleSvc, uidSvc, fetchRequestSvc)
+	appSvc := application.NewService(cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelUpsertSvc, scenariosSvc, packageSvc, accessRuleSvc, uidSvc)
 	tokenSvc := onetimetoken.NewTokenService(connectorGCLI, systemAuthSvc, appSvc, appConverter, tenantSvc, httpClient, oneTimeTokenCfg.ConnectorURL, pairingAdaptersMapping)
-	packageInstanceAuthSvc := packageinstanceauth.NewService(packageInstanceAuthRepo, uidSvc)
+	packageInstanceAuthSvc := packageinstanceauth.NewService(packageInstanceAuthRepo, accessRuleSvc, uidSvc)
+	roleSvc := role.NewService(roleRepo, roleBindingRepo, cfgProvider, systemAuthSvc, uidSvc)
 
 	return &RootResolver{
 		app:                 application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, packageSvc, packageConverter),
@@ -170,13 +177,15 @@ func NewRootResolver(
 		labelDef:            labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
 		token:               onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter),
 		systemAuth:          systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
-		oAuth20:             oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
+		oAuth20:             oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, accessRuleSvc, systemAuthSvc, systemAuthConverter, oAuth20Cfg),
 		intSys:              integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
 		viewer:              viewer.NewViewerResolver(),
 		tenant:              tenant.NewResolver(transact, tenantSvc, tenantConverter),
 		mpPackage:           packageutil.NewResolver(transact, packageSvc, packageInstanceAuthSvc, apiSvc, eventAPISvc, docSvc, packageConverter, packageInstanceAuthConv, apiConverter, eventAPIConverter, docConverter),
 		packageInstanceAuth: packageinstanceauth.NewResolver(transact, packageInstanceAuthSvc, packageSvc, packageInstanceAuthConv),
 		scenarioAssignment:  scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv),
+		role:                role.NewResolver(transact, roleSvc, roleConverter),
+		accessRule:          accessrule.NewResolver(transact, accessRuleSvc, accessRuleConverter),
 	}
 }
 
@@ -295,10 +304,69 @@ func (r *queryResolver) AutomaticScenarioAssignmentsForSelector(ctx context.Cont
 	return r.scenarioAssignment.AutomaticScenarioAssignmentsForSelector(ctx, selector)
 }
 
+func (r *queryResolver) SystemAuthsExpiringWithin(ctx context.Context, days int) ([]*graphql.SystemAuth, error) {
+	return r.systemAuth.SystemAuthsExpiringWithin(ctx, days)
+}
+
 func (r *queryResolver) AutomaticScenarioAssignments(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.AutomaticScenarioAssignmentPage, error) {
 	return r.scenarioAssignment.AutomaticScenarioAssignments(ctx, first, after)
 }
 
+func (r *queryResolver) Roles(ctx context.Context) ([]*graphql.Role, error) {
+	return r.role.Roles(ctx)
+}
+
+func (r *queryResolver) Role(ctx context.Context, id string) (*graphql.Role, error) {
+	return r.role.Role(ctx, id)
+}
+
+func (r *queryResolver) RoleBindings(ctx context.Context, roleID string) ([]*graphql.RoleBinding, error) {
+	return r.role.RoleBindings(ctx, roleID)
+}
+
+func (r *queryResolver) AccessRules(ctx context.Context) ([]*graphql.AccessRule, error) {
+	return r.accessRule.AccessRules(ctx)
+}
+
+func (r *queryResolver) Package(ctx context.Context, id string) (*graphql.Package, error) {
+	return r.mpPackage.Package(ctx, id)
+}
+
+func (r *queryResolver) APIDefinition(ctx context.Context, id string) (*graphql.APIDefinition, error) {
+	return r.api.APIDefinition(ctx, id)
+}
+
+func (r *queryResolver) EventDefinition(ctx context.Context, id string) (*graphql.EventDefinition, error) {
+	return r.eventAPI.EventDefinition(ctx, id)
+}
+
+func (r *queryResolver) Document(ctx context.Context, id string) (*graphql.Document, error) {
+	return r.doc.Document(ctx, id)
+}
+
+func (r *queryResolver) Webhook(ctx context.Context, id string) (*graphql.Webhook, error) {
+	return r.webhook.Webhook(ctx, id)
+}
+
+func (r *queryResolver) PackageInstanceAuth(ctx context.Context, id string) (*graphql.PackageInstanceAuth, error) {
+	return r.packageInstanceAuth.PackageInstanceAuth(ctx, id)
+}
+
+func (r *queryResolver) SystemAuthForApplication(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	fn := r.systemAuth.GenericGetSystemAuth(model.ApplicationReference)
+	return fn(ctx, authID)
+}
+
+func (r *queryResolver) SystemAuthForRuntime(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	fn := r.systemAuth.GenericGetSystemAuth(model.RuntimeReference)
+	return fn(ctx, authID)
+}
+
+func (r *queryResolver) SystemAuthForIntegrationSystem(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	fn := r.systemAuth.GenericGetSystemAuth(model.IntegrationSystemReference)
+	return fn(ctx, authID)
+}
+
 type mutationResolver struct {
 	*RootResolver
 }
@@ -395,11 +463,11 @@ func (r *mutationResolver) SetRuntimeLabel(ctx context.Context, runtimeID string
 func (r *mutationResolver) DeleteRuntimeLabel(ctx context.Context, runtimeID string, key string) (*graphql.Label, error) {
 	return r.runtime.DeleteRuntimeLabel(ctx, runtimeID, key)
 }
-func (r *mutationResolver) RequestOneTimeTokenForApplication(ctx context.Context, id string) (*graphql.OneTimeTokenForApplication, error) {
-	return r.token.RequestOneTimeTokenForApplication(ctx, id)
+func (r *mutationResolver) RequestOneTimeTokenForApplication(ctx context.Context, id string, allowedSourceCIDRs []string) (*graphql.OneTimeTokenForApplication, error) {
+	return r.token.RequestOneTimeTokenForApplication(ctx, id, allowedSourceCIDRs)
 }
-func (r *mutationResolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string) (*graphql.OneTimeTokenForRuntime, error) {
-	return r.token.RequestOneTimeTokenForRuntime(ctx, id)
+func (r *mutationResolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string, allowedSourceCIDRs []string) (*graphql.OneTimeTokenForRuntime, error) {
+	return r.token.RequestOneTimeTokenForRuntime(ctx, id, allowedSourceCIDRs)
 }
 func (r *mutationResolver) RequestClientCredentialsForRuntime(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return r.oAuth20.RequestClientCredentialsForRuntime(ctx, id)
@@ -410,6 +478,24 @@ func (r *mutationResolver) RequestClientCredentialsForApplication(ctx context.Co
 func (r *mutationResolver) RequestClientCredentialsForIntegrationSystem(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return r.oAuth20.RequestClientCredentialsForIntegrationSystem(ctx, id)
 }
+func (r *mutationResolver) RotateClientCredentialsForRuntime(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RotateClientCredentialsForRuntime(ctx, authID)
+}
+func (r *mutationResolver) RotateClientCredentialsForApplication(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RotateClientCredentialsForApplication(ctx, authID)
+}
+func (r *mutationResolver) RotateClientCredentialsForIntegrationSystem(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RotateClientCredentialsForIntegrationSystem(ctx, authID)
+}
+func (r *mutationResolver) RegisterSystemAuthForRuntime(ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RegisterSystemAuthForRuntime(ctx, id, in)
+}
+func (r *mutationResolver) RegisterSystemAuthForApplication(ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RegisterSystemAuthForApplication(ctx, id, in)
+}
+func (r *mutationResolver) RegisterSystemAuthForIntegrationSystem(ctx context.Context, id string, in graphql.SystemAuthCredentialInput) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RegisterSystemAuthForIntegrationSystem(ctx, id, in)
+}
 func (r *mutationResolver) DeleteSystemAuthForRuntime(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
 	fn := r.systemAuth.GenericDeleteSystemAuth(model.RuntimeReference)
 	return fn(ctx, authID)
@@ -483,6 +569,34 @@ func (r *mutationResolver) CreateAutomaticScenarioAssignment(ctx context.Context
 	return r.scenarioAssignment.CreateAutomaticScenarioAssignment(ctx, in)
 }
 
+func (r *mutationResolver) CreateRole(ctx context.Context, in graphql.RoleInput) (*graphql.Role, error) {
+	return r.role.CreateRole(ctx, in)
+}
+
+func (r *mutationResolver) UpdateRole(ctx context.Context, id string, in graphql.RoleInput) (*graphql.Role, error) {
+	return r.role.UpdateRole(ctx, id, in)
+}
+
+func (r *mutationResolver) DeleteRole(ctx context.Context, id string) (*graphql.Role, error) {
+	return r.role.DeleteRole(ctx, id)
+}
+
+func (r *mutationResolver) CreateRoleBinding(ctx context.Context, in graphql.RoleBindingInput) (*graphql.RoleBinding, error) {
+	return r.role.CreateRoleBinding(ctx, in)
+}
+
+func (r *mutationResolver) DeleteRoleBinding(ctx context.Context, id string) (*graphql.RoleBinding, error) {
+	return r.role.DeleteRoleBinding(ctx, id)
+}
+
+func (r *mutationResolver) CreateAccessRule(ctx context.Context, in graphql.AccessRuleInput) (*graphql.AccessRule, error) {
+	return r.accessRule.CreateAccessRule(ctx, in)
+}
+
+func (r *mutationResolver) DeleteAccessRule(ctx context.Context, id string) (*graphql.AccessRule, error) {
+	return r.accessRule.DeleteAccessRule(ctx, id)
+}
+
 type applicationResolver struct {
 	*RootResolver
 }
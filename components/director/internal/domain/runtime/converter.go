@@ -72,13 +72,22 @@ func (c *converter) statusToGraphQL(in *model.RuntimeStatus) *graphql.RuntimeSta
 		condition = graphql.RuntimeStatusConditionFailed
 	case model.RuntimeStatusConditionConnected:
 		condition = graphql.RuntimeStatusConditionConnected
+	case model.RuntimeStatusConditionDisconnected:
+		condition = graphql.RuntimeStatusConditionDisconnected
 	default:
 		condition = graphql.RuntimeStatusConditionInitial
 	}
 
+	var lastSeen *graphql.Timestamp
+	if in.LastSeen != nil {
+		timestamp := graphql.Timestamp(*in.LastSeen)
+		lastSeen = &timestamp
+	}
+
 	return &graphql.RuntimeStatus{
 		Condition: condition,
 		Timestamp: graphql.Timestamp(in.Timestamp),
+		LastSeen:  lastSeen,
 	}
 }
 
@@ -97,6 +106,8 @@ func (c *converter) statusConditionToModel(in *graphql.RuntimeStatusCondition) *
 	switch *in {
 	case graphql.RuntimeStatusConditionConnected:
 		condition = model.RuntimeStatusConditionConnected
+	case graphql.RuntimeStatusConditionDisconnected:
+		condition = model.RuntimeStatusConditionDisconnected
 	case graphql.RuntimeStatusConditionFailed:
 		condition = model.RuntimeStatusConditionFailed
 	case graphql.RuntimeStatusConditionProvisioning:
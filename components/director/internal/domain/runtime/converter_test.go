@@ -25,6 +25,26 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    allDetailsInput,
 			Expected: allDetailsExpected,
 		},
+		{
+			Name: "Disconnected with last seen timestamp",
+			Input: &model.Runtime{
+				Status: &model.RuntimeStatus{
+					Condition: model.RuntimeStatusConditionDisconnected,
+					Timestamp: testTimestamp,
+					LastSeen:  &testLastSeen,
+				},
+			},
+			Expected: &graphql.Runtime{
+				Status: &graphql.RuntimeStatus{
+					Condition: graphql.RuntimeStatusConditionDisconnected,
+					Timestamp: graphql.Timestamp(testTimestamp),
+					LastSeen:  timestampPtr(graphql.Timestamp(testLastSeen)),
+				},
+				Metadata: &graphql.RuntimeMetadata{
+					CreationTimestamp: graphql.Timestamp{},
+				},
+			},
+		},
 		{
 			Name:  "Empty",
 			Input: &model.Runtime{},
@@ -132,6 +152,11 @@ func TestConverter_InputFromGraphQL_StatusCondition(t *testing.T) {
 			CondtionGQL:    graphql.RuntimeStatusConditionConnected,
 			ConditionModel: model.RuntimeStatusConditionConnected,
 		},
+		{
+			Name:           "When status condition is DISCONNECTED",
+			CondtionGQL:    graphql.RuntimeStatusConditionDisconnected,
+			ConditionModel: model.RuntimeStatusConditionDisconnected,
+		},
 		{
 			Name:           "When status condition is INITIAL",
 			CondtionGQL:    graphql.RuntimeStatusConditionInitial,
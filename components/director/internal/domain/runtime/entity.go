@@ -17,6 +17,7 @@ type Runtime struct {
 	Description       sql.NullString `db:"description"`
 	StatusCondition   string         `db:"status_condition"`
 	StatusTimestamp   time.Time      `db:"status_timestamp"`
+	LastSeenTimestamp *time.Time     `db:"last_seen_timestamp"`
 	CreationTimestamp time.Time      `db:"creation_timestamp"`
 }
 
@@ -37,6 +38,7 @@ func EntityFromRuntimeModel(model *model.Runtime) (*Runtime, error) {
 		Description:       nullDescription,
 		StatusCondition:   string(model.Status.Condition),
 		StatusTimestamp:   model.Status.Timestamp,
+		LastSeenTimestamp: model.Status.LastSeen,
 		CreationTimestamp: model.CreationTimestamp,
 	}, nil
 }
@@ -57,6 +59,7 @@ func (e Runtime) ToModel() (*model.Runtime, error) {
 		Status: &model.RuntimeStatus{
 			Condition: model.RuntimeStatusCondition(e.StatusCondition),
 			Timestamp: e.StatusTimestamp,
+			LastSeen:  e.LastSeenTimestamp,
 		},
 		CreationTimestamp: e.CreationTimestamp,
 	}, nil
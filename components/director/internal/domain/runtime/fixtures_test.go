@@ -5,12 +5,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+var (
+	testTimestamp = time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+	testLastSeen  = time.Date(2020, 12, 15, 10, 0, 0, 0, time.UTC)
+)
+
 func fixRuntimePage(runtimes []*model.Runtime) *model.RuntimePage {
 	return &model.RuntimePage{
 		Data: runtimes,
@@ -256,3 +263,22 @@ func fixValidURL(t *testing.T, rawURL string) url.URL {
 	require.NotNil(t, eventingURL)
 	return *eventingURL
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("GetRestriction", mock.Anything, model.AccessRuleObjectTypeRuntime).Return(nil, nil).Maybe()
+	svc.On("HasAccess", mock.Anything, model.AccessRuleObjectTypeRuntime, mock.Anything).Return(true, nil).Maybe()
+	svc.On("SetOwner", mock.Anything, model.AccessRuleObjectTypeRuntime, mock.Anything).Return(nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc(objectID string) *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, model.AccessRuleObjectTypeRuntime, objectID).Return(false, nil).Maybe()
+	svc.On("SetOwner", mock.Anything, model.AccessRuleObjectTypeRuntime, objectID).Return(nil).Maybe()
+	return svc
+}
+
+func timestampPtr(timestamp graphql.Timestamp) *graphql.Timestamp {
+	return &timestamp
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accessrule"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
@@ -19,7 +20,7 @@ import (
 const runtimeTable string = `public.runtimes`
 
 var (
-	runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}
+	runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "last_seen_timestamp", "creation_timestamp"}
 	tenantColumn   = "tenant_id"
 )
 
@@ -126,7 +127,7 @@ func (r RuntimeCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, restriction *model.AccessRestriction, pageSize int, cursor string) (*model.RuntimePage, error) {
 	var runtimesCollection RuntimeCollection
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
@@ -142,6 +143,14 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
+	restrictionSubquery, restrictionArgs, err := accessrule.FilterQuery(restriction, tenantID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building access restriction query")
+	}
+	if restrictionSubquery != "" {
+		conditions = append(conditions, repo.NewInConditionForSubQuery("id", restrictionSubquery, restrictionArgs))
+	}
+
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "name", &runtimesCollection, conditions...)
 
 	if err != nil {
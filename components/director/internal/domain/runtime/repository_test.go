@@ -287,7 +287,7 @@ func TestPgRepository_List(t *testing.T) {
 				WillReturnRows(countRow)
 
 			//THEN
-			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, testCase.InputPageSize, testCase.InputCursor)
+			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, nil, testCase.InputPageSize, testCase.InputCursor)
 
 			//THEN
 			require.NoError(t, err)
@@ -310,7 +310,7 @@ func TestPgRepository_List(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := runtime.NewRepository()
 		//THEN
-		_, err := pgRepository.List(ctx, tenantID, nil, 2, convertIntToBase64String(-3))
+		_, err := pgRepository.List(ctx, tenantID, nil, nil, 2, convertIntToBase64String(-3))
 
 		//THEN
 		require.EqualError(t, err, "while decoding page cursor: Invalid data [reason=cursor is not correct]")
@@ -363,7 +363,7 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 	pgRepository := runtime.NewRepository()
 
 	// when
-	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, rowSize, "")
+	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, nil, rowSize, "")
 
 	//then
 	assert.NoError(t, err)
@@ -378,6 +378,60 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 	assert.Equal(t, tenantID, modelRuntimePage.Data[1].Tenant)
 }
 
+func TestPgRepository_List_WithAccessRestrictionShouldReturnAccessibleRuntimes(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+	tenantID := uuid.New().String()
+	rowSize := 2
+
+	timestamp, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	require.NoError(t, err)
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
+		AddRow(runtimeID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, timestamp)
+
+	restrictionQuery := `  AND id IN 
+						\(SELECT "runtime_id" FROM public.labels 
+							WHERE "runtime_id" IS NOT NULL 
+							AND "tenant_id" = \$2 
+							AND "key" = \$3\)`
+	sqlQuery := fmt.Sprintf(`^SELECT (.+) FROM public.runtimes 
+								WHERE tenant_id = \$1 %s ORDER BY name LIMIT %d OFFSET 0`, restrictionQuery, rowSize)
+
+	sqlMock.ExpectQuery(sqlQuery).
+		WithArgs(tenantID, tenantID, "team").
+		WillReturnRows(rows)
+
+	countRows := sqlMock.NewRows([]string{"count"}).AddRow(1)
+
+	countQuery := fmt.Sprintf(`^SELECT COUNT\(\*\) FROM public.runtimes WHERE tenant_id = \$1 %s`, restrictionQuery)
+	sqlMock.ExpectQuery(countQuery).
+		WithArgs(tenantID, tenantID, "team").
+		WillReturnRows(countRows)
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeRuntime,
+		Rules:      []*model.AccessRule{{LabelKey: str.Ptr("team")}},
+	}
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, restriction, rowSize, "")
+
+	//then
+	assert.NoError(t, err)
+	require.NotNil(t, modelRuntimePage)
+	assert.Equal(t, 1, modelRuntimePage.TotalCount)
+	require.Len(t, modelRuntimePage.Data, 1)
+	assert.Equal(t, runtimeID, modelRuntimePage.Data[0].ID)
+}
+
 func TestPgRepository_Create_ShouldCreateRuntimeEntityFromValidModel(t *testing.T) {
 	// given
 	runtimeID := uuid.New().String()
@@ -402,7 +456,7 @@ func TestPgRepository_Create_ShouldCreateRuntimeEntityFromValidModel(t *testing.
 	defer sqlMock.AssertExpectations(t)
 
 	sqlMock.ExpectExec(`^INSERT INTO public.runtimes \(.+\) VALUES \(.+\)$`).
-		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.CreationTimestamp).
+		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.Status.LastSeen, modelRuntime.CreationTimestamp).
 		WillReturnResult(sqlmock.NewResult(-1, 1))
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -20,7 +21,7 @@ type RuntimeRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error)
 	GetByFiltersGlobal(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.Runtime, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, restriction *model.AccessRestriction, pageSize int, cursor string) (*model.RuntimePage, error)
 	Create(ctx context.Context, item *model.Runtime) error
 	Update(ctx context.Context, item *model.Runtime) error
 	Delete(ctx context.Context, tenant, id string) error
@@ -58,6 +59,13 @@ type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	GetRestriction(ctx context.Context, objType model.AccessRuleObjectType) (*model.AccessRestriction, error)
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
+	SetOwner(ctx context.Context, objType model.AccessRuleObjectType, objectID string) error
+}
+
 type service struct {
 	repo      RuntimeRepository
 	labelRepo LabelRepository
@@ -66,6 +74,7 @@ type service struct {
 	uidService               UIDService
 	scenariosService         ScenariosService
 	scenarioAssignmentEngine ScenarioAssignmentEngine
+	accessRuleService        AccessRuleService
 }
 
 func NewService(repo RuntimeRepository,
@@ -73,14 +82,16 @@ func NewService(repo RuntimeRepository,
 	scenariosService ScenariosService,
 	labelUpsertService LabelUpsertService,
 	uidService UIDService,
-	scenarioAssignmentEngine ScenarioAssignmentEngine) *service {
+	scenarioAssignmentEngine ScenarioAssignmentEngine,
+	accessRuleService AccessRuleService) *service {
 	return &service{
 		repo:                     repo,
 		labelRepo:                labelRepo,
 		scenariosService:         scenariosService,
 		labelUpsertService:       labelUpsertService,
 		uidService:               uidService,
-		scenarioAssignmentEngine: scenarioAssignmentEngine}
+		scenarioAssignmentEngine: scenarioAssignmentEngine,
+		accessRuleService:        accessRuleService}
 }
 
 func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
@@ -93,7 +104,12 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 100")
 	}
 
-	return s.repo.List(ctx, rtmTenant, filter, pageSize, cursor)
+	restriction, err := s.accessRuleService.GetRestriction(ctx, model.AccessRuleObjectTypeRuntime)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting access restriction")
+	}
+
+	return s.repo.List(ctx, rtmTenant, filter, restriction, pageSize, cursor)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.Runtime, error) {
@@ -107,6 +123,10 @@ func (s *service) Get(ctx context.Context, id string) (*model.Runtime, error) {
 		return nil, errors.Wrapf(err, "while getting Runtime with ID %s", id)
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
 	return runtime, nil
 }
 
@@ -136,7 +156,7 @@ func (s *service) Exist(ctx context.Context, id string) (bool, error) {
 		return false, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	exist, err := s.repo.Exists(ctx, rtmTenant, id)
+	exist, err := s.exists(ctx, rtmTenant, id)
 	if err != nil {
 		return false, errors.Wrapf(err, "while getting Runtime with ID %s", id)
 	}
@@ -178,6 +198,19 @@ func (s *service) Create(ctx context.Context, in model.RuntimeInput) (string, er
 		return id, errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
+	err = s.accessRuleService.SetOwner(ctx, model.AccessRuleObjectTypeRuntime, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while setting owner of Runtime with ID %s", id)
+	}
+
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeRuntime, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while checking access to Runtime with ID %s", id)
+	}
+	if !hasAccess {
+		return "", apperrors.NewInvalidOperationError("created Runtime would not be accessible with the access rules of the caller")
+	}
+
 	return id, nil
 }
 
@@ -192,6 +225,10 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 		return errors.Wrapf(err, "while getting Runtime with id %s", id)
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	rtm = in.ToRuntime(id, rtm.Tenant, rtm.CreationTimestamp, time.Now())
 
 	err = s.repo.Update(ctx, rtm)
@@ -205,7 +242,7 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 	}
 
 	if in.Labels == nil {
-		return nil
+		return s.ensureAccessRetained(ctx, id)
 	}
 
 	scenarios, err := s.scenarioAssignmentEngine.MergeScenariosFromInputLabelsAndAssignments(ctx, in.Labels)
@@ -222,7 +259,7 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 		return errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
-	return nil
+	return s.ensureAccessRetained(ctx, id)
 }
 
 func (s *service) Delete(ctx context.Context, id string) error {
@@ -231,6 +268,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	err = s.repo.Delete(ctx, rtmTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Runtime")
@@ -276,7 +317,7 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		}
 	}
 
-	return nil
+	return s.ensureAccessRetained(ctx, labelInput.ObjectID)
 }
 
 func (s *service) GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error) {
@@ -285,7 +326,7 @@ func (s *service) GetLabel(ctx context.Context, runtimeID string, key string) (*
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	rtmExists, err := s.repo.Exists(ctx, rtmTenant, runtimeID)
+	rtmExists, err := s.exists(ctx, rtmTenant, runtimeID)
 	if err != nil {
 		return nil, errors.Wrap(err, "while checking Runtime existence")
 	}
@@ -307,7 +348,7 @@ func (s *service) ListLabels(ctx context.Context, runtimeID string) (map[string]
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	rtmExists, err := s.repo.Exists(ctx, rtmTenant, runtimeID)
+	rtmExists, err := s.exists(ctx, rtmTenant, runtimeID)
 	if err != nil {
 		return nil, errors.Wrap(err, "while checking Runtime existence")
 	}
@@ -359,11 +400,11 @@ func (s *service) DeleteLabel(ctx context.Context, runtimeID string, key string)
 		}
 	}
 
-	return nil
+	return s.ensureAccessRetained(ctx, runtimeID)
 }
 
 func (s *service) ensureRuntimeExists(ctx context.Context, tnt string, runtimeID string) error {
-	rtmExists, err := s.repo.Exists(ctx, tnt, runtimeID)
+	rtmExists, err := s.exists(ctx, tnt, runtimeID)
 	if err != nil {
 		return errors.Wrap(err, "while checking Runtime existence")
 	}
@@ -374,6 +415,41 @@ func (s *service) ensureRuntimeExists(ctx context.Context, tnt string, runtimeID
 	return nil
 }
 
+func (s *service) exists(ctx context.Context, tnt string, runtimeID string) (bool, error) {
+	rtmExists, err := s.repo.Exists(ctx, tnt, runtimeID)
+	if err != nil || !rtmExists {
+		return false, err
+	}
+
+	return s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeRuntime, runtimeID)
+}
+
+// ensureAccess hides the Runtimes, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, runtimeID string) error {
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeRuntime, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Runtime with ID %s", runtimeID)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.Runtime, runtimeID)
+	}
+
+	return nil
+}
+
+// ensureAccessRetained prevents the caller from modifying the Runtime in a way, which would make it inaccessible to them
+func (s *service) ensureAccessRetained(ctx context.Context, runtimeID string) error {
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeRuntime, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Runtime with ID %s", runtimeID)
+	}
+	if !hasAccess {
+		return apperrors.NewInvalidOperationError("the Runtime would not be accessible with the access rules of the caller after the change")
+	}
+
+	return nil
+}
+
 func (s *service) upsertScenariosLabelIfShould(ctx context.Context, runtimeID string, modifiedLabelKey string, currentRuntimeLabels, newRuntimeLabels map[string]interface{}) error {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
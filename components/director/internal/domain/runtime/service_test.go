@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -243,7 +244,7 @@ func TestService_Create(t *testing.T) {
 			labelSvc := testCase.LabelUpsertServiceFn()
 			scenariosSvc := testCase.ScenariosServiceFn()
 			engineSvc := testCase.EngineServiceFn()
-			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engineSvc)
+			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engineSvc, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			result, err := svc.Create(ctx, testCase.Input)
@@ -267,7 +268,7 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.RuntimeInput{})
 		// then
@@ -529,7 +530,7 @@ func TestService_Update(t *testing.T) {
 			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
 			engineSvc := testCase.EngineServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc)
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -550,7 +551,7 @@ func TestService_Update(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		err := svc.Update(context.TODO(), "id", model.RuntimeInput{})
 		// then
@@ -610,7 +611,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -628,7 +629,7 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		err := svc.Delete(context.TODO(), "id")
 		// then
@@ -691,7 +692,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			rtm, err := svc.Get(ctx, testCase.InputID)
@@ -708,9 +709,25 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 
+	t.Run("Returns not found error when runtime is not accessible", func(t *testing.T) {
+		// given
+		repo := &automock.RuntimeRepository{}
+		repo.On("GetByID", ctx, tnt, id).Return(runtimeModel, nil).Once()
+		accessRuleSvc := fixRestrictedAccessRuleSvc(id)
+		svc := runtime.NewService(repo, nil, nil, nil, nil, nil, accessRuleSvc)
+
+		// when
+		_, err := svc.Get(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		mock.AssertExpectationsForObjects(t, repo, accessRuleSvc)
+	})
+
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Get(context.TODO(), "id")
 		// then
@@ -772,7 +789,7 @@ func TestService_GetByTokenIssuer(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
 			rtm, err := svc.GetByTokenIssuer(ctx, tokenIssuer)
@@ -845,7 +862,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			rtmRepo := testCase.RepositoryFn()
-			svc := runtime.NewService(rtmRepo, nil, nil, nil, nil, nil)
+			svc := runtime.NewService(rtmRepo, nil, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc())
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputRuntimeID)
@@ -864,7 +881,7 @@ func TestService_Exist(t *testing.T) {
 	}
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Exist(context.TODO(), "id")
 		// then
@@ -898,25 +915,36 @@ func TestService_List(t *testing.T) {
 	tnt := "tenant"
 	externalTnt := "external-tnt"
 
+	restriction := &model.AccessRestriction{
+		ObjectType: model.AccessRuleObjectTypeRuntime,
+		Rules:      []*model.AccessRule{{ID: "rule", OwnedOnly: true}},
+	}
+
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.RuntimeRepository
-		InputLabelFilters  []*labelfilter.LabelFilter
-		InputPageSize      int
-		InputCursor        string
-		ExpectedResult     *model.RuntimePage
-		ExpectedErrMessage string
+		Name                string
+		RepositoryFn        func() *automock.RuntimeRepository
+		AccessRuleServiceFn func() *automock.AccessRuleService
+		InputLabelFilters   []*labelfilter.LabelFilter
+		InputPageSize       int
+		InputCursor         string
+		ExpectedResult      *model.RuntimePage
+		ExpectedErrMessage  string
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(runtimePage, nil).Once()
+				repo.On("List", ctx, tnt, filter, restriction, first, after).Return(runtimePage, nil).Once()
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				svc := &automock.AccessRuleService{}
+				svc.On("GetRestriction", ctx, model.AccessRuleObjectTypeRuntime).Return(restriction, nil).Once()
+				return svc
+			},
 			InputLabelFilters:  filter,
 			InputPageSize:      first,
 			InputCursor:        after,
@@ -927,9 +955,31 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when runtime listing failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, restriction, first, after).Return(nil, testErr).Once()
+				return repo
+			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				svc := &automock.AccessRuleService{}
+				svc.On("GetRestriction", ctx, model.AccessRuleObjectTypeRuntime).Return(restriction, nil).Once()
+				return svc
+			},
+			InputLabelFilters:  filter,
+			InputPageSize:      first,
+			InputCursor:        after,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when getting access restriction failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				svc := &automock.AccessRuleService{}
+				svc.On("GetRestriction", ctx, model.AccessRuleObjectTypeRuntime).Return(nil, testErr).Once()
+				return svc
+			},
 			InputLabelFilters:  filter,
 			InputPageSize:      first,
 			InputCursor:        after,
@@ -942,6 +992,9 @@ func TestService_List(t *testing.T) {
 				repo := &automock.RuntimeRepository{}
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				return &automock.AccessRuleService{}
+			},
 			InputLabelFilters:  filter,
 			InputPageSize:      0,
 			InputCursor:        after,
@@ -954,6 +1007,9 @@ func TestService_List(t *testing.T) {
 				repo := &automock.RuntimeRepository{}
 				return repo
 			},
+			AccessRuleServiceFn: func() *automock.AccessRuleService {
+				return &automock.AccessRuleService{}
+			},
 			InputLabelFilters:  filter,
 			InputPageSize:      101,
 			InputCursor:        after,
@@ -965,8 +1021,9 @@ func TestService_List(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
+			accessRuleSvc := testCase.AccessRuleServiceFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, accessRuleSvc)
 
 			// when
 			rtm, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
@@ -985,7 +1042,7 @@ func TestService_List(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.List(context.TODO(), nil, 1, "")
 		// then
@@ -1106,7 +1163,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			l, err := svc.GetLabel(ctx, testCase.InputRuntimeID, testCase.InputLabel.Key)
@@ -1126,7 +1183,7 @@ func TestService_GetLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.GetLabel(context.TODO(), "id", "key")
 		// then
@@ -1248,7 +1305,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputRuntimeID)
@@ -1268,7 +1325,7 @@ func TestService_ListLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.ListLabels(context.TODO(), "id")
 		// then
@@ -1676,7 +1733,7 @@ func TestService_SetLabel(t *testing.T) {
 			labelSvc := testCase.LabelUpsertServiceFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			engineSvc := testCase.EngineServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc)
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -1697,7 +1754,7 @@ func TestService_SetLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		err := svc.SetLabel(context.TODO(), &model.LabelInput{})
 		// then
@@ -2176,7 +2233,7 @@ func TestService_DeleteLabel(t *testing.T) {
 			labelRepo := testCase.LabelRepositoryFn()
 			labelUpsertSvc := testCase.LabelUpsertServiceFn()
 			engineSvc := testCase.EngineServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelUpsertSvc, nil, engineSvc)
+			svc := runtime.NewService(repo, labelRepo, nil, labelUpsertSvc, nil, engineSvc, fixUnrestrictedAccessRuleSvc())
 
 			// when
 			err := svc.DeleteLabel(ctx, testCase.InputRuntimeID, testCase.InputKey)
@@ -2198,7 +2255,7 @@ func TestService_DeleteLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		err := svc.DeleteLabel(context.TODO(), "id", "key")
 		// then
@@ -235,7 +235,7 @@ func TestPgRepository_List(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := runtime.NewRepository()
 		//THEN
-		_, err := pgRepository.List(ctx, tenantID, nil, 2, convertIntToBase64String(-3))
+		_, err := pgRepository.List(ctx, tenantID, nil, nil, 2, convertIntToBase64String(-3))
 
 		//THEN
 		require.EqualError(t, err, "while decoding page cursor: Invalid data [reason=cursor is not correct]")
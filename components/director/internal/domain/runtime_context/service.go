@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -38,6 +39,12 @@ type LabelUpsertService interface {
 	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
+	HasAccessToParent(ctx context.Context, nestedType model.AccessRuleNestedObjectType, nestedID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -48,17 +55,20 @@ type service struct {
 	labelRepo LabelRepository
 
 	labelUpsertService LabelUpsertService
+	accessRuleService  AccessRuleService
 	uidService         UIDService
 }
 
 func NewService(repo RuntimeContextRepository,
 	labelRepo LabelRepository,
 	labelUpsertService LabelUpsertService,
+	accessRuleService AccessRuleService,
 	uidService UIDService) *service {
 	return &service{
 		repo:               repo,
 		labelRepo:          labelRepo,
 		labelUpsertService: labelUpsertService,
+		accessRuleService:  accessRuleService,
 		uidService:         uidService,
 	}
 }
@@ -109,6 +119,15 @@ func (s *service) Create(ctx context.Context, in model.RuntimeContextInput) (str
 	if err != nil {
 		return "", errors.Wrapf(err, "while loading tenant from context")
 	}
+
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeRuntime, in.RuntimeID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while checking access to Runtime with id %s", in.RuntimeID)
+	}
+	if !hasAccess {
+		return "", apperrors.NewNotFoundError(resource.Runtime, in.RuntimeID)
+	}
+
 	id := s.uidService.Generate()
 	rtmCtx := in.ToRuntimeContext(id, rtmCtxTenant)
 
@@ -147,6 +166,10 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeContext
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	rtmCtx, err := s.repo.GetByID(ctx, rtmCtxTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "while getting Runtime Context with id %s", id)
@@ -191,6 +214,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	if err := s.ensureAccess(ctx, id); err != nil {
+		return err
+	}
+
 	err = s.repo.Delete(ctx, rtmTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Runtime Context with id %s", id)
@@ -223,3 +250,16 @@ func (s *service) ListLabels(ctx context.Context, runtimeCtxID string) (map[stri
 
 	return labels, nil
 }
+
+// ensureAccess hides the Runtime Contexts of the Runtimes, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, id string) error {
+	hasAccess, err := s.accessRuleService.HasAccessToParent(ctx, model.AccessRuleNestedObjectTypeRuntimeContext, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Runtime Context with id %s", id)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.RuntimeContext, id)
+	}
+
+	return nil
+}
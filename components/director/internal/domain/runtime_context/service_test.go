@@ -12,6 +12,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -147,7 +148,8 @@ func TestService_Create(t *testing.T) {
 			repo := testCase.RuntimeContextRepositoryFn()
 			idSvc := testCase.UIDServiceFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime_context.NewService(repo, nil, labelSvc, idSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := runtime_context.NewService(repo, nil, labelSvc, accessRuleSvc, idSvc)
 
 			// when
 			result, err := svc.Create(ctx, testCase.Input)
@@ -169,13 +171,26 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.RuntimeContextInput{})
 		// then
 		require.Error(t, err)
 		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
 	})
+	t.Run("Returns not found error when the caller cannot access the Runtime", func(t *testing.T) {
+		repo := &automock.RuntimeContextRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := runtime_context.NewService(repo, nil, nil, accessRuleSvc, nil)
+
+		// when
+		_, err := svc.Create(ctx, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestService_Update(t *testing.T) {
@@ -358,7 +373,8 @@ func TestService_Update(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime_context.NewService(repo, labelRepo, labelSvc, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := runtime_context.NewService(repo, labelRepo, labelSvc, accessRuleSvc, nil)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -378,13 +394,26 @@ func TestService_Update(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		err := svc.Update(context.TODO(), "id", model.RuntimeContextInput{})
 		// then
 		require.Error(t, err)
 		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
 	})
+	t.Run("Returns not found error when the caller cannot access the Runtime", func(t *testing.T) {
+		repo := &automock.RuntimeContextRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := runtime_context.NewService(repo, nil, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.Update(ctx, id, modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestService_Delete(t *testing.T) {
@@ -439,7 +468,8 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := runtime_context.NewService(repo, nil, nil, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := runtime_context.NewService(repo, nil, nil, accessRuleSvc, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -457,13 +487,26 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		err := svc.Delete(context.TODO(), "id")
 		// then
 		require.Error(t, err)
 		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
 	})
+	t.Run("Returns not found error when the caller cannot access the Runtime", func(t *testing.T) {
+		repo := &automock.RuntimeContextRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := runtime_context.NewService(repo, nil, nil, accessRuleSvc, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestService_Get(t *testing.T) {
@@ -523,7 +566,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime_context.NewService(repo, nil, nil, nil)
+			svc := runtime_context.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			rtmCtx, err := svc.Get(ctx, testCase.InputID)
@@ -542,7 +585,7 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Get(context.TODO(), "id")
 		// then
@@ -606,7 +649,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			rtmCtxRepo := testCase.RepositoryFn()
-			svc := runtime_context.NewService(rtmCtxRepo, nil, nil, nil)
+			svc := runtime_context.NewService(rtmCtxRepo, nil, nil, nil, nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputRuntimeContextID)
@@ -625,7 +668,7 @@ func TestService_Exist(t *testing.T) {
 	}
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Exist(context.TODO(), "id")
 		// then
@@ -747,7 +790,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime_context.NewService(repo, nil, nil, nil)
+			svc := runtime_context.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			rtmCtx, err := svc.List(ctx, runtimeID, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
@@ -766,7 +809,7 @@ func TestService_List(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.List(context.TODO(), "", nil, 1, "")
 		// then
@@ -888,7 +931,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime_context.NewService(repo, labelRepo, nil, nil)
+			svc := runtime_context.NewService(repo, labelRepo, nil, nil, nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputRuntimeContextID)
@@ -908,7 +951,7 @@ func TestService_ListLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.ListLabels(context.TODO(), "id")
 		// then
@@ -916,3 +959,17 @@ func TestService_ListLabel(t *testing.T) {
 		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
 	})
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	svc.On("HasAccessToParent", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return svc
+}
@@ -7,6 +7,8 @@ import (
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -102,6 +104,52 @@ func (_m *Repository) GetByIDGlobal(ctx context.Context, id string) (*model.Syst
 	return r0, r1
 }
 
+// ListExpiringBefore provides a mock function with given fields: ctx, tenant, before
+func (_m *Repository) ListExpiringBefore(ctx context.Context, tenant string, before time.Time) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, tenant, before)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []model.SystemAuth); ok {
+		r0 = rf(ctx, tenant, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, tenant, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiringBeforeGlobal provides a mock function with given fields: ctx, objectType, before
+func (_m *Repository) ListExpiringBeforeGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, before time.Time) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, objectType, before)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, model.SystemAuthReferenceObjectType, time.Time) []model.SystemAuth); ok {
+		r0 = rf(ctx, objectType, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SystemAuthReferenceObjectType, time.Time) error); ok {
+		r1 = rf(ctx, objectType, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListForObject provides a mock function with given fields: ctx, tenant, objectType, objectID
 func (_m *Repository) ListForObject(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
 	ret := _m.Called(ctx, tenant, objectType, objectID)
@@ -147,3 +195,17 @@ func (_m *Repository) ListForObjectGlobal(ctx context.Context, objectType model.
 
 	return r0, r1
 }
+
+// UpdateExpiresAt provides a mock function with given fields: ctx, id, expiresAt
+func (_m *Repository) UpdateExpiresAt(ctx context.Context, id string, expiresAt *time.Time) error {
+	ret := _m.Called(ctx, id, expiresAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(ctx, id, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
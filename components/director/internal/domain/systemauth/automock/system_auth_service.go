@@ -2,9 +2,14 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // SystemAuthService is an autogenerated mock type for the SystemAuthService type
 type SystemAuthService struct {
@@ -70,3 +75,26 @@ func (_m *SystemAuthService) GetGlobal(ctx context.Context, id string) (*model.S
 
 	return r0, r1
 }
+
+// ListExpiringWithin provides a mock function with given fields: ctx, within
+func (_m *SystemAuthService) ListExpiringWithin(ctx context.Context, within time.Duration) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, within)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) []model.SystemAuth); ok {
+		r0 = rf(ctx, within)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, within)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
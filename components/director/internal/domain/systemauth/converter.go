@@ -37,9 +37,16 @@ func (c *converter) ToGraphQL(in *model.SystemAuth) (*graphql.SystemAuth, error)
 		return nil, errors.Wrap(err, "while converting Auth")
 	}
 
+	var expiresAt *graphql.Timestamp
+	if in.ExpiresAt != nil {
+		timestamp := graphql.Timestamp(*in.ExpiresAt)
+		expiresAt = &timestamp
+	}
+
 	return &graphql.SystemAuth{
-		ID:   in.ID,
-		Auth: auth,
+		ID:        in.ID,
+		Auth:      auth,
+		ExpiresAt: expiresAt,
 	}, nil
 }
 
@@ -61,6 +68,7 @@ func (c *converter) ToEntity(in model.SystemAuth) (Entity, error) {
 		RuntimeID:           repo.NewNullableString(in.RuntimeID),
 		IntegrationSystemID: repo.NewNullableString(in.IntegrationSystemID),
 		Value:               value,
+		ExpiresAt:           in.ExpiresAt,
 	}, nil
 }
 
@@ -82,5 +90,6 @@ func (c *converter) FromEntity(in Entity) (model.SystemAuth, error) {
 		RuntimeID:           repo.StringPtrFromNullableString(in.RuntimeID),
 		IntegrationSystemID: repo.StringPtrFromNullableString(in.IntegrationSystemID),
 		Value:               value,
+		ExpiresAt:           in.ExpiresAt,
 	}, nil
 }
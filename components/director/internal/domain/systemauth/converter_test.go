@@ -2,6 +2,7 @@ package systemauth_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
 
@@ -25,6 +26,13 @@ func TestConverter_ToGraphQL(t *testing.T) {
 	modelIntSysAuth := fixModelSystemAuth(sysAuthID, model.IntegrationSystemReference, objectID, modelAuth)
 	gqlSysAuth := fixGQLSystemAuth(sysAuthID, gqlAuth)
 
+	expiresAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	modelExpiringSysAuth := fixModelSystemAuth(sysAuthID, model.RuntimeReference, objectID, modelAuth)
+	modelExpiringSysAuth.ExpiresAt = &expiresAt
+	gqlExpiringSysAuth := fixGQLSystemAuth(sysAuthID, gqlAuth)
+	gqlExpiresAt := graphql.Timestamp(expiresAt)
+	gqlExpiringSysAuth.ExpiresAt = &gqlExpiresAt
+
 	testCases := []struct {
 		Name           string
 		AuthConvFn     func() *automock.AuthConverter
@@ -61,6 +69,16 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:          modelIntSysAuth,
 			ExpectedOutput: gqlSysAuth,
 		},
+		{
+			Name: "Success when converting auth with expiration",
+			AuthConvFn: func() *automock.AuthConverter {
+				authConv := &automock.AuthConverter{}
+				authConv.On("ToGraphQL", modelAuth).Return(gqlAuth, nil).Once()
+				return authConv
+			},
+			Input:          modelExpiringSysAuth,
+			ExpectedOutput: gqlExpiringSysAuth,
+		},
 		{
 			Name: "Returns nil when input is nil",
 			AuthConvFn: func() *automock.AuthConverter {
@@ -102,6 +120,12 @@ func TestConverter_ToEntity(t *testing.T) {
 	entApp := fixEntity(sysAuthID, model.ApplicationReference, objectID, true)
 	entInt := fixEntity(sysAuthID, model.IntegrationSystemReference, objectID, true)
 
+	expiresAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	modelExpiringSysAuth := *fixModelSystemAuth(sysAuthID, model.RuntimeReference, objectID, modelAuth)
+	modelExpiringSysAuth.ExpiresAt = &expiresAt
+	entExpiring := fixEntity(sysAuthID, model.RuntimeReference, objectID, true)
+	entExpiring.ExpiresAt = &expiresAt
+
 	testCases := []struct {
 		Name           string
 		Input          model.SystemAuth
@@ -126,6 +150,12 @@ func TestConverter_ToEntity(t *testing.T) {
 			ExpectedOutput: entInt,
 			ExpectedError:  nil,
 		},
+		{
+			Name:           "Success when converting auth with expiration",
+			Input:          modelExpiringSysAuth,
+			ExpectedOutput: entExpiring,
+			ExpectedError:  nil,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -161,6 +191,12 @@ func TestConverter_FromEntity(t *testing.T) {
 	entApp := fixEntity(sysAuthID, model.ApplicationReference, objectID, true)
 	entInt := fixEntity(sysAuthID, model.IntegrationSystemReference, objectID, true)
 
+	expiresAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	modelExpiringSysAuth := *fixModelSystemAuth(sysAuthID, model.RuntimeReference, objectID, modelAuth)
+	modelExpiringSysAuth.ExpiresAt = &expiresAt
+	entExpiring := fixEntity(sysAuthID, model.RuntimeReference, objectID, true)
+	entExpiring.ExpiresAt = &expiresAt
+
 	testCases := []struct {
 		Name           string
 		Input          systemauth.Entity
@@ -185,6 +221,12 @@ func TestConverter_FromEntity(t *testing.T) {
 			ExpectedOutput: modelIntSysAuth,
 			ExpectedError:  nil,
 		},
+		{
+			Name:           "Success when converting auth with expiration",
+			Input:          entExpiring,
+			ExpectedOutput: modelExpiringSysAuth,
+			ExpectedError:  nil,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1,6 +1,9 @@
 package systemauth
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 type Entity struct {
 	ID                  string         `db:"id"`
@@ -9,6 +12,7 @@ type Entity struct {
 	RuntimeID           sql.NullString `db:"runtime_id"`
 	IntegrationSystemID sql.NullString `db:"integration_system_id"`
 	Value               sql.NullString `db:"value"`
+	ExpiresAt           *time.Time     `db:"expires_at"`
 }
 
 type Collection []Entity
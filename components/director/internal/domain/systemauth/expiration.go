@@ -0,0 +1,114 @@
+package systemauth
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var referenceObjectTypes = []model.SystemAuthReferenceObjectType{
+	model.ApplicationReference,
+	model.RuntimeReference,
+	model.IntegrationSystemReference,
+}
+
+type expirationEnforcer struct {
+	transact     persistence.Transactioner
+	repo         Repository
+	oAuth20Svc   OAuth20Service
+	timestampGen timestamp.Generator
+	logger       *logrus.Logger
+}
+
+// NewExpirationEnforcer returns an enforcer which deletes expired System Auths together with their OAuth 2.0 clients.
+func NewExpirationEnforcer(transact persistence.Transactioner, repo Repository, oAuth20Svc OAuth20Service) *expirationEnforcer {
+	return &expirationEnforcer{
+		transact:     transact,
+		repo:         repo,
+		oAuth20Svc:   oAuth20Svc,
+		timestampGen: timestamp.DefaultGenerator(),
+		logger:       logrus.New(),
+	}
+}
+
+// DeleteExpired deletes all System Auths which expired. Every System Auth is deleted in a separate transaction,
+// so that a failure for one of them does not block the others. Failed ones are retried on the next run.
+func (e *expirationEnforcer) DeleteExpired(ctx context.Context) (int, error) {
+	expired, err := e.listExpired(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, item := range expired {
+		if err := e.delete(ctx, item); err != nil {
+			e.logger.Errorf("Could not delete expired System Auth with ID %s: %v", item.ID, err)
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (e *expirationEnforcer) listExpired(ctx context.Context) ([]model.SystemAuth, error) {
+	tx, err := e.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer e.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	now := e.timestampGen()
+	var expired []model.SystemAuth
+	for _, objectType := range referenceObjectTypes {
+		items, err := e.repo.ListExpiringBeforeGlobal(ctx, objectType, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing expired System Auths for %s", objectType)
+		}
+		expired = append(expired, items...)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return expired, nil
+}
+
+func (e *expirationEnforcer) delete(ctx context.Context, item model.SystemAuth) error {
+	objectType, err := item.GetReferenceObjectType()
+	if err != nil {
+		return err
+	}
+
+	tx, err := e.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer e.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := e.repo.DeleteByIDForObjectGlobal(ctx, item.ID, objectType); err != nil {
+		return errors.Wrap(err, "while deleting System Auth")
+	}
+
+	if item.Value != nil && item.Value.Credential.OAuthClientID() != "" {
+		if err := e.oAuth20Svc.DeleteClientCredentials(ctx, item.Value.Credential.OAuthClientID()); err != nil {
+			return errors.Wrap(err, "while deleting OAuth 2.0 client")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	e.logger.Infof("Deleted expired System Auth with ID %s for %s", item.ID, objectType)
+	return nil
+}
@@ -0,0 +1,119 @@
+package systemauth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpirationEnforcer_DeleteExpired(t *testing.T) {
+	// GIVEN
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(-time.Hour)
+
+	basicSysAuth := fixModelSystemAuth("foo", model.ApplicationReference, "bar", fixModelAuth())
+	basicSysAuth.ExpiresAt = &expiresAt
+	oauthSysAuth := fixModelSystemAuth("foo2", model.IntegrationSystemReference, "bar2", &model.Auth{
+		Credential: model.CredentialData{
+			Oauth: &model.OAuthCredentialData{
+				ClientID:     "foo2",
+				ClientSecret: "secret",
+				URL:          "foo.bar/token",
+			},
+		},
+	})
+	oauthSysAuth.ExpiresAt = &expiresAt
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 3)
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.Repository{}
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.ApplicationReference, now).Return([]model.SystemAuth{*basicSysAuth}, nil).Once()
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.RuntimeReference, now).Return(nil, nil).Once()
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.IntegrationSystemReference, now).Return([]model.SystemAuth{*oauthSysAuth}, nil).Once()
+		repo.On("DeleteByIDForObjectGlobal", contextParam, basicSysAuth.ID, model.ApplicationReference).Return(nil).Once()
+		repo.On("DeleteByIDForObjectGlobal", contextParam, oauthSysAuth.ID, model.IntegrationSystemReference).Return(nil).Once()
+		defer repo.AssertExpectations(t)
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteClientCredentials", contextParam, "foo2").Return(nil).Once()
+		defer oAuth20Svc.AssertExpectations(t)
+
+		enforcer := systemauth.NewExpirationEnforcer(transact, repo, oAuth20Svc)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		deleted, err := enforcer.DeleteExpired(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+	})
+
+	t.Run("Skips System Auth which cannot be deleted", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 2)
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.Repository{}
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.ApplicationReference, now).Return([]model.SystemAuth{*basicSysAuth}, nil).Once()
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.RuntimeReference, now).Return(nil, nil).Once()
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.IntegrationSystemReference, now).Return([]model.SystemAuth{*oauthSysAuth}, nil).Once()
+		repo.On("DeleteByIDForObjectGlobal", contextParam, basicSysAuth.ID, model.ApplicationReference).Return(nil).Once()
+		repo.On("DeleteByIDForObjectGlobal", contextParam, oauthSysAuth.ID, model.IntegrationSystemReference).Return(nil).Once()
+		defer repo.AssertExpectations(t)
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteClientCredentials", contextParam, "foo2").Return(testErr).Once()
+		defer oAuth20Svc.AssertExpectations(t)
+
+		enforcer := systemauth.NewExpirationEnforcer(transact, repo, oAuth20Svc)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		deleted, err := enforcer.DeleteExpired(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+	})
+
+	t.Run("Error when listing expired System Auths", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(1, 0)
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.Repository{}
+		repo.On("ListExpiringBeforeGlobal", contextParam, model.ApplicationReference, now).Return(nil, testErr).Once()
+		defer repo.AssertExpectations(t)
+
+		enforcer := systemauth.NewExpirationEnforcer(transact, repo, nil)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := enforcer.DeleteExpired(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, "while listing expired System Auths for Application: test error")
+	})
+}
+
+func fixTransactioner(begins, commits int) (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	if commits > 0 {
+		persistTx.On("Commit").Return(nil).Times(commits)
+	}
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(begins)
+	transact.On("RollbackUnlessCommitted", persistTx).Return().Times(begins)
+
+	return persistTx, transact
+}
@@ -0,0 +1,11 @@
+package systemauth
+
+import "time"
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
+
+func (e *expirationEnforcer) SetTimestampGen(timestampGen func() time.Time) {
+	e.timestampGen = timestampGen
+}
@@ -2,6 +2,7 @@ package systemauth_test
 
 import (
 	"database/sql/driver"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -20,7 +21,7 @@ var (
 	testErr              = errors.New("test error")
 )
 
-var testTableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}
+var testTableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value", "expires_at"}
 
 func fixGQLSystemAuth(id string, auth *graphql.Auth) *graphql.SystemAuth {
 	return &graphql.SystemAuth{
@@ -149,21 +150,22 @@ func fixEntity(id string, objectType model.SystemAuthReferenceObjectType, object
 }
 
 type sqlRow struct {
-	id       string
-	tenant   *string
-	appID    *string
-	rtmID    *string
-	intSysID *string
+	id        string
+	tenant    *string
+	appID     *string
+	rtmID     *string
+	intSysID  *string
+	expiresAt *time.Time
 }
 
 func fixSQLRows(rows []sqlRow) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, row := range rows {
-		out.AddRow(row.id, row.tenant, row.appID, row.rtmID, row.intSysID, testMarshalledSchema)
+		out.AddRow(row.id, row.tenant, row.appID, row.rtmID, row.intSysID, testMarshalledSchema, row.expiresAt)
 	}
 	return out
 }
 
 func fixSystemAuthCreateArgs(ent systemauth.Entity) []driver.Value {
-	return []driver.Value{ent.ID, ent.TenantID, ent.AppID, ent.RuntimeID, ent.IntegrationSystemID, ent.Value}
+	return []driver.Value{ent.ID, ent.TenantID, ent.AppID, ent.RuntimeID, ent.IntegrationSystemID, ent.Value, ent.ExpiresAt}
 }
@@ -2,6 +2,7 @@ package systemauth
 
 import (
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
@@ -17,8 +19,11 @@ import (
 const tableName string = `public.system_auths`
 
 var (
-	tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}
-	tenantColumn = "tenant_id"
+	tableColumns     = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value", "expires_at"}
+	tenantColumn     = "tenant_id"
+	expiresAtColumn  = "expires_at"
+	updatableColumns = []string{expiresAtColumn}
+	idColumns        = []string{"id"}
 )
 
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
@@ -35,12 +40,14 @@ type repository struct {
 	listerGlobal       repo.ListerGlobal
 	deleter            repo.Deleter
 	deleterGlobal      repo.DeleterGlobal
+	updaterGlobal      repo.UpdaterGlobal
 	logger             *logrus.Logger
 
-	conv Converter
+	conv      Converter
+	encryptor encryption.Encryptor
 }
 
-func NewRepository(conv Converter) *repository {
+func NewRepository(conv Converter, encryptor encryption.Encryptor) *repository {
 	return &repository{
 		creator:            repo.NewCreator(resource.SystemAuth, tableName, tableColumns),
 		singleGetter:       repo.NewSingleGetter(resource.SystemAuth, tableName, tenantColumn, tableColumns),
@@ -49,8 +56,10 @@ func NewRepository(conv Converter) *repository {
 		listerGlobal:       repo.NewListerGlobal(resource.SystemAuth, tableName, tableColumns),
 		deleter:            repo.NewDeleter(resource.SystemAuth, tableName, tenantColumn),
 		deleterGlobal:      repo.NewDeleterGlobal(resource.SystemAuth, tableName),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.SystemAuth, tableName, updatableColumns, idColumns),
 		logger:             logrus.New(),
 		conv:               conv,
+		encryptor:          encryptor,
 	}
 }
 
@@ -60,6 +69,11 @@ func (r *repository) Create(ctx context.Context, item model.SystemAuth) error {
 		return errors.Wrap(err, "while converting model to entity")
 	}
 
+	entity.Value, err = r.encryptor.Encrypt(ctx, valueLocation(entity), entity.Value)
+	if err != nil {
+		return errors.Wrap(err, "while encrypting SystemAuth value")
+	}
+
 	r.logger.Debugf("Persisting SystemAuth entity with id %s to db", item.ID)
 	return r.creator.Create(ctx, entity)
 }
@@ -70,12 +84,7 @@ func (r *repository) GetByID(ctx context.Context, tenant, id string) (*model.Sys
 		return nil, err
 	}
 
-	itemModel, err := r.conv.FromEntity(entity)
-	if err != nil {
-		return nil, errors.Wrap(err, "while converting SystemAuth entity to model")
-	}
-
-	return &itemModel, nil
+	return r.fromEntity(ctx, entity)
 }
 
 func (r *repository) GetByIDGlobal(ctx context.Context, id string) (*model.SystemAuth, error) {
@@ -84,12 +93,7 @@ func (r *repository) GetByIDGlobal(ctx context.Context, id string) (*model.Syste
 		return nil, err
 	}
 
-	itemModel, err := r.conv.FromEntity(entity)
-	if err != nil {
-		return nil, errors.Wrap(err, "while converting SystemAuth entity to model")
-	}
-
-	return &itemModel, nil
+	return r.fromEntity(ctx, entity)
 }
 
 func (r *repository) ListForObject(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
@@ -110,7 +114,7 @@ func (r *repository) ListForObject(ctx context.Context, tenant string, objectTyp
 		return nil, err
 	}
 
-	return r.multipleFromEntities(entities)
+	return r.multipleFromEntities(ctx, entities)
 }
 
 func (r *repository) ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
@@ -130,14 +134,67 @@ func (r *repository) ListForObjectGlobal(ctx context.Context, objectType model.S
 		return nil, err
 	}
 
-	return r.multipleFromEntities(entities)
+	return r.multipleFromEntities(ctx, entities)
 }
 
-func (r *repository) multipleFromEntities(entities Collection) ([]model.SystemAuth, error) {
+func (r *repository) ListExpiringBefore(ctx context.Context, tenant string, before time.Time) ([]model.SystemAuth, error) {
+	var entities Collection
+	if err := r.lister.List(ctx, tenant, &entities, repo.NewLessThanCondition(expiresAtColumn, before)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(ctx, entities)
+}
+
+func (r *repository) ListExpiringBeforeGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, before time.Time) ([]model.SystemAuth, error) {
+	objTypeFieldName, err := referenceObjectField(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := repo.Conditions{
+		repo.NewNotNullCondition(objTypeFieldName),
+		repo.NewLessThanCondition(expiresAtColumn, before),
+	}
+
+	var entities Collection
+	if err := r.listerGlobal.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(ctx, entities)
+}
+
+func (r *repository) UpdateExpiresAt(ctx context.Context, id string, expiresAt *time.Time) error {
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, Entity{ID: id, ExpiresAt: expiresAt})
+}
+
+func (r *repository) fromEntity(ctx context.Context, entity Entity) (*model.SystemAuth, error) {
+	var err error
+	entity.Value, err = r.encryptor.Decrypt(ctx, valueLocation(entity), entity.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decrypting SystemAuth value")
+	}
+
+	itemModel, err := r.conv.FromEntity(entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting SystemAuth entity to model")
+	}
+
+	return &itemModel, nil
+}
+
+func (r *repository) multipleFromEntities(ctx context.Context, entities Collection) ([]model.SystemAuth, error) {
 
 	var items []model.SystemAuth
 
 	for _, ent := range entities {
+		var err error
+		ent.Value, err = r.encryptor.Decrypt(ctx, valueLocation(ent), ent.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "while decrypting SystemAuth value")
+		}
+
 		m, err := r.conv.FromEntity(ent)
 		if err != nil {
 			return nil, errors.Wrap(err, "while creating system auth model from entity")
@@ -196,3 +253,7 @@ func referenceObjectField(objectType model.SystemAuthReferenceObjectType) (strin
 
 	return "", apperrors.NewInternalError("unsupported reference object type")
 }
+
+func valueLocation(entity Entity) encryption.Location {
+	return encryption.Location{TenantID: entity.TenantID.String, Column: encryption.SystemAuthValueColumn, RowID: entity.ID}
+}
@@ -2,13 +2,17 @@ package systemauth_test
 
 import (
 	"context"
+	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
@@ -40,7 +44,7 @@ func TestRepository_Create(t *testing.T) {
 
 		convMock := automock.Converter{}
 		convMock.On("ToEntity", *modelSysAuth).Return(entSysAuth, nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		err := pgRepository.Create(ctx, *modelSysAuth)
@@ -64,7 +68,7 @@ func TestRepository_Create(t *testing.T) {
 
 		convMock := automock.Converter{}
 		convMock.On("ToEntity", *modelSysAuth).Return(entSysAuth, nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		err := pgRepository.Create(ctx, *modelSysAuth)
@@ -88,7 +92,7 @@ func TestRepository_Create(t *testing.T) {
 
 		convMock := automock.Converter{}
 		convMock.On("ToEntity", *modelSysAuth).Return(entSysAuth, nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		err := pgRepository.Create(ctx, *modelSysAuth)
@@ -99,6 +103,57 @@ func TestRepository_Create(t *testing.T) {
 		convMock.AssertExpectations(t)
 	})
 
+	t.Run("Success creating auth with encrypted value", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuth := fixModelSystemAuth("foo", model.ApplicationReference, objID, modelAuth)
+		entSysAuth := fixEntity(sysAuthID, model.ApplicationReference, objID, true)
+		encryptedValue := sql.NullString{String: `{"envelope":{}}`, Valid: true}
+		encryptedEntSysAuth := entSysAuth
+		encryptedEntSysAuth.Value = encryptedValue
+
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(fixSystemAuthCreateArgs(encryptedEntSysAuth)...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		convMock := automock.Converter{}
+		convMock.On("ToEntity", *modelSysAuth).Return(entSysAuth, nil).Once()
+		encryptorMock := encryptionautomock.Encryptor{}
+		encryptorMock.On("Encrypt", ctx, encryption.Location{TenantID: testTenant, Column: encryption.SystemAuthValueColumn, RowID: sysAuthID}, entSysAuth.Value).Return(encryptedValue, nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock, &encryptorMock)
+
+		//WHEN
+		err := pgRepository.Create(ctx, *modelSysAuth)
+
+		//THEN
+		require.NoError(t, err)
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+		encryptorMock.AssertExpectations(t)
+	})
+
+	t.Run("Error encrypting", func(t *testing.T) {
+		ctx := context.TODO()
+
+		modelSysAuth := fixModelSystemAuth("foo", model.IntegrationSystemReference, objID, modelAuth)
+		entSysAuth := fixEntity(sysAuthID, model.IntegrationSystemReference, objID, true)
+
+		convMock := automock.Converter{}
+		convMock.On("ToEntity", *modelSysAuth).Return(entSysAuth, nil).Once()
+		encryptorMock := encryptionautomock.Encryptor{}
+		encryptorMock.On("Encrypt", ctx, encryption.Location{Column: encryption.SystemAuthValueColumn, RowID: sysAuthID}, entSysAuth.Value).Return(sql.NullString{}, testErr).Once()
+		pgRepository := systemauth.NewRepository(&convMock, &encryptorMock)
+
+		//WHEN
+		err := pgRepository.Create(ctx, *modelSysAuth)
+
+		//THEN
+		require.EqualError(t, err, "while encrypting SystemAuth value: "+testErr.Error())
+		convMock.AssertExpectations(t)
+		encryptorMock.AssertExpectations(t)
+	})
+
 	t.Run("Error converting", func(t *testing.T) {
 		ctx := context.TODO()
 
@@ -106,7 +161,7 @@ func TestRepository_Create(t *testing.T) {
 
 		convMock := automock.Converter{}
 		convMock.On("ToEntity", *modelSysAuth).Return(systemauth.Entity{}, testErr).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		err := pgRepository.Create(ctx, *modelSysAuth)
@@ -130,7 +185,7 @@ func TestRepository_Create(t *testing.T) {
 
 		convMock := automock.Converter{}
 		convMock.On("ToEntity", *modelSysAuth).Return(entSysAuth, nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		// WHEN
 		err := pgRepository.Create(ctx, *modelSysAuth)
@@ -157,14 +212,14 @@ func TestRepository_GetByID(t *testing.T) {
 		mockConverter.On("FromEntity", saEntity).Return(*saModel, nil).Once()
 		defer mockConverter.AssertExpectations(t)
 
-		repo := systemauth.NewRepository(mockConverter)
+		repo := systemauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
 			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
 
-		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND id = $2"
+		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE tenant_id = $1 AND id = $2"
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, saID).WillReturnRows(rows)
 
@@ -186,7 +241,7 @@ func TestRepository_GetByID(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		mockConverter.On("FromEntity", saEntity).Return(model.SystemAuth{}, givenError())
 
-		repo := systemauth.NewRepository(mockConverter)
+		repo := systemauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -203,9 +258,68 @@ func TestRepository_GetByID(t *testing.T) {
 		require.EqualError(t, err, "while converting SystemAuth entity to model: some error")
 	})
 
+	t.Run("Success with encrypted value", func(t *testing.T) {
+		// GIVEN
+		saModel := fixModelSystemAuth(saID, model.RuntimeReference, objectID, fixModelAuth())
+		saEntity := fixEntity(saID, model.RuntimeReference, objectID, true)
+		encryptedValue := sql.NullString{String: `{"envelope":{}}`, Valid: true}
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromEntity", saEntity).Return(*saModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		encryptorMock := &encryptionautomock.Encryptor{}
+		encryptorMock.On("Decrypt", ctx, encryption.Location{TenantID: testTenant, Column: encryption.SystemAuthValueColumn, RowID: saID}, encryptedValue).Return(saEntity.Value, nil).Once()
+		defer encryptorMock.AssertExpectations(t)
+
+		repo := systemauth.NewRepository(mockConverter, encryptorMock)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, encryptedValue)
+
+		dbMock.ExpectQuery("SELECT .*").
+			WithArgs(testTenant, saID).WillReturnRows(rows)
+
+		// WHEN
+		actual, err := repo.GetByID(ctx, testTenant, saID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, saModel, actual)
+	})
+
+	t.Run("Error - Decrypt", func(t *testing.T) {
+		// GIVEN
+		saEntity := fixEntity(saID, model.RuntimeReference, objectID, true)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		encryptorMock := &encryptionautomock.Encryptor{}
+		encryptorMock.On("Decrypt", ctx, encryption.Location{TenantID: testTenant, Column: encryption.SystemAuthValueColumn, RowID: saID}, saEntity.Value).Return(sql.NullString{}, givenError()).Once()
+		defer encryptorMock.AssertExpectations(t)
+
+		repo := systemauth.NewRepository(nil, encryptorMock)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
+
+		dbMock.ExpectQuery("SELECT .*").
+			WithArgs(testTenant, saID).WillReturnRows(rows)
+
+		// WHEN
+		_, err := repo.GetByID(ctx, testTenant, saID)
+		// THEN
+		require.EqualError(t, err, "while decrypting SystemAuth value: some error")
+	})
+
 	t.Run("Error - DB", func(t *testing.T) {
 		// GIVEN
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -233,14 +347,14 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 		mockConverter.On("FromEntity", saEntity).Return(*saModel, nil).Once()
 		defer mockConverter.AssertExpectations(t)
 
-		repo := systemauth.NewRepository(mockConverter)
+		repo := systemauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
 			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
 
-		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE id = $1"
+		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE id = $1"
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(saID).WillReturnRows(rows)
 
@@ -262,7 +376,7 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		mockConverter.On("FromEntity", saEntity).Return(model.SystemAuth{}, givenError())
 
-		repo := systemauth.NewRepository(mockConverter)
+		repo := systemauth.NewRepository(mockConverter, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -281,7 +395,7 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 
 	t.Run("Error - DB", func(t *testing.T) {
 		// GIVEN
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
@@ -315,7 +429,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.RuntimeReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND runtime_id = $2`
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE tenant_id = $1 AND runtime_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -338,7 +452,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		convMock := automock.Converter{}
 		convMock.On("FromEntity", entSysAuths[0]).Return(*modelSysAuths[0], nil).Once()
 		convMock.On("FromEntity", entSysAuths[1]).Return(*modelSysAuths[1], nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListForObject(ctx, testTenant, model.RuntimeReference, objID)
@@ -363,7 +477,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.ApplicationReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND app_id = $2`
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE tenant_id = $1 AND app_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -386,7 +500,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		convMock := automock.Converter{}
 		convMock.On("FromEntity", entSysAuths[0]).Return(*modelSysAuths[0], nil).Once()
 		convMock.On("FromEntity", entSysAuths[1]).Return(*modelSysAuths[1], nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListForObject(ctx, testTenant, model.ApplicationReference, objID)
@@ -411,7 +525,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.IntegrationSystemReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE integration_system_id = $1`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -434,7 +548,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		convMock := automock.Converter{}
 		convMock.On("FromEntity", entSysAuths[0]).Return(*modelSysAuths[0], nil).Once()
 		convMock.On("FromEntity", entSysAuths[1]).Return(*modelSysAuths[1], nil).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListForObjectGlobal(ctx, model.IntegrationSystemReference, objID)
@@ -447,7 +561,7 @@ func TestRepository_ListForObject(t *testing.T) {
 	})
 
 	t.Run("Error listing auths for unsupported reference object type", func(t *testing.T) {
-		pgRepository := systemauth.NewRepository(nil)
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		errorMsg := "unsupported reference object type"
 
 		//WHEN
@@ -463,12 +577,12 @@ func TestRepository_ListForObject(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE integration_system_id = $1`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(objID).
 			WillReturnError(testErr)
 
-		pgRepository := systemauth.NewRepository(nil)
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListForObjectGlobal(ctx, model.IntegrationSystemReference, objID)
@@ -493,7 +607,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.IntegrationSystemReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE integration_system_id = $1`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -515,7 +629,7 @@ func TestRepository_ListForObject(t *testing.T) {
 
 		convMock := automock.Converter{}
 		convMock.On("FromEntity", entSysAuths[0]).Return(model.SystemAuth{}, testErr).Once()
-		pgRepository := systemauth.NewRepository(&convMock)
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
 
 		//WHEN
 		result, err := pgRepository.ListForObjectGlobal(ctx, model.IntegrationSystemReference, objID)
@@ -542,7 +656,7 @@ func TestRepository_DeleteAllForObject(t *testing.T) {
 			WithArgs(testTenant, sysAuthID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteAllForObject(ctx, testTenant, model.RuntimeReference, sysAuthID)
 		// THEN
@@ -558,7 +672,7 @@ func TestRepository_DeleteAllForObject(t *testing.T) {
 			WithArgs(testTenant, sysAuthID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteAllForObject(ctx, testTenant, model.ApplicationReference, sysAuthID)
 		// THEN
@@ -574,7 +688,7 @@ func TestRepository_DeleteAllForObject(t *testing.T) {
 			WithArgs(sysAuthID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteAllForObject(ctx, "", model.IntegrationSystemReference, sysAuthID)
 		// THEN
@@ -590,7 +704,7 @@ func TestRepository_DeleteAllForObject(t *testing.T) {
 			WithArgs(testTenant, sysAuthID).
 			WillReturnError(testErr)
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteAllForObject(ctx, testTenant, model.RuntimeReference, sysAuthID)
 		// THEN
@@ -599,7 +713,7 @@ func TestRepository_DeleteAllForObject(t *testing.T) {
 	})
 
 	t.Run("Error listing auths for unsupported reference object type", func(t *testing.T) {
-		pgRepository := systemauth.NewRepository(nil)
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		errorMsg := "unsupported reference object type"
 
 		//WHEN
@@ -624,7 +738,7 @@ func TestRepository_DeleteByIDForObject(t *testing.T) {
 			WithArgs(testTenant, sysAuthID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteByIDForObject(ctx, testTenant, sysAuthID, model.ApplicationReference)
 		// THEN
@@ -640,7 +754,7 @@ func TestRepository_DeleteByIDForObject(t *testing.T) {
 			WithArgs(testTenant, sysAuthID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteByIDForObject(ctx, testTenant, sysAuthID, model.RuntimeReference)
 		// THEN
@@ -656,7 +770,7 @@ func TestRepository_DeleteByIDForObject(t *testing.T) {
 			WithArgs(sysAuthID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteByIDForObjectGlobal(ctx, sysAuthID, model.IntegrationSystemReference)
 		// THEN
@@ -672,7 +786,7 @@ func TestRepository_DeleteByIDForObject(t *testing.T) {
 			WithArgs(testTenant, sysAuthID).
 			WillReturnError(testErr)
 
-		repo := systemauth.NewRepository(nil)
+		repo := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
 		// WHEN
 		err := repo.DeleteByIDForObject(ctx, testTenant, sysAuthID, model.ApplicationReference)
 		// THEN
@@ -681,6 +795,158 @@ func TestRepository_DeleteByIDForObject(t *testing.T) {
 	})
 }
 
+func TestRepository_ListExpiringBefore(t *testing.T) {
+	before := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := before.Add(-time.Hour)
+	objID := "bar"
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuth := fixModelSystemAuth("foo", model.RuntimeReference, objID, fixModelAuth())
+		modelSysAuth.ExpiresAt = &expiresAt
+		entSysAuth := fixEntity("foo", model.RuntimeReference, objID, true)
+		entSysAuth.ExpiresAt = &expiresAt
+
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE tenant_id = $1 AND expires_at < $2`
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, before).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				{
+					id:        modelSysAuth.ID,
+					tenant:    &testTenant,
+					rtmID:     modelSysAuth.RuntimeID,
+					expiresAt: &expiresAt,
+				},
+			}))
+
+		convMock := automock.Converter{}
+		convMock.On("FromEntity", entSysAuth).Return(*modelSysAuth, nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
+
+		//WHEN
+		result, err := pgRepository.ListExpiringBefore(ctx, testTenant, before)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []model.SystemAuth{*modelSysAuth}, result)
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("Error listing auths", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(`SELECT .*`).WillReturnError(testErr)
+
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
+
+		//WHEN
+		result, err := pgRepository.ListExpiringBefore(ctx, testTenant, before)
+
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+		dbMock.AssertExpectations(t)
+	})
+}
+
+func TestRepository_ListExpiringBeforeGlobal(t *testing.T) {
+	before := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := before.Add(-time.Hour)
+	objID := "bar"
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuth := fixModelSystemAuth("foo", model.IntegrationSystemReference, objID, fixModelAuth())
+		modelSysAuth.ExpiresAt = &expiresAt
+		entSysAuth := fixEntity("foo", model.IntegrationSystemReference, objID, true)
+		entSysAuth.ExpiresAt = &expiresAt
+
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, expires_at FROM public.system_auths WHERE integration_system_id IS NOT NULL AND expires_at < $1`
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(before).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				{
+					id:        modelSysAuth.ID,
+					intSysID:  modelSysAuth.IntegrationSystemID,
+					expiresAt: &expiresAt,
+				},
+			}))
+
+		convMock := automock.Converter{}
+		convMock.On("FromEntity", entSysAuth).Return(*modelSysAuth, nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock, encryption.NewNoopEncryptor())
+
+		//WHEN
+		result, err := pgRepository.ListExpiringBeforeGlobal(ctx, model.IntegrationSystemReference, before)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []model.SystemAuth{*modelSysAuth}, result)
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("Error listing auths for unsupported reference object type", func(t *testing.T) {
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
+
+		//WHEN
+		result, err := pgRepository.ListExpiringBeforeGlobal(context.TODO(), "unsupported", before)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported reference object type")
+		require.Nil(t, result)
+	})
+}
+
+func TestRepository_UpdateExpiresAt(t *testing.T) {
+	sysAuthID := "foo"
+	expiresAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := `UPDATE public.system_auths SET expires_at = ? WHERE id = ?`
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(&expiresAt, sysAuthID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
+
+		//WHEN
+		err := pgRepository.UpdateExpiresAt(ctx, sysAuthID, &expiresAt)
+
+		//THEN
+		require.NoError(t, err)
+		dbMock.AssertExpectations(t)
+	})
+
+	t.Run("Error when no row was updated", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(&expiresAt, sysAuthID).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		pgRepository := systemauth.NewRepository(nil, encryption.NewNoopEncryptor())
+
+		//WHEN
+		err := pgRepository.UpdateExpiresAt(ctx, sysAuthID, &expiresAt)
+
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: should update single row, but updated 0 rows")
+		dbMock.AssertExpectations(t)
+	})
+}
+
 func givenError() error {
 	return errors.New("some error")
 }
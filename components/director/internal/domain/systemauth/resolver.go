@@ -2,8 +2,10 @@ package systemauth
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
@@ -14,6 +16,7 @@ type SystemAuthService interface {
 	GetByIDForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, authID string) (*model.SystemAuth, error)
 	GetGlobal(ctx context.Context, id string) (*model.SystemAuth, error)
 	DeleteByIDForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, authID string) error
+	ListExpiringWithin(ctx context.Context, within time.Duration) ([]model.SystemAuth, error)
 }
 
 //go:generate mockery -name=OAuth20Service -output=automock -outpkg=automock -case=underscore
@@ -37,6 +40,38 @@ func NewResolver(transact persistence.Transactioner, svc SystemAuthService, oAut
 	return &Resolver{transact: transact, svc: svc, oAuth20Svc: oAuth20Svc, conv: conv}
 }
 
+func (r *Resolver) GenericGetSystemAuth(objectType model.SystemAuthReferenceObjectType) func(ctx context.Context, id string) (*graphql.SystemAuth, error) {
+	return func(ctx context.Context, id string) (*graphql.SystemAuth, error) {
+		tx, err := r.transact.Begin()
+		if err != nil {
+			return nil, err
+		}
+		defer r.transact.RollbackUnlessCommitted(tx)
+
+		ctx = persistence.SaveToContext(ctx, tx)
+
+		item, err := r.svc.GetByIDForObject(ctx, objectType, id)
+		if err != nil {
+			if apperrors.IsNotFoundError(err) {
+				return nil, tx.Commit()
+			}
+			return nil, err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+
+		gqlItem, err := r.conv.ToGraphQL(item)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting SystemAuth to GraphQL")
+		}
+
+		return gqlItem, nil
+	}
+}
+
 func (r *Resolver) GenericDeleteSystemAuth(objectType model.SystemAuthReferenceObjectType) func(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return func(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 		tx, err := r.transact.Begin()
@@ -57,8 +92,8 @@ func (r *Resolver) GenericDeleteSystemAuth(objectType model.SystemAuthReferenceO
 			return nil, errors.Wrap(err, "while converting SystemAuth to GraphQL")
 		}
 
-		if item.Value != nil && item.Value.Credential.Oauth != nil {
-			err := r.oAuth20Svc.DeleteClientCredentials(ctx, item.Value.Credential.Oauth.ClientID)
+		if item.Value != nil && item.Value.Credential.OAuthClientID() != "" {
+			err := r.oAuth20Svc.DeleteClientCredentials(ctx, item.Value.Credential.OAuthClientID())
 			if err != nil {
 				return nil, errors.Wrap(err, "while deleting OAuth 2.0 client")
 			}
@@ -77,3 +112,38 @@ func (r *Resolver) GenericDeleteSystemAuth(objectType model.SystemAuthReferenceO
 		return deletedItem, nil
 	}
 }
+
+func (r *Resolver) SystemAuthsExpiringWithin(ctx context.Context, days int) ([]*graphql.SystemAuth, error) {
+	if days < 0 {
+		return nil, apperrors.NewInvalidDataError("number of days cannot be negative")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	items, err := r.svc.ListExpiringWithin(ctx, time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	gqlItems := make([]*graphql.SystemAuth, 0, len(items))
+	for i := range items {
+		gqlItem, err := r.conv.ToGraphQL(&items[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting SystemAuth to GraphQL")
+		}
+		gqlItems = append(gqlItems, gqlItem)
+	}
+
+	return gqlItems, nil
+}
@@ -3,21 +3,159 @@ package systemauth_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
 var contextParam = txtest.CtxWithDBMatcher()
 
+func TestResolver_GenericGetSystemAuth(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	id := "foo"
+	objectID := "bar"
+	objectType := model.RuntimeReference
+	modelSystemAuth := fixModelSystemAuth(id, objectType, objectID, fixModelAuth())
+	gqlSystemAuth := fixGQLSystemAuth(id, fixGQLAuth())
+
+	testCases := []struct {
+		Name               string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn          func() *automock.SystemAuthService
+		ConverterFn        func() *automock.SystemAuthConverter
+		ExpectedSystemAuth *graphql.SystemAuth
+		ExpectedErr        error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("GetByIDForObject", contextParam, objectType, id).Return(modelSystemAuth, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				conv := &automock.SystemAuthConverter{}
+				conv.On("ToGraphQL", modelSystemAuth).Return(gqlSystemAuth, nil).Once()
+				return conv
+			},
+			ExpectedSystemAuth: gqlSystemAuth,
+			ExpectedErr:        nil,
+		},
+		{
+			Name:            "Returns null when System Auth not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("GetByIDForObject", contextParam, objectType, id).Return(nil, apperrors.NewNotFoundError(resource.SystemAuth, id)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedSystemAuth: nil,
+			ExpectedErr:        nil,
+		},
+		{
+			Name:            "Returns error when getting System Auth failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("GetByIDForObject", contextParam, objectType, id).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedSystemAuth: nil,
+			ExpectedErr:        testErr,
+		},
+		{
+			Name:            "Returns error when converting to GraphQL failed",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("GetByIDForObject", contextParam, objectType, id).Return(modelSystemAuth, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				conv := &automock.SystemAuthConverter{}
+				conv.On("ToGraphQL", modelSystemAuth).Return(nil, testErr).Once()
+				return conv
+			},
+			ExpectedSystemAuth: nil,
+			ExpectedErr:        testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedSystemAuth: nil,
+			ExpectedErr:        testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("GetByIDForObject", contextParam, objectType, id).Return(modelSystemAuth, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedSystemAuth: nil,
+			ExpectedErr:        testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			defer persist.AssertExpectations(t)
+			defer transact.AssertExpectations(t)
+			svc := testCase.ServiceFn()
+			defer svc.AssertExpectations(t)
+			converter := testCase.ConverterFn()
+			defer converter.AssertExpectations(t)
+
+			resolver := systemauth.NewResolver(transact, svc, nil, converter)
+
+			// when
+			fn := resolver.GenericGetSystemAuth(objectType)
+			result, err := fn(context.TODO(), id)
+
+			// then
+			assert.Equal(t, testCase.ExpectedSystemAuth, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestResolver_GenericDeleteSystemAuth(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -219,3 +357,113 @@ func TestResolver_GenericDeleteSystemAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_SystemAuthsExpiringWithin(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	days := 7
+	modelSystemAuths := []model.SystemAuth{
+		*fixModelSystemAuth("foo", model.RuntimeReference, "bar", fixModelAuth()),
+		*fixModelSystemAuth("foo2", model.IntegrationSystemReference, "bar2", fixModelAuth()),
+	}
+	gqlSystemAuths := []*graphql.SystemAuth{
+		fixGQLSystemAuth("foo", fixGQLAuth()),
+		fixGQLSystemAuth("foo2", fixGQLAuth()),
+	}
+
+	testCases := []struct {
+		Name                string
+		Days                int
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.SystemAuthService
+		ConverterFn         func() *automock.SystemAuthConverter
+		ExpectedSystemAuths []*graphql.SystemAuth
+		ExpectedErr         error
+	}{
+		{
+			Name:            "Success",
+			Days:            days,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListExpiringWithin", contextParam, 7*24*time.Hour).Return(modelSystemAuths, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				conv := &automock.SystemAuthConverter{}
+				conv.On("ToGraphQL", &modelSystemAuths[0]).Return(gqlSystemAuths[0], nil).Once()
+				conv.On("ToGraphQL", &modelSystemAuths[1]).Return(gqlSystemAuths[1], nil).Once()
+				return conv
+			},
+			ExpectedSystemAuths: gqlSystemAuths,
+		},
+		{
+			Name:            "Error - Negative number of days",
+			Days:            -1,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedErr: errors.New("Invalid data [reason=number of days cannot be negative]"),
+		},
+		{
+			Name:            "Error - Listing System Auths",
+			Days:            days,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListExpiringWithin", contextParam, 7*24*time.Hour).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Error - Transaction Commit",
+			Days:            days,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListExpiringWithin", contextParam, 7*24*time.Hour).Return(modelSystemAuths, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			defer persist.AssertExpectations(t)
+			defer transact.AssertExpectations(t)
+			svc := testCase.ServiceFn()
+			defer svc.AssertExpectations(t)
+			converter := testCase.ConverterFn()
+			defer converter.AssertExpectations(t)
+
+			resolver := systemauth.NewResolver(transact, svc, nil, converter)
+
+			// when
+			result, err := resolver.SystemAuthsExpiringWithin(context.TODO(), testCase.Days)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedSystemAuths, result)
+		})
+	}
+}
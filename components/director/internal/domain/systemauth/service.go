@@ -2,12 +2,15 @@ package systemauth
 
 import (
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -22,6 +25,14 @@ type Repository interface {
 	ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
 	DeleteByIDForObject(ctx context.Context, tenant, id string, objType model.SystemAuthReferenceObjectType) error
 	DeleteByIDForObjectGlobal(ctx context.Context, id string, objType model.SystemAuthReferenceObjectType) error
+	ListExpiringBefore(ctx context.Context, tenant string, before time.Time) ([]model.SystemAuth, error)
+	ListExpiringBeforeGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, before time.Time) ([]model.SystemAuth, error)
+	UpdateExpiresAt(ctx context.Context, id string, expiresAt *time.Time) error
+}
+
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -30,28 +41,32 @@ type UIDService interface {
 }
 
 type service struct {
-	repo       Repository
-	uidService UIDService
-	logger     *logrus.Logger
+	repo              Repository
+	accessRuleService AccessRuleService
+	uidService        UIDService
+	timestampGen      timestamp.Generator
+	logger            *logrus.Logger
 }
 
-func NewService(repo Repository, uidService UIDService) *service {
+func NewService(repo Repository, accessRuleService AccessRuleService, uidService UIDService) *service {
 	return &service{
-		repo:       repo,
-		uidService: uidService,
-		logger:     logrus.New(),
+		repo:              repo,
+		accessRuleService: accessRuleService,
+		uidService:        uidService,
+		timestampGen:      timestamp.DefaultGenerator(),
+		logger:            logrus.New(),
 	}
 }
 
 func (s *service) Create(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput) (string, error) {
-	return s.create(ctx, s.uidService.Generate(), objectType, objectID, authInput)
+	return s.create(ctx, s.uidService.Generate(), objectType, objectID, authInput, nil)
 }
 
-func (s *service) CreateWithCustomID(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput) (string, error) {
-	return s.create(ctx, id, objectType, objectID, authInput)
+func (s *service) CreateWithCustomID(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput, expiresAt *time.Time) (string, error) {
+	return s.create(ctx, id, objectType, objectID, authInput, expiresAt)
 }
 
-func (s *service) create(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput) (string, error) {
+func (s *service) create(ctx context.Context, id string, objectType model.SystemAuthReferenceObjectType, objectID string, authInput *model.AuthInput, expiresAt *time.Time) (string, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		if !model.IsIntegrationSystemNoTenantFlow(err, objectType) {
@@ -62,8 +77,9 @@ func (s *service) create(ctx context.Context, id string, objectType model.System
 	s.logger.Debugf("Tenant %s loaded while creating SystemAuth for %s with id %s", tnt, objectType, objectID)
 
 	systemAuth := model.SystemAuth{
-		ID:    id,
-		Value: authInput.ToAuth(),
+		ID:        id,
+		Value:     authInput.ToAuth(),
+		ExpiresAt: expiresAt,
 	}
 
 	switch objectType {
@@ -107,6 +123,10 @@ func (s *service) GetByIDForObject(ctx context.Context, objectType model.SystemA
 		return nil, errors.Wrapf(err, "while getting SystemAuth with ID %s", authID)
 	}
 
+	if err := s.ensureAccess(ctx, item); err != nil {
+		return nil, err
+	}
+
 	return item, nil
 }
 
@@ -160,3 +180,97 @@ func (s *service) DeleteByIDForObject(ctx context.Context, objectType model.Syst
 
 	return nil
 }
+
+// ensureAccess hides the System Auths of the Applications and Runtimes, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, item *model.SystemAuth) error {
+	hasAccess, err := s.hasAccess(ctx, item)
+	if err != nil {
+		return err
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resource.SystemAuth, item.ID)
+	}
+
+	return nil
+}
+
+func (s *service) hasAccess(ctx context.Context, item *model.SystemAuth) (bool, error) {
+	objType, err := item.GetReferenceObjectType()
+	if err != nil {
+		return false, err
+	}
+
+	accessRuleObjType, restricted := objType.AccessRuleObjectType()
+	if !restricted {
+		return true, nil
+	}
+
+	objID, err := item.GetReferenceObjectID()
+	if err != nil {
+		return false, err
+	}
+
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, accessRuleObjType, objID)
+	if err != nil {
+		return false, errors.Wrapf(err, "while checking access to %s with ID %s", objType, objID)
+	}
+
+	return hasAccess, nil
+}
+
+func (s *service) UpdateExpiresAt(ctx context.Context, id string, expiresAt *time.Time) error {
+	if err := s.repo.UpdateExpiresAt(ctx, id, expiresAt); err != nil {
+		return errors.Wrapf(err, "while updating expiration of System Auth with ID '%s'", id)
+	}
+
+	return nil
+}
+
+// ListExpiringWithin returns System Auths of the tenant from the context and of all Integration Systems
+// which expire within the given duration. Already expired System Auths, which are not yet removed, are included.
+// System Auths of the Applications and Runtimes, which cannot be accessed by the caller, are omitted.
+func (s *service) ListExpiringWithin(ctx context.Context, within time.Duration) ([]model.SystemAuth, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		if !apperrors.IsTenantRequired(err) {
+			return nil, err
+		}
+	}
+
+	before := s.timestampGen().Add(within)
+
+	var systemAuths []model.SystemAuth
+	if tnt != "" {
+		systemAuths, err = s.repo.ListExpiringBefore(ctx, tnt, before)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing expiring System Auths for tenant")
+		}
+
+		systemAuths, err = s.filterAccessible(ctx, systemAuths)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	intSysAuths, err := s.repo.ListExpiringBeforeGlobal(ctx, model.IntegrationSystemReference, before)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing expiring System Auths for %s", model.IntegrationSystemReference)
+	}
+
+	return append(systemAuths, intSysAuths...), nil
+}
+
+func (s *service) filterAccessible(ctx context.Context, items []model.SystemAuth) ([]model.SystemAuth, error) {
+	accessible := make([]model.SystemAuth, 0, len(items))
+	for i := range items {
+		hasAccess, err := s.hasAccess(ctx, &items[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "while checking access to System Auth with ID %s", items[i].ID)
+		}
+		if hasAccess {
+			accessible = append(accessible, items[i])
+		}
+	}
+
+	return accessible, nil
+}
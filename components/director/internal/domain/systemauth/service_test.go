@@ -3,6 +3,7 @@ package systemauth_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
@@ -119,7 +120,7 @@ func TestService_Create(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			sysAuthRepo := testCase.sysAuthRepoFn()
 			uidSvc := uidSvcFn()
-			svc := systemauth.NewService(sysAuthRepo, uidSvc)
+			svc := systemauth.NewService(sysAuthRepo, nil, uidSvc)
 
 			// WHEN
 			result, err := svc.Create(ctx, testCase.InputObjectType, objID, testCase.InputAuth)
@@ -141,7 +142,7 @@ func TestService_Create(t *testing.T) {
 	t.Run("Error when tenant not in context", func(t *testing.T) {
 		uidSvc := uidSvcFn()
 		defer uidSvc.AssertExpectations(t)
-		svc := systemauth.NewService(nil, uidSvc)
+		svc := systemauth.NewService(nil, nil, uidSvc)
 
 		// WHEN
 		_, err := svc.Create(context.TODO(), "", "", nil)
@@ -163,14 +164,18 @@ func TestService_CreateWithCustomID(t *testing.T) {
 	modelAuthInput := fixModelAuthInput()
 	modelAuth := fixModelAuth()
 
+	expiresAt := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	expectedSysAuth := fixModelSystemAuth(sysAuthID, model.RuntimeReference, objID, modelAuth)
+	expectedSysAuth.ExpiresAt = &expiresAt
+
 	sysAuthRepo := &automock.Repository{}
-	sysAuthRepo.On("Create", contextThatHasTenant(testTenant), *fixModelSystemAuth(sysAuthID, model.RuntimeReference, objID, modelAuth)).Return(nil)
+	sysAuthRepo.On("Create", contextThatHasTenant(testTenant), *expectedSysAuth).Return(nil)
 	defer sysAuthRepo.AssertExpectations(t)
 
-	svc := systemauth.NewService(sysAuthRepo, nil)
+	svc := systemauth.NewService(sysAuthRepo, nil, nil)
 
 	// WHEN
-	result, err := svc.CreateWithCustomID(ctx, sysAuthID, model.RuntimeReference, objID, &modelAuthInput)
+	result, err := svc.CreateWithCustomID(ctx, sysAuthID, model.RuntimeReference, objID, &modelAuthInput, &expiresAt)
 
 	// THEN
 	assert.NoError(t, err)
@@ -284,7 +289,7 @@ func TestService_ListForObject(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			sysAuthRepo := testCase.sysAuthRepoFn()
-			svc := systemauth.NewService(sysAuthRepo, nil)
+			svc := systemauth.NewService(sysAuthRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.ListForObject(ctx, testCase.InputObjectType, objID)
@@ -303,7 +308,7 @@ func TestService_ListForObject(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := systemauth.NewService(nil, nil)
+		svc := systemauth.NewService(nil, nil, nil)
 
 		// WHEN
 		_, err := svc.ListForObject(context.TODO(), "", "")
@@ -319,47 +324,64 @@ func TestService_GetByIDForObject(t *testing.T) {
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
 
 	sysAuthID := "foo"
-	modelSysAuth := fixModelSystemAuth(sysAuthID, model.RuntimeReference, "bar", nil)
+	objID := "bar"
+	rtmSysAuth := fixModelSystemAuth(sysAuthID, model.RuntimeReference, objID, nil)
+	appSysAuth := fixModelSystemAuth(sysAuthID, model.ApplicationReference, objID, nil)
+	intSysAuth := fixModelSystemAuth(sysAuthID, model.IntegrationSystemReference, objID, nil)
 
 	testCases := []struct {
-		Name            string
-		sysAuthRepoFn   func() *automock.Repository
-		InputObjectType model.SystemAuthReferenceObjectType
-		ExpectedSysAuth *model.SystemAuth
-		ExpectedError   error
+		Name                string
+		sysAuthRepoFn       func() *automock.Repository
+		accessRuleServiceFn func() *automock.AccessRuleService
+		InputObjectType     model.SystemAuthReferenceObjectType
+		ExpectedSysAuth     *model.SystemAuth
+		ExpectedError       error
 	}{
 		{
 			Name: "Success getting auth for Runtime",
 			sysAuthRepoFn: func() *automock.Repository {
 				sysAuthRepo := &automock.Repository{}
-				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(modelSysAuth, nil)
+				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(rtmSysAuth, nil)
 				return sysAuthRepo
 			},
+			accessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", contextThatHasTenant(testTenant), model.AccessRuleObjectTypeRuntime, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
 			InputObjectType: model.RuntimeReference,
 			ExpectedError:   nil,
-			ExpectedSysAuth: modelSysAuth,
+			ExpectedSysAuth: rtmSysAuth,
 		},
 		{
 			Name: "Success getting auth for Application",
 			sysAuthRepoFn: func() *automock.Repository {
 				sysAuthRepo := &automock.Repository{}
-				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(modelSysAuth, nil)
+				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(appSysAuth, nil)
 				return sysAuthRepo
 			},
+			accessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", contextThatHasTenant(testTenant), model.AccessRuleObjectTypeApplication, objID).Return(true, nil).Once()
+				return accessRuleSvc
+			},
 			InputObjectType: model.ApplicationReference,
 			ExpectedError:   nil,
-			ExpectedSysAuth: modelSysAuth,
+			ExpectedSysAuth: appSysAuth,
 		},
 		{
 			Name: "Success getting auth for Integration System",
 			sysAuthRepoFn: func() *automock.Repository {
 				sysAuthRepo := &automock.Repository{}
-				sysAuthRepo.On("GetByIDGlobal", contextThatHasTenant(testTenant), sysAuthID).Return(modelSysAuth, nil)
+				sysAuthRepo.On("GetByIDGlobal", contextThatHasTenant(testTenant), sysAuthID).Return(intSysAuth, nil)
 				return sysAuthRepo
 			},
+			accessRuleServiceFn: func() *automock.AccessRuleService {
+				return &automock.AccessRuleService{}
+			},
 			InputObjectType: model.IntegrationSystemReference,
 			ExpectedError:   nil,
-			ExpectedSysAuth: modelSysAuth,
+			ExpectedSysAuth: intSysAuth,
 		},
 		{
 			Name: "Error getting System Auths",
@@ -368,6 +390,39 @@ func TestService_GetByIDForObject(t *testing.T) {
 				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(nil, testErr)
 				return sysAuthRepo
 			},
+			accessRuleServiceFn: func() *automock.AccessRuleService {
+				return &automock.AccessRuleService{}
+			},
+			InputObjectType: model.RuntimeReference,
+			ExpectedError:   testErr,
+		},
+		{
+			Name: "Error when caller has no access to the Application",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(appSysAuth, nil)
+				return sysAuthRepo
+			},
+			accessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", contextThatHasTenant(testTenant), model.AccessRuleObjectTypeApplication, objID).Return(false, nil).Once()
+				return accessRuleSvc
+			},
+			InputObjectType: model.ApplicationReference,
+			ExpectedError:   errors.New("Object not found"),
+		},
+		{
+			Name: "Error when checking access to the Runtime",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, sysAuthID).Return(rtmSysAuth, nil)
+				return sysAuthRepo
+			},
+			accessRuleServiceFn: func() *automock.AccessRuleService {
+				accessRuleSvc := &automock.AccessRuleService{}
+				accessRuleSvc.On("HasAccess", contextThatHasTenant(testTenant), model.AccessRuleObjectTypeRuntime, objID).Return(false, testErr).Once()
+				return accessRuleSvc
+			},
 			InputObjectType: model.RuntimeReference,
 			ExpectedError:   testErr,
 		},
@@ -376,7 +431,8 @@ func TestService_GetByIDForObject(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			sysAuthRepo := testCase.sysAuthRepoFn()
-			svc := systemauth.NewService(sysAuthRepo, nil)
+			accessRuleSvc := testCase.accessRuleServiceFn()
+			svc := systemauth.NewService(sysAuthRepo, accessRuleSvc, nil)
 
 			// WHEN
 			sysAuth, err := svc.GetByIDForObject(ctx, testCase.InputObjectType, sysAuthID)
@@ -390,12 +446,12 @@ func TestService_GetByIDForObject(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedSysAuth, sysAuth)
 			}
 
-			sysAuthRepo.AssertExpectations(t)
+			mock.AssertExpectationsForObjects(t, sysAuthRepo, accessRuleSvc)
 		})
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := systemauth.NewService(nil, nil)
+		svc := systemauth.NewService(nil, nil, nil)
 
 		// WHEN
 		err := svc.DeleteByIDForObject(context.TODO(), "", "")
@@ -462,7 +518,7 @@ func TestService_DeleteByIDForObject(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			sysAuthRepo := testCase.sysAuthRepoFn()
-			svc := systemauth.NewService(sysAuthRepo, nil)
+			svc := systemauth.NewService(sysAuthRepo, nil, nil)
 
 			// WHEN
 			err := svc.DeleteByIDForObject(ctx, testCase.InputObjectType, sysAuthID)
@@ -480,7 +536,7 @@ func TestService_DeleteByIDForObject(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := systemauth.NewService(nil, nil)
+		svc := systemauth.NewService(nil, nil, nil)
 
 		// WHEN
 		err := svc.DeleteByIDForObject(context.TODO(), "", "")
@@ -491,6 +547,181 @@ func TestService_DeleteByIDForObject(t *testing.T) {
 	})
 }
 
+func TestService_UpdateExpiresAt(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+	sysAuthID := "foo"
+	expiresAt := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("UpdateExpiresAt", ctx, sysAuthID, &expiresAt).Return(nil).Once()
+		defer sysAuthRepo.AssertExpectations(t)
+
+		svc := systemauth.NewService(sysAuthRepo, nil, nil)
+
+		// WHEN
+		err := svc.UpdateExpiresAt(ctx, sysAuthID, &expiresAt)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when updating expiration", func(t *testing.T) {
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("UpdateExpiresAt", ctx, sysAuthID, &expiresAt).Return(testErr).Once()
+		defer sysAuthRepo.AssertExpectations(t)
+
+		svc := systemauth.NewService(sysAuthRepo, nil, nil)
+
+		// WHEN
+		err := svc.UpdateExpiresAt(ctx, sysAuthID, &expiresAt)
+
+		// THEN
+		require.EqualError(t, err, "while updating expiration of System Auth with ID 'foo': test error")
+	})
+}
+
+func TestService_ListExpiringWithin(t *testing.T) {
+	// GIVEN
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	within := 48 * time.Hour
+	before := now.Add(within)
+
+	rtmSysAuth := *fixModelSystemAuth("foo", model.RuntimeReference, "bar", fixModelAuth())
+	appSysAuth := *fixModelSystemAuth("foo3", model.ApplicationReference, "bar3", fixModelAuth())
+	intSysAuth := *fixModelSystemAuth("foo2", model.IntegrationSystemReference, "bar2", fixModelAuth())
+
+	t.Run("Success listing System Auths of tenant and Integration Systems", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("ListExpiringBefore", ctx, testTenant, before).Return([]model.SystemAuth{rtmSysAuth}, nil).Once()
+		sysAuthRepo.On("ListExpiringBeforeGlobal", ctx, model.IntegrationSystemReference, before).Return([]model.SystemAuth{intSysAuth}, nil).Once()
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeRuntime, "bar").Return(true, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, sysAuthRepo, accessRuleSvc)
+
+		svc := systemauth.NewService(sysAuthRepo, accessRuleSvc, nil)
+		svc.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		result, err := svc.ListExpiringWithin(ctx, within)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []model.SystemAuth{rtmSysAuth, intSysAuth}, result)
+	})
+
+	t.Run("Success omitting System Auths of objects which cannot be accessed", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("ListExpiringBefore", ctx, testTenant, before).Return([]model.SystemAuth{rtmSysAuth, appSysAuth}, nil).Once()
+		sysAuthRepo.On("ListExpiringBeforeGlobal", ctx, model.IntegrationSystemReference, before).Return([]model.SystemAuth{intSysAuth}, nil).Once()
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeRuntime, "bar").Return(false, nil).Once()
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeApplication, "bar3").Return(true, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, sysAuthRepo, accessRuleSvc)
+
+		svc := systemauth.NewService(sysAuthRepo, accessRuleSvc, nil)
+		svc.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		result, err := svc.ListExpiringWithin(ctx, within)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []model.SystemAuth{appSysAuth, intSysAuth}, result)
+	})
+
+	t.Run("Error when checking access to the Runtime", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("ListExpiringBefore", ctx, testTenant, before).Return([]model.SystemAuth{rtmSysAuth}, nil).Once()
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeRuntime, "bar").Return(false, testErr).Once()
+		defer mock.AssertExpectationsForObjects(t, sysAuthRepo, accessRuleSvc)
+
+		svc := systemauth.NewService(sysAuthRepo, accessRuleSvc, nil)
+		svc.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := svc.ListExpiringWithin(ctx, within)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Success listing System Auths of Integration Systems when tenant is empty", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), "", "")
+
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("ListExpiringBeforeGlobal", ctx, model.IntegrationSystemReference, before).Return([]model.SystemAuth{intSysAuth}, nil).Once()
+		defer sysAuthRepo.AssertExpectations(t)
+
+		svc := systemauth.NewService(sysAuthRepo, nil, nil)
+		svc.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		result, err := svc.ListExpiringWithin(ctx, within)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []model.SystemAuth{intSysAuth}, result)
+	})
+
+	t.Run("Error when listing System Auths of tenant", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("ListExpiringBefore", ctx, testTenant, before).Return(nil, testErr).Once()
+		defer sysAuthRepo.AssertExpectations(t)
+
+		svc := systemauth.NewService(sysAuthRepo, nil, nil)
+		svc.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := svc.ListExpiringWithin(ctx, within)
+
+		// THEN
+		require.EqualError(t, err, "while listing expiring System Auths for tenant: test error")
+	})
+
+	t.Run("Error when listing System Auths of Integration Systems", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+		sysAuthRepo := &automock.Repository{}
+		sysAuthRepo.On("ListExpiringBefore", ctx, testTenant, before).Return([]model.SystemAuth{rtmSysAuth}, nil).Once()
+		sysAuthRepo.On("ListExpiringBeforeGlobal", ctx, model.IntegrationSystemReference, before).Return(nil, testErr).Once()
+		accessRuleSvc := &automock.AccessRuleService{}
+		accessRuleSvc.On("HasAccess", ctx, model.AccessRuleObjectTypeRuntime, "bar").Return(true, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, sysAuthRepo, accessRuleSvc)
+
+		svc := systemauth.NewService(sysAuthRepo, accessRuleSvc, nil)
+		svc.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := svc.ListExpiringWithin(ctx, within)
+
+		// THEN
+		require.EqualError(t, err, "while listing expiring System Auths for Integration System: test error")
+	})
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := systemauth.NewService(nil, nil, nil)
+
+		// WHEN
+		_, err := svc.ListExpiringWithin(context.TODO(), within)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func contextThatHasTenant(expectedTenant string) interface{} {
 	return mock.MatchedBy(func(actual context.Context) bool {
 		actualTenant, err := tenant.LoadFromContext(actual)
@@ -1,8 +1,10 @@
 package webhook_test
 
 import (
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/mock"
 )
 
 func fixModelWebhook(id, appID, tenant, url string) *model.Webhook {
@@ -41,3 +43,15 @@ func fixGQLWebhookInput(url string) *graphql.WebhookInput {
 		Auth: &graphql.AuthInput{},
 	}
 }
+
+func fixUnrestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return svc
+}
+
+func fixRestrictedAccessRuleSvc() *automock.AccessRuleService {
+	svc := &automock.AccessRuleService{}
+	svc.On("HasAccess", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return svc
+}
@@ -51,6 +51,31 @@ func NewResolver(transact persistence.Transactioner, webhookSvc WebhookService,
 	}
 }
 
+func (r *Resolver) Webhook(ctx context.Context, id string) (*graphql.Webhook, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	webhook, err := r.webhookSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookConverter.ToGraphQL(webhook)
+}
+
 func (r *Resolver) AddApplicationWebhook(ctx context.Context, applicationID string, in graphql.WebhookInput) (*graphql.Webhook, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/pkg/errors"
@@ -20,20 +22,27 @@ type WebhookRepository interface {
 	Delete(ctx context.Context, tenant, id string) error
 }
 
+//go:generate mockery -name=AccessRuleService -output=automock -outpkg=automock -case=underscore
+type AccessRuleService interface {
+	HasAccess(ctx context.Context, objType model.AccessRuleObjectType, objectID string) (bool, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
 type service struct {
-	repo   WebhookRepository
-	uidSvc UIDService
+	repo              WebhookRepository
+	accessRuleService AccessRuleService
+	uidSvc            UIDService
 }
 
-func NewService(repo WebhookRepository, uidSvc UIDService) *service {
+func NewService(repo WebhookRepository, accessRuleService AccessRuleService, uidSvc UIDService) *service {
 	return &service{
-		repo:   repo,
-		uidSvc: uidSvc,
+		repo:              repo,
+		accessRuleService: accessRuleService,
+		uidSvc:            uidSvc,
 	}
 }
 
@@ -63,6 +72,11 @@ func (s *service) Create(ctx context.Context, applicationID string, in model.Web
 	if err != nil {
 		return "", err
 	}
+
+	if err := s.ensureAccess(ctx, applicationID, resource.Application, applicationID); err != nil {
+		return "", err
+	}
+
 	id := s.uidSvc.Generate()
 	webhook := in.ToWebhook(id, tnt, applicationID)
 
@@ -80,6 +94,10 @@ func (s *service) Update(ctx context.Context, id string, in model.WebhookInput)
 		return errors.Wrap(err, "while getting Webhook")
 	}
 
+	if err := s.ensureAccess(ctx, webhook.ApplicationID, resource.Webhook, id); err != nil {
+		return err
+	}
+
 	webhook = in.ToWebhook(id, webhook.Tenant, webhook.ApplicationID)
 
 	err = s.repo.Update(ctx, webhook)
@@ -96,5 +114,22 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrap(err, "while getting Webhook")
 	}
 
+	if err := s.ensureAccess(ctx, webhook.ApplicationID, resource.Webhook, id); err != nil {
+		return err
+	}
+
 	return s.repo.Delete(ctx, webhook.Tenant, webhook.ID)
 }
+
+// ensureAccess hides the Webhooks of the Applications, which cannot be accessed by the caller, as if they did not exist
+func (s *service) ensureAccess(ctx context.Context, applicationID string, resourceType resource.Type, id string) error {
+	hasAccess, err := s.accessRuleService.HasAccess(ctx, model.AccessRuleObjectTypeApplication, applicationID)
+	if err != nil {
+		return errors.Wrapf(err, "while checking access to Application with id %s", applicationID)
+	}
+	if !hasAccess {
+		return apperrors.NewNotFoundError(resourceType, id)
+	}
+
+	return nil
+}
@@ -73,7 +73,8 @@ func TestService_Create(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			uidSvc := testCase.UIDServiceFn()
 
-			svc := webhook.NewService(repo, uidSvc)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := webhook.NewService(repo, accessRuleSvc, uidSvc)
 
 			// when
 			result, err := svc.Create(ctx, givenApplicationID(), *modelInput)
@@ -93,11 +94,24 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
-		svc := webhook.NewService(nil, nil)
+		svc := webhook.NewService(nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), givenApplicationID(), *modelInput)
 		assert.True(t, apperrors.IsCannotReadTenant(err))
 	})
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.WebhookRepository{}
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := webhook.NewService(repo, accessRuleSvc, nil)
+
+		// when
+		_, err := svc.Create(ctx, givenApplicationID(), *modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestService_Get(t *testing.T) {
@@ -143,7 +157,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := webhook.NewService(repo, nil)
+			svc := webhook.NewService(repo, nil, nil)
 
 			// when
 			actual, err := svc.Get(ctx, id)
@@ -161,7 +175,7 @@ func TestService_Get(t *testing.T) {
 	}
 
 	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
-		svc := webhook.NewService(nil, nil)
+		svc := webhook.NewService(nil, nil, nil)
 		// when
 		_, err := svc.Get(context.TODO(), givenApplicationID())
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -212,7 +226,7 @@ func TestService_List(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := webhook.NewService(repo, nil)
+			svc := webhook.NewService(repo, nil, nil)
 
 			// when
 			webhooks, err := svc.List(ctx, applicationID)
@@ -230,7 +244,7 @@ func TestService_List(t *testing.T) {
 	}
 
 	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
-		svc := webhook.NewService(nil, nil)
+		svc := webhook.NewService(nil, nil, nil)
 		// when
 		_, err := svc.List(context.TODO(), givenApplicationID())
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -293,7 +307,8 @@ func TestService_Update(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := webhook.NewService(repo, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := webhook.NewService(repo, accessRuleSvc, nil)
 
 			// when
 			err := svc.Update(ctx, id, *modelInput)
@@ -310,11 +325,25 @@ func TestService_Update(t *testing.T) {
 	}
 
 	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
-		svc := webhook.NewService(nil, nil)
+		svc := webhook.NewService(nil, nil, nil)
 		// when
 		err := svc.Update(context.TODO(), givenApplicationID(), *modelInput)
 		assert.EqualError(t, err, fmt.Sprintf("while getting Webhook: %s", apperrors.NewCannotReadTenantError().Error()))
 	})
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.WebhookRepository{}
+		repo.On("GetByID", ctx, givenTenant(), id).Return(webhookModel, nil).Once()
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := webhook.NewService(repo, accessRuleSvc, nil)
+
+		// when
+		err := svc.Update(ctx, id, *modelInput)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestService_Delete(t *testing.T) {
@@ -368,7 +397,8 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := webhook.NewService(repo, nil)
+			accessRuleSvc := fixUnrestrictedAccessRuleSvc()
+			svc := webhook.NewService(repo, accessRuleSvc, nil)
 
 			// when
 			err := svc.Delete(ctx, id)
@@ -385,9 +415,23 @@ func TestService_Delete(t *testing.T) {
 	}
 
 	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
-		svc := webhook.NewService(nil, nil)
+		svc := webhook.NewService(nil, nil, nil)
 		// when
 		err := svc.Delete(context.TODO(), id)
 		assert.EqualError(t, err, fmt.Sprintf("while getting Webhook: %s", apperrors.NewCannotReadTenantError()))
 	})
+	t.Run("Returns not found error when the caller cannot access the Application", func(t *testing.T) {
+		repo := &automock.WebhookRepository{}
+		repo.On("GetByID", ctx, givenTenant(), id).Return(webhookModel, nil).Once()
+		accessRuleSvc := fixRestrictedAccessRuleSvc()
+		svc := webhook.NewService(repo, accessRuleSvc, nil)
+
+		// when
+		err := svc.Delete(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		repo.AssertExpectations(t)
+	})
 }
@@ -0,0 +1,6 @@
+package encryption
+
+type Config struct {
+	// MasterKeysFile is a path to the JSON file with the master keys. If it is empty, stored credentials are not encrypted.
+	MasterKeysFile string `envconfig:"optional,APP_ENCRYPTION_MASTER_KEYS_FILE"`
+}
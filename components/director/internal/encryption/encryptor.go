@@ -0,0 +1,261 @@
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=Encryptor -output=automock -outpkg=automock -case=underscore
+
+// Encryptor encrypts values of the columns which hold credentials. Encrypted values are stored as JSON envelopes,
+// so they fit into the JSONB columns. Values which are not envelopes are treated as not encrypted yet and returned as they are.
+type Encryptor interface {
+	Encrypt(ctx context.Context, location Location, value sql.NullString) (sql.NullString, error)
+	Decrypt(ctx context.Context, location Location, value sql.NullString) (sql.NullString, error)
+}
+
+//go:generate mockery -name=DataKeyRepository -output=automock -outpkg=automock -case=underscore
+type DataKeyRepository interface {
+	GetActive(ctx context.Context, tenantID string) (*DataKey, error)
+	GetByID(ctx context.Context, id string) (*DataKey, error)
+	Create(ctx context.Context, dataKey DataKey) error
+	ListNotWrappedWith(ctx context.Context, masterKeyID string) ([]DataKey, error)
+	UpdateWrappedKey(ctx context.Context, id, masterKeyID string, wrappedKey []byte) error
+	DeactivateAll(ctx context.Context) error
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+// Location identifies where an encrypted value is stored. It is authenticated together with the value,
+// so that a value copied to another row, column or tenant cannot be decrypted there.
+type Location struct {
+	TenantID string
+	Column   Column
+	RowID    string
+}
+
+func (l Location) additionalData() ([]byte, error) {
+	return json.Marshal([]string{l.TenantID, l.Column.Table, l.Column.ValueColumn, l.RowID})
+}
+
+// locationBoundVersion is the version of envelopes whose ciphertext is authenticated together with the Location of the value.
+// Envelopes without version were encrypted before, they are decrypted without additional data until they are re-encrypted.
+const locationBoundVersion = 1
+
+// Envelope is a value encrypted with a data key.
+type Envelope struct {
+	Version    int    `json:"version,omitempty"`
+	DataKeyID  string `json:"dataKeyID"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type encryptedValue struct {
+	Envelope *Envelope `json:"envelope"`
+}
+
+type encryptor struct {
+	masterKeys   MasterKeyProvider
+	repo         DataKeyRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+
+	mutex    sync.RWMutex
+	dataKeys map[string]cipher.AEAD
+}
+
+func NewEncryptor(masterKeys MasterKeyProvider, repo DataKeyRepository, uidService UIDService) *encryptor {
+	return &encryptor{
+		masterKeys:   masterKeys,
+		repo:         repo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator(),
+		dataKeys:     make(map[string]cipher.AEAD),
+	}
+}
+
+// Encrypt encrypts the value with the active data key of the tenant of the location. An empty tenant ID means the global data key.
+// If the tenant does not have an active data key yet, a new one is generated.
+func (e *encryptor) Encrypt(ctx context.Context, location Location, value sql.NullString) (sql.NullString, error) {
+	if !value.Valid {
+		return value, nil
+	}
+
+	dataKey, err := e.activeDataKey(ctx, location.TenantID)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	aead, err := e.dataKeyCipher(ctx, dataKey.ID, dataKey)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	additionalData, err := location.additionalData()
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while marshalling location")
+	}
+
+	ciphertext, err := seal(aead, []byte(value.String), additionalData)
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while encrypting value")
+	}
+
+	marshalled, err := json.Marshal(encryptedValue{Envelope: &Envelope{Version: locationBoundVersion, DataKeyID: dataKey.ID, Ciphertext: ciphertext}})
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while marshalling envelope")
+	}
+
+	return sql.NullString{String: string(marshalled), Valid: true}, nil
+}
+
+// Decrypt decrypts the value, which can be decrypted only at the location it was encrypted for.
+func (e *encryptor) Decrypt(ctx context.Context, location Location, value sql.NullString) (sql.NullString, error) {
+	envelope := parseEnvelope(value)
+	if envelope == nil {
+		return value, nil
+	}
+
+	aead, err := e.dataKeyCipher(ctx, envelope.DataKeyID, nil)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	var additionalData []byte
+	if envelope.Version >= locationBoundVersion {
+		additionalData, err = location.additionalData()
+		if err != nil {
+			return sql.NullString{}, errors.Wrap(err, "while marshalling location")
+		}
+	}
+
+	plaintext, err := open(aead, envelope.Ciphertext, additionalData)
+	if err != nil {
+		return sql.NullString{}, errors.Wrapf(err, "while decrypting value with data key [%s]", envelope.DataKeyID)
+	}
+
+	return sql.NullString{String: string(plaintext), Valid: true}, nil
+}
+
+func (e *encryptor) activeDataKey(ctx context.Context, tenantID string) (*DataKey, error) {
+	dataKey, err := e.repo.GetActive(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if dataKey != nil {
+		return dataKey, nil
+	}
+
+	if err := e.createDataKey(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	// The data key could have been created concurrently, so the active one is read again.
+	dataKey, err = e.repo.GetActive(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if dataKey == nil {
+		return nil, errors.Errorf("active data key for tenant [%s] not found after creation", tenantID)
+	}
+
+	return dataKey, nil
+}
+
+func (e *encryptor) createDataKey(ctx context.Context, tenantID string) error {
+	key := make([]byte, dataKeyLength)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return errors.Wrap(err, "while generating data key")
+	}
+
+	masterKeyID, wrappedKey, err := e.masterKeys.Encrypt(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "while wrapping data key")
+	}
+
+	return e.repo.Create(ctx, DataKey{
+		ID:          e.uidService.Generate(),
+		TenantID:    nullableTenant(tenantID),
+		MasterKeyID: masterKeyID,
+		WrappedKey:  wrappedKey,
+		Active:      true,
+		CreatedAt:   e.timestampGen(),
+	})
+}
+
+// dataKeyCipher returns the cipher for the data key with the given ID. Unwrapped data keys are cached, as their material never changes.
+// If the data key is not known, it is loaded from the database.
+func (e *encryptor) dataKeyCipher(ctx context.Context, dataKeyID string, dataKey *DataKey) (cipher.AEAD, error) {
+	e.mutex.RLock()
+	aead, ok := e.dataKeys[dataKeyID]
+	e.mutex.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	if dataKey == nil {
+		var err error
+		dataKey, err = e.repo.GetByID(ctx, dataKeyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := e.masterKeys.Decrypt(ctx, dataKey.MasterKeyID, dataKey.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while unwrapping data key [%s]", dataKey.ID)
+	}
+
+	aead, err = newAEAD(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating cipher for data key [%s]", dataKey.ID)
+	}
+
+	e.mutex.Lock()
+	e.dataKeys[dataKeyID] = aead
+	e.mutex.Unlock()
+
+	return aead, nil
+}
+
+func parseEnvelope(value sql.NullString) *Envelope {
+	if !value.Valid {
+		return nil
+	}
+
+	var encrypted encryptedValue
+	if err := json.Unmarshal([]byte(value.String), &encrypted); err != nil {
+		return nil
+	}
+
+	return encrypted.Envelope
+}
+
+type noopEncryptor struct{}
+
+// NewNoopEncryptor returns an Encryptor which stores values as they are. It is used when encryption is not configured.
+func NewNoopEncryptor() *noopEncryptor {
+	return &noopEncryptor{}
+}
+
+func (e *noopEncryptor) Encrypt(_ context.Context, _ Location, value sql.NullString) (sql.NullString, error) {
+	return value, nil
+}
+
+func (e *noopEncryptor) Decrypt(_ context.Context, _ Location, value sql.NullString) (sql.NullString, error) {
+	if parseEnvelope(value) != nil {
+		return sql.NullString{}, errors.New("value is encrypted, but encryption is not configured")
+	}
+
+	return value, nil
+}
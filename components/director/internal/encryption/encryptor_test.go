@@ -0,0 +1,248 @@
+package encryption_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptor_Encrypt(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("encrypts value with active data key of tenant", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeyProvider(t, "key-2")
+		dataKey := fixDataKey(t, masterKeys, dataKeyID)
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, tenantID).Return(dataKey, nil).Once()
+
+		encryptor := encryption.NewEncryptor(masterKeys, repo, nil)
+		// WHEN
+		encrypted, err := encryptor.Encrypt(ctx, location, plaintext)
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, encrypted.Valid)
+		assert.NotContains(t, encrypted.String, "bar")
+
+		envelope := struct {
+			Envelope encryption.Envelope `json:"envelope"`
+		}{}
+		require.NoError(t, json.Unmarshal([]byte(encrypted.String), &envelope))
+		assert.Equal(t, dataKeyID, envelope.Envelope.DataKeyID)
+		assert.Equal(t, 1, envelope.Envelope.Version)
+
+		decrypted, err := encryptor.Decrypt(ctx, location, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("creates data key when tenant does not have active one", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeyProvider(t, "key-2")
+		dataKey := fixDataKey(t, masterKeys, dataKeyID)
+
+		uidSvc := &automock.UIDService{}
+		defer uidSvc.AssertExpectations(t)
+		uidSvc.On("Generate").Return(dataKeyID).Once()
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, "").Return(nil, nil).Once()
+		repo.On("Create", ctx, mock.MatchedBy(func(created encryption.DataKey) bool {
+			return created.ID == dataKeyID && !created.TenantID.Valid && created.MasterKeyID == "key-2" && created.Active
+		})).Return(nil).Once()
+		repo.On("GetActive", ctx, "").Return(dataKey, nil).Once()
+
+		encryptor := encryption.NewEncryptor(masterKeys, repo, uidSvc)
+		// WHEN
+		encrypted, err := encryptor.Encrypt(ctx, encryption.Location{Column: encryption.ClientRegistrationAccessTokenColumn, RowID: rowID}, plaintext)
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, encrypted.Valid)
+	})
+
+	t.Run("does not encrypt null value", func(t *testing.T) {
+		// GIVEN
+		encryptor := encryption.NewEncryptor(nil, nil, nil)
+		// WHEN
+		encrypted, err := encryptor.Encrypt(ctx, location, sql.NullString{})
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, encrypted.Valid)
+	})
+
+	t.Run("returns error when getting active data key fails", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, tenantID).Return(nil, testErr).Once()
+
+		encryptor := encryption.NewEncryptor(fixMasterKeyProvider(t, "key-2"), repo, nil)
+		// WHEN
+		_, err := encryptor.Encrypt(ctx, location, plaintext)
+		// THEN
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("returns error when wrapping data key fails", func(t *testing.T) {
+		// GIVEN
+		masterKeys := &automock.MasterKeyProvider{}
+		defer masterKeys.AssertExpectations(t)
+		masterKeys.On("Encrypt", ctx, mock.Anything).Return("", nil, testErr).Once()
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, tenantID).Return(nil, nil).Once()
+
+		encryptor := encryption.NewEncryptor(masterKeys, repo, nil)
+		// WHEN
+		_, err := encryptor.Encrypt(ctx, location, plaintext)
+		// THEN
+		require.EqualError(t, err, "while wrapping data key: test error")
+	})
+}
+
+func TestEncryptor_Decrypt(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("decrypts value with data key wrapped by previous master key", func(t *testing.T) {
+		// GIVEN
+		previousMasterKeys := fixMasterKeyProvider(t, "key-1")
+		dataKey := fixDataKey(t, previousMasterKeys, dataKeyID)
+
+		encryptingRepo := &automock.DataKeyRepository{}
+		encryptingRepo.On("GetActive", ctx, tenantID).Return(dataKey, nil).Once()
+		encrypted, err := encryption.NewEncryptor(previousMasterKeys, encryptingRepo, nil).Encrypt(ctx, location, plaintext)
+		require.NoError(t, err)
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, dataKeyID).Return(dataKey, nil).Once()
+
+		encryptor := encryption.NewEncryptor(fixMasterKeyProvider(t, "key-2"), repo, nil)
+		// WHEN
+		decrypted, err := encryptor.Decrypt(ctx, location, encrypted)
+		require.NoError(t, err)
+		decryptedAgain, err := encryptor.Decrypt(ctx, location, encrypted)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+		assert.Equal(t, plaintext, decryptedAgain)
+	})
+
+	t.Run("returns error when value is decrypted at another location", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeyProvider(t, "key-2")
+		dataKey := fixDataKey(t, masterKeys, dataKeyID)
+
+		repo := &automock.DataKeyRepository{}
+		repo.On("GetActive", ctx, tenantID).Return(dataKey, nil).Once()
+
+		encryptor := encryption.NewEncryptor(masterKeys, repo, nil)
+		encrypted, err := encryptor.Encrypt(ctx, location, plaintext)
+		require.NoError(t, err)
+
+		otherRow := location
+		otherRow.RowID = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+		otherTenant := location
+		otherTenant.TenantID = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+		otherColumn := location
+		otherColumn.Column = encryption.PackageInstanceAuthValueColumn
+
+		for _, otherLocation := range []encryption.Location{otherRow, otherTenant, otherColumn} {
+			// WHEN
+			_, err := encryptor.Decrypt(ctx, otherLocation, encrypted)
+			// THEN
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "while decrypting value with data key [bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb]")
+		}
+	})
+
+	t.Run("decrypts value encrypted before it was bound to its location", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeyProvider(t, "key-2")
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, dataKeyID).Return(fixDataKey(t, masterKeys, dataKeyID), nil).Once()
+
+		encryptor := encryption.NewEncryptor(masterKeys, repo, nil)
+		// WHEN
+		decrypted, err := encryptor.Decrypt(ctx, location, fixEnvelopeValue(encryption.Envelope{
+			DataKeyID:  dataKeyID,
+			Ciphertext: fixUnboundCiphertext(t, plaintext.String),
+		}))
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("returns value which is not encrypted", func(t *testing.T) {
+		// GIVEN
+		encryptor := encryption.NewEncryptor(nil, nil, nil)
+		// WHEN
+		decrypted, err := encryptor.Decrypt(ctx, location, plaintext)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("returns error when data key does not exist", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, dataKeyID).Return(nil, testErr).Once()
+
+		encryptor := encryption.NewEncryptor(fixMasterKeyProvider(t, "key-2"), repo, nil)
+		// WHEN
+		_, err := encryptor.Decrypt(ctx, location, fixEncryptedValue(dataKeyID, []byte("ciphertext")))
+		// THEN
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("returns error when ciphertext is invalid", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeyProvider(t, "key-2")
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, dataKeyID).Return(fixDataKey(t, masterKeys, dataKeyID), nil).Once()
+
+		encryptor := encryption.NewEncryptor(masterKeys, repo, nil)
+		// WHEN
+		_, err := encryptor.Decrypt(ctx, location, fixEncryptedValue(dataKeyID, []byte("invalid ciphertext")))
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decrypting value with data key [bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb]")
+	})
+}
+
+func TestNoopEncryptor(t *testing.T) {
+	ctx := context.TODO()
+	encryptor := encryption.NewNoopEncryptor()
+
+	t.Run("stores value as it is", func(t *testing.T) {
+		// WHEN
+		encrypted, err := encryptor.Encrypt(ctx, location, plaintext)
+		require.NoError(t, err)
+		decrypted, err := encryptor.Decrypt(ctx, location, encrypted)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, encrypted)
+		assert.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("returns error when value is encrypted", func(t *testing.T) {
+		// WHEN
+		_, err := encryptor.Decrypt(ctx, location, fixEncryptedValue(dataKeyID, []byte("ciphertext")))
+		// THEN
+		require.EqualError(t, err, "value is encrypted, but encryption is not configured")
+	})
+}
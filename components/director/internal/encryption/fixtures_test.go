@@ -0,0 +1,94 @@
+package encryption_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenantID  = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	dataKeyID = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	rowID     = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+
+	dataKeyMaterial = "0123456789abcdef0123456789abcdef"
+)
+
+var (
+	testErr   = errors.New("test error")
+	createdAt = time.Date(2020, 11, 12, 10, 30, 0, 0, time.UTC)
+	plaintext = sql.NullString{String: `{"Credential":{"Basic":{"Username":"foo","Password":"bar"}}}`, Valid: true}
+	location  = encryption.Location{TenantID: tenantID, Column: encryption.SystemAuthValueColumn, RowID: rowID}
+)
+
+func fixMasterKeys() encryption.MasterKeys {
+	return encryption.MasterKeys{
+		Current: "key-2",
+		Keys: map[string]string{
+			"key-1": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+			"key-2": "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA=",
+		},
+	}
+}
+
+func fixMasterKeyProvider(t *testing.T, current string) encryption.MasterKeyProvider {
+	masterKeys := fixMasterKeys()
+	masterKeys.Current = current
+
+	provider, err := encryption.NewMasterKeyProvider(masterKeys)
+	require.NoError(t, err)
+
+	return provider
+}
+
+func fixDataKey(t *testing.T, masterKeys encryption.MasterKeyProvider, id string) *encryption.DataKey {
+	masterKeyID, wrappedKey, err := masterKeys.Encrypt(context.TODO(), []byte(dataKeyMaterial))
+	require.NoError(t, err)
+
+	return &encryption.DataKey{
+		ID:          id,
+		TenantID:    sql.NullString{String: tenantID, Valid: true},
+		MasterKeyID: masterKeyID,
+		WrappedKey:  wrappedKey,
+		Active:      true,
+		CreatedAt:   createdAt,
+	}
+}
+
+func fixDataKeyColumns() []string {
+	return []string{"id", "tenant_id", "master_key_id", "wrapped_key", "active", "created_at"}
+}
+
+func fixEncryptedValue(dataKeyID string, ciphertext []byte) sql.NullString {
+	return fixEnvelopeValue(encryption.Envelope{Version: 1, DataKeyID: dataKeyID, Ciphertext: ciphertext})
+}
+
+func fixEnvelopeValue(envelope encryption.Envelope) sql.NullString {
+	marshalled, err := json.Marshal(map[string]encryption.Envelope{
+		"envelope": envelope,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sql.NullString{String: string(marshalled), Valid: true}
+}
+
+// fixUnboundCiphertext encrypts the value with the data key material without additional data, as values were encrypted before they were bound to their location
+func fixUnboundCiphertext(t *testing.T, value string) []byte {
+	block, err := aes.NewCipher([]byte(dataKeyMaterial))
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	nonce := make([]byte, aead.NonceSize())
+	return aead.Seal(nonce, nonce, []byte(value), nil)
+}
@@ -0,0 +1,145 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const dataKeyLength = 32
+
+//go:generate mockery -name=MasterKeyProvider -output=automock -outpkg=automock -case=underscore
+
+// MasterKeyProvider wraps and unwraps data keys with a master key. Its shape follows the encrypt and decrypt
+// operations of KMS services, so that the master keys can be kept outside of the Director.
+type MasterKeyProvider interface {
+	// CurrentKeyID returns the ID of the master key used by Encrypt.
+	CurrentKeyID() string
+	// Encrypt wraps the data key with the current master key and returns the ID of that master key.
+	Encrypt(ctx context.Context, plaintext []byte) (string, []byte, error)
+	// Decrypt unwraps the data key with the master key with the given ID.
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte) ([]byte, error)
+}
+
+// MasterKeys is the content of the master keys file. Keys are base64 encoded 256-bit AES keys indexed by their IDs.
+// Previous keys have to be kept in the file until all data keys are wrapped with the current one.
+type MasterKeys struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+type fileMasterKeyProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// NewFileMasterKeyProvider loads the master keys from the JSON file with the given path.
+func NewFileMasterKeyProvider(path string) (*fileMasterKeyProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening master keys file")
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Warnf("Got error on closing master keys file: %v", err)
+		}
+	}()
+
+	masterKeys := MasterKeys{}
+	if err := json.NewDecoder(file).Decode(&masterKeys); err != nil {
+		return nil, errors.Wrapf(err, "while decoding file [%s]", path)
+	}
+
+	return NewMasterKeyProvider(masterKeys)
+}
+
+// NewMasterKeyProvider creates a MasterKeyProvider which wraps data keys locally with AES-GCM.
+func NewMasterKeyProvider(masterKeys MasterKeys) (*fileMasterKeyProvider, error) {
+	if _, ok := masterKeys.Keys[masterKeys.Current]; !ok {
+		return nil, errors.Errorf("current master key [%s] is not defined", masterKeys.Current)
+	}
+
+	keys := make(map[string]cipher.AEAD, len(masterKeys.Keys))
+	for id, encoded := range masterKeys.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while decoding master key [%s]", id)
+		}
+		if len(key) != dataKeyLength {
+			return nil, errors.Errorf("master key [%s] has to be %d bytes long", id, dataKeyLength)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while creating cipher for master key [%s]", id)
+		}
+		keys[id] = aead
+	}
+
+	return &fileMasterKeyProvider{
+		currentKeyID: masterKeys.Current,
+		keys:         keys,
+	}, nil
+}
+
+func (p *fileMasterKeyProvider) CurrentKeyID() string {
+	return p.currentKeyID
+}
+
+func (p *fileMasterKeyProvider) Encrypt(_ context.Context, plaintext []byte) (string, []byte, error) {
+	ciphertext, err := seal(p.keys[p.currentKeyID], plaintext, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return p.currentKeyID, ciphertext, nil
+}
+
+func (p *fileMasterKeyProvider) Decrypt(_ context.Context, keyID string, ciphertext []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("master key [%s] is not defined", keyID)
+	}
+
+	return open(aead, ciphertext, nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "while generating nonce")
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decrypting")
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,103 @@
+package encryption_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileMasterKeyProvider(t *testing.T) {
+	t.Run("loads master keys from file", func(t *testing.T) {
+		// WHEN
+		provider, err := encryption.NewFileMasterKeyProvider("testdata/master-keys.json")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "key-2", provider.CurrentKeyID())
+	})
+
+	t.Run("returns error when file does not exist", func(t *testing.T) {
+		// WHEN
+		_, err := encryption.NewFileMasterKeyProvider("testdata/missing.json")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while opening master keys file")
+	})
+}
+
+func TestNewMasterKeyProvider(t *testing.T) {
+	t.Run("returns error when current key is not defined", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeys()
+		masterKeys.Current = "key-3"
+		// WHEN
+		_, err := encryption.NewMasterKeyProvider(masterKeys)
+		// THEN
+		require.EqualError(t, err, "current master key [key-3] is not defined")
+	})
+
+	t.Run("returns error when key has invalid length", func(t *testing.T) {
+		// GIVEN
+		masterKeys := fixMasterKeys()
+		masterKeys.Keys["key-1"] = "c2hvcnQ="
+		// WHEN
+		_, err := encryption.NewMasterKeyProvider(masterKeys)
+		// THEN
+		require.EqualError(t, err, "master key [key-1] has to be 32 bytes long")
+	})
+}
+
+func TestMasterKeyProvider_EncryptDecrypt(t *testing.T) {
+	ctx := context.TODO()
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	t.Run("wraps data key with current master key", func(t *testing.T) {
+		// GIVEN
+		provider := fixMasterKeyProvider(t, "key-2")
+		// WHEN
+		keyID, wrapped, err := provider.Encrypt(ctx, dataKey)
+		require.NoError(t, err)
+		unwrapped, err := provider.Decrypt(ctx, keyID, wrapped)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "key-2", keyID)
+		assert.NotEqual(t, dataKey, wrapped)
+		assert.Equal(t, dataKey, unwrapped)
+	})
+
+	t.Run("unwraps data key with previous master key", func(t *testing.T) {
+		// GIVEN
+		previous := fixMasterKeyProvider(t, "key-1")
+		keyID, wrapped, err := previous.Encrypt(ctx, dataKey)
+		require.NoError(t, err)
+		provider := fixMasterKeyProvider(t, "key-2")
+		// WHEN
+		unwrapped, err := provider.Decrypt(ctx, keyID, wrapped)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, dataKey, unwrapped)
+	})
+
+	t.Run("returns error when master key is not defined", func(t *testing.T) {
+		// GIVEN
+		provider := fixMasterKeyProvider(t, "key-2")
+		// WHEN
+		_, err := provider.Decrypt(ctx, "key-3", []byte("wrapped"))
+		// THEN
+		require.EqualError(t, err, "master key [key-3] is not defined")
+	})
+
+	t.Run("returns error when data key was wrapped with different master key", func(t *testing.T) {
+		// GIVEN
+		provider := fixMasterKeyProvider(t, "key-2")
+		_, wrapped, err := provider.Encrypt(ctx, dataKey)
+		require.NoError(t, err)
+		// WHEN
+		_, err = provider.Decrypt(ctx, "key-1", wrapped)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decrypting")
+	})
+}
@@ -0,0 +1,127 @@
+package encryption
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+const (
+	getActiveQuery = `SELECT id, tenant_id, master_key_id, wrapped_key, active, created_at FROM public.data_keys WHERE tenant_id IS NOT DISTINCT FROM $1 AND active`
+	getByIDQuery   = `SELECT id, tenant_id, master_key_id, wrapped_key, active, created_at FROM public.data_keys WHERE id = $1`
+	createQuery    = `INSERT INTO public.data_keys (id, tenant_id, master_key_id, wrapped_key, active, created_at) VALUES (:id, :tenant_id, :master_key_id, :wrapped_key, :active, :created_at) ON CONFLICT DO NOTHING`
+	listQuery      = `SELECT id, tenant_id, master_key_id, wrapped_key, active, created_at FROM public.data_keys WHERE master_key_id <> $1`
+	rewrapQuery    = `UPDATE public.data_keys SET master_key_id = $1, wrapped_key = $2 WHERE id = $3`
+	deactivateAll  = `UPDATE public.data_keys SET active = FALSE WHERE active`
+)
+
+// DataKey is a tenant data key wrapped with a master key. A tenant has at most one active data key,
+// which is used for encryption. Inactive data keys are only used to decrypt values which have not been re-encrypted yet.
+// Data keys without tenant are used for credentials of integration systems.
+type DataKey struct {
+	ID          string         `db:"id"`
+	TenantID    sql.NullString `db:"tenant_id"`
+	MasterKeyID string         `db:"master_key_id"`
+	WrappedKey  []byte         `db:"wrapped_key"`
+	Active      bool           `db:"active"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
+
+type repository struct{}
+
+func NewRepository() *repository {
+	return &repository{}
+}
+
+func (r *repository) GetActive(ctx context.Context, tenantID string) (*DataKey, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var dataKey DataKey
+	err = persist.Get(&dataKey, getActiveQuery, nullableTenant(tenantID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "while getting active data key from DB")
+	}
+
+	return &dataKey, nil
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (*DataKey, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var dataKey DataKey
+	if err := persist.Get(&dataKey, getByIDQuery, id); err != nil {
+		return nil, errors.Wrapf(err, "while getting data key [%s] from DB", id)
+	}
+
+	return &dataKey, nil
+}
+
+// Create inserts the data key. If there is already an active data key for the same tenant, nothing is inserted.
+func (r *repository) Create(ctx context.Context, dataKey DataKey) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	if _, err := persist.NamedExec(createQuery, dataKey); err != nil {
+		return errors.Wrap(err, "while inserting data key to DB")
+	}
+
+	return nil
+}
+
+func (r *repository) ListNotWrappedWith(ctx context.Context, masterKeyID string) ([]DataKey, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var dataKeys []DataKey
+	if err := persist.Select(&dataKeys, listQuery, masterKeyID); err != nil {
+		return nil, errors.Wrap(err, "while listing data keys from DB")
+	}
+
+	return dataKeys, nil
+}
+
+func (r *repository) UpdateWrappedKey(ctx context.Context, id, masterKeyID string, wrappedKey []byte) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	if _, err := persist.Exec(rewrapQuery, masterKeyID, wrappedKey, id); err != nil {
+		return errors.Wrapf(err, "while updating data key [%s]", id)
+	}
+
+	return nil
+}
+
+func (r *repository) DeactivateAll(ctx context.Context) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	if _, err := persist.Exec(deactivateAll); err != nil {
+		return errors.Wrap(err, "while deactivating data keys")
+	}
+
+	return nil
+}
+
+func nullableTenant(tenantID string) sql.NullString {
+	return sql.NullString{String: tenantID, Valid: tenantID != ""}
+}
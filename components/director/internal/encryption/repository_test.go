@@ -0,0 +1,144 @@
+package encryption_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetActive(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, tenant_id, master_key_id, wrapped_key, active, created_at FROM public.data_keys WHERE tenant_id IS NOT DISTINCT FROM $1 AND active`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(query).
+			WithArgs(tenantID).
+			WillReturnRows(sqlmock.NewRows(fixDataKeyColumns()).AddRow(dataKeyID, tenantID, "key-2", []byte("wrapped"), true, createdAt))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := encryption.NewRepository()
+		// WHEN
+		dataKey, err := repo.GetActive(ctx, tenantID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &encryption.DataKey{
+			ID:          dataKeyID,
+			TenantID:    sql.NullString{String: tenantID, Valid: true},
+			MasterKeyID: "key-2",
+			WrappedKey:  []byte("wrapped"),
+			Active:      true,
+			CreatedAt:   createdAt,
+		}, dataKey)
+	})
+
+	t.Run("Success when there is no active data key", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(query).
+			WithArgs(sql.NullString{}).
+			WillReturnRows(sqlmock.NewRows(fixDataKeyColumns()))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := encryption.NewRepository()
+		// WHEN
+		dataKey, err := repo.GetActive(ctx, "")
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, dataKey)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(query).
+			WithArgs(tenantID).
+			WillReturnError(testErr)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := encryption.NewRepository()
+		// WHEN
+		_, err := repo.GetActive(ctx, tenantID)
+		// THEN
+		require.EqualError(t, err, "while getting active data key from DB: test error")
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		dataKey := encryption.DataKey{
+			ID:          dataKeyID,
+			TenantID:    sql.NullString{String: tenantID, Valid: true},
+			MasterKeyID: "key-2",
+			WrappedKey:  []byte("wrapped"),
+			Active:      true,
+			CreatedAt:   createdAt,
+		}
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.data_keys (id, tenant_id, master_key_id, wrapped_key, active, created_at) VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`)).
+			WithArgs(dataKeyID, dataKey.TenantID, "key-2", []byte("wrapped"), true, createdAt).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := encryption.NewRepository()
+		// WHEN
+		err := repo.Create(ctx, dataKey)
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestRepository_UpdateWrappedKey(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.data_keys SET master_key_id = $1, wrapped_key = $2 WHERE id = $3`)).
+			WithArgs("key-2", []byte("wrapped"), dataKeyID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := encryption.NewRepository()
+		// WHEN
+		err := repo.UpdateWrappedKey(ctx, dataKeyID, "key-2", []byte("wrapped"))
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.data_keys`)).
+			WillReturnError(testErr)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := encryption.NewRepository()
+		// WHEN
+		err := repo.UpdateWrappedKey(ctx, dataKeyID, "key-2", []byte("wrapped"))
+		// THEN
+		require.EqualError(t, err, "while updating data key [bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb]: test error")
+	})
+}
+
+func TestRepository_DeactivateAll(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.data_keys SET active = FALSE WHERE active`)).
+		WillReturnResult(sqlmock.NewResult(-1, 3))
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := encryption.NewRepository()
+	// WHEN
+	err := repo.DeactivateAll(ctx)
+	// THEN
+	require.NoError(t, err)
+}
@@ -0,0 +1,184 @@
+package encryption
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// FirstID is the ID to start re-encryption of a column from. All IDs are UUIDs, so it is lower than any other ID.
+// The IDs of the dynamically registered OAuth clients are the IDs of the system auths, so they are UUIDs as well.
+const FirstID = "00000000-0000-0000-0000-000000000000"
+
+const (
+	listRowsQuery = `SELECT %[1]s AS id, %[2]s AS tenant_id, %[3]s AS value FROM %[4]s WHERE %[3]s IS NOT NULL AND %[1]s > $1 ORDER BY %[1]s LIMIT $2`
+	// updateRowQuery replaces the value only if it has not been changed since it was listed,
+	// so that a value written concurrently by the Director is not overwritten with the re-encrypted previous one.
+	updateRowQuery = `UPDATE %[1]s SET %[3]s = $1 WHERE %[2]s = $2 AND %[3]s = $3`
+)
+
+// Column is a table column which holds credentials.
+// Values of a table without TenantColumn are encrypted with the data key which does not belong to any tenant.
+type Column struct {
+	Table        string
+	IDColumn     string
+	TenantColumn string
+	ValueColumn  string
+}
+
+var (
+	SystemAuthValueColumn               = Column{Table: "public.system_auths", IDColumn: "id", TenantColumn: "tenant_id", ValueColumn: "value"}
+	PackageDefaultInstanceAuthColumn    = Column{Table: "public.packages", IDColumn: "id", TenantColumn: "tenant_id", ValueColumn: "default_instance_auth"}
+	PackageInstanceAuthValueColumn      = Column{Table: "public.package_instance_auths", IDColumn: "id", TenantColumn: "tenant_id", ValueColumn: "auth_value"}
+	FetchRequestAuthColumn              = Column{Table: "public.fetch_requests", IDColumn: "id", TenantColumn: "tenant_id", ValueColumn: "auth"}
+	ClientRegistrationAccessTokenColumn = Column{Table: "public.oauth_client_registrations", IDColumn: "client_id", ValueColumn: "registration_access_token"}
+)
+
+// Columns lists all columns with credentials which are encrypted by the Director.
+var Columns = []Column{
+	SystemAuthValueColumn,
+	PackageDefaultInstanceAuthColumn,
+	PackageInstanceAuthValueColumn,
+	FetchRequestAuthColumn,
+	ClientRegistrationAccessTokenColumn,
+}
+
+func (c Column) tenantExpression() string {
+	if c.TenantColumn == "" {
+		return "NULL"
+	}
+	return c.TenantColumn
+}
+
+type row struct {
+	ID       string         `db:"id"`
+	TenantID sql.NullString `db:"tenant_id"`
+	Value    sql.NullString `db:"value"`
+}
+
+type keyRotator struct {
+	masterKeys MasterKeyProvider
+	repo       DataKeyRepository
+	encryptor  Encryptor
+}
+
+// NewKeyRotator creates a service which rotates keys and re-encrypts stored credentials.
+func NewKeyRotator(masterKeys MasterKeyProvider, repo DataKeyRepository, encryptor Encryptor) *keyRotator {
+	return &keyRotator{
+		masterKeys: masterKeys,
+		repo:       repo,
+		encryptor:  encryptor,
+	}
+}
+
+// RewrapDataKeys wraps all data keys which are wrapped with a previous master key with the current one.
+// Values encrypted with the data keys do not have to be re-encrypted.
+func (r *keyRotator) RewrapDataKeys(ctx context.Context) (int, error) {
+	currentKeyID := r.masterKeys.CurrentKeyID()
+
+	dataKeys, err := r.repo.ListNotWrappedWith(ctx, currentKeyID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dataKey := range dataKeys {
+		key, err := r.masterKeys.Decrypt(ctx, dataKey.MasterKeyID, dataKey.WrappedKey)
+		if err != nil {
+			return 0, errors.Wrapf(err, "while unwrapping data key [%s]", dataKey.ID)
+		}
+
+		masterKeyID, wrappedKey, err := r.masterKeys.Encrypt(ctx, key)
+		if err != nil {
+			return 0, errors.Wrapf(err, "while wrapping data key [%s]", dataKey.ID)
+		}
+
+		if err := r.repo.UpdateWrappedKey(ctx, dataKey.ID, masterKeyID, wrappedKey); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(dataKeys), nil
+}
+
+// RotateDataKeys deactivates all data keys, so that new ones are generated for the next encrypted values.
+// Previous data keys are still used to decrypt values until they are re-encrypted.
+func (r *keyRotator) RotateDataKeys(ctx context.Context) error {
+	return r.repo.DeactivateAll(ctx)
+}
+
+// ReencryptBatch re-encrypts up to batchSize values of the column with IDs greater than afterID. Values which are not encrypted,
+// are encrypted with an inactive data key, or are not bound to their location yet, are encrypted with the active data key of their tenant.
+// Values changed since they were listed are skipped, as they are already encrypted with the active data key of their tenant.
+// It returns the last processed ID and the number of processed rows. Fewer processed rows than batchSize means the column is done.
+func (r *keyRotator) ReencryptBatch(ctx context.Context, column Column, afterID string, batchSize int) (string, int, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var rows []row
+	stmt := fmt.Sprintf(listRowsQuery, column.IDColumn, column.tenantExpression(), column.ValueColumn, column.Table)
+	if err := persist.Select(&rows, stmt, afterID, batchSize); err != nil {
+		return "", 0, errors.Wrapf(err, "while listing values of %s.%s", column.Table, column.ValueColumn)
+	}
+
+	activeDataKeys := make(map[string]string)
+	updated := 0
+	for _, row := range rows {
+		tenantID := row.TenantID.String
+		if _, ok := activeDataKeys[tenantID]; !ok {
+			dataKey, err := r.repo.GetActive(ctx, tenantID)
+			if err != nil {
+				return "", 0, err
+			}
+			if dataKey != nil {
+				activeDataKeys[tenantID] = dataKey.ID
+			}
+		}
+
+		if envelope := parseEnvelope(row.Value); envelope != nil && envelope.DataKeyID == activeDataKeys[tenantID] && envelope.Version >= locationBoundVersion {
+			continue
+		}
+
+		location := Location{TenantID: tenantID, Column: column, RowID: row.ID}
+		plaintext, err := r.encryptor.Decrypt(ctx, location, row.Value)
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "while decrypting value of %s with id %s", column.Table, row.ID)
+		}
+
+		encrypted, err := r.encryptor.Encrypt(ctx, location, plaintext)
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "while encrypting value of %s with id %s", column.Table, row.ID)
+		}
+
+		if envelope := parseEnvelope(encrypted); envelope != nil {
+			activeDataKeys[tenantID] = envelope.DataKeyID
+		}
+
+		result, err := persist.Exec(fmt.Sprintf(updateRowQuery, column.Table, column.IDColumn, column.ValueColumn), encrypted, row.ID, row.Value)
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "while updating value of %s with id %s", column.Table, row.ID)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "while checking affected rows of %s with id %s", column.Table, row.ID)
+		}
+		if affected == 0 {
+			log.Infof("Value of %s with id %s changed while it was re-encrypted, skipping", column.Table, row.ID)
+			continue
+		}
+		updated++
+	}
+
+	if len(rows) == 0 {
+		return afterID, 0, nil
+	}
+
+	log.Infof("Re-encrypted %d of %d values of %s.%s", updated, len(rows), column.Table, column.ValueColumn)
+	return rows[len(rows)-1].ID, len(rows), nil
+}
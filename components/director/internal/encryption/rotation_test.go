@@ -0,0 +1,249 @@
+package encryption_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption"
+	"github.com/kyma-incubator/compass/components/director/internal/encryption/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRotator_RewrapDataKeys(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("wraps data keys with current master key", func(t *testing.T) {
+		// GIVEN
+		previousMasterKeys := fixMasterKeyProvider(t, "key-1")
+		dataKey := fixDataKey(t, previousMasterKeys, dataKeyID)
+		masterKeys := fixMasterKeyProvider(t, "key-2")
+
+		var rewrapped []byte
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListNotWrappedWith", ctx, "key-2").Return([]encryption.DataKey{*dataKey}, nil).Once()
+		repo.On("UpdateWrappedKey", ctx, dataKeyID, "key-2", mock.Anything).Run(func(args mock.Arguments) {
+			rewrapped = args.Get(3).([]byte)
+		}).Return(nil).Once()
+
+		rotator := encryption.NewKeyRotator(masterKeys, repo, nil)
+		// WHEN
+		count, err := rotator.RewrapDataKeys(ctx)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		original, err := previousMasterKeys.Decrypt(ctx, "key-1", dataKey.WrappedKey)
+		require.NoError(t, err)
+		unwrapped, err := masterKeys.Decrypt(ctx, "key-2", rewrapped)
+		require.NoError(t, err)
+		assert.Equal(t, original, unwrapped)
+	})
+
+	t.Run("returns error when previous master key is missing", func(t *testing.T) {
+		// GIVEN
+		dataKey := encryption.DataKey{ID: dataKeyID, MasterKeyID: "key-0", WrappedKey: []byte("wrapped")}
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListNotWrappedWith", ctx, "key-2").Return([]encryption.DataKey{dataKey}, nil).Once()
+
+		rotator := encryption.NewKeyRotator(fixMasterKeyProvider(t, "key-2"), repo, nil)
+		// WHEN
+		_, err := rotator.RewrapDataKeys(ctx)
+		// THEN
+		require.EqualError(t, err, "while unwrapping data key [bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb]: master key [key-0] is not defined")
+	})
+}
+
+func TestKeyRotator_ReencryptBatch(t *testing.T) {
+	column := encryption.SystemAuthValueColumn
+	selectQuery := regexp.QuoteMeta(`SELECT id AS id, tenant_id AS tenant_id, value AS value FROM public.system_auths WHERE value IS NOT NULL AND id > $1 ORDER BY id LIMIT $2`)
+	updateQuery := regexp.QuoteMeta(`UPDATE public.system_auths SET value = $1 WHERE id = $2 AND value = $3`)
+	upToDateID := "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	encrypted := fixEncryptedValue(dataKeyID, []byte("ciphertext"))
+
+	t.Run("encrypts values which are not encrypted with active data key", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(encryption.FirstID, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "value"}).
+				AddRow(rowID, tenantID, plaintext).
+				AddRow(upToDateID, tenantID, encrypted))
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(encrypted, rowID, plaintext).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, tenantID).Return(&encryption.DataKey{ID: dataKeyID}, nil).Once()
+
+		encryptor := &automock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Decrypt", ctx, location, plaintext).Return(plaintext, nil).Once()
+		encryptor.On("Encrypt", ctx, location, plaintext).Return(encrypted, nil).Once()
+
+		rotator := encryption.NewKeyRotator(nil, repo, encryptor)
+		// WHEN
+		lastID, count, err := rotator.ReencryptBatch(ctx, column, encryption.FirstID, 10)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, upToDateID, lastID)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("re-encrypts values encrypted with active data key before they were bound to their location", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		unbound := fixEnvelopeValue(encryption.Envelope{DataKeyID: dataKeyID, Ciphertext: []byte("ciphertext")})
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(encryption.FirstID, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "value"}).
+				AddRow(rowID, tenantID, unbound))
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(encrypted, rowID, unbound).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, tenantID).Return(&encryption.DataKey{ID: dataKeyID}, nil).Once()
+
+		encryptor := &automock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Decrypt", ctx, location, unbound).Return(plaintext, nil).Once()
+		encryptor.On("Encrypt", ctx, location, plaintext).Return(encrypted, nil).Once()
+
+		rotator := encryption.NewKeyRotator(nil, repo, encryptor)
+		// WHEN
+		lastID, count, err := rotator.ReencryptBatch(ctx, column, encryption.FirstID, 10)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, rowID, lastID)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("skips values changed after they were listed", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(encryption.FirstID, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "value"}).
+				AddRow(rowID, tenantID, plaintext))
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(encrypted, rowID, plaintext).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, tenantID).Return(&encryption.DataKey{ID: dataKeyID}, nil).Once()
+
+		encryptor := &automock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Decrypt", ctx, location, plaintext).Return(plaintext, nil).Once()
+		encryptor.On("Encrypt", ctx, location, plaintext).Return(encrypted, nil).Once()
+
+		rotator := encryption.NewKeyRotator(nil, repo, encryptor)
+		// WHEN
+		lastID, count, err := rotator.ReencryptBatch(ctx, column, encryption.FirstID, 10)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, rowID, lastID)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("returns the same ID when there is nothing to re-encrypt", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(upToDateID, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "value"}))
+
+		rotator := encryption.NewKeyRotator(nil, nil, nil)
+		// WHEN
+		lastID, count, err := rotator.ReencryptBatch(ctx, column, upToDateID, 10)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, upToDateID, lastID)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("encrypts values of table without tenant column with data key without tenant", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		registrations := encryption.Column{Table: "public.oauth_client_registrations", IDColumn: "client_id", ValueColumn: "registration_access_token"}
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT client_id AS id, NULL AS tenant_id, registration_access_token AS value FROM public.oauth_client_registrations WHERE registration_access_token IS NOT NULL AND client_id > $1 ORDER BY client_id LIMIT $2`)).
+			WithArgs(encryption.FirstID, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "value"}).
+				AddRow(rowID, nil, plaintext))
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.oauth_client_registrations SET registration_access_token = $1 WHERE client_id = $2 AND registration_access_token = $3`)).
+			WithArgs(encrypted, rowID, plaintext).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, "").Return(nil, nil).Once()
+
+		encryptor := &automock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		registrationLocation := encryption.Location{Column: registrations, RowID: rowID}
+		encryptor.On("Decrypt", ctx, registrationLocation, plaintext).Return(plaintext, nil).Once()
+		encryptor.On("Encrypt", ctx, registrationLocation, plaintext).Return(encrypted, nil).Once()
+
+		rotator := encryption.NewKeyRotator(nil, repo, encryptor)
+		// WHEN
+		lastID, count, err := rotator.ReencryptBatch(ctx, registrations, encryption.FirstID, 10)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, rowID, lastID)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("returns error when decryption fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(encryption.FirstID, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "value"}).
+				AddRow(rowID, nil, encrypted))
+
+		repo := &automock.DataKeyRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetActive", ctx, "").Return(nil, nil).Once()
+
+		encryptor := &automock.Encryptor{}
+		defer encryptor.AssertExpectations(t)
+		encryptor.On("Decrypt", ctx, encryption.Location{Column: column, RowID: rowID}, encrypted).Return(sql.NullString{}, testErr).Once()
+
+		rotator := encryption.NewKeyRotator(nil, repo, encryptor)
+		// WHEN
+		_, _, err := rotator.ReencryptBatch(ctx, column, encryption.FirstID, 10)
+		// THEN
+		require.EqualError(t, err, "while decrypting value of public.system_auths with id cccccccc-cccc-cccc-cccc-cccccccccccc: test error")
+	})
+}
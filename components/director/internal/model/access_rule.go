@@ -0,0 +1,87 @@
+package model
+
+type AccessRuleSubjectType string
+
+const (
+	AccessRuleSubjectTypeUser              AccessRuleSubjectType = "USER"
+	AccessRuleSubjectTypeGroup             AccessRuleSubjectType = "GROUP"
+	AccessRuleSubjectTypeIntegrationSystem AccessRuleSubjectType = "INTEGRATION_SYSTEM"
+)
+
+// AccessRuleSubject identifies the user, the group or the integration system to which an Access Rule applies
+type AccessRuleSubject struct {
+	Type AccessRuleSubjectType
+	Name string
+}
+
+type AccessRuleObjectType string
+
+const (
+	AccessRuleObjectTypeApplication AccessRuleObjectType = "APPLICATION"
+	AccessRuleObjectTypeRuntime     AccessRuleObjectType = "RUNTIME"
+)
+
+// AccessRuleNestedObjectType is the type of an object, which can be accessed by the callers allowed to access
+// the Application or the Runtime it belongs to
+type AccessRuleNestedObjectType string
+
+const (
+	AccessRuleNestedObjectTypePackage             AccessRuleNestedObjectType = "PACKAGE"
+	AccessRuleNestedObjectTypeAPIDefinition       AccessRuleNestedObjectType = "API_DEFINITION"
+	AccessRuleNestedObjectTypeEventDefinition     AccessRuleNestedObjectType = "EVENT_DEFINITION"
+	AccessRuleNestedObjectTypeDocument            AccessRuleNestedObjectType = "DOCUMENT"
+	AccessRuleNestedObjectTypeWebhook             AccessRuleNestedObjectType = "WEBHOOK"
+	AccessRuleNestedObjectTypeRuntimeContext      AccessRuleNestedObjectType = "RUNTIME_CONTEXT"
+	AccessRuleNestedObjectTypePackageInstanceAuth AccessRuleNestedObjectType = "PACKAGE_INSTANCE_AUTH"
+)
+
+// ParentType returns the type of the object, to which the nested objects of the given type belong
+func (t AccessRuleNestedObjectType) ParentType() AccessRuleObjectType {
+	if t == AccessRuleNestedObjectTypeRuntimeContext {
+		return AccessRuleObjectTypeRuntime
+	}
+
+	return AccessRuleObjectTypeApplication
+}
+
+type AccessRule struct {
+	ID         string
+	Tenant     string
+	Subject    AccessRuleSubject
+	ObjectType AccessRuleObjectType
+	LabelKey   *string
+	LabelQuery *string
+	OwnedOnly  bool
+}
+
+type AccessRuleInput struct {
+	Subject    AccessRuleSubject
+	ObjectType AccessRuleObjectType
+	LabelKey   *string
+	LabelQuery *string
+	OwnedOnly  bool
+}
+
+func (i *AccessRuleInput) ToAccessRule(id, tenant string) *AccessRule {
+	if i == nil {
+		return nil
+	}
+
+	return &AccessRule{
+		ID:         id,
+		Tenant:     tenant,
+		Subject:    i.Subject,
+		ObjectType: i.ObjectType,
+		LabelKey:   i.LabelKey,
+		LabelQuery: i.LabelQuery,
+		OwnedOnly:  i.OwnedOnly,
+	}
+}
+
+// AccessRestriction limits the objects of one type, which the caller can access, to the objects matched by any of the Rules.
+// Objects are matched by the rules with OwnedOnly set if they were created by the Owner.
+type AccessRestriction struct {
+	ObjectType AccessRuleObjectType
+	Rules      []*AccessRule
+	Owner      *AccessRuleSubject
+}
@@ -0,0 +1,69 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessRuleInput_ToAccessRule(t *testing.T) {
+	// given
+	id := "foo"
+	tenant := "sample"
+	testCases := []struct {
+		Name     string
+		Input    *model.AccessRuleInput
+		Expected *model.AccessRule
+	}{
+		{
+			Name: "Label rule",
+			Input: &model.AccessRuleInput{
+				Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: "team-a"},
+				ObjectType: model.AccessRuleObjectTypeApplication,
+				LabelKey:   str.Ptr("team"),
+				LabelQuery: str.Ptr(`"a"`),
+			},
+			Expected: &model.AccessRule{
+				ID:         id,
+				Tenant:     tenant,
+				Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeGroup, Name: "team-a"},
+				ObjectType: model.AccessRuleObjectTypeApplication,
+				LabelKey:   str.Ptr("team"),
+				LabelQuery: str.Ptr(`"a"`),
+			},
+		},
+		{
+			Name: "Owned only rule",
+			Input: &model.AccessRuleInput{
+				Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: "john"},
+				ObjectType: model.AccessRuleObjectTypeRuntime,
+				OwnedOnly:  true,
+			},
+			Expected: &model.AccessRule{
+				ID:         id,
+				Tenant:     tenant,
+				Subject:    model.AccessRuleSubject{Type: model.AccessRuleSubjectTypeUser, Name: "john"},
+				ObjectType: model.AccessRuleObjectTypeRuntime,
+				OwnedOnly:  true,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToAccessRule(id, tenant)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
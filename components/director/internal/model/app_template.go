@@ -6,6 +6,9 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
+// ApplicationTemplateNameKey is the key of the label which holds the name of the Application Template an Application was registered from
+const ApplicationTemplateNameKey = "applicationTemplateName"
+
 type ApplicationTemplate struct {
 	ID                   string
 	Name                 string
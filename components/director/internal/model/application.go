@@ -40,14 +40,16 @@ func (app *Application) SetFromUpdateInput(update ApplicationUpdateInput, timest
 type ApplicationStatus struct {
 	Condition ApplicationStatusCondition
 	Timestamp time.Time
+	LastSeen  *time.Time
 }
 
 type ApplicationStatusCondition string
 
 const (
-	ApplicationStatusConditionInitial   ApplicationStatusCondition = "INITIAL"
-	ApplicationStatusConditionConnected ApplicationStatusCondition = "CONNECTED"
-	ApplicationStatusConditionFailed    ApplicationStatusCondition = "FAILED"
+	ApplicationStatusConditionInitial      ApplicationStatusCondition = "INITIAL"
+	ApplicationStatusConditionConnected    ApplicationStatusCondition = "CONNECTED"
+	ApplicationStatusConditionDisconnected ApplicationStatusCondition = "DISCONNECTED"
+	ApplicationStatusConditionFailed       ApplicationStatusCondition = "FAILED"
 )
 
 type ApplicationPage struct {
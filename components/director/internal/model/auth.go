@@ -19,8 +19,25 @@ type CSRFTokenCredentialRequestAuth struct {
 }
 
 type CredentialData struct {
-	Basic *BasicCredentialData
-	Oauth *OAuthCredentialData
+	Basic         *BasicCredentialData
+	Oauth         *OAuthCredentialData
+	PrivateKeyJWT *PrivateKeyJWTCredentialData `json:",omitempty"`
+	Certificate   *CertificateCredentialData   `json:",omitempty"`
+}
+
+// OAuthClientID returns the ID of the OAuth 2.0 client which the credential belongs to.
+// It is empty for credentials which are not registered as OAuth 2.0 clients.
+func (c CredentialData) OAuthClientID() string {
+	switch {
+	case c.Oauth != nil:
+		return c.Oauth.ClientID
+	case c.PrivateKeyJWT != nil:
+		return c.PrivateKeyJWT.ClientID
+	case c.Certificate != nil:
+		return c.Certificate.ClientID
+	}
+
+	return ""
 }
 
 type BasicCredentialData struct {
@@ -33,6 +50,20 @@ type OAuthCredentialData struct {
 	URL          string
 }
 
+type PrivateKeyJWTCredentialData struct {
+	ClientID string
+	JWKS     *string
+	JWKSURI  *string
+	URL      string
+}
+
+type CertificateCredentialData struct {
+	ClientID   string
+	Subject    string
+	Thumbprint string
+	URL        string
+}
+
 type AuthInput struct {
 	Credential            *CredentialDataInput
 	AdditionalHeaders     map[string][]string
@@ -64,8 +95,10 @@ func (i *AuthInput) ToAuth() *Auth {
 }
 
 type CredentialDataInput struct {
-	Basic *BasicCredentialDataInput
-	Oauth *OAuthCredentialDataInput
+	Basic         *BasicCredentialDataInput
+	Oauth         *OAuthCredentialDataInput
+	PrivateKeyJWT *PrivateKeyJWTCredentialDataInput
+	Certificate   *CertificateCredentialDataInput
 }
 
 func (i *CredentialDataInput) ToCredentialData() *CredentialData {
@@ -75,6 +108,8 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 
 	var basic *BasicCredentialData
 	var oauth *OAuthCredentialData
+	var privateKeyJWT *PrivateKeyJWTCredentialData
+	var certificate *CertificateCredentialData
 
 	if i.Basic != nil {
 		basic = i.Basic.ToBasicCredentialData()
@@ -84,9 +119,19 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 		oauth = i.Oauth.ToOAuthCredentialData()
 	}
 
+	if i.PrivateKeyJWT != nil {
+		privateKeyJWT = i.PrivateKeyJWT.ToPrivateKeyJWTCredentialData()
+	}
+
+	if i.Certificate != nil {
+		certificate = i.Certificate.ToCertificateCredentialData()
+	}
+
 	return &CredentialData{
-		Basic: basic,
-		Oauth: oauth,
+		Basic:         basic,
+		Oauth:         oauth,
+		PrivateKeyJWT: privateKeyJWT,
+		Certificate:   certificate,
 	}
 }
 
@@ -124,6 +169,46 @@ func (i *OAuthCredentialDataInput) ToOAuthCredentialData() *OAuthCredentialData
 	}
 }
 
+type PrivateKeyJWTCredentialDataInput struct {
+	ClientID string
+	JWKS     *string
+	JWKSURI  *string
+	URL      string
+}
+
+func (i *PrivateKeyJWTCredentialDataInput) ToPrivateKeyJWTCredentialData() *PrivateKeyJWTCredentialData {
+	if i == nil {
+		return nil
+	}
+
+	return &PrivateKeyJWTCredentialData{
+		ClientID: i.ClientID,
+		JWKS:     i.JWKS,
+		JWKSURI:  i.JWKSURI,
+		URL:      i.URL,
+	}
+}
+
+type CertificateCredentialDataInput struct {
+	ClientID   string
+	Subject    string
+	Thumbprint string
+	URL        string
+}
+
+func (i *CertificateCredentialDataInput) ToCertificateCredentialData() *CertificateCredentialData {
+	if i == nil {
+		return nil
+	}
+
+	return &CertificateCredentialData{
+		ClientID:   i.ClientID,
+		Subject:    i.Subject,
+		Thumbprint: i.Thumbprint,
+		URL:        i.URL,
+	}
+}
+
 type CredentialRequestAuthInput struct {
 	Csrf *CSRFTokenCredentialRequestAuthInput
 }
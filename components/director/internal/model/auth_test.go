@@ -94,6 +94,12 @@ func TestCredentialDataInput_ToCredentialData(t *testing.T) {
 				Oauth: &model.OAuthCredentialDataInput{
 					URL: "test",
 				},
+				PrivateKeyJWT: &model.PrivateKeyJWTCredentialDataInput{
+					URL: "test",
+				},
+				Certificate: &model.CertificateCredentialDataInput{
+					URL: "test",
+				},
 			},
 			Expected: &model.CredentialData{
 				Basic: &model.BasicCredentialData{
@@ -102,6 +108,12 @@ func TestCredentialDataInput_ToCredentialData(t *testing.T) {
 				Oauth: &model.OAuthCredentialData{
 					URL: "test",
 				},
+				PrivateKeyJWT: &model.PrivateKeyJWTCredentialData{
+					URL: "test",
+				},
+				Certificate: &model.CertificateCredentialData{
+					URL: "test",
+				},
 			},
 		},
 		{
@@ -214,6 +226,141 @@ func TestOAuthCredentialDataInput_ToOAuthCredentialData(t *testing.T) {
 	}
 }
 
+func TestPrivateKeyJWTCredentialDataInput_ToPrivateKeyJWTCredentialData(t *testing.T) {
+	// given
+	jwks := `{"keys":[]}`
+	jwksURI := "http://foo.bar/jwks"
+	testCases := []struct {
+		Name     string
+		Input    *model.PrivateKeyJWTCredentialDataInput
+		Expected *model.PrivateKeyJWTCredentialData
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.PrivateKeyJWTCredentialDataInput{
+				ClientID: "id",
+				JWKS:     &jwks,
+				JWKSURI:  &jwksURI,
+				URL:      "test",
+			},
+			Expected: &model.PrivateKeyJWTCredentialData{
+				ClientID: "id",
+				JWKS:     &jwks,
+				JWKSURI:  &jwksURI,
+				URL:      "test",
+			},
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.PrivateKeyJWTCredentialDataInput{},
+			Expected: &model.PrivateKeyJWTCredentialData{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+
+			// when
+			result := testCase.Input.ToPrivateKeyJWTCredentialData()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestCertificateCredentialDataInput_ToCertificateCredentialData(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.CertificateCredentialDataInput
+		Expected *model.CertificateCredentialData
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.CertificateCredentialDataInput{
+				ClientID:   "id",
+				Subject:    "CN=foo",
+				Thumbprint: "thumbprint",
+				URL:        "test",
+			},
+			Expected: &model.CertificateCredentialData{
+				ClientID:   "id",
+				Subject:    "CN=foo",
+				Thumbprint: "thumbprint",
+				URL:        "test",
+			},
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.CertificateCredentialDataInput{},
+			Expected: &model.CertificateCredentialData{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+
+			// when
+			result := testCase.Input.ToCertificateCredentialData()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestCredentialData_OAuthClientID(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    model.CredentialData
+		Expected string
+	}{
+		{
+			Name:     "Client credentials",
+			Input:    model.CredentialData{Oauth: &model.OAuthCredentialData{ClientID: "foo"}},
+			Expected: "foo",
+		},
+		{
+			Name:     "Private key JWT",
+			Input:    model.CredentialData{PrivateKeyJWT: &model.PrivateKeyJWTCredentialData{ClientID: "foo"}},
+			Expected: "foo",
+		},
+		{
+			Name:     "Certificate",
+			Input:    model.CredentialData{Certificate: &model.CertificateCredentialData{ClientID: "foo"}},
+			Expected: "foo",
+		},
+		{
+			Name:     "Basic",
+			Input:    model.CredentialData{Basic: &model.BasicCredentialData{Username: "foo"}},
+			Expected: "",
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+
+			// when
+			result := testCase.Input.OAuthClientID()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
 func TestCredentialRequestAuthInput_ToCredentialRequestAuth(t *testing.T) {
 	// given
 	testCases := []struct {
@@ -1,6 +1,10 @@
 package model
 
+import "time"
+
 type OneTimeToken struct {
 	Token        string
 	ConnectorURL string
+	ExpiresAt    *time.Time
+	Metadata     map[string]interface{}
 }
@@ -0,0 +1,68 @@
+package model
+
+type Role struct {
+	ID          string
+	Tenant      string
+	Name        string
+	Description *string
+	Scopes      []string
+}
+
+type RoleInput struct {
+	Name        string
+	Description *string
+	Scopes      []string
+}
+
+func (i *RoleInput) ToRole(id, tenant string) *Role {
+	if i == nil {
+		return nil
+	}
+
+	return &Role{
+		ID:          id,
+		Tenant:      tenant,
+		Name:        i.Name,
+		Description: i.Description,
+		Scopes:      i.Scopes,
+	}
+}
+
+type RoleBindingSubjectType string
+
+const (
+	RoleBindingSubjectTypeUser       RoleBindingSubjectType = "USER"
+	RoleBindingSubjectTypeGroup      RoleBindingSubjectType = "GROUP"
+	RoleBindingSubjectTypeSystemAuth RoleBindingSubjectType = "SYSTEM_AUTH"
+)
+
+// RoleBindingSubject identifies the user, the group or the system auth to which a Role is bound
+type RoleBindingSubject struct {
+	Type RoleBindingSubjectType
+	Name string
+}
+
+type RoleBinding struct {
+	ID      string
+	Tenant  string
+	RoleID  string
+	Subject RoleBindingSubject
+}
+
+type RoleBindingInput struct {
+	RoleID  string
+	Subject RoleBindingSubject
+}
+
+func (i *RoleBindingInput) ToRoleBinding(id, tenant string) *RoleBinding {
+	if i == nil {
+		return nil
+	}
+
+	return &RoleBinding{
+		ID:      id,
+		Tenant:  tenant,
+		RoleID:  i.RoleID,
+		Subject: i.Subject,
+	}
+}
@@ -0,0 +1,108 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleInput_ToRole(t *testing.T) {
+	// given
+	id := "foo"
+	tenant := "sample"
+	testCases := []struct {
+		Name     string
+		Input    *model.RoleInput
+		Expected *model.Role
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.RoleInput{
+				Name:        "admin",
+				Description: str.Ptr("desc"),
+				Scopes:      []string{"application:read", "application:write"},
+			},
+			Expected: &model.Role{
+				ID:          id,
+				Tenant:      tenant,
+				Name:        "admin",
+				Description: str.Ptr("desc"),
+				Scopes:      []string{"application:read", "application:write"},
+			},
+		},
+		{
+			Name:  "Empty",
+			Input: &model.RoleInput{},
+			Expected: &model.Role{
+				ID:     id,
+				Tenant: tenant,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToRole(id, tenant)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestRoleBindingInput_ToRoleBinding(t *testing.T) {
+	// given
+	id := "foo"
+	tenant := "sample"
+	testCases := []struct {
+		Name     string
+		Input    *model.RoleBindingInput
+		Expected *model.RoleBinding
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.RoleBindingInput{
+				RoleID:  "bar",
+				Subject: model.RoleBindingSubject{Type: model.RoleBindingSubjectTypeGroup, Name: "admins"},
+			},
+			Expected: &model.RoleBinding{
+				ID:      id,
+				Tenant:  tenant,
+				RoleID:  "bar",
+				Subject: model.RoleBindingSubject{Type: model.RoleBindingSubjectTypeGroup, Name: "admins"},
+			},
+		},
+		{
+			Name:  "Empty",
+			Input: &model.RoleBindingInput{},
+			Expected: &model.RoleBinding{
+				ID:     id,
+				Tenant: tenant,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToRoleBinding(id, tenant)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
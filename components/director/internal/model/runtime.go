@@ -18,6 +18,7 @@ type Runtime struct {
 type RuntimeStatus struct {
 	Condition RuntimeStatusCondition
 	Timestamp time.Time
+	LastSeen  *time.Time
 }
 
 type RuntimeStatusCondition string
@@ -26,6 +27,7 @@ const (
 	RuntimeStatusConditionInitial      RuntimeStatusCondition = "INITIAL"
 	RuntimeStatusConditionProvisioning RuntimeStatusCondition = "PROVISIONING"
 	RuntimeStatusConditionConnected    RuntimeStatusCondition = "CONNECTED"
+	RuntimeStatusConditionDisconnected RuntimeStatusCondition = "DISCONNECTED"
 	RuntimeStatusConditionFailed       RuntimeStatusCondition = "FAILED"
 )
 
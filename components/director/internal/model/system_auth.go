@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 )
 
@@ -11,6 +13,11 @@ type SystemAuth struct {
 	RuntimeID           *string
 	IntegrationSystemID *string
 	Value               *Auth
+	ExpiresAt           *time.Time
+}
+
+func (sa SystemAuth) IsExpired(now time.Time) bool {
+	return sa.ExpiresAt != nil && !now.Before(*sa.ExpiresAt)
 }
 
 func (sa SystemAuth) GetReferenceObjectType() (SystemAuthReferenceObjectType, error) {
@@ -53,6 +60,19 @@ const (
 	IntegrationSystemReference SystemAuthReferenceObjectType = "Integration System"
 )
 
+// AccessRuleObjectType returns the type of the object restricted by Access Rules, which the System Auths of the given type
+// belong to. Integration Systems are not restricted by Access Rules.
+func (t SystemAuthReferenceObjectType) AccessRuleObjectType() (AccessRuleObjectType, bool) {
+	switch t {
+	case ApplicationReference:
+		return AccessRuleObjectTypeApplication, true
+	case RuntimeReference:
+		return AccessRuleObjectTypeRuntime, true
+	}
+
+	return "", false
+}
+
 func IsIntegrationSystemNoTenantFlow(err error, objectType SystemAuthReferenceObjectType) bool {
 	return apperrors.IsTenantRequired(err) && objectType == IntegrationSystemReference
 }
@@ -1,7 +1,10 @@
 package oathkeeper
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"net/http"
+	"regexp"
 
 	log "github.com/sirupsen/logrus"
 
@@ -25,8 +28,14 @@ const (
 	ClientIDTokenKey  = "client-id-from-token"
 	ExternalTenantKey = "tenant"
 	ScopesKey         = "scope"
+
+	ConfirmationKey          = "cnf"
+	CertificateThumbprintKey = "x5t#S256"
+	CertificateDataHeader    = "Certificate-Data"
 )
 
+var certificateHashRegex = regexp.MustCompile("Hash=([0-9a-f]+)")
+
 // AuthFlow wraps possible flows of auth like OAuth2, JWT and certificate
 type AuthFlow string
 
@@ -140,6 +149,51 @@ func (d *ReqData) GetScopes() (string, error) {
 	return "", apperrors.NewKeyDoesNotExistError(ScopesKey)
 }
 
+// GetCertificateThumbprint returns the thumbprint of the certificate the access token is bound to, as defined in RFC 8705
+func (d *ReqData) GetCertificateThumbprint() (string, error) {
+	cnfVal, ok := d.Body.Extra[ConfirmationKey]
+	if !ok {
+		return "", apperrors.NewKeyDoesNotExistError(ConfirmationKey)
+	}
+
+	cnf, ok := cnfVal.(map[string]interface{})
+	if !ok {
+		return "", errors.Errorf("while parsing the value for %s", ConfirmationKey)
+	}
+
+	thumbprintVal, ok := cnf[CertificateThumbprintKey]
+	if !ok {
+		return "", apperrors.NewKeyDoesNotExistError(CertificateThumbprintKey)
+	}
+
+	thumbprint, err := str.Cast(thumbprintVal)
+	if err != nil {
+		return "", errors.Wrapf(err, "while parsing the value for %s", CertificateThumbprintKey)
+	}
+
+	return thumbprint, nil
+}
+
+// GetClientCertificateThumbprint returns the base64url-encoded SHA-256 thumbprint of the client certificate presented in the request
+func (d *ReqData) GetClientCertificateThumbprint() (string, error) {
+	certData := d.Header.Get(CertificateDataHeader)
+	if certData == "" {
+		return "", apperrors.NewKeyDoesNotExistError(CertificateDataHeader)
+	}
+
+	match := certificateHashRegex.FindStringSubmatch(certData)
+	if match == nil {
+		return "", errors.Errorf("certificate hash not found in %s header", CertificateDataHeader)
+	}
+
+	hash, err := hex.DecodeString(match[1])
+	if err != nil {
+		return "", errors.Wrap(err, "while decoding certificate hash")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(hash), nil
+}
+
 // GetUserGroups returns group name or empty string if there's no group
 func (d *ReqData) GetUserGroups() []string {
 	userGroups := []string{}
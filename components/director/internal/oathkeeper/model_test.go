@@ -237,6 +237,126 @@ func TestReqData_GetScopes(t *testing.T) {
 	})
 }
 
+func TestReqData_GetCertificateThumbprint(t *testing.T) {
+	t.Run("returns thumbprint when it is specified in the confirmation claim", func(t *testing.T) {
+		expectedThumbprint := "bwcK0esc3ACC3DB2Y5flESsXE8o9ltc05O89jdN-dg0"
+		reqData := ReqData{
+			Body: ReqBody{
+				Extra: map[string]interface{}{
+					ConfirmationKey: map[string]interface{}{
+						CertificateThumbprintKey: expectedThumbprint,
+					},
+				},
+			},
+		}
+
+		thumbprint, err := reqData.GetCertificateThumbprint()
+
+		require.NoError(t, err)
+		require.Equal(t, expectedThumbprint, thumbprint)
+	})
+
+	t.Run("returns error when confirmation claim is not specified", func(t *testing.T) {
+		reqData := ReqData{}
+
+		_, err := reqData.GetCertificateThumbprint()
+
+		require.EqualError(t, err, "the key does not exist in the source object [key=cnf]")
+	})
+
+	t.Run("returns error when confirmation claim is not an object", func(t *testing.T) {
+		reqData := ReqData{
+			Body: ReqBody{
+				Extra: map[string]interface{}{
+					ConfirmationKey: "foo",
+				},
+			},
+		}
+
+		_, err := reqData.GetCertificateThumbprint()
+
+		require.EqualError(t, err, "while parsing the value for cnf")
+	})
+
+	t.Run("returns error when thumbprint is not specified in the confirmation claim", func(t *testing.T) {
+		reqData := ReqData{
+			Body: ReqBody{
+				Extra: map[string]interface{}{
+					ConfirmationKey: map[string]interface{}{},
+				},
+			},
+		}
+
+		_, err := reqData.GetCertificateThumbprint()
+
+		require.EqualError(t, err, "the key does not exist in the source object [key=x5t#S256]")
+	})
+
+	t.Run("returns error when thumbprint is specified in a non-string format", func(t *testing.T) {
+		reqData := ReqData{
+			Body: ReqBody{
+				Extra: map[string]interface{}{
+					ConfirmationKey: map[string]interface{}{
+						CertificateThumbprintKey: []byte{1, 2, 3},
+					},
+				},
+			},
+		}
+
+		_, err := reqData.GetCertificateThumbprint()
+
+		require.EqualError(t, err, "while parsing the value for x5t#S256: Internal Server Error: unable to cast the value to a string type")
+	})
+}
+
+func TestReqData_GetClientCertificateThumbprint(t *testing.T) {
+	t.Run("returns base64url-encoded thumbprint of the certificate from the header", func(t *testing.T) {
+		reqData := ReqData{
+			Header: http.Header{
+				CertificateDataHeader: []string{`Hash=6f070ad1eb1cdc0082dc30766397e5112b1713ca3d96d734e4ef3d8dd37e760d;Subject="CN=foo"`},
+			},
+		}
+
+		thumbprint, err := reqData.GetClientCertificateThumbprint()
+
+		require.NoError(t, err)
+		require.Equal(t, "bwcK0esc3ACC3DB2Y5flESsXE8o9ltc05O89jdN-dg0", thumbprint)
+	})
+
+	t.Run("returns error when header is not specified", func(t *testing.T) {
+		reqData := ReqData{}
+
+		_, err := reqData.GetClientCertificateThumbprint()
+
+		require.EqualError(t, err, "the key does not exist in the source object [key=Certificate-Data]")
+	})
+
+	t.Run("returns error when header does not contain certificate hash", func(t *testing.T) {
+		reqData := ReqData{
+			Header: http.Header{
+				CertificateDataHeader: []string{`Subject="CN=foo"`},
+			},
+		}
+
+		_, err := reqData.GetClientCertificateThumbprint()
+
+		require.EqualError(t, err, "certificate hash not found in Certificate-Data header")
+	})
+
+	t.Run("returns error when certificate hash is malformed", func(t *testing.T) {
+		reqData := ReqData{
+			Header: http.Header{
+				CertificateDataHeader: []string{`Hash=abc;Subject="CN=foo"`},
+			},
+		}
+
+		_, err := reqData.GetClientCertificateThumbprint()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while decoding certificate hash")
+	})
+}
+
 func TestReqData_GetGroups(t *testing.T) {
 	t.Run("returns groups when it is specified in the Extra map", func(t *testing.T) {
 		expectedGroups := []string{
@@ -0,0 +1,12 @@
+package persistedquery
+
+type Config struct {
+	// Src is the path to the JSON file with the registered queries in the `{"<sha256 hash>": "<query>"}` form
+	Src string `envconfig:"optional,APP_PERSISTED_QUERIES_SRC"`
+	// CacheSize is the maximum number of automatic persisted queries kept in memory
+	CacheSize int `envconfig:"default=1000,APP_PERSISTED_QUERIES_CACHE_SIZE"`
+	// AllowListConsumerTypes are the consumer types which can send only the registered queries
+	AllowListConsumerTypes []string `envconfig:"optional,APP_PERSISTED_QUERIES_ALLOW_LIST_CONSUMER_TYPES"`
+	// MaxBatchSize is the maximum number of operations in a batched request
+	MaxBatchSize int `envconfig:"default=10,APP_GRAPHQL_MAX_BATCH_SIZE"`
+}
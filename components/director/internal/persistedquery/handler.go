@@ -0,0 +1,230 @@
+package persistedquery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+type params struct {
+	Query      string      `json:"query"`
+	Extensions *extensions `json:"extensions"`
+}
+
+type extensions struct {
+	PersistedQuery *persistedQuery `json:"persistedQuery"`
+}
+
+type persistedQuery struct {
+	Sha256 string `json:"sha256Hash"`
+}
+
+type handler struct {
+	registry     *Registry
+	allowList    map[consumer.ConsumerType]struct{}
+	maxBatchSize int
+}
+
+func NewHandler(registry *Registry, cfg Config) *handler {
+	allowList := make(map[consumer.ConsumerType]struct{}, len(cfg.AllowListConsumerTypes))
+	for _, consumerType := range cfg.AllowListConsumerTypes {
+		allowList[consumer.ConsumerType(strings.TrimSpace(consumerType))] = struct{}{}
+	}
+
+	return &handler{
+		registry:     registry,
+		allowList:    allowList,
+		maxBatchSize: cfg.MaxBatchSize,
+	}
+}
+
+// Handler executes every operation of an array-batched request separately and returns the array of their responses.
+// For the consumer types in the allow-list, it accepts only the operations which reference a registered persisted query.
+func (h *handler) Handler() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				if err := h.checkGetRequest(r); err != nil {
+					writeError(w, err.Error(), http.StatusForbidden)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.Method != http.MethodPost || r.Body == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+				if err := h.checkAllowList(r, params{}); err != nil {
+					writeError(w, err.Error(), http.StatusForbidden)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				writeError(w, "could not read request body", http.StatusBadRequest)
+				return
+			}
+
+			if isBatch(body) {
+				h.serveBatch(w, r, body, next)
+				return
+			}
+
+			h.serveOperation(w, r, body, next)
+		})
+	}
+}
+
+func (h *handler) serveBatch(w http.ResponseWriter, r *http.Request, body []byte, next http.Handler) {
+	var operations []json.RawMessage
+	if err := json.Unmarshal(body, &operations); err != nil {
+		writeError(w, "json body could not be decoded: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(operations) == 0 {
+		writeError(w, "batch must contain at least one operation", http.StatusBadRequest)
+		return
+	}
+	if h.maxBatchSize > 0 && len(operations) > h.maxBatchSize {
+		writeError(w, fmt.Sprintf("batch contains %d operations, which exceeds the limit of %d", len(operations), h.maxBatchSize), http.StatusBadRequest)
+		return
+	}
+
+	responses := make([]json.RawMessage, 0, len(operations))
+	for _, operation := range operations {
+		buffer := newResponseBuffer()
+		h.serveOperation(buffer, r, operation, next)
+		responses = append(responses, buffer.result())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(responses); err != nil {
+		log.Error(errors.Wrap(err, "while encoding batch response"))
+	}
+}
+
+func (h *handler) serveOperation(w http.ResponseWriter, r *http.Request, body []byte, next http.Handler) {
+	var reqParams params
+	if err := json.Unmarshal(body, &reqParams); err != nil {
+		writeError(w, "json body could not be decoded: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.checkAllowList(r, reqParams); err != nil {
+		writeError(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	operationReq := r.Clone(r.Context())
+	operationReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	operationReq.ContentLength = int64(len(body))
+	next.ServeHTTP(w, operationReq)
+}
+
+func (h *handler) checkGetRequest(r *http.Request) error {
+	query := r.URL.Query()
+
+	reqParams := params{Query: query.Get("query")}
+	if ext := query.Get("extensions"); ext != "" {
+		if err := json.Unmarshal([]byte(ext), &reqParams.Extensions); err != nil {
+			return errors.New("extensions could not be decoded")
+		}
+	}
+	return h.checkAllowList(r, reqParams)
+}
+
+// checkAllowList returns error if the consumer can send only registered queries and the operation does not reference one
+func (h *handler) checkAllowList(r *http.Request, reqParams params) error {
+	if len(h.allowList) == 0 {
+		return nil
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(r.Context())
+	if err != nil {
+		return errors.Wrap(err, "while fetching consumer info from context")
+	}
+	if _, ok := h.allowList[consumerInfo.ConsumerType]; !ok {
+		return nil
+	}
+
+	if reqParams.Extensions == nil || reqParams.Extensions.PersistedQuery == nil || !h.registry.IsRegistered(reqParams.Extensions.PersistedQuery.Sha256) {
+		return errors.Errorf("consumer type %s can send only registered persisted queries", consumerInfo.ConsumerType)
+	}
+	if reqParams.Query != "" && Hash(reqParams.Query) != reqParams.Extensions.PersistedQuery.Sha256 {
+		return errors.New("provided sha does not match query")
+	}
+	return nil
+}
+
+func isBatch(body []byte) bool {
+	trimmed := bytes.TrimSpace(body)
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+type errorResponse struct {
+	Errors []gqlError `json:"errors"`
+}
+
+type gqlError struct {
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, message string, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	resp := errorResponse{Errors: []gqlError{{Message: message}}}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Error(errors.Wrap(err, "while encoding data"))
+	}
+}
+
+// responseBuffer collects the response of a single operation of a batch
+type responseBuffer struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: http.Header{}}
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *responseBuffer) WriteHeader(int) {
+}
+
+// result returns the response of the operation, or a GraphQL error if the operation did not respond with JSON
+func (b *responseBuffer) result() json.RawMessage {
+	body := bytes.TrimSpace(b.body.Bytes())
+	if json.Valid(body) {
+		return body
+	}
+
+	resp, err := json.Marshal(errorResponse{Errors: []gqlError{{Message: "operation returned invalid response"}}})
+	if err != nil {
+		return json.RawMessage(`{}`)
+	}
+	return resp
+}
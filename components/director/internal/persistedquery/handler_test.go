@@ -0,0 +1,163 @@
+package persistedquery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/persistedquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	registry, err := persistedquery.NewRegistry(map[string]string{persistedquery.Hash(registeredQuery): registeredQuery}, 10)
+	require.NoError(t, err)
+
+	cfg := persistedquery.Config{
+		AllowListConsumerTypes: []string{string(consumer.Runtime)},
+		MaxBatchSize:           2,
+	}
+
+	registeredRequest := fmt.Sprintf(`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, persistedquery.Hash(registeredQuery))
+	automaticRequest := fmt.Sprintf(`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, persistedquery.Hash(automaticQuery))
+	plainRequest := fmt.Sprintf(`{"query":"%s"}`, automaticQuery)
+
+	testCases := []struct {
+		Name             string
+		Request          *http.Request
+		ExpectedStatus   int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "Executes single request",
+			Request:          fixRequest(t, plainRequest, consumer.Application),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: fixResponse(plainRequest) + "\n",
+		},
+		{
+			Name:             "Executes every operation of batch",
+			Request:          fixRequest(t, fmt.Sprintf("[%s, %s]", plainRequest, registeredRequest), consumer.Application),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: fmt.Sprintf("[%s,%s]\n", fixResponse(plainRequest), fixResponse(registeredRequest)),
+		},
+		{
+			Name:             "Rejects batch over the limit",
+			Request:          fixRequest(t, fmt.Sprintf("[%s, %s, %s]", plainRequest, plainRequest, plainRequest), consumer.Application),
+			ExpectedStatus:   http.StatusBadRequest,
+			ExpectedResponse: `{"errors":[{"message":"batch contains 3 operations, which exceeds the limit of 2"}]}` + "\n",
+		},
+		{
+			Name:             "Rejects empty batch",
+			Request:          fixRequest(t, "[]", consumer.Application),
+			ExpectedStatus:   http.StatusBadRequest,
+			ExpectedResponse: `{"errors":[{"message":"batch must contain at least one operation"}]}` + "\n",
+		},
+		{
+			Name:             "Executes registered persisted query for consumer in allow-list",
+			Request:          fixRequest(t, registeredRequest, consumer.Runtime),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: fixResponse(registeredRequest) + "\n",
+		},
+		{
+			Name:             "Rejects automatic persisted query for consumer in allow-list",
+			Request:          fixRequest(t, automaticRequest, consumer.Runtime),
+			ExpectedStatus:   http.StatusForbidden,
+			ExpectedResponse: `{"errors":[{"message":"consumer type Runtime can send only registered persisted queries"}]}` + "\n",
+		},
+		{
+			Name:             "Rejects query without hash for consumer in allow-list",
+			Request:          fixRequest(t, plainRequest, consumer.Runtime),
+			ExpectedStatus:   http.StatusForbidden,
+			ExpectedResponse: `{"errors":[{"message":"consumer type Runtime can send only registered persisted queries"}]}` + "\n",
+		},
+		{
+			Name:             "Rejects only not registered operations of batch for consumer in allow-list",
+			Request:          fixRequest(t, fmt.Sprintf("[%s, %s]", registeredRequest, plainRequest), consumer.Runtime),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: fmt.Sprintf(`[%s,{"errors":[{"message":"consumer type Runtime can send only registered persisted queries"}]}]`+"\n", fixResponse(registeredRequest)),
+		},
+		{
+			Name:             "Rejects query which does not match the registered hash",
+			Request:          fixRequest(t, fmt.Sprintf(`{"query":"%s","extensions":{"persistedQuery":{"version":1,"sha256Hash":"%s"}}}`, automaticQuery, persistedquery.Hash(registeredQuery)), consumer.Runtime),
+			ExpectedStatus:   http.StatusForbidden,
+			ExpectedResponse: `{"errors":[{"message":"provided sha does not match query"}]}` + "\n",
+		},
+		{
+			Name:             "Rejects HTTP GET query without hash for consumer in allow-list",
+			Request:          fixGetRequest(t, url.Values{"query": []string{registeredQuery}}, consumer.Runtime),
+			ExpectedStatus:   http.StatusForbidden,
+			ExpectedResponse: `{"errors":[{"message":"consumer type Runtime can send only registered persisted queries"}]}` + "\n",
+		},
+		{
+			Name:             "Executes HTTP GET registered persisted query for consumer in allow-list",
+			Request:          fixGetRequest(t, url.Values{"extensions": []string{fmt.Sprintf(`{"persistedQuery":{"version":1,"sha256Hash":"%s"}}`, persistedquery.Hash(registeredQuery))}}, consumer.Runtime),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: fixResponse("") + "\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			handler := persistedquery.NewHandler(registry, cfg).Handler()(fixNextHandler(t))
+			rr := httptest.NewRecorder()
+
+			//WHEN
+			handler.ServeHTTP(rr, testCase.Request)
+
+			//THEN
+			assert.Equal(t, testCase.ExpectedStatus, rr.Code)
+			assert.Equal(t, testCase.ExpectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func fixRequest(t *testing.T, body string, consumerType consumer.ConsumerType) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "/graphql", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return withConsumer(req, consumerType)
+}
+
+func fixGetRequest(t *testing.T, values url.Values, consumerType consumer.ConsumerType) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "/graphql?"+values.Encode(), nil)
+	require.NoError(t, err)
+	return withConsumer(req, consumerType)
+}
+
+func withConsumer(req *http.Request, consumerType consumer.ConsumerType) *http.Request {
+	ctx := consumer.SaveToContext(context.TODO(), consumer.Consumer{ConsumerID: "consumer-id", ConsumerType: consumerType})
+	return req.WithContext(ctx)
+}
+
+// fixNextHandler responds with the request body wrapped in the data field
+func fixNextHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+		if r.Body != nil {
+			var err error
+			body, err = ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(fixResponse(string(body)) + "\n"))
+		require.NoError(t, err)
+	})
+}
+
+func fixResponse(request string) string {
+	data, err := json.Marshal(request)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf(`{"data":%s}`, data)
+}
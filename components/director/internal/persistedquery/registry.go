@@ -0,0 +1,95 @@
+package persistedquery
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Registry resolves persisted queries by their SHA-256 hash. The registered queries are loaded on startup,
+// the automatic persisted queries are added by the clients and kept in a bounded in-memory cache.
+type Registry struct {
+	registered map[string]string
+	cache      *lru.Cache
+}
+
+func NewRegistry(registered map[string]string, cacheSize int) (*Registry, error) {
+	for hash, query := range registered {
+		if Hash(query) != hash {
+			return nil, errors.Errorf("hash %s does not match the registered query", hash)
+		}
+	}
+
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating persisted query cache")
+	}
+
+	return &Registry{
+		registered: registered,
+		cache:      cache,
+	}, nil
+}
+
+// LoadRegistry creates Registry with the queries registered in the JSON file
+func LoadRegistry(src string, cacheSize int) (*Registry, error) {
+	registered := map[string]string{}
+	if src == "" {
+		log.Infof("No registered persisted queries")
+		return NewRegistry(registered, cacheSize)
+	}
+
+	file, err := os.Open(src)
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening persisted queries file")
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Warnf("Got error on closing persisted queries file: %v", err)
+		}
+	}()
+
+	if err := json.NewDecoder(file).Decode(&registered); err != nil {
+		return nil, errors.Wrapf(err, "while decoding file [%s] to map[string]string", src)
+	}
+
+	log.Infof("Loaded %d registered persisted queries", len(registered))
+	return NewRegistry(registered, cacheSize)
+}
+
+// Add stores the automatic persisted query sent by a client
+func (r *Registry) Add(_ context.Context, hash string, query string) {
+	if _, ok := r.registered[hash]; ok {
+		return
+	}
+	r.cache.Add(hash, query)
+}
+
+func (r *Registry) Get(_ context.Context, hash string) (string, bool) {
+	if query, ok := r.registered[hash]; ok {
+		return query, true
+	}
+
+	query, ok := r.cache.Get(hash)
+	if !ok {
+		return "", false
+	}
+	return query.(string), true
+}
+
+func (r *Registry) IsRegistered(hash string) bool {
+	_, ok := r.registered[hash]
+	return ok
+}
+
+// Hash returns the hex encoded SHA-256 hash of the query, as sent in the `persistedQuery` extension
+func Hash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
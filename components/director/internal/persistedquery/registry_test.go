@@ -0,0 +1,109 @@
+package persistedquery_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/persistedquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	registeredQuery = "query { applications { data { id } } }"
+	automaticQuery  = "query { runtimes { data { id } } }"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("Resolves registered and automatic persisted queries", func(t *testing.T) {
+		//GIVEN
+		ctx := context.TODO()
+		registry, err := persistedquery.NewRegistry(map[string]string{persistedquery.Hash(registeredQuery): registeredQuery}, 10)
+		require.NoError(t, err)
+
+		//WHEN
+		registry.Add(ctx, persistedquery.Hash(automaticQuery), automaticQuery)
+
+		//THEN
+		query, ok := registry.Get(ctx, persistedquery.Hash(registeredQuery))
+		assert.True(t, ok)
+		assert.Equal(t, registeredQuery, query)
+		assert.True(t, registry.IsRegistered(persistedquery.Hash(registeredQuery)))
+
+		query, ok = registry.Get(ctx, persistedquery.Hash(automaticQuery))
+		assert.True(t, ok)
+		assert.Equal(t, automaticQuery, query)
+		assert.False(t, registry.IsRegistered(persistedquery.Hash(automaticQuery)))
+
+		_, ok = registry.Get(ctx, "unknown")
+		assert.False(t, ok)
+	})
+
+	t.Run("Evicts automatic persisted queries over the cache size", func(t *testing.T) {
+		//GIVEN
+		ctx := context.TODO()
+		registry, err := persistedquery.NewRegistry(map[string]string{}, 1)
+		require.NoError(t, err)
+
+		//WHEN
+		registry.Add(ctx, persistedquery.Hash(registeredQuery), registeredQuery)
+		registry.Add(ctx, persistedquery.Hash(automaticQuery), automaticQuery)
+
+		//THEN
+		_, ok := registry.Get(ctx, persistedquery.Hash(registeredQuery))
+		assert.False(t, ok)
+		_, ok = registry.Get(ctx, persistedquery.Hash(automaticQuery))
+		assert.True(t, ok)
+	})
+
+	t.Run("Returns error when hash does not match the query", func(t *testing.T) {
+		//WHEN
+		_, err := persistedquery.NewRegistry(map[string]string{"hash": registeredQuery}, 10)
+
+		//THEN
+		require.EqualError(t, err, "hash hash does not match the registered query")
+	})
+}
+
+func TestLoadRegistry(t *testing.T) {
+	t.Run("Loads queries from file", func(t *testing.T) {
+		//GIVEN
+		dir, err := ioutil.TempDir("", "persisted-queries")
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, os.RemoveAll(dir))
+		}()
+
+		src := filepath.Join(dir, "queries.json")
+		content := `{"` + persistedquery.Hash(registeredQuery) + `":"` + registeredQuery + `"}`
+		require.NoError(t, ioutil.WriteFile(src, []byte(content), 0600))
+
+		//WHEN
+		registry, err := persistedquery.LoadRegistry(src, 10)
+
+		//THEN
+		require.NoError(t, err)
+		assert.True(t, registry.IsRegistered(persistedquery.Hash(registeredQuery)))
+	})
+
+	t.Run("Returns empty registry without file", func(t *testing.T) {
+		//WHEN
+		registry, err := persistedquery.LoadRegistry("", 10)
+
+		//THEN
+		require.NoError(t, err)
+		assert.False(t, registry.IsRegistered(persistedquery.Hash(registeredQuery)))
+	})
+
+	t.Run("Returns error when file does not exist", func(t *testing.T) {
+		//WHEN
+		_, err := persistedquery.LoadRegistry("/not/existing/queries.json", 10)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while opening persisted queries file")
+	})
+}
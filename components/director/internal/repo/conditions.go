@@ -76,6 +76,26 @@ func (c *notNullCondition) GetQueryArgs() ([]interface{}, bool) {
 	return nil, false
 }
 
+func NewLessThanCondition(field string, val interface{}) Condition {
+	return &lessThanCondition{
+		field: field,
+		val:   val,
+	}
+}
+
+type lessThanCondition struct {
+	field string
+	val   interface{}
+}
+
+func (c *lessThanCondition) GetQueryPart() string {
+	return fmt.Sprintf("%s < ?", c.field)
+}
+
+func (c *lessThanCondition) GetQueryArgs() ([]interface{}, bool) {
+	return []interface{}{c.val}, true
+}
+
 func NewInConditionForSubQuery(field, subQuery string, args []interface{}) Condition {
 	return &inCondition{
 		field:       field,
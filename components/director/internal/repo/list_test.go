@@ -68,6 +68,23 @@ func TestList(t *testing.T) {
 		assert.Len(t, dest, 1)
 	})
 
+	t.Run("lists items successfully with less than condition", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND age < $2`)).
+			WithArgs(givenTenant, 50).WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		err := sut.List(ctx, givenTenant, &dest, repo.NewLessThanCondition("age", 50))
+		require.NoError(t, err)
+		assert.Len(t, dest, 1)
+		assert.Contains(t, dest, peter)
+	})
+
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
 		err := sut.List(ctx, givenTenant, nil)
@@ -35,6 +35,20 @@ func (_m *StatusUpdateRepository) IsConnected(ctx context.Context, id string, ob
 	return r0, r1
 }
 
+// UpdateLastSeen provides a mock function with given fields: ctx, id, object
+func (_m *StatusUpdateRepository) UpdateLastSeen(ctx context.Context, id string, object statusupdate.WithStatusObject) error {
+	ret := _m.Called(ctx, id, object)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, statusupdate.WithStatusObject) error); ok {
+		r0 = rf(ctx, id, object)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateStatus provides a mock function with given fields: ctx, id, object
 func (_m *StatusUpdateRepository) UpdateStatus(ctx context.Context, id string, object statusupdate.WithStatusObject) error {
 	ret := _m.Called(ctx, id, object)
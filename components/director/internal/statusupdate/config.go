@@ -0,0 +1,32 @@
+package statusupdate
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Config struct {
+	// How often the last seen timestamp of a consumer is written to the database at most
+	LastSeenUpdateInterval time.Duration `envconfig:"default=1m,APP_STATUS_LAST_SEEN_UPDATE_INTERVAL"`
+	// How often the silent consumers are looked up. 0 disables automatic status transitions
+	CheckPeriod time.Duration `envconfig:"default=1m,APP_STATUS_CHECK_PERIOD"`
+	// Silence after which a connected consumer becomes DISCONNECTED. 0 disables the transition
+	DisconnectedAfter time.Duration `envconfig:"default=10m,APP_STATUS_DISCONNECTED_AFTER"`
+	// Silence after which a connected or disconnected consumer becomes FAILED. 0 disables the transition
+	FailedAfter time.Duration `envconfig:"default=24h,APP_STATUS_FAILED_AFTER"`
+}
+
+func (c Config) Validate() error {
+	if c.DisconnectedAfter != 0 && c.DisconnectedAfter <= c.LastSeenUpdateInterval {
+		return errors.New("disconnected after period must be longer than last seen update interval")
+	}
+	if c.FailedAfter != 0 && c.FailedAfter <= c.LastSeenUpdateInterval {
+		return errors.New("failed after period must be longer than last seen update interval")
+	}
+	if c.DisconnectedAfter != 0 && c.FailedAfter != 0 && c.FailedAfter <= c.DisconnectedAfter {
+		return errors.New("failed after period must be longer than disconnected after period")
+	}
+
+	return nil
+}
@@ -5,3 +5,18 @@ import "time"
 func (r *repository) SetTimestampGen(timestampGen func() time.Time) {
 	r.timestampGen = timestampGen
 }
+
+func (u *update) SetTimestampGen(timestampGen func() time.Time) {
+	u.timestampGen = timestampGen
+}
+
+func (u *update) LastSeenEntries() int {
+	u.lastSeenMutex.Lock()
+	defer u.lastSeenMutex.Unlock()
+
+	return len(u.lastSeen)
+}
+
+func (e *inactivityEnforcer) SetTimestampGen(timestampGen func() time.Time) {
+	e.timestampGen = timestampGen
+}
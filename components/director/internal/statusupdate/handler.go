@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
 	"github.com/pkg/errors"
 
@@ -15,14 +19,21 @@ import (
 )
 
 type update struct {
-	transact persistence.Transactioner
-	repo     StatusUpdateRepository
-	logger   *log.Logger
+	transact         persistence.Transactioner
+	repo             StatusUpdateRepository
+	lastSeenInterval time.Duration
+	timestampGen     timestamp.Generator
+	logger           *log.Logger
+
+	lastSeenMutex  sync.Mutex
+	lastSeen       map[string]time.Time
+	lastSeenPruned time.Time
 }
 
 //go:generate mockery -name=StatusUpdateRepository -output=automock -outpkg=automock -case=underscore
 type StatusUpdateRepository interface {
 	UpdateStatus(ctx context.Context, id string, object WithStatusObject) error
+	UpdateLastSeen(ctx context.Context, id string, object WithStatusObject) error
 	IsConnected(ctx context.Context, id string, object WithStatusObject) (bool, error)
 }
 
@@ -33,11 +44,16 @@ const (
 	Runtimes     WithStatusObject = "runtimes"
 )
 
-func New(transact persistence.Transactioner, repo StatusUpdateRepository, logger *log.Logger) *update {
+// New returns a middleware which marks the calling applications and runtimes as CONNECTED and records when they were last seen.
+// The last seen timestamp of a consumer is written at most once per lastSeenInterval by a single Director instance.
+func New(transact persistence.Transactioner, repo StatusUpdateRepository, lastSeenInterval time.Duration, logger *log.Logger) *update {
 	return &update{
-		transact: transact,
-		repo:     repo,
-		logger:   logger,
+		transact:         transact,
+		repo:             repo,
+		lastSeenInterval: lastSeenInterval,
+		timestampGen:     timestamp.DefaultGenerator(),
+		logger:           logger,
+		lastSeen:         make(map[string]time.Time),
 	}
 }
 
@@ -61,6 +77,12 @@ func (u *update) Handler() func(next http.Handler) http.Handler {
 				return
 			}
 
+			lastSeenKey := string(object) + "/" + consumerInfo.ConsumerID
+			if u.recentlySeen(lastSeenKey) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			tx, err := u.transact.Begin()
 			if err != nil {
 				u.logger.Error(errors.Wrap(err, "while opening transaction").Error())
@@ -85,6 +107,13 @@ func (u *update) Handler() func(next http.Handler) http.Handler {
 					next.ServeHTTP(w, r)
 					return
 				}
+			} else {
+				err = u.repo.UpdateLastSeen(ctxWithDB, consumerInfo.ConsumerID, object)
+				if err != nil {
+					u.logger.Error(errors.Wrap(err, "while updating last seen timestamp").Error())
+					next.ServeHTTP(w, r)
+					return
+				}
 			}
 
 			if err := tx.Commit(); err != nil {
@@ -92,11 +121,41 @@ func (u *update) Handler() func(next http.Handler) http.Handler {
 				next.ServeHTTP(w, r)
 				return
 			}
+
+			u.markSeen(lastSeenKey)
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// recentlySeen checks whether the last seen timestamp of the consumer was recorded within the last seen interval
+func (u *update) recentlySeen(key string) bool {
+	u.lastSeenMutex.Lock()
+	defer u.lastSeenMutex.Unlock()
+
+	seen, ok := u.lastSeen[key]
+	return ok && u.timestampGen().Sub(seen) < u.lastSeenInterval
+}
+
+// markSeen records that the last seen timestamp of the consumer was written. Once per last seen interval, the entries
+// older than the interval are evicted, so that consumers which stopped calling the Director are not kept in memory
+func (u *update) markSeen(key string) {
+	u.lastSeenMutex.Lock()
+	defer u.lastSeenMutex.Unlock()
+
+	now := u.timestampGen()
+	if now.Sub(u.lastSeenPruned) >= u.lastSeenInterval {
+		for seenKey, seen := range u.lastSeen {
+			if now.Sub(seen) >= u.lastSeenInterval {
+				delete(u.lastSeen, seenKey)
+			}
+		}
+		u.lastSeenPruned = now
+	}
+
+	u.lastSeen[key] = now
+}
+
 type errorResponse struct {
 	Errors []gqlError `json:"errors"`
 }
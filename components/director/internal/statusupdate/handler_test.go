@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -98,11 +99,12 @@ func TestUpdate_Handler(t *testing.T) {
 			MockNextHandler:  fixNextHandler(t, testID, consumer.Runtime),
 		},
 		{
-			Name: "In case of application already connected do nothing and execute next handler",
+			Name: "In case of application already connected update last seen timestamp and execute next handler",
 			TxFn: txGen.ThatSucceeds,
 			RepoFn: func() *automock.StatusUpdateRepository {
 				repo := automock.StatusUpdateRepository{}
 				repo.On("IsConnected", mock.Anything, testID, statusupdate.Applications).Return(true, nil)
+				repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), testID, statusupdate.Applications).Return(nil)
 				return &repo
 			},
 			Request:          createRequestWithClaims(t, testID, consumer.Application),
@@ -111,11 +113,12 @@ func TestUpdate_Handler(t *testing.T) {
 			MockNextHandler:  fixNextHandler(t, testID, consumer.Application),
 		},
 		{
-			Name: "In case of runtime already connected do nothing and execute next handler",
+			Name: "In case of runtime already connected update last seen timestamp and execute next handler",
 			TxFn: txGen.ThatSucceeds,
 			RepoFn: func() *automock.StatusUpdateRepository {
 				repo := automock.StatusUpdateRepository{}
 				repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(true, nil)
+				repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(nil)
 				return &repo
 			},
 			Request:          createRequestWithClaims(t, testID, consumer.Runtime),
@@ -166,6 +169,7 @@ func TestUpdate_Handler(t *testing.T) {
 			RepoFn: func() *automock.StatusUpdateRepository {
 				repo := automock.StatusUpdateRepository{}
 				repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.Applications).Return(true, nil)
+				repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), testID, statusupdate.Applications).Return(nil)
 				return &repo
 			},
 			Request:         createRequestWithClaims(t, testID, consumer.Application),
@@ -187,6 +191,20 @@ func TestUpdate_Handler(t *testing.T) {
 			ExpectedLog:     *bytes.NewBufferString("while updating status: test"),
 			MockNextHandler: fixNextHandler(t, testID, consumer.Application),
 		},
+		{
+			Name: "Error when updating last seen timestamp",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.StatusUpdateRepository {
+				repo := automock.StatusUpdateRepository{}
+				repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(true, nil)
+				repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(testErr)
+				return &repo
+			},
+			Request:         createRequestWithClaims(t, testID, consumer.Runtime),
+			ExpectedStatus:  http.StatusOK,
+			ExpectedLog:     *bytes.NewBufferString("while updating last seen timestamp: test"),
+			MockNextHandler: fixNextHandler(t, testID, consumer.Runtime),
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
@@ -198,7 +216,7 @@ func TestUpdate_Handler(t *testing.T) {
 				DisableTimestamp: true,
 			})
 			logger.SetOutput(&actualLog)
-			update := statusupdate.New(transact, repo, logger)
+			update := statusupdate.New(transact, repo, time.Minute, logger)
 
 			// WHEN
 			rr := httptest.NewRecorder()
@@ -222,6 +240,83 @@ func TestUpdate_Handler(t *testing.T) {
 
 }
 
+func TestUpdate_Handler_ThrottlesLastSeenUpdates(t *testing.T) {
+	//given
+	now := time.Date(2020, 12, 15, 10, 0, 0, 0, time.UTC)
+
+	persist := &persistenceautomock.PersistenceTx{}
+	persist.On("Commit").Return(nil).Twice()
+	defer persist.AssertExpectations(t)
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persist, nil).Twice()
+	transact.On("RollbackUnlessCommitted", persist).Return().Twice()
+	defer transact.AssertExpectations(t)
+
+	repo := &automock.StatusUpdateRepository{}
+	repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(false, nil).Once()
+	repo.On("UpdateStatus", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(nil).Once()
+	repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(true, nil).Once()
+	repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(nil).Once()
+	defer repo.AssertExpectations(t)
+
+	update := statusupdate.New(transact, repo, time.Minute, logrus.New())
+	updateHandler := update.Handler()(fixNextHandler(t, testID, consumer.Runtime))
+
+	serve := func(at time.Time) {
+		update.SetTimestampGen(func() time.Time { return at })
+		rr := httptest.NewRecorder()
+		updateHandler.ServeHTTP(rr, createRequestWithClaims(t, testID, consumer.Runtime))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "OK", rr.Body.String())
+	}
+
+	//when
+	serve(now)
+	serve(now.Add(30 * time.Second))
+	serve(now.Add(time.Minute))
+
+	//then
+	// the call within the last seen interval is expected not to touch the database
+}
+
+func TestUpdate_Handler_EvictsStaleLastSeenEntries(t *testing.T) {
+	//given
+	now := time.Date(2020, 12, 15, 10, 0, 0, 0, time.UTC)
+	otherID := "bar"
+
+	persist := &persistenceautomock.PersistenceTx{}
+	persist.On("Commit").Return(nil).Times(3)
+	defer persist.AssertExpectations(t)
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persist, nil).Times(3)
+	transact.On("RollbackUnlessCommitted", persist).Return().Times(3)
+	defer transact.AssertExpectations(t)
+
+	repo := &automock.StatusUpdateRepository{}
+	repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(true, nil).Once()
+	repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), testID, statusupdate.Runtimes).Return(nil).Once()
+	repo.On("IsConnected", txtest.CtxWithDBMatcher(), otherID, statusupdate.Runtimes).Return(true, nil).Twice()
+	repo.On("UpdateLastSeen", txtest.CtxWithDBMatcher(), otherID, statusupdate.Runtimes).Return(nil).Twice()
+	defer repo.AssertExpectations(t)
+
+	update := statusupdate.New(transact, repo, time.Minute, logrus.New())
+
+	serve := func(id string, at time.Time) {
+		update.SetTimestampGen(func() time.Time { return at })
+		rr := httptest.NewRecorder()
+		update.Handler()(fixNextHandler(t, id, consumer.Runtime)).ServeHTTP(rr, createRequestWithClaims(t, id, consumer.Runtime))
+		assert.Equal(t, http.StatusOK, rr.Code)
+	}
+
+	//when
+	serve(testID, now)
+	serve(otherID, now.Add(30*time.Second))
+	serve(otherID, now.Add(2*time.Minute))
+
+	//then
+	assert.Equal(t, 1, update.LastSeenEntries())
+}
+
 func createRequestWithClaims(t *testing.T, id string, consumerType consumer.ConsumerType) *http.Request {
 	req := http.Request{}
 	apiConsumer := consumer.Consumer{ConsumerID: id, ConsumerType: consumerType}
@@ -0,0 +1,76 @@
+package statusupdate
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=InactivityRepository -output=automock -outpkg=automock -case=underscore
+type InactivityRepository interface {
+	MarkDisconnected(ctx context.Context, object WithStatusObject, notSeenSince time.Time) (int, error)
+	MarkFailed(ctx context.Context, object WithStatusObject, notSeenSince time.Time) (int, error)
+}
+
+var inactivityObjects = []WithStatusObject{Applications, Runtimes}
+
+type inactivityEnforcer struct {
+	transact          persistence.Transactioner
+	repo              InactivityRepository
+	disconnectedAfter time.Duration
+	failedAfter       time.Duration
+	timestampGen      timestamp.Generator
+}
+
+// NewInactivityEnforcer returns an enforcer which changes the status of the applications and runtimes
+// that stopped calling the Director to DISCONNECTED or FAILED.
+func NewInactivityEnforcer(transact persistence.Transactioner, repo InactivityRepository, cfg Config) *inactivityEnforcer {
+	return &inactivityEnforcer{
+		transact:          transact,
+		repo:              repo,
+		disconnectedAfter: cfg.DisconnectedAfter,
+		failedAfter:       cfg.FailedAfter,
+		timestampGen:      timestamp.DefaultGenerator(),
+	}
+}
+
+// MarkInactive updates the status of the applications and runtimes which were not seen for the configured periods
+// and returns the number of updated objects. Objects silent for longer than the failed period are moved directly to FAILED.
+func (e *inactivityEnforcer) MarkInactive(ctx context.Context) (int, error) {
+	tx, err := e.transact.Begin()
+	if err != nil {
+		return 0, errors.Wrap(err, "while opening transaction")
+	}
+	defer e.transact.RollbackUnlessCommitted(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	now := e.timestampGen()
+	updated := 0
+	for _, object := range inactivityObjects {
+		if e.failedAfter != 0 {
+			failed, err := e.repo.MarkFailed(ctx, object, now.Add(-e.failedAfter))
+			if err != nil {
+				return 0, errors.Wrapf(err, "while marking %s as failed", object)
+			}
+			updated += failed
+		}
+
+		if e.disconnectedAfter != 0 {
+			disconnected, err := e.repo.MarkDisconnected(ctx, object, now.Add(-e.disconnectedAfter))
+			if err != nil {
+				return 0, errors.Wrapf(err, "while marking %s as disconnected", object)
+			}
+			updated += disconnected
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "while committing transaction")
+	}
+
+	return updated, nil
+}
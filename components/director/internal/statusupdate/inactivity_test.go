@@ -0,0 +1,185 @@
+package statusupdate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/statusupdate"
+	"github.com/kyma-incubator/compass/components/director/internal/statusupdate/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInactivityEnforcer_MarkInactive(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	now := time.Date(2020, 12, 15, 10, 0, 0, 0, time.UTC)
+	cfg := statusupdate.Config{
+		DisconnectedAfter: 10 * time.Minute,
+		FailedAfter:       24 * time.Hour,
+	}
+	disconnectedSince := now.Add(-cfg.DisconnectedAfter)
+	failedSince := now.Add(-cfg.FailedAfter)
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txGen.ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.InactivityRepository{}
+		repo.On("MarkFailed", txtest.CtxWithDBMatcher(), statusupdate.Applications, failedSince).Return(1, nil).Once()
+		repo.On("MarkDisconnected", txtest.CtxWithDBMatcher(), statusupdate.Applications, disconnectedSince).Return(2, nil).Once()
+		repo.On("MarkFailed", txtest.CtxWithDBMatcher(), statusupdate.Runtimes, failedSince).Return(0, nil).Once()
+		repo.On("MarkDisconnected", txtest.CtxWithDBMatcher(), statusupdate.Runtimes, disconnectedSince).Return(3, nil).Once()
+		defer repo.AssertExpectations(t)
+
+		enforcer := statusupdate.NewInactivityEnforcer(transact, repo, cfg)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		updated, err := enforcer.MarkInactive(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 6, updated)
+	})
+
+	t.Run("Skips disabled transitions", func(t *testing.T) {
+		persistTx, transact := txGen.ThatSucceeds()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.InactivityRepository{}
+		repo.On("MarkDisconnected", txtest.CtxWithDBMatcher(), statusupdate.Applications, disconnectedSince).Return(1, nil).Once()
+		repo.On("MarkDisconnected", txtest.CtxWithDBMatcher(), statusupdate.Runtimes, disconnectedSince).Return(1, nil).Once()
+		defer repo.AssertExpectations(t)
+
+		enforcer := statusupdate.NewInactivityEnforcer(transact, repo, statusupdate.Config{DisconnectedAfter: cfg.DisconnectedAfter})
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		updated, err := enforcer.MarkInactive(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+	})
+
+	t.Run("Error when marking as failed", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.InactivityRepository{}
+		repo.On("MarkFailed", txtest.CtxWithDBMatcher(), statusupdate.Applications, failedSince).Return(0, testErr).Once()
+		defer repo.AssertExpectations(t)
+
+		enforcer := statusupdate.NewInactivityEnforcer(transact, repo, cfg)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := enforcer.MarkInactive(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, "while marking applications as failed: test")
+	})
+
+	t.Run("Error when marking as disconnected", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.InactivityRepository{}
+		repo.On("MarkFailed", txtest.CtxWithDBMatcher(), statusupdate.Applications, failedSince).Return(0, nil).Once()
+		repo.On("MarkDisconnected", txtest.CtxWithDBMatcher(), statusupdate.Applications, disconnectedSince).Return(0, testErr).Once()
+		defer repo.AssertExpectations(t)
+
+		enforcer := statusupdate.NewInactivityEnforcer(transact, repo, cfg)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := enforcer.MarkInactive(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, "while marking applications as disconnected: test")
+	})
+
+	t.Run("Error when opening transaction", func(t *testing.T) {
+		persistTx, transact := txGen.ThatFailsOnBegin()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		enforcer := statusupdate.NewInactivityEnforcer(transact, &automock.InactivityRepository{}, cfg)
+
+		// WHEN
+		_, err := enforcer.MarkInactive(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, "while opening transaction: test")
+	})
+
+	t.Run("Error when committing transaction", func(t *testing.T) {
+		persistTx, transact := txGen.ThatFailsOnCommit()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact)
+
+		repo := &automock.InactivityRepository{}
+		repo.On("MarkFailed", txtest.CtxWithDBMatcher(), mock.Anything, failedSince).Return(0, nil).Twice()
+		repo.On("MarkDisconnected", txtest.CtxWithDBMatcher(), mock.Anything, disconnectedSince).Return(0, nil).Twice()
+		defer repo.AssertExpectations(t)
+
+		enforcer := statusupdate.NewInactivityEnforcer(transact, repo, cfg)
+		enforcer.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := enforcer.MarkInactive(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, "while committing transaction: test")
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        statusupdate.Config
+		ExpectedError string
+	}{
+		{
+			Name:   "Valid",
+			Config: statusupdate.Config{LastSeenUpdateInterval: time.Minute, DisconnectedAfter: 10 * time.Minute, FailedAfter: 24 * time.Hour},
+		},
+		{
+			Name:   "Valid with disabled transitions",
+			Config: statusupdate.Config{LastSeenUpdateInterval: time.Minute},
+		},
+		{
+			Name:          "Disconnected after shorter than last seen update interval",
+			Config:        statusupdate.Config{LastSeenUpdateInterval: time.Minute, DisconnectedAfter: 30 * time.Second},
+			ExpectedError: "disconnected after period must be longer than last seen update interval",
+		},
+		{
+			Name:          "Failed after shorter than last seen update interval",
+			Config:        statusupdate.Config{LastSeenUpdateInterval: time.Minute, FailedAfter: time.Minute},
+			ExpectedError: "failed after period must be longer than last seen update interval",
+		},
+		{
+			Name:          "Failed after shorter than disconnected after",
+			Config:        statusupdate.Config{LastSeenUpdateInterval: time.Minute, DisconnectedAfter: time.Hour, FailedAfter: 10 * time.Minute},
+			ExpectedError: "failed after period must be longer than disconnected after period",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Config.Validate()
+
+			// THEN
+			if testCase.ExpectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, testCase.ExpectedError)
+			}
+		})
+	}
+}
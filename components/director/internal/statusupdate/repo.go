@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
@@ -13,8 +14,11 @@ import (
 )
 
 const (
-	updateQuery = "UPDATE public.%s SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = $2"
-	existsQuery = "SELECT 1 FROM public.%s WHERE id = $1 AND status_condition = 'CONNECTED'"
+	updateQuery         = "UPDATE public.%s SET status_condition = 'CONNECTED', status_timestamp = $1, last_seen_timestamp = $1 WHERE id = $2"
+	updateLastSeenQuery = "UPDATE public.%s SET last_seen_timestamp = $1 WHERE id = $2"
+	existsQuery         = "SELECT 1 FROM public.%s WHERE id = $1 AND status_condition = 'CONNECTED'"
+	disconnectQuery     = "UPDATE public.%s SET status_condition = 'DISCONNECTED', status_timestamp = $1 WHERE status_condition = 'CONNECTED' AND last_seen_timestamp < $2"
+	failQuery           = "UPDATE public.%s SET status_condition = 'FAILED', status_timestamp = $1 WHERE status_condition IN ('CONNECTED', 'DISCONNECTED') AND last_seen_timestamp < $2"
 )
 
 type repository struct {
@@ -43,6 +47,22 @@ func (r *repository) UpdateStatus(ctx context.Context, id string, object WithSta
 	return nil
 }
 
+func (r *repository) UpdateLastSeen(ctx context.Context, id string, object WithStatusObject) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	stmt := fmt.Sprintf(updateLastSeenQuery, object)
+
+	_, err = persist.Exec(stmt, r.timestampGen(), id)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("while updating %s last seen timestamp", object))
+	}
+
+	return nil
+}
+
 func (r *repository) IsConnected(ctx context.Context, id string, object WithStatusObject) (bool, error) {
 
 	persist, err := persistence.FromCtx(ctx)
@@ -64,3 +84,34 @@ func (r *repository) IsConnected(ctx context.Context, id string, object WithStat
 	return true, nil
 
 }
+
+// MarkDisconnected moves the connected objects which were not seen since the given time to DISCONNECTED
+func (r *repository) MarkDisconnected(ctx context.Context, object WithStatusObject, notSeenSince time.Time) (int, error) {
+	return r.markNotSeenSince(ctx, disconnectQuery, object, notSeenSince)
+}
+
+// MarkFailed moves the connected and disconnected objects which were not seen since the given time to FAILED
+func (r *repository) MarkFailed(ctx context.Context, object WithStatusObject, notSeenSince time.Time) (int, error) {
+	return r.markNotSeenSince(ctx, failQuery, object, notSeenSince)
+}
+
+func (r *repository) markNotSeenSince(ctx context.Context, query string, object WithStatusObject, notSeenSince time.Time) (int, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	stmt := fmt.Sprintf(query, object)
+
+	res, err := persist.Exec(stmt, r.timestampGen(), notSeenSince)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("while updating %s status", object))
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "while checking affected rows")
+	}
+
+	return int(affected), nil
+}
@@ -112,7 +112,7 @@ func TestRepository_UpdateStatus(t *testing.T) {
 		//GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = $2`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET status_condition = 'CONNECTED', status_timestamp = $1, last_seen_timestamp = $1 WHERE id = $2`)).
 			WithArgs(timestamp, testID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -128,7 +128,7 @@ func TestRepository_UpdateStatus(t *testing.T) {
 		//GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = $2`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'CONNECTED', status_timestamp = $1, last_seen_timestamp = $1 WHERE id = $2`)).
 			WithArgs(timestamp, testID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -144,7 +144,7 @@ func TestRepository_UpdateStatus(t *testing.T) {
 		//GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = $2`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET status_condition = 'CONNECTED', status_timestamp = $1, last_seen_timestamp = $1 WHERE id = $2`)).
 			WithArgs(timestamp, testID).
 			WillReturnError(testError)
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -161,7 +161,7 @@ func TestRepository_UpdateStatus(t *testing.T) {
 		//GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = $2`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'CONNECTED', status_timestamp = $1, last_seen_timestamp = $1 WHERE id = $2`)).
 			WithArgs(timestamp, testID).
 			WillReturnError(testError)
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -173,3 +173,130 @@ func TestRepository_UpdateStatus(t *testing.T) {
 		require.EqualError(t, err, fmt.Sprintf("while updating runtimes status: %s", testError.Error()))
 	})
 }
+
+func TestRepository_UpdateLastSeen(t *testing.T) {
+	timestamp := time.Now()
+	repo := statusupdate.NewRepository()
+	repo.SetTimestampGen(func() time.Time { return timestamp })
+	testError := errors.New("test")
+
+	t.Run("Success for runtimes", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET last_seen_timestamp = $1 WHERE id = $2`)).
+			WithArgs(timestamp, testID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		err := repo.UpdateLastSeen(ctx, testID, "runtimes")
+
+		//THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error for applications", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET last_seen_timestamp = $1 WHERE id = $2`)).
+			WithArgs(timestamp, testID).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		err := repo.UpdateLastSeen(ctx, testID, "applications")
+
+		//THEN
+		require.EqualError(t, err, fmt.Sprintf("while updating applications last seen timestamp: %s", testError.Error()))
+	})
+}
+
+func TestRepository_MarkDisconnected(t *testing.T) {
+	timestamp := time.Now()
+	notSeenSince := timestamp.Add(-10 * time.Minute)
+	repo := statusupdate.NewRepository()
+	repo.SetTimestampGen(func() time.Time { return timestamp })
+	testError := errors.New("test")
+
+	t.Run("Success for runtimes", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'DISCONNECTED', status_timestamp = $1 WHERE status_condition = 'CONNECTED' AND last_seen_timestamp < $2`)).
+			WithArgs(timestamp, notSeenSince).
+			WillReturnResult(sqlmock.NewResult(-1, 3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		updated, err := repo.MarkDisconnected(ctx, "runtimes", notSeenSince)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, 3, updated)
+	})
+
+	t.Run("Error for applications", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET status_condition = 'DISCONNECTED', status_timestamp = $1 WHERE status_condition = 'CONNECTED' AND last_seen_timestamp < $2`)).
+			WithArgs(timestamp, notSeenSince).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		_, err := repo.MarkDisconnected(ctx, "applications", notSeenSince)
+
+		//THEN
+		require.EqualError(t, err, fmt.Sprintf("while updating applications status: %s", testError.Error()))
+	})
+}
+
+func TestRepository_MarkFailed(t *testing.T) {
+	timestamp := time.Now()
+	notSeenSince := timestamp.Add(-24 * time.Hour)
+	repo := statusupdate.NewRepository()
+	repo.SetTimestampGen(func() time.Time { return timestamp })
+	testError := errors.New("test")
+
+	t.Run("Success for applications", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET status_condition = 'FAILED', status_timestamp = $1 WHERE status_condition IN ('CONNECTED', 'DISCONNECTED') AND last_seen_timestamp < $2`)).
+			WithArgs(timestamp, notSeenSince).
+			WillReturnResult(sqlmock.NewResult(-1, 2))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		updated, err := repo.MarkFailed(ctx, "applications", notSeenSince)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+	})
+
+	t.Run("Error for runtimes", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'FAILED', status_timestamp = $1 WHERE status_condition IN ('CONNECTED', 'DISCONNECTED') AND last_seen_timestamp < $2`)).
+			WithArgs(timestamp, notSeenSince).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		_, err := repo.MarkFailed(ctx, "runtimes", notSeenSince)
+
+		//THEN
+		require.EqualError(t, err, fmt.Sprintf("while updating runtimes status: %s", testError.Error()))
+	})
+}
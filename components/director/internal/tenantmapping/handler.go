@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -39,6 +40,11 @@ type TenantRepository interface {
 	GetByExternalTenant(ctx context.Context, externalTenant string) (*model.BusinessTenantMapping, error)
 }
 
+//go:generate mockery -name=RoleRepository -output=automock -outpkg=automock -case=underscore
+type RoleRepository interface {
+	ListForSubjects(ctx context.Context, tenant string, subjects []model.RoleBindingSubject) ([]*model.Role, error)
+}
+
 type LoggerKey = struct{}
 
 type Handler struct {
@@ -114,6 +120,7 @@ func (h Handler) processRequest(ctx context.Context, reqData oathkeeper.ReqData)
 	reqData.Body.Extra["scope"] = objCtx.Scopes
 	reqData.Body.Extra["consumerID"] = objCtx.ConsumerID
 	reqData.Body.Extra["consumerType"] = objCtx.ConsumerType
+	reqData.Body.Extra["consumerGroups"] = strings.Join(objCtx.ConsumerGroups, " ")
 
 	return reqData.Body
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 
@@ -15,11 +16,12 @@ import (
 	"github.com/pkg/errors"
 )
 
-func NewMapperForSystemAuth(systemAuthSvc systemauth.SystemAuthService, scopesGetter ScopesGetter, tenantRepo TenantRepository) *mapperForSystemAuth {
+func NewMapperForSystemAuth(systemAuthSvc systemauth.SystemAuthService, scopesGetter ScopesGetter, tenantRepo TenantRepository, roleRepo RoleRepository) *mapperForSystemAuth {
 	return &mapperForSystemAuth{
 		systemAuthSvc: systemAuthSvc,
 		scopesGetter:  scopesGetter,
 		tenantRepo:    tenantRepo,
+		roleRepo:      roleRepo,
 	}
 }
 
@@ -27,6 +29,7 @@ type mapperForSystemAuth struct {
 	systemAuthSvc systemauth.SystemAuthService
 	scopesGetter  ScopesGetter
 	tenantRepo    TenantRepository
+	roleRepo      RoleRepository
 }
 
 func (m *mapperForSystemAuth) GetObjectContext(ctx context.Context, reqData oathkeeper.ReqData, authID string, authFlow oathkeeper.AuthFlow) (ObjectContext, error) {
@@ -37,6 +40,14 @@ func (m *mapperForSystemAuth) GetObjectContext(ctx context.Context, reqData oath
 		return ObjectContext{}, errors.Wrap(err, "while retrieving system auth from database")
 	}
 
+	if sysAuth.IsExpired(time.Now()) {
+		return ObjectContext{}, errors.Errorf("system auth with id %s has expired", sysAuth.ID)
+	}
+
+	if err := verifyCertificateBinding(reqData, sysAuth, authFlow); err != nil {
+		return ObjectContext{}, err
+	}
+
 	refObjType, err := sysAuth.GetReferenceObjectType()
 	if err != nil {
 		return ObjectContext{}, errors.Wrapf(err, "while getting reference object type for system auth id %s", sysAuth.ID)
@@ -60,6 +71,14 @@ func (m *mapperForSystemAuth) GetObjectContext(ctx context.Context, reqData oath
 	}
 	log.Debugf("Successfully got tenant context - external ID: %s, internal ID: %s", tenantCtx.ExternalTenantID, tenantCtx.TenantID)
 
+	if tenantCtx.TenantID != "" {
+		subjects := []model.RoleBindingSubject{{Type: model.RoleBindingSubjectTypeSystemAuth, Name: sysAuth.ID}}
+		scopes, err = addScopesFromRoles(ctx, m.roleRepo, tenantCtx.TenantID, scopes, subjects)
+		if err != nil {
+			return ObjectContext{}, errors.Wrapf(err, "while getting scopes from roles for system auth with id: %s", sysAuth.ID)
+		}
+	}
+
 	refObjID, err := sysAuth.GetReferenceObjectID()
 	if err != nil {
 		return ObjectContext{}, errors.Wrap(err, "while getting reference object id")
@@ -173,6 +192,39 @@ func (m *mapperForSystemAuth) getTenantAndScopesForApplicationOrRuntime(ctx cont
 	return NewTenantContext(externalTenantID, *sysAuth.TenantID), scopes, nil
 }
 
+// verifyCertificateBinding checks that a system auth with certificate credential is used with an access token bound
+// to the registered certificate and that the same certificate is presented in the request, as defined in RFC 8705
+func verifyCertificateBinding(reqData oathkeeper.ReqData, sysAuth *model.SystemAuth, authFlow oathkeeper.AuthFlow) error {
+	if sysAuth.Value == nil || sysAuth.Value.Credential.Certificate == nil {
+		return nil
+	}
+	registeredThumbprint := sysAuth.Value.Credential.Certificate.Thumbprint
+
+	if !authFlow.IsOAuth2Flow() {
+		return errors.Errorf("system auth with id %s requires certificate-bound access token", sysAuth.ID)
+	}
+
+	tokenThumbprint, err := reqData.GetCertificateThumbprint()
+	if err != nil {
+		return errors.Wrap(err, "while fetching certificate thumbprint from access token")
+	}
+
+	if tokenThumbprint != registeredThumbprint {
+		return errors.Errorf("access token is not bound to the certificate of system auth with id %s", sysAuth.ID)
+	}
+
+	presentedThumbprint, err := reqData.GetClientCertificateThumbprint()
+	if err != nil {
+		return errors.Wrap(err, "while fetching thumbprint of client certificate")
+	}
+
+	if presentedThumbprint != registeredThumbprint {
+		return errors.Errorf("client certificate does not match the certificate of system auth with id %s", sysAuth.ID)
+	}
+
+	return nil
+}
+
 func buildPath(refObjectType model.SystemAuthReferenceObjectType) string {
 	lowerCaseType := strings.ToLower(string(refObjectType))
 	transformedObjType := strings.ReplaceAll(lowerCaseType, " ", "_")
@@ -3,8 +3,10 @@ package tenantmapping_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -39,7 +41,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		scopesGetterMock := getScopesGetterMock()
 		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return(expectedScopes, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock, nil, getRoleRepoMockWithoutRoles())
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.CertificateFlow)
 
@@ -82,7 +84,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -96,6 +98,90 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, tenantRepoMock)
 	})
 
+	t.Run("returns scopes extended with the scopes of the Roles bound to the SystemAuth", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		expectedTenantID := uuid.New()
+		expectedExternalTenantID := uuid.New().String()
+		sysAuth := &model.SystemAuth{
+			ID:                  authID.String(),
+			IntegrationSystemID: str.Ptr(refObjID.String()),
+		}
+		tenantMappingModel := &model.BusinessTenantMapping{
+			ID:             expectedTenantID.String(),
+			ExternalTenant: expectedExternalTenantID,
+		}
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.ExternalTenantKey: expectedExternalTenantID,
+					oathkeeper.ScopesKey:         "application:read",
+				},
+			},
+		}
+		roles := []*model.Role{{Scopes: []string{"application:read", "label_definition:write"}}}
+		subjects := []model.RoleBindingSubject{{Type: model.RoleBindingSubjectTypeSystemAuth, Name: authID.String()}}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID).Return(tenantMappingModel, nil).Once()
+
+		roleRepoMock := &tenantmappingmock.RoleRepository{}
+		roleRepoMock.On("ListForSubjects", mock.Anything, expectedTenantID.String(), subjects).Return(roles, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, tenantRepoMock, roleRepoMock)
+
+		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedTenantID.String(), objCtx.TenantID)
+		require.Equal(t, "application:read label_definition:write", objCtx.Scopes)
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, tenantRepoMock, roleRepoMock)
+	})
+
+	t.Run("returns error when unable to list the Roles bound to the SystemAuth", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		expectedTenantID := uuid.New()
+		expectedExternalTenantID := uuid.New().String()
+		sysAuth := &model.SystemAuth{
+			ID:                  authID.String(),
+			IntegrationSystemID: str.Ptr(refObjID.String()),
+		}
+		tenantMappingModel := &model.BusinessTenantMapping{
+			ID:             expectedTenantID.String(),
+			ExternalTenant: expectedExternalTenantID,
+		}
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.ExternalTenantKey: expectedExternalTenantID,
+					oathkeeper.ScopesKey:         "application:read",
+				},
+			},
+		}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID).Return(tenantMappingModel, nil).Once()
+
+		roleRepoMock := &tenantmappingmock.RoleRepository{}
+		roleRepoMock.On("ListForSubjects", mock.Anything, expectedTenantID.String(), mock.Anything).Return(nil, errors.New("some-error")).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, tenantRepoMock, roleRepoMock)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.EqualError(t, err, fmt.Sprintf("while getting scopes from roles for system auth with id: %s: while listing Roles bound to the subjects: some-error", authID.String()))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, tenantRepoMock, roleRepoMock)
+	})
+
 	t.Run("returns tenant and scopes from the ReqData in the Application or Runtime SystemAuth case for OAuth2 flow", func(t *testing.T) {
 		authID := uuid.New()
 		refObjID := uuid.New()
@@ -117,7 +203,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, getRoleRepoMockWithoutRoles())
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -139,7 +225,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(&model.SystemAuth{}, errors.New("some-error")).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -158,7 +244,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -180,7 +266,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -210,7 +296,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -249,7 +335,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, externalTenantID).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, tenantRepoMock, nil)
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -278,7 +364,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -301,7 +387,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
 
@@ -327,7 +413,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		scopesGetterMock := getScopesGetterMock()
 		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return([]string{}, errors.New("some-error")).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.CertificateFlow)
 
@@ -335,6 +421,184 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 
 		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock)
 	})
+
+	t.Run("returns error when system auth has expired", func(t *testing.T) {
+		authID := uuid.New()
+		expiresAt := time.Now().Add(-time.Minute)
+		sysAuth := &model.SystemAuth{
+			ID:        authID.String(),
+			TenantID:  str.Ptr(uuid.New().String()),
+			AppID:     str.Ptr(uuid.New().String()),
+			ExpiresAt: &expiresAt,
+		}
+		reqData := oathkeeper.ReqData{}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.EqualError(t, err, fmt.Sprintf("system auth with id %s has expired", sysAuth.ID))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+
+	t.Run("returns tenant and scopes when access token is bound to the certificate of SystemAuth", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		expectedTenantID := uuid.New()
+		expectedScopes := "application:read"
+		sysAuth := fixCertificateSystemAuth(authID.String(), expectedTenantID.String(), refObjID.String())
+		reqData := fixCertificateBoundReqData(expectedScopes, certificateThumbprint, certificateData)
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, getRoleRepoMockWithoutRoles())
+
+		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedTenantID.String(), objCtx.TenantID)
+		require.Equal(t, expectedScopes, objCtx.Scopes)
+		require.Equal(t, refObjID.String(), objCtx.ConsumerID)
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+
+	t.Run("returns error when SystemAuth with certificate credential is used without access token", func(t *testing.T) {
+		authID := uuid.New()
+		sysAuth := fixCertificateSystemAuth(authID.String(), uuid.New().String(), uuid.New().String())
+		reqData := oathkeeper.ReqData{}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.CertificateFlow)
+
+		require.EqualError(t, err, fmt.Sprintf("system auth with id %s requires certificate-bound access token", sysAuth.ID))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+
+	t.Run("returns error when access token is not bound to any certificate", func(t *testing.T) {
+		authID := uuid.New()
+		sysAuth := fixCertificateSystemAuth(authID.String(), uuid.New().String(), uuid.New().String())
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.ScopesKey: "application:read",
+				},
+			},
+		}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.EqualError(t, err, "while fetching certificate thumbprint from access token: the key does not exist in the source object [key=cnf]")
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+
+	t.Run("returns error when access token is bound to a different certificate", func(t *testing.T) {
+		authID := uuid.New()
+		sysAuth := fixCertificateSystemAuth(authID.String(), uuid.New().String(), uuid.New().String())
+		reqData := fixCertificateBoundReqData("application:read", "other", certificateData)
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.EqualError(t, err, fmt.Sprintf("access token is not bound to the certificate of system auth with id %s", sysAuth.ID))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+
+	t.Run("returns error when client certificate is not presented", func(t *testing.T) {
+		authID := uuid.New()
+		sysAuth := fixCertificateSystemAuth(authID.String(), uuid.New().String(), uuid.New().String())
+		reqData := fixCertificateBoundReqData("application:read", certificateThumbprint, "")
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.EqualError(t, err, "while fetching thumbprint of client certificate: the key does not exist in the source object [key=Certificate-Data]")
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+
+	t.Run("returns error when presented client certificate differs from the one of SystemAuth", func(t *testing.T) {
+		authID := uuid.New()
+		sysAuth := fixCertificateSystemAuth(authID.String(), uuid.New().String(), uuid.New().String())
+		reqData := fixCertificateBoundReqData("application:read", certificateThumbprint, `Hash=0000;Subject="CN=foo"`)
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), oathkeeper.OAuth2Flow)
+
+		require.EqualError(t, err, fmt.Sprintf("client certificate does not match the certificate of system auth with id %s", sysAuth.ID))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+	})
+}
+
+const (
+	certificateThumbprint = "bwcK0esc3ACC3DB2Y5flESsXE8o9ltc05O89jdN-dg0"
+	certificateData       = `Hash=6f070ad1eb1cdc0082dc30766397e5112b1713ca3d96d734e4ef3d8dd37e760d;Subject="CN=foo"`
+)
+
+func fixCertificateSystemAuth(id, tenantID, appID string) *model.SystemAuth {
+	return &model.SystemAuth{
+		ID:       id,
+		TenantID: str.Ptr(tenantID),
+		AppID:    str.Ptr(appID),
+		Value: &model.Auth{
+			Credential: model.CredentialData{
+				Certificate: &model.CertificateCredentialData{
+					ClientID:   id,
+					Subject:    "CN=foo",
+					Thumbprint: certificateThumbprint,
+				},
+			},
+		},
+	}
+}
+
+func fixCertificateBoundReqData(scopes, thumbprint, certData string) oathkeeper.ReqData {
+	header := http.Header{}
+	if certData != "" {
+		header.Set(oathkeeper.CertificateDataHeader, certData)
+	}
+
+	return oathkeeper.ReqData{
+		Body: oathkeeper.ReqBody{
+			Extra: map[string]interface{}{
+				oathkeeper.ScopesKey: scopes,
+				oathkeeper.ConfirmationKey: map[string]interface{}{
+					oathkeeper.CertificateThumbprintKey: thumbprint,
+				},
+			},
+		},
+		Header: header,
+	}
 }
 
 func getSystemAuthSvcMock() *systemauthmock.SystemAuthService {
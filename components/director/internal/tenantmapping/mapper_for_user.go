@@ -8,16 +8,18 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/oathkeeper"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
 )
 
-func NewMapperForUser(staticUserRepo StaticUserRepository, staticGroupRepo StaticGroupRepository, tenantRepo TenantRepository) *mapperForUser {
+func NewMapperForUser(staticUserRepo StaticUserRepository, staticGroupRepo StaticGroupRepository, tenantRepo TenantRepository, roleRepo RoleRepository) *mapperForUser {
 	return &mapperForUser{
 		staticUserRepo:  staticUserRepo,
 		staticGroupRepo: staticGroupRepo,
 		tenantRepo:      tenantRepo,
+		roleRepo:        roleRepo,
 	}
 }
 
@@ -25,12 +27,13 @@ type mapperForUser struct {
 	staticUserRepo  StaticUserRepository
 	staticGroupRepo StaticGroupRepository
 	tenantRepo      TenantRepository
+	roleRepo        RoleRepository
 }
 
 func (m *mapperForUser) GetObjectContext(ctx context.Context, reqData oathkeeper.ReqData, username string) (ObjectContext, error) {
 	var externalTenantID, scopes string
 	var staticUser *StaticUser
-	var err error
+	var staticUserErr, err error
 
 	log := loggerFromContextOrDefault(ctx).WithFields(logrus.Fields{
 		"consumer_type": consumer.User,
@@ -41,9 +44,15 @@ func (m *mapperForUser) GetObjectContext(ctx context.Context, reqData oathkeeper
 	if !hasScopes(scopes) {
 		log.Info("No scopes found from groups, getting user data")
 
-		staticUser, scopes, err = m.getUserData(reqData, username, log)
+		staticUser, err = m.getStaticUser(username, log)
 		if err != nil {
-			return ObjectContext{}, errors.Wrapf(err, "while getting user data for user: %s", username)
+			// the user may still get scopes from the Roles bound to them in the tenant
+			staticUserErr = errors.Wrapf(err, "while getting user data for user: %s", username)
+		} else {
+			scopes, err = m.getStaticUserScopes(reqData, *staticUser, log)
+			if err != nil {
+				return ObjectContext{}, errors.Wrapf(err, "while getting user data for user: %s", username)
+			}
 		}
 	}
 
@@ -54,6 +63,10 @@ func (m *mapperForUser) GetObjectContext(ctx context.Context, reqData oathkeeper
 		}
 		log.Warningf("Could not get tenant external id, error: %s", err.Error())
 
+		if staticUserErr != nil {
+			return ObjectContext{}, staticUserErr
+		}
+
 		log.Info("Could not create tenant context, returning empty context...")
 		return NewObjectContext(TenantContext{}, scopes, username, consumer.User), nil
 	}
@@ -64,6 +77,10 @@ func (m *mapperForUser) GetObjectContext(ctx context.Context, reqData oathkeeper
 		if apperrors.IsNotFoundError(err) {
 			log.Warningf("Could not find tenant with external ID: %s, error: %s", externalTenantID, err.Error())
 
+			if staticUserErr != nil {
+				return ObjectContext{}, staticUserErr
+			}
+
 			log.Infof("Returning tenant context with empty internal tenant ID and external ID %s", externalTenantID)
 			return NewObjectContext(NewTenantContext(externalTenantID, ""), scopes, username, consumer.User), nil
 		}
@@ -74,7 +91,18 @@ func (m *mapperForUser) GetObjectContext(ctx context.Context, reqData oathkeeper
 		return ObjectContext{}, apperrors.NewInternalError(fmt.Sprintf("Static tenant with username: %s missmatch external tenant: %s", staticUser.Username, tenantMapping.ExternalTenant))
 	}
 
+	log.Infof("Getting scopes from roles bound to user %s", username)
+	scopes, err = addScopesFromRoles(ctx, m.roleRepo, tenantMapping.ID, scopes, userSubjects(reqData, username))
+	if err != nil {
+		return ObjectContext{}, errors.Wrapf(err, "while getting scopes from roles for user: %s", username)
+	}
+
+	if !hasScopes(scopes) && staticUserErr != nil {
+		return ObjectContext{}, staticUserErr
+	}
+
 	objCtx := NewObjectContext(NewTenantContext(externalTenantID, tenantMapping.ID), scopes, username, consumer.User)
+	objCtx.ConsumerGroups = reqData.GetUserGroups()
 	log.Infof("Successfully got object context: %+v", objCtx)
 
 	return objCtx, nil
@@ -98,23 +126,36 @@ func (m *mapperForUser) getScopesForUserGroups(reqData oathkeeper.ReqData, log *
 	return scopes
 }
 
-func (m *mapperForUser) getUserData(reqData oathkeeper.ReqData, username string, log *logrus.Entry) (*StaticUser, string, error) {
+func (m *mapperForUser) getStaticUser(username string, log *logrus.Entry) (*StaticUser, error) {
 	staticUser, err := m.staticUserRepo.Get(username)
 	if err != nil {
-		return nil, "", errors.Wrapf(err, "while searching for a static user with username %s", username)
+		return nil, errors.Wrapf(err, "while searching for a static user with username %s", username)
 	}
 	log.Debugf("Found static user with name %s and tenants: %s", staticUser.Username, staticUser.Tenants)
 
+	return &staticUser, nil
+}
+
+func (m *mapperForUser) getStaticUserScopes(reqData oathkeeper.ReqData, staticUser StaticUser, log *logrus.Entry) (string, error) {
 	scopes, err := reqData.GetScopes()
 	if err != nil {
 		if !apperrors.IsKeyDoesNotExist(err) {
-			return nil, "", errors.Wrap(err, "while fetching scopes")
+			return "", errors.Wrap(err, "while fetching scopes")
 		}
 		scopes = strings.Join(staticUser.Scopes, " ")
 	}
 	log.Debugf("Found scopes: %s", scopes)
 
-	return &staticUser, scopes, nil
+	return scopes, nil
+}
+
+func userSubjects(reqData oathkeeper.ReqData, username string) []model.RoleBindingSubject {
+	subjects := []model.RoleBindingSubject{{Type: model.RoleBindingSubjectTypeUser, Name: username}}
+	for _, group := range reqData.GetUserGroups() {
+		subjects = append(subjects, model.RoleBindingSubject{Type: model.RoleBindingSubjectTypeGroup, Name: group})
+	}
+
+	return subjects
 }
 
 func hasValidTenant(assignedTenants []string, tenant string) bool {
@@ -54,7 +54,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -91,7 +91,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -130,7 +130,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -169,7 +169,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -205,7 +205,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -256,7 +256,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -304,7 +304,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(nil, staticGroupRepoMock, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(nil, staticGroupRepoMock, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -312,6 +312,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		require.Equal(t, strings.Join(allExpectedGroupScopes, " "), objCtx.Scopes)
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
+		require.Equal(t, []string{groupName1, groupName2}, objCtx.ConsumerGroups)
 
 		mock.AssertExpectationsForObjects(t, staticGroupRepoMock, tenantRepoMock)
 	})
@@ -348,7 +349,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock, getRoleRepoMockWithoutRoles())
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.NoError(t, err)
@@ -385,7 +386,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, nonExistingExternalTenantID).Return(tenantMappingModel, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, nil)
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.EqualError(t, err, apperrors.NewInternalError(fmt.Sprintf("Static tenant with username: some-user missmatch external tenant: %s", nonExistingExternalTenantID)).Error())
@@ -410,7 +411,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		staticUserRepoMock := getStaticUserRepoMock()
 		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, nil)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, nil, nil)
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.EqualError(t, err, "could not parse external ID for user: some-user: while parsing the value for key=tenant: Internal Server Error: unable to cast the value to a string type")
@@ -435,7 +436,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		staticUserRepoMock := getStaticUserRepoMock()
 		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, nil)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, nil, nil)
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.EqualError(t, err, "while getting user data for user: some-user: while fetching scopes: while parsing the value for scope: Internal Server Error: unable to cast the value to a string type")
@@ -443,6 +444,114 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, staticUserRepoMock)
 	})
 
+	t.Run("returns scopes of the Roles bound to the user and their groups when the user is not static", func(t *testing.T) {
+		groupName := "test"
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.ExternalTenantKey: expectedExternalTenantID.String(),
+					oathkeeper.GroupsKey:         []interface{}{groupName},
+				},
+			},
+		}
+		tenantMappingModel := &model.BusinessTenantMapping{
+			ID:             expectedTenantID.String(),
+			ExternalTenant: expectedExternalTenantID.String(),
+		}
+		subjects := []model.RoleBindingSubject{
+			{Type: model.RoleBindingSubjectTypeUser, Name: username},
+			{Type: model.RoleBindingSubjectTypeGroup, Name: groupName},
+		}
+		roles := []*model.Role{
+			{Scopes: []string{"application:read"}},
+			{Scopes: []string{"application:read", "application:write"}},
+		}
+
+		staticGroupRepoMock := getStaticGroupRepoMock()
+		staticGroupRepoMock.On("Get", []string{groupName}).Return(tenantmapping.StaticGroups{}).Once()
+
+		staticUserRepoMock := getStaticUserRepoMock()
+		staticUserRepoMock.On("Get", username).Return(tenantmapping.StaticUser{}, errors.New("some-error")).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
+
+		roleRepoMock := &automock.RoleRepository{}
+		roleRepoMock.On("ListForSubjects", mock.Anything, expectedTenantID.String(), subjects).Return(roles, nil).Once()
+
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock, roleRepoMock)
+		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, username)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedTenantID.String(), objCtx.TenantID)
+		require.Equal(t, strings.Join(expectedScopes, " "), objCtx.Scopes)
+		require.Equal(t, username, objCtx.ConsumerID)
+
+		mock.AssertExpectationsForObjects(t, staticGroupRepoMock, staticUserRepoMock, tenantRepoMock, roleRepoMock)
+	})
+
+	t.Run("returns error when the user is not static and has no Roles bound in the tenant", func(t *testing.T) {
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.ExternalTenantKey: expectedExternalTenantID.String(),
+				},
+			},
+		}
+		tenantMappingModel := &model.BusinessTenantMapping{
+			ID:             expectedTenantID.String(),
+			ExternalTenant: expectedExternalTenantID.String(),
+		}
+
+		staticUserRepoMock := getStaticUserRepoMock()
+		staticUserRepoMock.On("Get", username).Return(tenantmapping.StaticUser{}, errors.New("some-error")).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
+
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, getRoleRepoMockWithoutRoles())
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, username)
+
+		require.EqualError(t, err, "while getting user data for user: some-user: while searching for a static user with username some-user: some-error")
+
+		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+	})
+
+	t.Run("returns error when unable to list the Roles bound to the user", func(t *testing.T) {
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.ExternalTenantKey: expectedExternalTenantID.String(),
+				},
+			},
+		}
+		staticUser := tenantmapping.StaticUser{
+			Username: username,
+			Tenants:  []string{expectedExternalTenantID.String()},
+			Scopes:   expectedScopes,
+		}
+		tenantMappingModel := &model.BusinessTenantMapping{
+			ID:             expectedTenantID.String(),
+			ExternalTenant: expectedExternalTenantID.String(),
+		}
+
+		staticUserRepoMock := getStaticUserRepoMock()
+		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
+
+		roleRepoMock := &automock.RoleRepository{}
+		roleRepoMock.On("ListForSubjects", mock.Anything, expectedTenantID.String(), mock.Anything).Return(nil, errors.New("some-error")).Once()
+
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, tenantRepoMock, roleRepoMock)
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, username)
+
+		require.EqualError(t, err, "while getting scopes from roles for user: some-user: while listing Roles bound to the subjects: some-error")
+
+		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock, roleRepoMock)
+	})
+
 	t.Run("returns error when user repository returns error", func(t *testing.T) {
 		reqData := oathkeeper.ReqData{}
 		username := "non-existing"
@@ -450,7 +559,7 @@ func TestMapperForUserGetObjectContext(t *testing.T) {
 		staticUserRepoMock := getStaticUserRepoMock()
 		staticUserRepoMock.On("Get", username).Return(tenantmapping.StaticUser{}, errors.New("some-error")).Once()
 
-		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, nil)
+		mapper := tenantmapping.NewMapperForUser(staticUserRepoMock, nil, nil, nil)
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, username)
 
 		require.EqualError(t, err, "while getting user data for user: non-existing: while searching for a static user with username non-existing: some-error")
@@ -468,3 +577,9 @@ func getStaticGroupRepoMock() *automock.StaticGroupRepository {
 	repo := &automock.StaticGroupRepository{}
 	return repo
 }
+
+func getRoleRepoMockWithoutRoles() *automock.RoleRepository {
+	repo := &automock.RoleRepository{}
+	repo.On("ListForSubjects", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	return repo
+}
@@ -22,9 +22,10 @@ func NewTenantContext(externalTenantID, tenantID string) TenantContext {
 
 type ObjectContext struct {
 	TenantContext
-	Scopes       string
-	ConsumerID   string
-	ConsumerType consumer.ConsumerType
+	Scopes         string
+	ConsumerID     string
+	ConsumerType   consumer.ConsumerType
+	ConsumerGroups []string
 }
 
 func NewObjectContext(tenantCtx TenantContext, scopes, consumerID string, consumerType consumer.ConsumerType) ObjectContext {
@@ -0,0 +1,42 @@
+package tenantmapping
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+// addScopesFromRoles extends the scopes with the scopes of the Roles which are bound in the tenant to any of the subjects
+func addScopesFromRoles(ctx context.Context, roleRepo RoleRepository, tenantID, scopes string, subjects []model.RoleBindingSubject) (string, error) {
+	roles, err := roleRepo.ListForSubjects(ctx, tenantID, subjects)
+	if err != nil {
+		return "", errors.Wrap(err, "while listing Roles bound to the subjects")
+	}
+
+	if len(roles) == 0 {
+		return scopes, nil
+	}
+
+	allScopes := strings.Fields(scopes)
+	for _, role := range roles {
+		for _, roleScope := range role.Scopes {
+			if !containsScope(allScopes, roleScope) {
+				allScopes = append(allScopes, roleScope)
+			}
+		}
+	}
+
+	return strings.Join(allScopes, " "), nil
+}
+
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
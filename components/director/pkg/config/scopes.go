@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 )
@@ -35,3 +36,54 @@ func (p *Provider) GetRequiredScopes(path string) ([]string, error) {
 	}
 	return scopes, nil
 }
+
+// ListRequiredScopes returns all distinct scopes which are defined under the given path, sorted alphabetically
+func (p *Provider) ListRequiredScopes(path string) ([]string, error) {
+	val, err := p.getValueForJSONPath(path)
+	if err != nil {
+		if apperrors.IsValueNotFoundInConfiguration(err) {
+			return nil, apperrors.NewRequiredScopesNotDefinedError()
+		}
+		return nil, err
+	}
+
+	scopesSet := make(map[string]struct{})
+	if err := collectScopes(val, scopesSet); err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, 0, len(scopesSet))
+	for scope := range scopesSet {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	return scopes, nil
+}
+
+func collectScopes(val interface{}, scopes map[string]struct{}) error {
+	switch v := val.(type) {
+	case nil:
+		return nil
+	case string:
+		scopes[v] = struct{}{}
+	case []interface{}:
+		for _, item := range v {
+			strVal, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("unexpected scope value in a list, should be string but was %T", item)
+			}
+			scopes[strVal] = struct{}{}
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			if err := collectScopes(item, scopes); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unexpected scopes definition, should be string, list of strings or map, but was %T", val)
+	}
+
+	return nil
+}
@@ -74,3 +74,45 @@ func TestProvider_GetRequiredScopes(t *testing.T) {
 
 	})
 }
+
+func TestProvider_ListRequiredScopes(t *testing.T) {
+	t.Run("requires Load", func(t *testing.T) {
+		sut := config.NewProvider("anything")
+		_, err := sut.ListRequiredScopes("graphql.query")
+		require.Error(t, err, "required scopes configuration not loaded")
+	})
+
+	// GIVEN
+	sut := config.NewProvider("testdata/valid.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns sorted scopes defined under the path", func(t *testing.T) {
+		// WHEN
+		actual, err := sut.ListRequiredScopes("graphql.query")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []string{"application:get", "runtime:get"}, actual)
+	})
+
+	t.Run("returns distinct scopes from lists", func(t *testing.T) {
+		// WHEN
+		actual, err := sut.ListRequiredScopes("clientCredentialsRegistrationScopes")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []string{"application:read", "application:write", "runtime:read", "runtime:write"}, actual)
+	})
+
+	t.Run("returns error if path not found", func(t *testing.T) {
+		// WHEN
+		_, err := sut.ListRequiredScopes("does.not.exist")
+		// THEN
+		require.EqualError(t, err, "while searching configuration using path $.does.not.exist: key error: does not found in object")
+	})
+
+	t.Run("returns error if path points to list with invalid types", func(t *testing.T) {
+		// WHEN
+		_, err := sut.ListRequiredScopes("graphql.mutation")
+		// THEN
+		require.EqualError(t, err, "unexpected scope value in a list, should be string but was float64")
+	})
+}
@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+func (i AccessRuleInput) Validate() error {
+	var ownedOnly *bool
+	if i.OwnedOnly != nil && *i.OwnedOnly {
+		ownedOnly = i.OwnedOnly
+	}
+
+	subjectRules := []validation.Rule{validation.Required, validation.RuneLength(0, longStringLengthLimit)}
+	if i.SubjectType == AccessRuleSubjectTypeIntegrationSystem {
+		subjectRules = append(subjectRules, is.UUID)
+	}
+
+	labelQueryErr := validation.Validate(i.LabelQuery, validation.RuneLength(0, descriptionStringLengthLimit))
+	if labelQueryErr == nil && i.LabelQuery != nil && i.LabelKey == nil {
+		labelQueryErr = errors.New("labelQuery can be specified only together with labelKey")
+	}
+
+	return validation.Errors{
+		"Rule.ExactlyOneNotNil": inputvalidation.ValidateExactlyOneNotNil(
+			"exactly one of labelKey and ownedOnly has to be specified",
+			i.LabelKey, ownedOnly,
+		),
+		"SubjectType": validation.Validate(i.SubjectType, validation.Required, validation.In(AccessRuleSubjectTypeUser, AccessRuleSubjectTypeGroup, AccessRuleSubjectTypeIntegrationSystem)),
+		"Subject":     validation.Validate(i.Subject, subjectRules...),
+		"ObjectType":  validation.Validate(i.ObjectType, validation.Required, validation.In(AccessRuleObjectTypeApplication, AccessRuleObjectTypeRuntime)),
+		"LabelKey":    validation.Validate(i.LabelKey, validation.RuneLength(0, longStringLengthLimit), validation.Match(alphanumericUnderscoreRegexp)),
+		"LabelQuery":  labelQueryErr,
+	}.Filter()
+}
@@ -0,0 +1,225 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessRuleInput_Validate_Rule(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		LabelKey      *string
+		LabelQuery    *string
+		OwnedOnly     *bool
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid - label key",
+			LabelKey:      str.Ptr("team"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - label key and query",
+			LabelKey:      str.Ptr("team"),
+			LabelQuery:    str.Ptr(`"a"`),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - label key with owned only set to false",
+			LabelKey:      str.Ptr("team"),
+			OwnedOnly:     boolPtr(false),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - owned only",
+			OwnedOnly:     boolPtr(true),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - no rule provided",
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - owned only set to false",
+			OwnedOnly:     boolPtr(false),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - label key and owned only",
+			LabelKey:      str.Ptr("team"),
+			OwnedOnly:     boolPtr(true),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - label query without label key",
+			LabelQuery:    str.Ptr(`"a"`),
+			OwnedOnly:     boolPtr(true),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - label key with not allowed characters",
+			LabelKey:      str.Ptr("team-a"),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - label key longer than 256 chars",
+			LabelKey:      str.Ptr(inputvalidationtest.String257Long),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - label query longer than 2000 chars",
+			LabelKey:      str.Ptr("team"),
+			LabelQuery:    str.Ptr(inputvalidationtest.String2001Long),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidAccessRuleInput()
+			in.LabelKey = testCase.LabelKey
+			in.LabelQuery = testCase.LabelQuery
+			in.OwnedOnly = testCase.OwnedOnly
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAccessRuleInput_Validate_Subject(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		SubjectType   graphql.AccessRuleSubjectType
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid user",
+			SubjectType:   graphql.AccessRuleSubjectTypeUser,
+			Value:         "admin@example.com",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid group",
+			SubjectType:   graphql.AccessRuleSubjectTypeGroup,
+			Value:         "team-a",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid integration system",
+			SubjectType:   graphql.AccessRuleSubjectTypeIntegrationSystem,
+			Value:         "a4d4f1c6-5d3a-4c0b-9c7e-2f1e3d4c5b6a",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Integration system ID which is not UUID",
+			SubjectType:   graphql.AccessRuleSubjectTypeIntegrationSystem,
+			Value:         "team-a",
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Empty string",
+			SubjectType:   graphql.AccessRuleSubjectTypeGroup,
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "String longer than 256 chars",
+			SubjectType:   graphql.AccessRuleSubjectTypeUser,
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid subject type",
+			SubjectType:   graphql.AccessRuleSubjectType("INVALID"),
+			Value:         "team-a",
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidAccessRuleInput()
+			in.SubjectType = testCase.SubjectType
+			in.Subject = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAccessRuleInput_Validate_ObjectType(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         graphql.AccessRuleObjectType
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid application",
+			Value:         graphql.AccessRuleObjectTypeApplication,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid runtime",
+			Value:         graphql.AccessRuleObjectTypeRuntime,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty",
+			Value:         graphql.AccessRuleObjectType(""),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid object type",
+			Value:         graphql.AccessRuleObjectType("INVALID"),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidAccessRuleInput()
+			in.ObjectType = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func fixValidAccessRuleInput() graphql.AccessRuleInput {
+	return graphql.AccessRuleInput{
+		SubjectType: graphql.AccessRuleSubjectTypeGroup,
+		Subject:     "team-a",
+		ObjectType:  graphql.AccessRuleObjectTypeApplication,
+		LabelKey:    str.Ptr("team"),
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -47,7 +47,7 @@ func (fp *GqlFieldsProvider) ForApplication(ctx ...FieldCtx) string {
 		description
 		integrationSystemID
 		labels
-		status {condition timestamp}
+		status {condition timestamp lastSeen}
 		webhooks {%s}
 		healthCheckURL
 		packages {%s}
@@ -206,7 +206,7 @@ func (fp *GqlFieldsProvider) ForRuntime() string {
 		name
 		description
 		labels 
-		status {condition timestamp}
+		status {condition timestamp lastSeen}
 		metadata { creationTimestamp }
 		auths {%s}
 		eventingConfiguration { defaultURL }`, fp.ForSystemAuth())
@@ -230,7 +230,9 @@ func (fp *GqlFieldsProvider) ForOneTimeTokenForApplication() string {
 		connectorURL
 		raw
 		rawEncoded
-		legacyConnectorURL`
+		legacyConnectorURL
+		expiresAt
+		metadata`
 }
 
 func (fp *GqlFieldsProvider) ForOneTimeTokenForRuntime() string {
@@ -55,6 +55,32 @@ type APISpecInput struct {
 	FetchRequest *FetchRequestInput `json:"fetchRequest"`
 }
 
+type AccessRule struct {
+	ID          string                `json:"id"`
+	SubjectType AccessRuleSubjectType `json:"subjectType"`
+	Subject     string                `json:"subject"`
+	ObjectType  AccessRuleObjectType  `json:"objectType"`
+	LabelKey    *string               `json:"labelKey"`
+	LabelQuery  *string               `json:"labelQuery"`
+	OwnedOnly   bool                  `json:"ownedOnly"`
+}
+
+// **Validation:** labelKey or ownedOnly required, but not both
+type AccessRuleInput struct {
+	SubjectType AccessRuleSubjectType `json:"subjectType"`
+	// **Validation:** required, max=256, valid UUID for INTEGRATION_SYSTEM
+	Subject    string               `json:"subject"`
+	ObjectType AccessRuleObjectType `json:"objectType"`
+	// Key of the label, which the objects have to have to be matched by the rule
+	// **Validation:** max=256, alphanumeric characters and underscore
+	LabelKey *string `json:"labelKey"`
+	// Query for the value of the label, in the same format as in the label filters
+	// **Validation:** only with labelKey, max=2000
+	LabelQuery *string `json:"labelQuery"`
+	// Matches only the objects created by the caller
+	OwnedOnly *bool `json:"ownedOnly"`
+}
+
 type ApplicationEventingConfiguration struct {
 	DefaultURL string `json:"defaultURL"`
 }
@@ -94,6 +120,7 @@ type ApplicationRegisterInput struct {
 type ApplicationStatus struct {
 	Condition ApplicationStatusCondition `json:"condition"`
 	Timestamp Timestamp                  `json:"timestamp"`
+	LastSeen  *Timestamp                 `json:"lastSeen"`
 }
 
 type ApplicationTemplate struct {
@@ -208,6 +235,25 @@ type CSRFTokenCredentialRequestAuthInput struct {
 }
 
 // **Validation:** basic or oauth field required
+type CertificateCredentialData struct {
+	ClientID   string `json:"clientId"`
+	Subject    string `json:"subject"`
+	Thumbprint string `json:"thumbprint"`
+	// URL for getting access token
+	URL string `json:"url"`
+}
+
+func (CertificateCredentialData) IsCredentialData() {}
+
+type CertificateCredentialInput struct {
+	// Subject distinguished name of the client certificate, which the identity provider uses to authenticate the client
+	// **Validation:** required
+	Subject string `json:"subject"`
+	// Base64url-encoded SHA-256 thumbprint of the DER-encoded client certificate, to which the access tokens have to be bound
+	// **Validation:** base64url-encoded SHA-256 hash
+	Thumbprint string `json:"thumbprint"`
+}
+
 type CredentialDataInput struct {
 	Basic *BasicCredentialDataInput `json:"basic"`
 	Oauth *OAuthCredentialDataInput `json:"oauth"`
@@ -485,6 +531,56 @@ type PlaceholderDefinitionInput struct {
 	Description *string `json:"description"`
 }
 
+type PrivateKeyJWTCredentialData struct {
+	ClientID string  `json:"clientId"`
+	Jwks     *string `json:"jwks"`
+	JwksURI  *string `json:"jwksURI"`
+	// URL for getting access token
+	URL string `json:"url"`
+}
+
+func (PrivateKeyJWTCredentialData) IsCredentialData() {}
+
+// **Validation:** jwks or jwksURI field required
+type PrivateKeyJWTCredentialInput struct {
+	// JSON Web Key Set with the public keys used to verify the client assertions
+	// **Validation:** valid JSON Web Key Set
+	Jwks *string `json:"jwks"`
+	// **Validation:** valid URL
+	JwksURI *string `json:"jwksURI"`
+}
+
+type Role struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Scopes      []string `json:"scopes"`
+}
+
+type RoleBinding struct {
+	ID          string                 `json:"id"`
+	RoleID      string                 `json:"roleID"`
+	SubjectType RoleBindingSubjectType `json:"subjectType"`
+	Subject     string                 `json:"subject"`
+}
+
+type RoleBindingInput struct {
+	// **Validation:** required, valid UUID
+	RoleID      string                 `json:"roleID"`
+	SubjectType RoleBindingSubjectType `json:"subjectType"`
+	// **Validation:** required, max=256, valid UUID for SYSTEM_AUTH
+	Subject string `json:"subject"`
+}
+
+type RoleInput struct {
+	// **Validation:** required, max=256
+	Name string `json:"name"`
+	// **Validation:** max=2000
+	Description *string `json:"description"`
+	// **Validation:** required, scopes which are required by GraphQL operations
+	Scopes []string `json:"scopes"`
+}
+
 type RuntimeContextInput struct {
 	// **Validation:** required max=512, alphanumeric chartacters and underscore
 	Key   string `json:"key"`
@@ -530,11 +626,19 @@ func (RuntimePage) IsPageable() {}
 type RuntimeStatus struct {
 	Condition RuntimeStatusCondition `json:"condition"`
 	Timestamp Timestamp              `json:"timestamp"`
+	LastSeen  *Timestamp             `json:"lastSeen"`
 }
 
 type SystemAuth struct {
-	ID   string `json:"id"`
-	Auth *Auth  `json:"auth"`
+	ID        string     `json:"id"`
+	Auth      *Auth      `json:"auth"`
+	ExpiresAt *Timestamp `json:"expiresAt"`
+}
+
+// **Validation:** privateKeyJWT or certificate field required
+type SystemAuthCredentialInput struct {
+	PrivateKeyJwt *PrivateKeyJWTCredentialInput `json:"privateKeyJWT"`
+	Certificate   *CertificateCredentialInput   `json:"certificate"`
 }
 
 type TemplateValueInput struct {
@@ -630,23 +734,109 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type AccessRuleObjectType string
+
+const (
+	AccessRuleObjectTypeApplication AccessRuleObjectType = "APPLICATION"
+	AccessRuleObjectTypeRuntime     AccessRuleObjectType = "RUNTIME"
+)
+
+var AllAccessRuleObjectType = []AccessRuleObjectType{
+	AccessRuleObjectTypeApplication,
+	AccessRuleObjectTypeRuntime,
+}
+
+func (e AccessRuleObjectType) IsValid() bool {
+	switch e {
+	case AccessRuleObjectTypeApplication, AccessRuleObjectTypeRuntime:
+		return true
+	}
+	return false
+}
+
+func (e AccessRuleObjectType) String() string {
+	return string(e)
+}
+
+func (e *AccessRuleObjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccessRuleObjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccessRuleObjectType", str)
+	}
+	return nil
+}
+
+func (e AccessRuleObjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AccessRuleSubjectType string
+
+const (
+	AccessRuleSubjectTypeUser              AccessRuleSubjectType = "USER"
+	AccessRuleSubjectTypeGroup             AccessRuleSubjectType = "GROUP"
+	AccessRuleSubjectTypeIntegrationSystem AccessRuleSubjectType = "INTEGRATION_SYSTEM"
+)
+
+var AllAccessRuleSubjectType = []AccessRuleSubjectType{
+	AccessRuleSubjectTypeUser,
+	AccessRuleSubjectTypeGroup,
+	AccessRuleSubjectTypeIntegrationSystem,
+}
+
+func (e AccessRuleSubjectType) IsValid() bool {
+	switch e {
+	case AccessRuleSubjectTypeUser, AccessRuleSubjectTypeGroup, AccessRuleSubjectTypeIntegrationSystem:
+		return true
+	}
+	return false
+}
+
+func (e AccessRuleSubjectType) String() string {
+	return string(e)
+}
+
+func (e *AccessRuleSubjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccessRuleSubjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccessRuleSubjectType", str)
+	}
+	return nil
+}
+
+func (e AccessRuleSubjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatusCondition string
 
 const (
-	ApplicationStatusConditionInitial   ApplicationStatusCondition = "INITIAL"
-	ApplicationStatusConditionConnected ApplicationStatusCondition = "CONNECTED"
-	ApplicationStatusConditionFailed    ApplicationStatusCondition = "FAILED"
+	ApplicationStatusConditionInitial      ApplicationStatusCondition = "INITIAL"
+	ApplicationStatusConditionConnected    ApplicationStatusCondition = "CONNECTED"
+	ApplicationStatusConditionDisconnected ApplicationStatusCondition = "DISCONNECTED"
+	ApplicationStatusConditionFailed       ApplicationStatusCondition = "FAILED"
 )
 
 var AllApplicationStatusCondition = []ApplicationStatusCondition{
 	ApplicationStatusConditionInitial,
 	ApplicationStatusConditionConnected,
+	ApplicationStatusConditionDisconnected,
 	ApplicationStatusConditionFailed,
 }
 
 func (e ApplicationStatusCondition) IsValid() bool {
 	switch e {
-	case ApplicationStatusConditionInitial, ApplicationStatusConditionConnected, ApplicationStatusConditionFailed:
+	case ApplicationStatusConditionInitial, ApplicationStatusConditionConnected, ApplicationStatusConditionDisconnected, ApplicationStatusConditionFailed:
 		return true
 	}
 	return false
@@ -1083,12 +1273,56 @@ func (e PackageInstanceAuthStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RoleBindingSubjectType string
+
+const (
+	RoleBindingSubjectTypeUser       RoleBindingSubjectType = "USER"
+	RoleBindingSubjectTypeGroup      RoleBindingSubjectType = "GROUP"
+	RoleBindingSubjectTypeSystemAuth RoleBindingSubjectType = "SYSTEM_AUTH"
+)
+
+var AllRoleBindingSubjectType = []RoleBindingSubjectType{
+	RoleBindingSubjectTypeUser,
+	RoleBindingSubjectTypeGroup,
+	RoleBindingSubjectTypeSystemAuth,
+}
+
+func (e RoleBindingSubjectType) IsValid() bool {
+	switch e {
+	case RoleBindingSubjectTypeUser, RoleBindingSubjectTypeGroup, RoleBindingSubjectTypeSystemAuth:
+		return true
+	}
+	return false
+}
+
+func (e RoleBindingSubjectType) String() string {
+	return string(e)
+}
+
+func (e *RoleBindingSubjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RoleBindingSubjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RoleBindingSubjectType", str)
+	}
+	return nil
+}
+
+func (e RoleBindingSubjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RuntimeStatusCondition string
 
 const (
 	RuntimeStatusConditionInitial      RuntimeStatusCondition = "INITIAL"
 	RuntimeStatusConditionProvisioning RuntimeStatusCondition = "PROVISIONING"
 	RuntimeStatusConditionConnected    RuntimeStatusCondition = "CONNECTED"
+	RuntimeStatusConditionDisconnected RuntimeStatusCondition = "DISCONNECTED"
 	RuntimeStatusConditionFailed       RuntimeStatusCondition = "FAILED"
 )
 
@@ -1096,12 +1330,13 @@ var AllRuntimeStatusCondition = []RuntimeStatusCondition{
 	RuntimeStatusConditionInitial,
 	RuntimeStatusConditionProvisioning,
 	RuntimeStatusConditionConnected,
+	RuntimeStatusConditionDisconnected,
 	RuntimeStatusConditionFailed,
 }
 
 func (e RuntimeStatusCondition) IsValid() bool {
 	switch e {
-	case RuntimeStatusConditionInitial, RuntimeStatusConditionProvisioning, RuntimeStatusConditionConnected, RuntimeStatusConditionFailed:
+	case RuntimeStatusConditionInitial, RuntimeStatusConditionProvisioning, RuntimeStatusConditionConnected, RuntimeStatusConditionDisconnected, RuntimeStatusConditionFailed:
 		return true
 	}
 	return false
@@ -7,7 +7,9 @@ type TokenWithURL struct {
 
 type OneTimeTokenForApplication struct {
 	TokenWithURL
-	LegacyConnectorURL string `json:"legacyConnectorURL"`
+	LegacyConnectorURL string     `json:"legacyConnectorURL"`
+	ExpiresAt          *Timestamp `json:"expiresAt"`
+	Metadata           Labels     `json:"metadata"`
 }
 
 func (t *OneTimeTokenForApplication) IsOneTimeToken() {}
@@ -0,0 +1,27 @@
+package graphql
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+func (i RoleInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Name, validation.Required, validation.RuneLength(0, longStringLengthLimit)),
+		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
+		validation.Field(&i.Scopes, validation.Required, validation.Each(validation.Required)),
+	)
+}
+
+func (i RoleBindingInput) Validate() error {
+	subjectRules := []validation.Rule{validation.Required, validation.RuneLength(0, longStringLengthLimit)}
+	if i.SubjectType == RoleBindingSubjectTypeSystemAuth {
+		subjectRules = append(subjectRules, is.UUID)
+	}
+
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.RoleID, validation.Required, is.UUID),
+		validation.Field(&i.SubjectType, validation.Required, validation.In(RoleBindingSubjectTypeUser, RoleBindingSubjectTypeGroup, RoleBindingSubjectTypeSystemAuth)),
+		validation.Field(&i.Subject, subjectRules...),
+	)
+}
@@ -0,0 +1,259 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleInput_Validate_Name(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "Tenant Admin",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "String longer than 256 chars",
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidRoleInput()
+			in.Name = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleInput_Validate_Description(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         str.Ptr("valid description"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Nil pointer",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "String longer than 2000 chars",
+			Value:         str.Ptr(inputvalidationtest.String2001Long),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidRoleInput()
+			in.Description = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleInput_Validate_Scopes(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         []string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         []string{"application:read", "application:write"},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Nil",
+			Value:         nil,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Empty",
+			Value:         []string{},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Empty scope",
+			Value:         []string{"application:read", inputvalidationtest.EmptyString},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidRoleInput()
+			in.Scopes = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleBindingInput_Validate_RoleID(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "a4d4f1c6-5d3a-4c0b-9c7e-2f1e3d4c5b6a",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid UUID",
+			Value:         "not-a-uuid",
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidRoleBindingInput()
+			in.RoleID = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleBindingInput_Validate_Subject(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		SubjectType   graphql.RoleBindingSubjectType
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid user",
+			SubjectType:   graphql.RoleBindingSubjectTypeUser,
+			Value:         "admin@example.com",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid group",
+			SubjectType:   graphql.RoleBindingSubjectTypeGroup,
+			Value:         "admins",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid system auth",
+			SubjectType:   graphql.RoleBindingSubjectTypeSystemAuth,
+			Value:         "a4d4f1c6-5d3a-4c0b-9c7e-2f1e3d4c5b6a",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "System auth ID which is not UUID",
+			SubjectType:   graphql.RoleBindingSubjectTypeSystemAuth,
+			Value:         "admins",
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Empty string",
+			SubjectType:   graphql.RoleBindingSubjectTypeGroup,
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "String longer than 256 chars",
+			SubjectType:   graphql.RoleBindingSubjectTypeUser,
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid subject type",
+			SubjectType:   graphql.RoleBindingSubjectType("INVALID"),
+			Value:         "admins",
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := fixValidRoleBindingInput()
+			in.SubjectType = testCase.SubjectType
+			in.Subject = testCase.Value
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func fixValidRoleInput() graphql.RoleInput {
+	return graphql.RoleInput{
+		Name:   "Tenant Admin",
+		Scopes: []string{"application:read"},
+	}
+}
+
+func fixValidRoleBindingInput() graphql.RoleBindingInput {
+	return graphql.RoleBindingInput{
+		RoleID:      "a4d4f1c6-5d3a-4c0b-9c7e-2f1e3d4c5b6a",
+		SubjectType: graphql.RoleBindingSubjectTypeGroup,
+		Subject:     "admins",
+	}
+}
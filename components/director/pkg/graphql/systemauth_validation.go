@@ -0,0 +1,35 @@
+package graphql
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+)
+
+func (i SystemAuthCredentialInput) Validate() error {
+	return validation.Errors{
+		"Rule.ExactlyOneNotNil": inputvalidation.ValidateExactlyOneNotNil(
+			"exactly one credential input has to be specified",
+			i.PrivateKeyJwt, i.Certificate,
+		),
+		"PrivateKeyJwt": validation.Validate(i.PrivateKeyJwt),
+		"Certificate":   validation.Validate(i.Certificate),
+	}.Filter()
+}
+
+func (i PrivateKeyJWTCredentialInput) Validate() error {
+	return validation.Errors{
+		"Rule.ExactlyOneNotNil": inputvalidation.ValidateExactlyOneNotNil(
+			"exactly one of jwks and jwksURI has to be specified",
+			i.Jwks, i.JwksURI,
+		),
+		"Jwks":    validation.Validate(i.Jwks, inputvalidation.JWKS),
+		"JwksURI": validation.Validate(i.JwksURI, inputvalidation.IsURL),
+	}.Filter()
+}
+
+func (i CertificateCredentialInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Subject, validation.Required),
+		validation.Field(&i.Thumbprint, validation.Required, inputvalidation.SHA256Thumbprint),
+	)
+}
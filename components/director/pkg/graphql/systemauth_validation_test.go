@@ -0,0 +1,211 @@
+package graphql_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemAuthCredentialInput_Validate(t *testing.T) {
+	privateKeyJWT := fixValidPrivateKeyJWTCredentialInput()
+	certificate := fixValidCertificateCredentialInput()
+
+	testCases := []struct {
+		Name          string
+		Value         graphql.SystemAuthCredentialInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid - private key JWT",
+			Value:         graphql.SystemAuthCredentialInput{PrivateKeyJwt: &privateKeyJWT},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - certificate",
+			Value:         graphql.SystemAuthCredentialInput{Certificate: &certificate},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - no credential provided",
+			Value:         graphql.SystemAuthCredentialInput{},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - multiple credentials provided",
+			Value:         graphql.SystemAuthCredentialInput{PrivateKeyJwt: &privateKeyJWT, Certificate: &certificate},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - nested validation error in PrivateKeyJwt",
+			Value:         graphql.SystemAuthCredentialInput{PrivateKeyJwt: &graphql.PrivateKeyJWTCredentialInput{}},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - nested validation error in Certificate",
+			Value:         graphql.SystemAuthCredentialInput{Certificate: &graphql.CertificateCredentialInput{}},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := testCase.Value.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPrivateKeyJWTCredentialInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         graphql.PrivateKeyJWTCredentialInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid - JWKS",
+			Value:         fixValidPrivateKeyJWTCredentialInput(),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - JWKS URI",
+			Value:         graphql.PrivateKeyJWTCredentialInput{JwksURI: str.Ptr("https://kyma-project.io/jwks")},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - none provided",
+			Value:         graphql.PrivateKeyJWTCredentialInput{},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - both provided",
+			Value: graphql.PrivateKeyJWTCredentialInput{
+				Jwks:    fixValidPrivateKeyJWTCredentialInput().Jwks,
+				JwksURI: str.Ptr("https://kyma-project.io/jwks"),
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - JWKS without keys",
+			Value:         graphql.PrivateKeyJWTCredentialInput{Jwks: str.Ptr(`{"keys":[]}`)},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - JWKS URI",
+			Value:         graphql.PrivateKeyJWTCredentialInput{JwksURI: str.Ptr("kyma-project.io")},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := testCase.Value.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCertificateCredentialInput_Validate_Subject(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "CN=integration-system,O=Kyma",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidCertificateCredentialInput()
+			sut.Subject = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCertificateCredentialInput_Validate_Thumbprint(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         fixValidCertificateCredentialInput().Thumbprint,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Not a SHA-256 hash",
+			Value:         "dGh1bWJwcmludA",
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidCertificateCredentialInput()
+			sut.Thumbprint = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func fixValidPrivateKeyJWTCredentialInput() graphql.PrivateKeyJWTCredentialInput {
+	return graphql.PrivateKeyJWTCredentialInput{
+		Jwks: str.Ptr(`{"keys":[{"kty":"EC","crv":"P-256","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0","kid":"foo"}]}`),
+	}
+}
+
+func fixValidCertificateCredentialInput() graphql.CertificateCredentialInput {
+	hash := sha256.Sum256([]byte("certificate"))
+	return graphql.CertificateCredentialInput{
+		Subject:    "CN=integration-system,O=Kyma",
+		Thumbprint: base64.RawURLEncoding.EncodeToString(hash[:]),
+	}
+}
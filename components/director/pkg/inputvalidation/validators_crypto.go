@@ -0,0 +1,56 @@
+package inputvalidation
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/pkg/errors"
+)
+
+var (
+	JWKS             = &jwksRule{}
+	SHA256Thumbprint = &sha256ThumbprintRule{}
+)
+
+type jwksRule struct{}
+
+func (v *jwksRule) Validate(value interface{}) error {
+	s, isNil, err := ensureIsString(value)
+	if err != nil {
+		return err
+	}
+	if isNil {
+		return nil
+	}
+
+	set, err := jwk.ParseString(s)
+	if err != nil {
+		return errors.New("must be a valid JSON Web Key Set")
+	}
+	if len(set.Keys) == 0 {
+		return errors.New("must contain at least one key")
+	}
+	return nil
+}
+
+type sha256ThumbprintRule struct{}
+
+func (v *sha256ThumbprintRule) Validate(value interface{}) error {
+	s, isNil, err := ensureIsString(value)
+	if err != nil {
+		return err
+	}
+	if isNil {
+		return nil
+	}
+
+	hash, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return errors.New("must be base64url-encoded without padding")
+	}
+	if len(hash) != sha256.Size {
+		return errors.Errorf("must be a SHA-256 hash of %d bytes", sha256.Size)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package inputvalidation_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSValidator_Validate(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Input interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Input: `{"keys":[{"kty":"oct","kid":"foo","k":"Zm9v"}]}`,
+			Valid: true,
+		},
+		{
+			Name:  "Valid pointer",
+			Input: str.Ptr(`{"keys":[{"kty":"oct","kid":"foo","k":"Zm9v"}]}`),
+			Valid: true,
+		},
+		{
+			Name:  "Nil pointer",
+			Input: (*string)(nil),
+			Valid: true,
+		},
+		{
+			Name:  "No keys",
+			Input: `{"keys":[]}`,
+			Valid: false,
+		},
+		{
+			Name:  "Invalid JSON",
+			Input: `{"keys":`,
+			Valid: false,
+		},
+		{
+			Name:  "Not string",
+			Input: 123,
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := validation.Validate(testCase.Input, inputvalidation.JWKS)
+
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSHA256ThumbprintValidator_Validate(t *testing.T) {
+	hash := sha256.Sum256([]byte("certificate"))
+
+	testCases := []struct {
+		Name  string
+		Input interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Input: base64.RawURLEncoding.EncodeToString(hash[:]),
+			Valid: true,
+		},
+		{
+			Name:  "Padded",
+			Input: base64.URLEncoding.EncodeToString(hash[:]),
+			Valid: false,
+		},
+		{
+			Name:  "Too short",
+			Input: base64.RawURLEncoding.EncodeToString(hash[:20]),
+			Valid: false,
+		},
+		{
+			Name:  "Not base64url",
+			Input: "not a thumbprint!",
+			Valid: false,
+		},
+		{
+			Name:  "Not string",
+			Input: 123,
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := validation.Validate(testCase.Input, inputvalidation.SHA256Thumbprint)
+
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,13 +1,23 @@
 package pairing
 
-import "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
 
 type RequestData struct {
 	Application graphql.Application
 	Tenant      string
 	ClientUser  string
+	Labels      graphql.Labels
+	// ApplicationTemplateName is the name of the Application Template the Application was registered from, if any.
+	ApplicationTemplateName string `json:",omitempty"`
 }
 
 type ResponseData struct {
-	Token string
+	Token        string
+	ExpiresAt    *time.Time
+	ConnectorURL string
+	Metadata     map[string]interface{}
 }
@@ -20,6 +20,9 @@ const (
 	EventDefinition            Type = "EventDefinition"
 	AutomaticScenarioAssigment Type = "AutomaticScenarioAssigment"
 	Webhook                    Type = "Webhook"
+	Role                       Type = "Role"
+	RoleBinding                Type = "RoleBinding"
+	AccessRule                 Type = "AccessRule"
 )
 
 type SQLOperation string
@@ -12,23 +12,29 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/handler"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/persistedquery"
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit"
 	timeservices "github.com/kyma-incubator/compass/components/gateway/internal/time"
 	"github.com/kyma-incubator/compass/components/gateway/internal/uuid"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/vrischmann/envconfig"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 )
 
 type config struct {
-	Address string `envconfig:"default=127.0.0.1:3000"`
+	Address        string `envconfig:"default=127.0.0.1:3000"`
+	MetricsAddress string `envconfig:"default=127.0.0.1:3003"`
 
 	ServerTimeout time.Duration `envconfig:"default=114s"`
 
-	DirectorOrigin  string `envconfig:"default=http://127.0.0.1:3001"`
-	ConnectorOrigin string `envconfig:"default=http://127.0.0.1:3002"`
-	AuditlogEnabled bool   `envconfig:"default=false"`
+	DirectorOrigin      string `envconfig:"default=http://127.0.0.1:3001"`
+	DirectorGraphqlPath string `envconfig:"default=/graphql"`
+	ConnectorOrigin     string `envconfig:"default=http://127.0.0.1:3002"`
+	AuditlogEnabled     bool   `envconfig:"default=false"`
 }
 
 func main() {
@@ -40,25 +46,36 @@ func main() {
 	router.Use(correlation.AttachCorrelationIDToContext())
 
 	done := make(chan bool)
-	var auditlogSink proxy.AuditlogService
-	var auditlogSvc proxy.AuditlogService
+	var components auditlogComponents
 	if cfg.AuditlogEnabled {
 		log.Println("Auditlog is enabled")
-		auditlogSink, auditlogSvc, err = initAuditLogs(done)
+		components, err = initAuditLogs(done, cfg.DirectorOrigin+cfg.DirectorGraphqlPath)
 		exitOnError(err, "Error while initializing auditlog service")
 	} else {
 		log.Println("Auditlog is disabled")
-		auditlogSink = &auditlog.NoOpService{}
-		auditlogSvc = &auditlog.NoOpService{}
+		components = auditlogComponents{
+			sink:        &auditlog.NoOpService{},
+			svc:         &auditlog.NoOpService{},
+			redactor:    &auditlog.NoOpRedactor{},
+			tracker:     &auditlog.NoOpChangeTracker{},
+			readAuditor: &auditlog.NoOpReadAuditor{},
+		}
 	}
 
+	persistedQueries, err := initPersistedQueries()
+	exitOnError(err, "Error while loading persisted queries")
+
 	correlationTr := httputil.NewCorrelationIDTransport(http.DefaultTransport)
-	tr := proxy.NewTransport(auditlogSink, auditlogSvc, correlationTr)
+	connectorTr := proxy.NewTransport(components.sink, components.svc, components.redactor, &auditlog.NoOpChangeTracker{}, &auditlog.NoOpReadAuditor{}, nil, correlationTr)
+	directorTr := proxy.NewTransport(components.sink, components.svc, components.redactor, components.tracker, components.readAuditor, persistedQueries, correlationTr)
+
+	connectorMiddleware, directorMiddleware, err := initRateLimits()
+	exitOnError(err, "Error while initializing rate limits")
 
-	err = proxyRequestsForComponent(router, "/connector", cfg.ConnectorOrigin, tr)
+	err = proxyRequestsForComponent(router, "/connector", cfg.ConnectorOrigin, connectorTr, connectorMiddleware...)
 	exitOnError(err, "Error while initializing proxy for Connector")
 
-	err = proxyRequestsForComponent(router, "/director", cfg.DirectorOrigin, tr)
+	err = proxyRequestsForComponent(router, "/director", cfg.DirectorOrigin, directorTr, directorMiddleware...)
 	exitOnError(err, "Error while initializing proxy for Director")
 
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
@@ -79,6 +96,8 @@ func main() {
 		ReadHeaderTimeout: cfg.ServerTimeout,
 	}
 
+	go startMetricsServer(cfg.MetricsAddress)
+
 	log.Printf("Listening on %s", cfg.Address)
 	if err := server.ListenAndServe(); err != nil {
 		done <- true
@@ -108,18 +127,209 @@ func exitOnError(err error, context string) {
 	}
 }
 
-// initAuditLogs creates proxy.AuditlogService instances, the first one is an asynchronous sink,
-// while the second one is a synchronous service with pre-logging functionality.
-func initAuditLogs(done chan bool) (proxy.AuditlogService, proxy.AuditlogService, error) {
+// initRateLimits creates the rate limiting middleware for the Connector and the Director, each component has its own buckets
+func initPersistedQueries() (*persistedquery.Store, error) {
+	cfg := persistedquery.Config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persisted queries cfg")
+	}
+
+	return persistedquery.LoadStore(cfg)
+}
+
+func initRateLimits() ([]mux.MiddlewareFunc, []mux.MiddlewareFunc, error) {
+	cfg := ratelimit.Config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while loading rate limit cfg")
+	}
+
+	if !cfg.Enabled {
+		log.Println("Rate limiting is disabled")
+		return nil, nil, nil
+	}
+
+	limits, err := ratelimit.ParseLimits(cfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while parsing rate limits")
+	}
+
+	metrics := ratelimit.NewMetrics()
+	prometheus.MustRegister(metrics)
+
+	log.Println("Rate limiting is enabled")
+	connectorLimiter := ratelimit.NewLimiter("connector", limits, metrics)
+	directorLimiter := ratelimit.NewLimiter("director", limits, metrics)
+	return []mux.MiddlewareFunc{connectorLimiter.Middleware}, []mux.MiddlewareFunc{directorLimiter.Middleware}, nil
+}
+
+// auditlogComponents are used by the proxy transport to audit requests
+type auditlogComponents struct {
+	// sink is an asynchronous proxy.AuditlogService
+	sink proxy.AuditlogService
+	// svc is a synchronous proxy.AuditlogService with pre-logging functionality
+	svc proxy.AuditlogService
+	// redactor masks sensitive values in audited requests
+	redactor proxy.RequestRedactor
+	// tracker resolves the fields of Director objects changed by mutations
+	tracker proxy.ChangeTracker
+	// readAuditor resolves the Director objects whose sensitive fields were read by queries
+	readAuditor proxy.ReadAuditor
+}
+
+func initAuditLogs(done chan bool, directorGraphQLURL string) (auditlogComponents, error) {
 	cfg := auditlog.Config{}
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "while loading auditlog cfg")
+		return auditlogComponents{}, errors.Wrap(err, "while loading auditlog cfg")
 	}
 
 	uuidSvc := uuid.NewService()
 	timeSvc := &timeservices.TimeService{}
 
+	var clients []auditlog.AuditlogClient
+	var msgFactory auditlog.AuditlogMessageFactory
+	for _, backend := range cfg.Backends {
+		switch backend {
+		case auditlog.ServiceBackend:
+			client, factory, err := initAuditlogServiceClient(cfg, uuidSvc, timeSvc)
+			if err != nil {
+				return auditlogComponents{}, err
+			}
+			clients = append(clients, client)
+			msgFactory = factory
+		case auditlog.FileBackend:
+			var fileCfg auditlog.FileConfig
+			if err := envconfig.InitWithPrefix(&fileCfg, "APP"); err != nil {
+				return auditlogComponents{}, errors.Wrap(err, "while loading auditlog file configuration")
+			}
+
+			client, err := auditlog.NewFileClient(fileCfg)
+			if err != nil {
+				return auditlogComponents{}, errors.Wrap(err, "while creating auditlog file client")
+			}
+			clients = append(clients, client)
+		case auditlog.SyslogBackend:
+			var syslogCfg auditlog.SyslogConfig
+			if err := envconfig.InitWithPrefix(&syslogCfg, "APP"); err != nil {
+				return auditlogComponents{}, errors.Wrap(err, "while loading auditlog syslog configuration")
+			}
+
+			client, err := auditlog.NewSyslogClient(syslogCfg)
+			if err != nil {
+				return auditlogComponents{}, errors.Wrap(err, "while creating auditlog syslog client")
+			}
+			clients = append(clients, client)
+		case auditlog.WebhookBackend:
+			var webhookCfg auditlog.WebhookConfig
+			if err := envconfig.InitWithPrefix(&webhookCfg, "APP"); err != nil {
+				return auditlogComponents{}, errors.Wrap(err, "while loading auditlog webhook configuration")
+			}
+
+			httpClient := &http.Client{
+				Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+				Timeout:   cfg.ClientTimeout,
+			}
+			client, err := auditlog.NewWebhookClient(webhookCfg, httpClient)
+			if err != nil {
+				return auditlogComponents{}, errors.Wrap(err, "while creating auditlog webhook client")
+			}
+			clients = append(clients, client)
+		default:
+			return auditlogComponents{}, fmt.Errorf("invalid auditlog backend: %s", backend)
+		}
+	}
+
+	if len(clients) == 0 {
+		return auditlogComponents{}, errors.New("at least one auditlog backend is required")
+	}
+
+	if msgFactory == nil {
+		var msgCfg auditlog.MessageConfig
+		if err := envconfig.InitWithPrefix(&msgCfg, "APP"); err != nil {
+			return auditlogComponents{}, errors.Wrap(err, "while loading auditlog message configuration")
+		}
+		msgFactory = auditlog.NewMessageFactory(msgCfg.User, msgCfg.Tenant, uuidSvc, timeSvc)
+	}
+
+	var auditlogClient auditlog.AuditlogClient = auditlog.NewFanOutClient(clients...)
+	if len(clients) == 1 {
+		auditlogClient = clients[0]
+	}
+
+	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
+
+	directorSchema, err := auditlog.LoadDirectorSchema(cfg.DirectorSchemaPath)
+	if err != nil {
+		return auditlogComponents{}, errors.Wrap(err, "while loading Director schema")
+	}
+
+	sensitiveFields := cfg.SensitiveFields
+	if len(sensitiveFields) == 0 {
+		sensitiveFields = auditlog.DefaultSensitiveFields
+	}
+	redactor := auditlog.NewRedactor(directorSchema, sensitiveFields)
+
+	components := auditlogComponents{
+		svc:         auditlogSvc,
+		redactor:    redactor,
+		tracker:     &auditlog.NoOpChangeTracker{},
+		readAuditor: &auditlog.NoOpReadAuditor{},
+	}
+
+	if cfg.ReadAuditingEnabled {
+		sensitiveReadFields := cfg.SensitiveReadFields
+		if len(sensitiveReadFields) == 0 {
+			sensitiveReadFields = auditlog.DefaultSensitiveReadFields
+		}
+		components.readAuditor = auditlog.NewReadAuditor(directorSchema, sensitiveReadFields)
+	}
+
+	if cfg.TrackChanges {
+		directorClient := &http.Client{
+			Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+			Timeout:   cfg.ClientTimeout,
+		}
+		components.tracker = auditlog.NewChangeTracker(directorClient, directorGraphQLURL, redactor)
+	}
+
+	if cfg.SpoolDir != "" {
+		spool, err := auditlog.NewSpool(cfg.SpoolDir, cfg.SpoolMaxEntries)
+		if err != nil {
+			return auditlogComponents{}, errors.Wrap(err, "while opening auditlog spool")
+		}
+		prometheus.MustRegister(auditlog.NewSpoolCollector(spool))
+
+		sink := auditlog.NewSpoolSink(spool, auditlogSvc, cfg.WriteWorkers, auditlog.RetryConfig{
+			InitialBackoff: cfg.RetryInitialBackoff,
+			MaxBackoff:     cfg.RetryMaxBackoff,
+		}, done)
+		sink.Start()
+		components.sink = sink
+
+		log.Printf("Auditlog configured successfully with spool in %s, backends:%v", cfg.SpoolDir, cfg.Backends)
+		return components, nil
+	}
+
+	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
+	workers := make(chan bool, cfg.WriteWorkers)
+	initWorkers(workers, auditlogSvc, done, msgChannel)
+
+	log.Printf("Auditlog configured successfully, backends:%v", cfg.Backends)
+	components.sink = auditlog.NewSink(msgChannel, cfg.MsgChannelTimeout)
+	return components, nil
+}
+
+// initAuditlogServiceClient creates the client of the external audit log service and the message factory for its auth mode
+func initAuditlogServiceClient(cfg auditlog.Config, uuidSvc auditlog.UUIDService, timeSvc auditlog.TimeService) (auditlog.AuditlogClient, auditlog.AuditlogMessageFactory, error) {
+	if cfg.URL == "" {
+		return nil, nil, errors.New("auditlog URL is required for the service backend")
+	}
+	if cfg.ReadAuditingEnabled && cfg.DataAccessPath == "" {
+		return nil, nil, errors.New("auditlog data access path is required for read auditing with the service backend")
+	}
+
 	var httpClient auditlog.HttpClient
 	var msgFactory auditlog.AuditlogMessageFactory
 
@@ -170,13 +380,17 @@ func initAuditLogs(done chan bool) (proxy.AuditlogService, proxy.AuditlogService
 		return nil, nil, errors.Wrap(err, "Error while creating auditlog client from cfg")
 	}
 
-	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
-	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
-	workers := make(chan bool, cfg.WriteWorkers)
-	initWorkers(workers, auditlogSvc, done, msgChannel)
+	return auditlogClient, msgFactory, nil
+}
 
-	log.Printf("Auditlog configured successfully, auth mode:%s", cfg.AuthMode)
-	return auditlog.NewSink(msgChannel, cfg.MsgChannelTimeout), auditlogSvc, nil
+func startMetricsServer(address string) {
+	router := mux.NewRouter()
+	router.Handle("/metrics", promhttp.Handler())
+
+	log.Printf("Serving metrics on %s", address)
+	if err := http.ListenAndServe(address, router); err != nil {
+		log.Printf("Error while serving metrics: %s", err.Error())
+	}
 }
 
 func fillJWTCredentials(cfg auditlog.OAuthConfig) clientcredentials.Config {
@@ -28,6 +28,20 @@ func (_m *AuditlogClient) LogConfigurationChange(ctx context.Context, change mod
 	return r0
 }
 
+// LogDataAccess provides a mock function with given fields: ctx, access
+func (_m *AuditlogClient) LogDataAccess(ctx context.Context, access model.DataAccess) error {
+	ret := _m.Called(ctx, access)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.DataAccess) error); ok {
+		r0 = rf(ctx, access)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // LogSecurityEvent provides a mock function with given fields: ctx, event
 func (_m *AuditlogClient) LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
 	ret := _m.Called(ctx, event)
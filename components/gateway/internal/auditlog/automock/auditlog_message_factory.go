@@ -24,6 +24,20 @@ func (_m *AuditlogMessageFactory) CreateConfigurationChange() model.Configuratio
 	return r0
 }
 
+// CreateDataAccess provides a mock function with given fields:
+func (_m *AuditlogMessageFactory) CreateDataAccess() model.DataAccess {
+	ret := _m.Called()
+
+	var r0 model.DataAccess
+	if rf, ok := ret.Get(0).(func() model.DataAccess); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.DataAccess)
+	}
+
+	return r0
+}
+
 // CreateSecurityEvent provides a mock function with given fields:
 func (_m *AuditlogMessageFactory) CreateSecurityEvent() model.SecurityEvent {
 	ret := _m.Called()
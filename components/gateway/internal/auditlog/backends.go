@@ -0,0 +1,85 @@
+package auditlog
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	ConfigurationChangeRecord = "configuration-change"
+	SecurityEventRecord       = "security-event"
+	DataAccessRecord          = "data-access"
+)
+
+// Record is the audit log message written by the file, syslog and HTTP backends
+type Record struct {
+	Type    string      `json:"type"`
+	Message interface{} `json:"message"`
+}
+
+func configurationChangeRecord(change model.ConfigurationChange) Record {
+	return Record{Type: ConfigurationChangeRecord, Message: change}
+}
+
+func securityEventRecord(event model.SecurityEvent) Record {
+	return Record{Type: SecurityEventRecord, Message: event}
+}
+
+func dataAccessRecord(access model.DataAccess) Record {
+	return Record{Type: DataAccessRecord, Message: access}
+}
+
+// FanOutClient sends every message to all clients. The message fails if any of the clients fails,
+// so a retried message may be written again to the clients which have already accepted it.
+type FanOutClient struct {
+	clients []AuditlogClient
+}
+
+func NewFanOutClient(clients ...AuditlogClient) *FanOutClient {
+	return &FanOutClient{
+		clients: clients,
+	}
+}
+
+func (c *FanOutClient) LogConfigurationChange(ctx context.Context, change model.ConfigurationChange) error {
+	var errs []string
+	for _, client := range c.clients {
+		if err := client.LogConfigurationChange(ctx, change); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return fanOutError(errs)
+}
+
+func (c *FanOutClient) LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	var errs []string
+	for _, client := range c.clients {
+		if err := client.LogSecurityEvent(ctx, event); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return fanOutError(errs)
+}
+
+func (c *FanOutClient) LogDataAccess(ctx context.Context, access model.DataAccess) error {
+	var errs []string
+	for _, client := range c.clients {
+		if err := client.LogDataAccess(ctx, access); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return fanOutError(errs)
+}
+
+func fanOutError(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Errorf("while sending to %d auditlog backends: [%s]", len(errs), strings.Join(errs, "; "))
+}
@@ -0,0 +1,52 @@
+package auditlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanOutClient(t *testing.T) {
+	t.Run("should send configuration change to all clients", func(t *testing.T) {
+		//GIVEN
+		msg := fixFilledConfigChangeMsg()
+		first := &automock.AuditlogClient{}
+		first.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+		second := &automock.AuditlogClient{}
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+
+		client := auditlog.NewFanOutClient(first, second)
+
+		//WHEN
+		err := client.LogConfigurationChange(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, first, second)
+	})
+
+	t.Run("should send security event to all clients even if one of them fails", func(t *testing.T) {
+		//GIVEN
+		msg := fixFabricatedSecurityEventMsg()
+		first := &automock.AuditlogClient{}
+		first.On("LogSecurityEvent", context.TODO(), msg).Return(errors.New("test-error")).Once()
+		second := &automock.AuditlogClient{}
+		second.On("LogSecurityEvent", context.TODO(), msg).Return(nil).Once()
+
+		client := auditlog.NewFanOutClient(first, second)
+
+		//WHEN
+		err := client.LogSecurityEvent(context.TODO(), msg)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "test-error")
+		mock.AssertExpectationsForObjects(t, first, second)
+	})
+}
@@ -26,6 +26,7 @@ type Client struct {
 	httpClient       HttpClient
 	configChangeURL  string
 	securityEventURL string
+	dataAccessURL    string
 }
 
 func NewClient(cfg Config, httpClient HttpClient) (*Client, error) {
@@ -39,9 +40,15 @@ func NewClient(cfg Config, httpClient HttpClient) (*Client, error) {
 		return nil, errors.Wrap(err, "while creating auditlog security event url")
 	}
 
+	dataAccessURL, err := createURL(cfg.URL, cfg.DataAccessPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating auditlog data access url")
+	}
+
 	return &Client{
 		configChangeURL:  configChangeURL.String(),
 		securityEventURL: securityEventURL.String(),
+		dataAccessURL:    dataAccessURL.String(),
 		httpClient:       httpClient,
 	}, nil
 }
@@ -74,6 +81,20 @@ func (c *Client) LogSecurityEvent(ctx context.Context, event model.SecurityEvent
 	return c.sendAuditLog(req)
 }
 
+func (c *Client) LogDataAccess(ctx context.Context, access model.DataAccess) error {
+	payload, err := json.Marshal(&access)
+	if err != nil {
+		return errors.Wrap(err, "while marshaling auditlog payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.dataAccessURL, bytes.NewBuffer(payload))
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+
+	return c.sendAuditLog(req)
+}
+
 func (c *Client) sendAuditLog(req *http.Request) error {
 	response, err := c.httpClient.Do(req)
 	if err != nil {
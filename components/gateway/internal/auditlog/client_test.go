@@ -21,8 +21,9 @@ import (
 )
 
 const (
-	configPath   = "/audit-log/v2/configuration-changes"
-	securityPath = "/audit-log/v2/security-events"
+	configPath     = "/audit-log/v2/configuration-changes"
+	securityPath   = "/audit-log/v2/security-events"
+	dataAccessPath = "/audit-log/v2/data-accesses"
 )
 
 func TestClient_LogConfigurationChange(t *testing.T) {
@@ -171,6 +172,56 @@ func TestClient_LogSecurityEvent(t *testing.T) {
 	})
 }
 
+func TestClient_LogDataAccess(t *testing.T) {
+	//GIVEN
+	dataAccessMsg := fixFilledDataAccessMsg()
+
+	t.Run("Success", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, dataAccessPath)
+			output, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			var inputMsg model.DataAccess
+			require.NoError(t, json.Unmarshal(output, &inputMsg))
+			assert.Equal(t, dataAccessMsg, inputMsg)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer ts.Close()
+		cfg := fixAuditlogConfig()
+		cfg.URL = ts.URL
+
+		httpClient := &http.Client{}
+		client, err := auditlog.NewClient(cfg, httpClient)
+		require.NoError(t, err)
+
+		//WHEN
+		err = client.LogDataAccess(context.TODO(), dataAccessMsg)
+
+		//THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Response Code different than 201", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer ts.Close()
+		cfg := fixAuditlogConfig()
+		cfg.URL = ts.URL
+
+		httpClient := &http.Client{}
+		client, err := auditlog.NewClient(cfg, httpClient)
+		require.NoError(t, err)
+
+		//WHEN
+		err = client.LogDataAccess(context.TODO(), dataAccessMsg)
+
+		//THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "Write to auditlog failed with status code: 400")
+	})
+}
+
 func TestDateFormat(t *testing.T) {
 	//GIVEN
 	expected := "2020-03-06T13:45:53.904Z"
@@ -205,7 +256,8 @@ func readSecurityEventRequestBody(t *testing.T, r *http.Request) model.SecurityE
 
 func fixAuditlogConfig() auditlog.Config {
 	return auditlog.Config{
-		ConfigPath:   configPath,
-		SecurityPath: securityPath,
+		ConfigPath:     configPath,
+		SecurityPath:   securityPath,
+		DataAccessPath: dataAccessPath,
 	}
 }
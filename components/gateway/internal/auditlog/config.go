@@ -3,14 +3,57 @@ package auditlog
 import "time"
 
 type Config struct {
-	URL               string        `envconfig:"APP_AUDITLOG_URL"`
-	ConfigPath        string        `envconfig:"APP_AUDITLOG_CONFIG_PATH"`
-	SecurityPath      string        `envconfig:"APP_AUDITLOG_SECURITY_PATH"`
-	AuthMode          AuthMode      `envconfig:"APP_AUDITLOG_AUTH_MODE"`
+	Backends          []Backend     `envconfig:"APP_AUDITLOG_BACKENDS,default=service"`
+	URL               string        `envconfig:"optional,APP_AUDITLOG_URL"`
+	ConfigPath        string        `envconfig:"optional,APP_AUDITLOG_CONFIG_PATH"`
+	SecurityPath      string        `envconfig:"optional,APP_AUDITLOG_SECURITY_PATH"`
+	DataAccessPath    string        `envconfig:"optional,APP_AUDITLOG_DATA_ACCESS_PATH"`
+	AuthMode          AuthMode      `envconfig:"optional,APP_AUDITLOG_AUTH_MODE"`
 	ClientTimeout     time.Duration `envconfig:"APP_AUDITLOG_CLIENT_TIMEOUT,default=30s"`
 	MsgChannelSize    int           `envconfig:"APP_AUDITLOG_CHANNEL_SIZE,default=100"`
 	MsgChannelTimeout time.Duration `envconfig:"APP_AUDITLOG_CHANNEL_TIMEOUT,default=5s"`
 	WriteWorkers      int           `envconfig:"APP_AUDITLOG_WRITE_WORKERS,default=5"`
+
+	DirectorSchemaPath string   `envconfig:"optional,APP_AUDITLOG_DIRECTOR_SCHEMA_PATH"`
+	SensitiveFields    []string `envconfig:"optional,APP_AUDITLOG_SENSITIVE_FIELDS"`
+	TrackChanges       bool     `envconfig:"APP_AUDITLOG_TRACK_CHANGES,default=true"`
+
+	ReadAuditingEnabled bool     `envconfig:"APP_AUDITLOG_READ_AUDITING_ENABLED,default=false"`
+	SensitiveReadFields []string `envconfig:"optional,APP_AUDITLOG_SENSITIVE_READ_FIELDS"`
+
+	SpoolDir            string        `envconfig:"optional,APP_AUDITLOG_SPOOL_DIR"`
+	SpoolMaxEntries     int           `envconfig:"APP_AUDITLOG_SPOOL_MAX_ENTRIES,default=10000"`
+	RetryInitialBackoff time.Duration `envconfig:"APP_AUDITLOG_RETRY_INITIAL_BACKOFF,default=1s"`
+	RetryMaxBackoff     time.Duration `envconfig:"APP_AUDITLOG_RETRY_MAX_BACKOFF,default=1m"`
+}
+
+// MessageConfig is used to create messages when the service backend is disabled
+type MessageConfig struct {
+	User   string `envconfig:"APP_AUDITLOG_MESSAGE_USER,default=proxy"`
+	Tenant string `envconfig:"optional,APP_AUDITLOG_MESSAGE_TENANT"`
+}
+
+type FileConfig struct {
+	Path       string `envconfig:"APP_AUDITLOG_FILE_PATH"`
+	MaxSize    int64  `envconfig:"APP_AUDITLOG_FILE_MAX_SIZE,default=104857600"`
+	MaxBackups int    `envconfig:"APP_AUDITLOG_FILE_MAX_BACKUPS,default=5"`
+}
+
+type SyslogConfig struct {
+	Address  string        `envconfig:"APP_AUDITLOG_SYSLOG_ADDRESS"`
+	Network  SyslogNetwork `envconfig:"APP_AUDITLOG_SYSLOG_NETWORK,default=tcp"`
+	CAFile   string        `envconfig:"optional,APP_AUDITLOG_SYSLOG_CA_FILE"`
+	AppName  string        `envconfig:"APP_AUDITLOG_SYSLOG_APP_NAME,default=compass-gateway"`
+	Facility int           `envconfig:"APP_AUDITLOG_SYSLOG_FACILITY,default=13"`
+	Timeout  time.Duration `envconfig:"APP_AUDITLOG_SYSLOG_TIMEOUT,default=10s"`
+}
+
+type WebhookConfig struct {
+	URL             string   `envconfig:"APP_AUDITLOG_WEBHOOK_URL"`
+	Method          string   `envconfig:"APP_AUDITLOG_WEBHOOK_METHOD,default=POST"`
+	Headers         []string `envconfig:"optional,APP_AUDITLOG_WEBHOOK_HEADERS"`
+	ContentType     string   `envconfig:"APP_AUDITLOG_WEBHOOK_CONTENT_TYPE,default=application/json"`
+	PayloadTemplate string   `envconfig:"optional,APP_AUDITLOG_WEBHOOK_PAYLOAD_TEMPLATE"`
 }
 
 type BasicAuthConfig struct {
@@ -33,3 +76,19 @@ const (
 	Basic AuthMode = "basic"
 	OAuth AuthMode = "oauth"
 )
+
+type Backend string
+
+const (
+	ServiceBackend Backend = "service"
+	FileBackend    Backend = "file"
+	SyslogBackend  Backend = "syslog"
+	WebhookBackend Backend = "http"
+)
+
+type SyslogNetwork string
+
+const (
+	SyslogTCP SyslogNetwork = "tcp"
+	SyslogTLS SyslogNetwork = "tls"
+)
@@ -45,6 +45,17 @@ func (f *MessageFactory) CreateSecurityEvent() model.SecurityEvent {
 		}}
 }
 
+func (f *MessageFactory) CreateDataAccess() model.DataAccess {
+	t := f.timeSvc.Now()
+	logTime := t.Format(model.LogFormatDate)
+
+	return model.DataAccess{User: f.user,
+		Metadata: model.Metadata{Tenant: f.tenant,
+			Time: logTime,
+			UUID: f.uuidSvc.Generate(),
+		}}
+}
+
 func NewMessageFactory(user, tenant string, uuidSvc UUIDService, timeSvc TimeService) *MessageFactory {
 	return &MessageFactory{
 		user:    user,
@@ -28,6 +28,23 @@ func TestMessageFactory(t *testing.T) {
 		assert.Equal(t, expected, output)
 	})
 
+	t.Run("Data access", func(t *testing.T) {
+		expected := model.DataAccess{User: "user", Metadata: model.Metadata{
+			UUID:   TestMsgID,
+			Time:   Timestamp_text,
+			Tenant: TestTenant,
+		}}
+		timestamp := time.Date(2020, 3, 17, 12, 37, 44, 1093, time.FixedZone("test", 3600))
+		uuidSvc, timeSvc := initMocks(TestMsgID, timestamp)
+
+		factory := auditlog.NewMessageFactory("user", TestTenant, uuidSvc, timeSvc)
+		//WHEN
+		output := factory.CreateDataAccess()
+
+		//THEN
+		assert.Equal(t, expected, output)
+	})
+
 	t.Run("Configuration change", func(t *testing.T) {
 		expected := model.ConfigurationChange{User: "user", Metadata: model.Metadata{
 			UUID:   TestMsgID,
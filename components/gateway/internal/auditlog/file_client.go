@@ -0,0 +1,136 @@
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/pkg/errors"
+)
+
+// FileClient writes audit log messages to a file as JSON lines. When the file exceeds the maximum size
+// it is rotated to <path>.1, the previous backups are shifted and the oldest one is removed.
+type FileClient struct {
+	cfg FileConfig
+
+	mutex sync.Mutex
+	file  *os.File
+	size  int64
+}
+
+func NewFileClient(cfg FileConfig) (*FileClient, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("auditlog file path is required")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0700); err != nil {
+		return nil, errors.Wrap(err, "while creating auditlog file directory")
+	}
+
+	client := &FileClient{cfg: cfg}
+	if err := client.open(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *FileClient) LogConfigurationChange(_ context.Context, change model.ConfigurationChange) error {
+	return c.write(configurationChangeRecord(change))
+}
+
+func (c *FileClient) LogSecurityEvent(_ context.Context, event model.SecurityEvent) error {
+	return c.write(securityEventRecord(event))
+}
+
+func (c *FileClient) LogDataAccess(_ context.Context, access model.DataAccess) error {
+	return c.write(dataAccessRecord(access))
+}
+
+func (c *FileClient) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.file.Close()
+}
+
+func (c *FileClient) write(record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(permanentError{err}, "while marshalling auditlog record")
+	}
+	line = append(line, '\n')
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.cfg.MaxSize > 0 && c.size > 0 && c.size+int64(len(line)) > c.cfg.MaxSize {
+		if err := c.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := c.file.Write(line)
+	c.size += int64(n)
+	if err != nil {
+		return errors.Wrapf(err, "while writing to auditlog file %s", c.cfg.Path)
+	}
+
+	return errors.Wrapf(c.file.Sync(), "while syncing auditlog file %s", c.cfg.Path)
+}
+
+func (c *FileClient) open() error {
+	file, err := os.OpenFile(c.cfg.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while opening auditlog file %s", c.cfg.Path)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "while reading size of auditlog file %s", c.cfg.Path)
+	}
+
+	c.file = file
+	c.size = info.Size()
+	return nil
+}
+
+// rotate moves the current file to the first backup and opens a new one. If the backups cannot be moved,
+// the messages are still appended to the current file, so no message is lost.
+func (c *FileClient) rotate() error {
+	if err := c.file.Close(); err != nil {
+		log.Printf("Error while closing auditlog file %s: %s", c.cfg.Path, err.Error())
+	}
+
+	if err := c.moveBackups(); err != nil {
+		log.Printf("Error while rotating auditlog file: %s", err.Error())
+	}
+	return c.open()
+}
+
+func (c *FileClient) moveBackups() error {
+	if c.cfg.MaxBackups <= 0 {
+		if err := os.Remove(c.cfg.Path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "while removing auditlog file %s", c.cfg.Path)
+		}
+		return nil
+	}
+
+	for i := c.cfg.MaxBackups - 1; i > 0; i-- {
+		err := os.Rename(c.backupPath(i), c.backupPath(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "while rotating auditlog file %s", c.backupPath(i))
+		}
+	}
+
+	return errors.Wrapf(os.Rename(c.cfg.Path, c.backupPath(1)), "while rotating auditlog file %s", c.cfg.Path)
+}
+
+func (c *FileClient) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", c.cfg.Path, i)
+}
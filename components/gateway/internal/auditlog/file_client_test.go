@@ -0,0 +1,94 @@
+package auditlog_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileClient(t *testing.T) {
+	t.Run("should write messages as JSON lines", func(t *testing.T) {
+		//GIVEN
+		dir := fixFileDir(t)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "audit", "auditlog.json")
+		client, err := auditlog.NewFileClient(auditlog.FileConfig{Path: path})
+		require.NoError(t, err)
+		defer client.Close()
+
+		//WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixFilledConfigChangeMsg())
+		require.NoError(t, err)
+		err = client.LogSecurityEvent(context.TODO(), fixFabricatedSecurityEventMsg())
+		require.NoError(t, err)
+
+		//THEN
+		records := readRecords(t, path)
+		require.Len(t, records, 2)
+		assert.Equal(t, auditlog.ConfigurationChangeRecord, records[0].Type)
+		assert.Equal(t, auditlog.SecurityEventRecord, records[1].Type)
+	})
+
+	t.Run("should rotate file when it exceeds maximum size", func(t *testing.T) {
+		//GIVEN
+		dir := fixFileDir(t)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "auditlog.json")
+		client, err := auditlog.NewFileClient(auditlog.FileConfig{Path: path, MaxSize: 1, MaxBackups: 2})
+		require.NoError(t, err)
+		defer client.Close()
+
+		//WHEN
+		for i := 0; i < 4; i++ {
+			err = client.LogSecurityEvent(context.TODO(), fixFabricatedSecurityEventMsg())
+			require.NoError(t, err)
+		}
+
+		//THEN
+		assert.Len(t, readRecords(t, path), 1)
+		assert.Len(t, readRecords(t, path+".1"), 1)
+		assert.Len(t, readRecords(t, path+".2"), 1)
+		_, err = os.Stat(path + ".3")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should return error when path is empty", func(t *testing.T) {
+		//WHEN
+		_, err := auditlog.NewFileClient(auditlog.FileConfig{})
+
+		//THEN
+		require.Error(t, err)
+	})
+}
+
+func fixFileDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "auditlog-file")
+	require.NoError(t, err)
+	return dir
+}
+
+func readRecords(t *testing.T, path string) []auditlog.Record {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []auditlog.Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record auditlog.Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
 )
 
 const (
@@ -69,6 +71,27 @@ func fillID(claims proxy.Claims, name string) map[string]string {
 	}
 }
 
+func fixFabricatedDataAccessMsg() model.DataAccess {
+	return model.DataAccess{User: User, Metadata: model.Metadata{
+		Tenant: TestTenant,
+		UUID:   TestMsgID,
+		Time:   Timestamp_text,
+	}}
+}
+
+func fixFilledDataAccessMsg() model.DataAccess {
+	msg := fixFabricatedDataAccessMsg()
+	msg.Object = model.Object{
+		Type: "Application",
+		ID: map[string]string{
+			"type": "Application",
+			"id":   "app-id",
+		},
+	}
+	msg.Attributes = []model.DataAccessAttribute{{Name: "auths", Successful: true}}
+	return msg
+}
+
 func fixFilledConfigChangeMsg() model.ConfigurationChange {
 	msg := fixFabricatedConfigChangeMsg()
 	msg.Object = model.Object{
@@ -90,6 +113,12 @@ func fixFilledSecurityEventMsg() model.SecurityEvent {
 	return msg
 }
 
+func fixDirectorSchema(t *testing.T) *ast.Schema {
+	schema, err := auditlog.LoadDirectorSchema("")
+	require.NoError(t, err)
+	return schema
+}
+
 func fixCorrelationID() correlation.Headers {
 	return map[string]string{
 		correlation.RequestIDHeaderKey: "d135d5f1-3dd0-45fa-8f26-55d8d6a44876",
@@ -0,0 +1,48 @@
+package auditlog
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "compass"
+	metricsSubsystem = "gateway_auditlog_spool"
+)
+
+var (
+	spoolDepthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "depth"),
+		"Number of auditlog messages in the spool which have not been acknowledged by the auditlog service",
+		nil, nil)
+	spoolOldestMessageAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "oldest_message_age_seconds"),
+		"Age of the oldest auditlog message in the spool which has not been acknowledged by the auditlog service",
+		nil, nil)
+	spoolRejectedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "rejected_messages"),
+		"Number of auditlog messages which cannot be delivered and are kept in the spool for manual recovery",
+		nil, nil)
+)
+
+// SpoolCollector exports the state of the auditlog spool as Prometheus metrics
+type SpoolCollector struct {
+	spool *Spool
+}
+
+func NewSpoolCollector(spool *Spool) *SpoolCollector {
+	return &SpoolCollector{spool: spool}
+}
+
+func (c *SpoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- spoolDepthDesc
+	ch <- spoolOldestMessageAgeDesc
+	ch <- spoolRejectedDesc
+}
+
+func (c *SpoolCollector) Collect(ch chan<- prometheus.Metric) {
+	depth, oldestAge, rejected := c.spool.Stats()
+
+	ch <- prometheus.MustNewConstMetric(spoolDepthDesc, prometheus.GaugeValue, float64(depth))
+	ch <- prometheus.MustNewConstMetric(spoolOldestMessageAgeDesc, prometheus.GaugeValue, oldestAge.Seconds())
+	ch <- prometheus.MustNewConstMetric(spoolRejectedDesc, prometheus.GaugeValue, float64(rejected))
+}
@@ -0,0 +1,298 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/parser"
+)
+
+// DefaultSensitiveReadFields are the fields of Director types which return credentials
+var DefaultSensitiveReadFields = []string{
+	"Application.auths",
+	"Runtime.auths",
+	"IntegrationSystem.auths",
+	"Package.instanceAuth",
+	"Package.instanceAuths",
+	"Package.defaultInstanceAuth",
+	"OAuthCredentialData.clientSecret",
+	"BasicCredentialData.password",
+}
+
+// ReadAuditor detects queries which select sensitive fields and resolves the objects whose sensitive fields were returned.
+// A sensitive field is attributed to the closest enclosing object with a selected `id`. If none of the enclosing
+// objects has a selected `id`, the field is attributed to the closest enclosing object with an empty ID.
+type ReadAuditor struct {
+	schema *ast.Schema
+	fields map[string]map[string]struct{}
+}
+
+// NewReadAuditor creates ReadAuditor for fields in the form `<Type>.<field>` of the Director schema
+func NewReadAuditor(schema *ast.Schema, sensitiveFields []string) *ReadAuditor {
+	auditor := &ReadAuditor{
+		schema: schema,
+		fields: map[string]map[string]struct{}{},
+	}
+
+	for _, field := range sensitiveFields {
+		parts := strings.SplitN(strings.TrimSpace(field), ".", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			log.Printf("Ignoring invalid sensitive read field %q, expected <Type>.<field>", field)
+			continue
+		}
+
+		if _, ok := auditor.fields[parts[0]]; !ok {
+			auditor.fields[parts[0]] = map[string]struct{}{}
+		}
+		auditor.fields[parts[0]][parts[1]] = struct{}{}
+	}
+
+	return auditor
+}
+
+type NoOpReadAuditor struct {
+}
+
+func (a *NoOpReadAuditor) IsSensitive(string) bool {
+	return false
+}
+
+func (a *NoOpReadAuditor) Reads(string, string) []proxy.ObjectRead {
+	return nil
+}
+
+// IsSensitive returns true if the query selects any sensitive field
+func (a *ReadAuditor) IsSensitive(request string) bool {
+	doc, ok := a.parse(request)
+	if !ok {
+		return false
+	}
+
+	w := &readWalker{auditor: a, doc: doc, visitedFragments: map[string]struct{}{}}
+	for _, op := range doc.Operations {
+		if op.Operation == ast.Query && w.selectsSensitive(op.SelectionSet, a.schema.Query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reads returns the objects whose sensitive fields are present in the response of the query
+func (a *ReadAuditor) Reads(request, response string) []proxy.ObjectRead {
+	doc, ok := a.parse(request)
+	if !ok {
+		return nil
+	}
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(response), &resp); err != nil || resp.Data == nil {
+		return nil
+	}
+
+	w := &readWalker{auditor: a, doc: doc, reads: map[readKey]map[string]struct{}{}}
+	for _, op := range doc.Operations {
+		if op.Operation == ast.Query {
+			w.walkObject(op.SelectionSet, a.schema.Query, resp.Data, nil, "")
+		}
+	}
+
+	return w.objectReads()
+}
+
+func (a *ReadAuditor) parse(request string) (*ast.QueryDocument, bool) {
+	var req graphqlRequest
+	if err := json.Unmarshal([]byte(request), &req); err != nil {
+		return nil, false
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		return nil, false
+	}
+	return doc, true
+}
+
+func (a *ReadAuditor) isSensitive(typeName, field string) bool {
+	_, sensitive := a.fields[typeName][field]
+	return sensitive
+}
+
+func (a *ReadAuditor) fieldType(parent *ast.Definition, field string) *ast.Definition {
+	if parent == nil {
+		return nil
+	}
+
+	fieldDef := parent.Fields.ForName(field)
+	if fieldDef == nil {
+		return nil
+	}
+	return a.schema.Types[fieldDef.Type.Name()]
+}
+
+type readKey struct {
+	objectType string
+	id         string
+}
+
+type readWalker struct {
+	auditor          *ReadAuditor
+	doc              *ast.QueryDocument
+	visitedFragments map[string]struct{}
+	reads            map[readKey]map[string]struct{}
+}
+
+func (w *readWalker) selectsSensitive(selectionSet ast.SelectionSet, parent *ast.Definition) bool {
+	for _, selection := range selectionSet {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if parent != nil && w.auditor.isSensitive(parent.Name, sel.Name) {
+				return true
+			}
+			if w.selectsSensitive(sel.SelectionSet, w.auditor.fieldType(parent, sel.Name)) {
+				return true
+			}
+		case *ast.InlineFragment:
+			child := parent
+			if sel.TypeCondition != "" {
+				child = w.auditor.schema.Types[sel.TypeCondition]
+			}
+			if w.selectsSensitive(sel.SelectionSet, child) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if _, visited := w.visitedFragments[sel.Name]; visited {
+				continue
+			}
+			w.visitedFragments[sel.Name] = struct{}{}
+
+			fragment := w.doc.Fragments.ForName(sel.Name)
+			if fragment != nil && w.selectsSensitive(fragment.SelectionSet, w.auditor.schema.Types[fragment.TypeCondition]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// walk follows the value of a field, which is either an object, a list or a scalar
+func (w *readWalker) walk(selectionSet ast.SelectionSet, def *ast.Definition, value interface{}, owner *readKey, path string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		w.walkObject(selectionSet, def, v, owner, path)
+	case []interface{}:
+		for _, elem := range v {
+			w.walk(selectionSet, def, elem, owner, path)
+		}
+	}
+}
+
+func (w *readWalker) walkObject(selectionSet ast.SelectionSet, def *ast.Definition, object map[string]interface{}, owner *readKey, path string) {
+	if def != nil && def != w.auditor.schema.Query {
+		if id, ok := w.selectedID(selectionSet, object); ok {
+			owner, path = &readKey{objectType: def.Name, id: id}, ""
+		} else if owner == nil || owner.id == "" {
+			owner, path = &readKey{objectType: def.Name}, ""
+		}
+	}
+
+	w.walkSelections(selectionSet, def, object, owner, path, map[string]struct{}{})
+}
+
+func (w *readWalker) walkSelections(selectionSet ast.SelectionSet, def *ast.Definition, object map[string]interface{}, owner *readKey, path string, visitedFragments map[string]struct{}) {
+	for _, selection := range selectionSet {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			key := sel.Alias
+			if key == "" {
+				key = sel.Name
+			}
+
+			fieldValue, ok := object[key]
+			if !ok || fieldValue == nil {
+				continue
+			}
+			if list, ok := fieldValue.([]interface{}); ok && len(list) == 0 {
+				continue
+			}
+
+			fieldPath := sel.Name
+			if path != "" {
+				fieldPath = path + "." + sel.Name
+			}
+
+			if def != nil && owner != nil && w.auditor.isSensitive(def.Name, sel.Name) {
+				w.record(*owner, fieldPath)
+				continue
+			}
+
+			w.walk(sel.SelectionSet, w.auditor.fieldType(def, sel.Name), fieldValue, owner, fieldPath)
+		case *ast.InlineFragment:
+			child := def
+			if sel.TypeCondition != "" {
+				child = w.auditor.schema.Types[sel.TypeCondition]
+			}
+			w.walkSelections(sel.SelectionSet, child, object, owner, path, visitedFragments)
+		case *ast.FragmentSpread:
+			if _, visited := visitedFragments[sel.Name]; visited {
+				continue
+			}
+			visitedFragments[sel.Name] = struct{}{}
+
+			if fragment := w.doc.Fragments.ForName(sel.Name); fragment != nil {
+				w.walkSelections(fragment.SelectionSet, w.auditor.schema.Types[fragment.TypeCondition], object, owner, path, visitedFragments)
+			}
+		}
+	}
+}
+
+// selectedID returns the value of the `id` field if it is selected directly in the selection set
+func (w *readWalker) selectedID(selectionSet ast.SelectionSet, object map[string]interface{}) (string, bool) {
+	for _, selection := range selectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || field.Name != "id" {
+			continue
+		}
+
+		key := field.Alias
+		if key == "" {
+			key = field.Name
+		}
+		if id, ok := object[key].(string); ok && id != "" {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func (w *readWalker) record(key readKey, field string) {
+	if _, ok := w.reads[key]; !ok {
+		w.reads[key] = map[string]struct{}{}
+	}
+	w.reads[key][field] = struct{}{}
+}
+
+func (w *readWalker) objectReads() []proxy.ObjectRead {
+	reads := make([]proxy.ObjectRead, 0, len(w.reads))
+	for key, fields := range w.reads {
+		read := proxy.ObjectRead{Type: key.objectType, ID: key.id}
+		for field := range fields {
+			read.Fields = append(read.Fields, field)
+		}
+		sort.Strings(read.Fields)
+		reads = append(reads, read)
+	}
+
+	sort.Slice(reads, func(i, j int) bool {
+		if reads[i].Type != reads[j].Type {
+			return reads[i].Type < reads[j].Type
+		}
+		return reads[i].ID < reads[j].ID
+	})
+	return reads
+}
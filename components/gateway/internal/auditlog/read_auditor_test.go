@@ -0,0 +1,118 @@
+package auditlog_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAuditor_IsSensitive(t *testing.T) {
+	auditor := auditlog.NewReadAuditor(fixDirectorSchema(t), auditlog.DefaultSensitiveReadFields)
+
+	testCases := []struct {
+		Name     string
+		Query    string
+		Expected bool
+	}{
+		{
+			Name:     "query selecting auths of applications",
+			Query:    `query { applications { data { id auths { id } } } }`,
+			Expected: true,
+		},
+		{
+			Name:     "query selecting client secret in a fragment",
+			Query:    `query { runtime(id: "rt") { ...cred } } fragment cred on Runtime { auths { auth { credential { ... on OAuthCredentialData { clientId clientSecret } } } } }`,
+			Expected: true,
+		},
+		{
+			Name:     "query selecting instance auths under an alias",
+			Query:    `query { app: application(id: "app") { packages { data { secrets: instanceAuths { id } } } } }`,
+			Expected: true,
+		},
+		{
+			Name:     "query without sensitive fields",
+			Query:    `query { applications { data { id name labels } } }`,
+			Expected: false,
+		},
+		{
+			Name:     "mutation",
+			Query:    `mutation { unregisterApplication(id: "app") { auths { id } } }`,
+			Expected: false,
+		},
+		{
+			Name:     "query which cannot be parsed",
+			Query:    `query { applications {`,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			sensitive := auditor.IsSensitive(fixGraphQLRequest(t, testCase.Query, nil))
+
+			//THEN
+			assert.Equal(t, testCase.Expected, sensitive)
+		})
+	}
+}
+
+func TestReadAuditor_Reads(t *testing.T) {
+	auditor := auditlog.NewReadAuditor(fixDirectorSchema(t), auditlog.DefaultSensitiveReadFields)
+
+	t.Run("should attribute sensitive fields to objects with selected IDs", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t, `query { applications { data { id auths { id } packages { data { id instanceAuths { id } defaultInstanceAuth { additionalHeaders } } } } } }`, nil)
+		response := `{"data":{"applications":{"data":[
+			{"id":"app-1","auths":[{"id":"auth-1"}],"packages":{"data":[{"id":"pkg-1","instanceAuths":[{"id":"ia-1"}],"defaultInstanceAuth":null}]}},
+			{"id":"app-2","auths":[],"packages":{"data":[]}}
+		]}}}`
+
+		//WHEN
+		reads := auditor.Reads(request, response)
+
+		//THEN
+		assert.Equal(t, []proxy.ObjectRead{
+			{Type: "Application", ID: "app-1", Fields: []string{"auths"}},
+			{Type: "Package", ID: "pkg-1", Fields: []string{"instanceAuths"}},
+		}, reads)
+	})
+
+	t.Run("should attribute sensitive fields to the closest object when IDs are not selected", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t, `query ($id: ID!) { rt: runtime(id: $id) { name credentials: auths { id } } }`, map[string]interface{}{"id": "rt"})
+		response := `{"data":{"rt":{"name":"runtime","credentials":[{"id":"auth-1"}]}}}`
+
+		//WHEN
+		reads := auditor.Reads(request, response)
+
+		//THEN
+		assert.Equal(t, []proxy.ObjectRead{{Type: "Runtime", Fields: []string{"auths"}}}, reads)
+	})
+
+	t.Run("should attribute nested sensitive fields with their path", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t, `query { application(id: "app") { id webhooks { url auth { credential { ... on BasicCredentialData { username password } } } } } }`, nil)
+		response := `{"data":{"application":{"id":"app","webhooks":[{"url":"http://hook","auth":{"credential":{"username":"user","password":"secret"}}}]}}}`
+
+		//WHEN
+		reads := auditor.Reads(request, response)
+
+		//THEN
+		assert.Equal(t, []proxy.ObjectRead{{Type: "Application", ID: "app", Fields: []string{"webhooks.auth.credential.password"}}}, reads)
+	})
+
+	t.Run("should return nothing when sensitive fields are not returned", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t, `query { application(id: "app") { id auths { id } } }`, nil)
+		response := `{"data":{"application":null},"errors":[{"message":"not found"}]}`
+
+		//WHEN
+		reads := auditor.Reads(request, response)
+
+		//THEN
+		assert.Empty(t, reads)
+	})
+}
@@ -0,0 +1,406 @@
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/formatter"
+	"github.com/vektah/gqlparser/parser"
+)
+
+const (
+	redactedValue           = "***"
+	unparsableQueryValue    = "<query redacted: it cannot be parsed>"
+	unparsableResponseValue = "<response redacted: it cannot be parsed>"
+
+	inputTypeSuffix = "Input"
+)
+
+// DefaultSensitiveFields are the fields of Director input types which contain credentials
+var DefaultSensitiveFields = []string{
+	"BasicCredentialDataInput.password",
+	"OAuthCredentialDataInput.clientSecret",
+	"AuthInput.additionalHeaders",
+	"AuthInput.additionalHeadersSerialized",
+	"AuthInput.additionalQueryParams",
+	"AuthInput.additionalQueryParamsSerialized",
+	"CSRFTokenCredentialRequestAuthInput.additionalHeaders",
+	"CSRFTokenCredentialRequestAuthInput.additionalHeadersSerialized",
+	"CSRFTokenCredentialRequestAuthInput.additionalQueryParams",
+	"CSRFTokenCredentialRequestAuthInput.additionalQueryParamsSerialized",
+}
+
+// Redactor masks the values of sensitive input fields in GraphQL requests before they are audited.
+// The input types are resolved against the Director schema, fields of types unknown to the schema are
+// masked when their name matches the name of any sensitive field.
+type Redactor struct {
+	schema     *ast.Schema
+	fields     map[string]map[string]struct{}
+	fieldNames map[string]struct{}
+}
+
+// NewRedactor creates Redactor for fields in the form `<InputType>.<field>` of the Director schema
+func NewRedactor(schema *ast.Schema, sensitiveFields []string) *Redactor {
+	redactor := &Redactor{
+		schema:     schema,
+		fields:     map[string]map[string]struct{}{},
+		fieldNames: map[string]struct{}{},
+	}
+
+	for _, field := range sensitiveFields {
+		parts := strings.SplitN(strings.TrimSpace(field), ".", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			log.Printf("Ignoring invalid sensitive field %q, expected <InputType>.<field>", field)
+			continue
+		}
+
+		if _, ok := redactor.fields[parts[0]]; !ok {
+			redactor.fields[parts[0]] = map[string]struct{}{}
+		}
+		redactor.fields[parts[0]][parts[1]] = struct{}{}
+		redactor.fieldNames[parts[1]] = struct{}{}
+	}
+
+	return redactor
+}
+
+type NoOpRedactor struct {
+}
+
+func (r *NoOpRedactor) Redact(request string) string {
+	return request
+}
+
+func (r *NoOpRedactor) RedactResponse(response string) string {
+	return response
+}
+
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Redact returns the request, which is either a GraphQL query or a JSON encoded GraphQL request, with sensitive values masked
+func (r *Redactor) Redact(request string) string {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(request), &body); err != nil {
+		query, _ := r.redactQuery(request, nil)
+		return query
+	}
+
+	var req graphqlRequest
+	if err := json.Unmarshal([]byte(request), &req); err != nil {
+		redacted, _ := json.Marshal(graphqlRequest{Query: unparsableQueryValue})
+		return string(redacted)
+	}
+
+	query, changed := r.redactQuery(req.Query, req.Variables)
+	if !changed {
+		return request
+	}
+
+	rawQuery, err := json.Marshal(query)
+	if err != nil {
+		return request
+	}
+	body["query"] = rawQuery
+
+	if req.Variables != nil {
+		rawVariables, err := json.Marshal(req.Variables)
+		if err != nil {
+			return request
+		}
+		body["variables"] = rawVariables
+	}
+
+	redacted, err := json.Marshal(body)
+	if err != nil {
+		return request
+	}
+	return string(redacted)
+}
+
+// RedactResponse returns the GraphQL response with its data masked, as the data can contain credentials returned by the Director.
+// The errors are kept, so it can still be audited which part of the request failed.
+func (r *Redactor) RedactResponse(response string) string {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(response), &body); err != nil {
+		return unparsableResponseValue
+	}
+
+	data, ok := body["data"]
+	if !ok || string(bytes.TrimSpace(data)) == "null" {
+		return response
+	}
+
+	rawRedacted, err := json.Marshal(redactedValue)
+	if err != nil {
+		return unparsableResponseValue
+	}
+	body["data"] = rawRedacted
+
+	redacted, err := json.Marshal(body)
+	if err != nil {
+		return unparsableResponseValue
+	}
+	return string(redacted)
+}
+
+// redactQuery masks sensitive literals in the query and sensitive variables in place. It returns the query and whether anything was masked.
+func (r *Redactor) redactQuery(query string, variables map[string]interface{}) (string, bool) {
+	if strings.TrimSpace(query) == "" {
+		return query, false
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		for name := range variables {
+			variables[name] = redactedValue
+		}
+		return unparsableQueryValue, true
+	}
+
+	w := &redactionWalker{
+		redactor:           r,
+		doc:                doc,
+		sensitiveVariables: map[string]struct{}{},
+		visitedFragments:   map[string]struct{}{},
+	}
+	for _, op := range doc.Operations {
+		w.walkSelectionSet(op.SelectionSet, r.schema.Types[r.rootTypeName(op.Operation)])
+	}
+	for _, fragment := range doc.Fragments {
+		w.walkFragment(fragment)
+	}
+
+	changed := w.changed
+	for _, op := range doc.Operations {
+		for _, variable := range op.VariableDefinitions {
+			typeName := variable.Type.Name()
+			if variable.DefaultValue != nil {
+				w.walkValue(variable.DefaultValue, typeName)
+			}
+
+			value, ok := variables[variable.Variable]
+			if !ok || value == nil {
+				continue
+			}
+
+			if _, sensitive := w.sensitiveVariables[variable.Variable]; sensitive {
+				variables[variable.Variable] = redactedValue
+				changed = true
+				continue
+			}
+
+			if r.redactJSON(value, typeName) {
+				changed = true
+			}
+		}
+	}
+
+	if !w.changed {
+		return query, changed
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatQueryDocument(doc)
+	return buf.String(), true
+}
+
+func (r *Redactor) rootTypeName(operation ast.Operation) string {
+	var def *ast.Definition
+	switch operation {
+	case ast.Mutation:
+		def = r.schema.Mutation
+	case ast.Subscription:
+		def = r.schema.Subscription
+	default:
+		def = r.schema.Query
+	}
+
+	if def == nil {
+		return ""
+	}
+	return def.Name
+}
+
+func (r *Redactor) isSensitive(typeName, field string) bool {
+	if _, known := r.schema.Types[typeName]; !known {
+		_, sensitive := r.fieldNames[field]
+		return sensitive
+	}
+
+	_, sensitive := r.fields[typeName][field]
+	return sensitive
+}
+
+// isSensitiveOutput returns true if the field of the output type is sensitive. The sensitive fields of input types
+// also apply to the output types of the same name without the `Input` suffix, such as `BasicCredentialData.password`.
+func (r *Redactor) isSensitiveOutput(typeName, field string) bool {
+	if r.isSensitive(typeName, field) {
+		return true
+	}
+
+	if _, known := r.schema.Types[typeName+inputTypeSuffix]; !known {
+		return false
+	}
+	return r.isSensitive(typeName+inputTypeSuffix, field)
+}
+
+func (r *Redactor) fieldTypeName(typeName, field string) string {
+	def, ok := r.schema.Types[typeName]
+	if !ok {
+		return ""
+	}
+
+	fieldDef := def.Fields.ForName(field)
+	if fieldDef == nil {
+		return ""
+	}
+	return fieldDef.Type.Name()
+}
+
+func (r *Redactor) redactJSON(value interface{}, typeName string) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if fieldValue != nil && r.isSensitive(typeName, field) {
+				v[field] = redactedValue
+				changed = true
+				continue
+			}
+			if r.redactJSON(fieldValue, r.fieldTypeName(typeName, field)) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if r.redactJSON(elem, typeName) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+type redactionWalker struct {
+	redactor           *Redactor
+	doc                *ast.QueryDocument
+	sensitiveVariables map[string]struct{}
+	visitedFragments   map[string]struct{}
+	changed            bool
+}
+
+func (w *redactionWalker) walkSelectionSet(selectionSet ast.SelectionSet, parent *ast.Definition) {
+	for _, selection := range selectionSet {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			var fieldDef *ast.FieldDefinition
+			if parent != nil {
+				fieldDef = parent.Fields.ForName(sel.Name)
+			}
+
+			for _, arg := range sel.Arguments {
+				typeName := ""
+				if fieldDef != nil {
+					if argDef := fieldDef.Arguments.ForName(arg.Name); argDef != nil {
+						typeName = argDef.Type.Name()
+					}
+				}
+				w.walkValue(arg.Value, typeName)
+			}
+
+			var child *ast.Definition
+			if fieldDef != nil {
+				child = w.redactor.schema.Types[fieldDef.Type.Name()]
+			}
+			w.walkSelectionSet(sel.SelectionSet, child)
+		case *ast.InlineFragment:
+			child := parent
+			if sel.TypeCondition != "" {
+				child = w.redactor.schema.Types[sel.TypeCondition]
+			}
+			w.walkSelectionSet(sel.SelectionSet, child)
+		case *ast.FragmentSpread:
+			if fragment := w.doc.Fragments.ForName(sel.Name); fragment != nil {
+				w.walkFragment(fragment)
+			}
+		}
+	}
+}
+
+func (w *redactionWalker) walkFragment(fragment *ast.FragmentDefinition) {
+	if _, visited := w.visitedFragments[fragment.Name]; visited {
+		return
+	}
+	w.visitedFragments[fragment.Name] = struct{}{}
+
+	w.walkSelectionSet(fragment.SelectionSet, w.redactor.schema.Types[fragment.TypeCondition])
+}
+
+func (w *redactionWalker) walkValue(value *ast.Value, typeName string) {
+	if value == nil {
+		return
+	}
+
+	switch value.Kind {
+	case ast.ObjectValue:
+		for _, child := range value.Children {
+			if w.redactor.isSensitive(typeName, child.Name) {
+				w.mask(child.Value)
+				continue
+			}
+			w.walkValue(child.Value, w.redactor.fieldTypeName(typeName, child.Name))
+		}
+	case ast.ListValue:
+		for _, child := range value.Children {
+			w.walkValue(child.Value, typeName)
+		}
+	}
+}
+
+// mask replaces the literal with the redacted value, variables used in it are masked in the request variables
+func (w *redactionWalker) mask(value *ast.Value) {
+	if value == nil {
+		return
+	}
+
+	switch value.Kind {
+	case ast.Variable:
+		w.sensitiveVariables[value.Raw] = struct{}{}
+		return
+	case ast.NullValue:
+		return
+	case ast.ObjectValue, ast.ListValue:
+		for _, child := range value.Children {
+			w.collectVariables(child.Value)
+		}
+	}
+
+	*value = ast.Value{
+		Kind:     ast.StringValue,
+		Raw:      redactedValue,
+		Position: value.Position,
+	}
+	w.changed = true
+}
+
+func (w *redactionWalker) collectVariables(value *ast.Value) {
+	if value == nil {
+		return
+	}
+
+	if value.Kind == ast.Variable {
+		w.sensitiveVariables[value.Raw] = struct{}{}
+		return
+	}
+
+	for _, child := range value.Children {
+		w.collectVariables(child.Value)
+	}
+}
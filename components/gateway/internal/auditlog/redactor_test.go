@@ -0,0 +1,190 @@
+package auditlog_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactor_Redact(t *testing.T) {
+	redactor := auditlog.NewRedactor(fixDirectorSchema(t), auditlog.DefaultSensitiveFields)
+
+	t.Run("should mask sensitive literals in the query", func(t *testing.T) {
+		//GIVEN
+		request := `mutation {
+			setPackageInstanceAuth(authID: "auth-id", in: {auth: {credential: {basic: {username: "user", password: "top-secret"}}}}) {
+				id
+			}
+		}`
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+		assert.Contains(t, redacted, `"user"`)
+		assert.Contains(t, redacted, `password:"***"`)
+		assert.Regexp(t, "^mutation", redacted)
+	})
+
+	t.Run("should mask sensitive variables of JSON request", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t,
+			`mutation ($in: PackageInstanceAuthSetInput!) { setPackageInstanceAuth(authID: "auth-id", in: $in) { id } }`,
+			map[string]interface{}{
+				"in": map[string]interface{}{
+					"auth": map[string]interface{}{
+						"credential": map[string]interface{}{
+							"oauth": map[string]interface{}{
+								"clientId":     "client",
+								"clientSecret": "top-secret",
+								"url":          "https://oauth",
+							},
+						},
+						"additionalHeaders": map[string]interface{}{
+							"Authorization": []string{"Bearer token"},
+						},
+					},
+				},
+			})
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+		assert.NotContains(t, redacted, "Bearer token")
+
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(redacted), &body))
+		auth := body.Variables["in"].(map[string]interface{})["auth"].(map[string]interface{})
+		oauth := auth["credential"].(map[string]interface{})["oauth"].(map[string]interface{})
+		assert.Equal(t, "client", oauth["clientId"])
+		assert.Equal(t, "***", oauth["clientSecret"])
+		assert.Equal(t, "***", auth["additionalHeaders"])
+	})
+
+	t.Run("should mask variables used as sensitive fields", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t,
+			`mutation ($password: String!) { setPackageInstanceAuth(authID: "auth-id", in: {auth: {credential: {basic: {username: "user", password: $password}}}}) { id } }`,
+			map[string]interface{}{"password": "top-secret"})
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+	})
+
+	t.Run("should mask fields of types unknown to the schema by name", func(t *testing.T) {
+		//GIVEN
+		request := `mutation { registerSomethingNew(in: {credential: {password: "top-secret", clientSecret: "other-secret"}}) { id } }`
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+		assert.NotContains(t, redacted, "other-secret")
+	})
+
+	t.Run("should not change request without sensitive fields", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t, `mutation { registerApplication(in: {name: "app"}) { id } }`, nil)
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.Equal(t, request, redacted)
+	})
+
+	t.Run("should mask whole query which cannot be parsed", func(t *testing.T) {
+		//GIVEN
+		request := fixGraphQLRequest(t, `mutation { setPackageInstanceAuth(in: {password: "top-secret"`, map[string]interface{}{"secret": "top-secret"})
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+	})
+
+	t.Run("should mask only configured fields", func(t *testing.T) {
+		//GIVEN
+		redactor := auditlog.NewRedactor(fixDirectorSchema(t), []string{"BasicCredentialDataInput.username"})
+		request := `mutation { setPackageInstanceAuth(authID: "auth-id", in: {auth: {credential: {basic: {username: "user", password: "not-secret"}}}}) { id } }`
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, `"user"`)
+		assert.Contains(t, redacted, "not-secret")
+	})
+
+	t.Run("should resolve input types against loaded schema", func(t *testing.T) {
+		//GIVEN
+		redactor := auditlog.NewRedactor(fixLoadedSchema(t), []string{"SecretInput.value"})
+		request := `mutation {
+			registerSecret(in: {name: "name", value: "top-secret"})
+			registerSetting(in: {name: "name", value: "not-secret"})
+		}`
+
+		//WHEN
+		redacted := redactor.Redact(request)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+		assert.Contains(t, redacted, "not-secret")
+	})
+}
+
+func TestRedactor_RedactResponse(t *testing.T) {
+	redactor := auditlog.NewRedactor(fixDirectorSchema(t), auditlog.DefaultSensitiveFields)
+
+	t.Run("should mask data and keep errors", func(t *testing.T) {
+		//GIVEN
+		response := `{"data":{"result":{"auths":[{"auth":{"credential":{"password":"top-secret"}}}]}},"errors":[{"message":"partial error","path":["result","webhooks"]}]}`
+
+		//WHEN
+		redacted := redactor.RedactResponse(response)
+
+		//THEN
+		assert.JSONEq(t, `{"data":"***","errors":[{"message":"partial error","path":["result","webhooks"]}]}`, redacted)
+	})
+
+	t.Run("should not modify response without data", func(t *testing.T) {
+		//GIVEN
+		response := `{"data":null,"errors":[{"message":"error","path":["result"]}]}`
+
+		//WHEN
+		redacted := redactor.RedactResponse(response)
+
+		//THEN
+		assert.Equal(t, response, redacted)
+	})
+
+	t.Run("should mask response which cannot be parsed", func(t *testing.T) {
+		//WHEN
+		redacted := redactor.RedactResponse(`{"data":{"password":"top-secret"`)
+
+		//THEN
+		assert.NotContains(t, redacted, "top-secret")
+	})
+}
+
+func fixGraphQLRequest(t *testing.T, query string, variables map[string]interface{}) string {
+	request, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	require.NoError(t, err)
+	return string(request)
+}
@@ -0,0 +1,31 @@
+package auditlog
+
+import (
+	"io/ioutil"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// LoadDirectorSchema returns the Director schema used to resolve the types of audited requests. The schema is loaded
+// from the SDL file at path, so it matches the deployed Director. If path is empty, it returns the schema
+// of the Director version the Gateway is built with, which does not know the types added in later versions.
+func LoadDirectorSchema(path string) (*ast.Schema, error) {
+	if path == "" {
+		return graphql.NewExecutableSchema(graphql.Config{}).Schema(), nil
+	}
+
+	sdl, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading Director schema from %s", path)
+	}
+
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: path, Input: string(sdl)})
+	if gqlErr != nil {
+		return nil, errors.Wrapf(gqlErr, "while parsing Director schema from %s", path)
+	}
+
+	return schema, nil
+}
@@ -0,0 +1,92 @@
+package auditlog_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+)
+
+const testSchema = `
+type Query {
+	settings: [String!]!
+}
+
+type Mutation {
+	registerSecret(in: SecretInput!): String
+	registerSetting(in: SettingInput!): String
+}
+
+input SecretInput {
+	name: String!
+	value: String!
+}
+
+input SettingInput {
+	name: String!
+	value: String!
+}
+`
+
+func TestLoadDirectorSchema(t *testing.T) {
+	t.Run("should return schema of Director the Gateway is built with if path is empty", func(t *testing.T) {
+		//WHEN
+		schema, err := auditlog.LoadDirectorSchema("")
+
+		//THEN
+		require.NoError(t, err)
+		assert.NotNil(t, schema.Types["Application"])
+	})
+
+	t.Run("should load schema from file", func(t *testing.T) {
+		//WHEN
+		schema := fixLoadedSchema(t)
+
+		//THEN
+		assert.NotNil(t, schema.Types["SecretInput"])
+		assert.Nil(t, schema.Types["Application"])
+	})
+
+	t.Run("should return error if file does not exist", func(t *testing.T) {
+		//WHEN
+		_, err := auditlog.LoadDirectorSchema(filepath.Join(fixSchemaDir(t), "schema.graphql"))
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while reading Director schema")
+	})
+
+	t.Run("should return error if schema is invalid", func(t *testing.T) {
+		//GIVEN
+		path := writeSchema(t, "type Query { settings: Unknown }")
+
+		//WHEN
+		_, err := auditlog.LoadDirectorSchema(path)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing Director schema")
+	})
+}
+
+func fixLoadedSchema(t *testing.T) *ast.Schema {
+	schema, err := auditlog.LoadDirectorSchema(writeSchema(t, testSchema))
+	require.NoError(t, err)
+	return schema
+}
+
+func writeSchema(t *testing.T, sdl string) string {
+	path := filepath.Join(fixSchemaDir(t), "schema.graphql")
+	require.NoError(t, ioutil.WriteFile(path, []byte(sdl), 0600))
+	return path
+}
+
+func fixSchemaDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "auditlog-schema")
+	require.NoError(t, err)
+	return dir
+}
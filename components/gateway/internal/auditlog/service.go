@@ -3,10 +3,12 @@ package auditlog
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
@@ -36,6 +38,17 @@ func (sink *Sink) Log(_ context.Context, msg proxy.AuditlogMessage) error {
 	return nil
 }
 
+// permanentError marks an auditlog message which can never be delivered, so retrying it makes no sense
+type permanentError struct {
+	error
+}
+
+// IsPermanent returns true if the error is caused by the message itself and not by the auditlog service
+func IsPermanent(err error) bool {
+	_, ok := errors.Cause(err).(permanentError)
+	return ok
+}
+
 type NoOpService struct {
 }
 
@@ -52,12 +65,14 @@ const (
 type AuditlogClient interface {
 	LogConfigurationChange(ctx context.Context, change model.ConfigurationChange) error
 	LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error
+	LogDataAccess(ctx context.Context, access model.DataAccess) error
 }
 
 //go:generate mockery --name=AuditlogMessageFactory --output=automock --outpkg=automock --case=underscore
 type AuditlogMessageFactory interface {
 	CreateConfigurationChange() model.ConfigurationChange
 	CreateSecurityEvent() model.SecurityEvent
+	CreateDataAccess() model.DataAccess
 }
 
 type Service struct {
@@ -77,15 +92,24 @@ func (svc *Service) PreLog(ctx context.Context, msg proxy.AuditlogMessage) error
 }
 
 func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
+	if len(msg.Reads) > 0 {
+		return svc.logReads(ctx, msg)
+	}
+
 	graphqlResponse, err := svc.parseResponse(msg.Response)
 	if err != nil {
-		return errors.Wrap(err, "while parsing response")
+		return errors.Wrap(permanentError{err}, "while parsing response")
 	}
 
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
 
+	if err := svc.logChanges(ctx, msg, correlationID); err != nil {
+		return err
+	}
+
 	if len(graphqlResponse.Errors) == 0 {
 		configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+		setMessageUUID(&configChangeMsg.Metadata, msg, PostAuditlogOperation)
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
 				Name: "response",
@@ -99,6 +123,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 
 	if svc.hasInsufficientScopeError(graphqlResponse.Errors) {
 		securityEventMsg := svc.msgFactory.CreateSecurityEvent()
+		setMessageUUID(&securityEventMsg.Metadata, msg, "security-event")
 		eventData := model.SecurityEventData{
 			ID:            fillID(msg.Claims, "Security Event"),
 			CorrelationID: correlationID,
@@ -106,7 +131,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 		}
 		data, err := json.Marshal(&eventData)
 		if err != nil {
-			return errors.Wrap(err, "while marshalling security event data")
+			return errors.Wrap(permanentError{err}, "while marshalling security event data")
 		}
 
 		securityEventMsg.Data = string(data)
@@ -116,10 +141,11 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 
 	isReadErr, err := isReadError(graphqlResponse, msg.Request)
 	if err != nil {
-		return errors.Wrap(err, "while checking if error is read error")
+		return errors.Wrap(permanentError{err}, "while checking if error is read error")
 	}
 
 	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+	setMessageUUID(&configChangeMsg.Metadata, msg, PostAuditlogOperation)
 	if isReadErr {
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
@@ -142,6 +168,88 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 	return errors.Wrap(err, "while sending configuration change")
 }
 
+// logChanges sends a configuration change with the old and new values of changed fields for every object changed by the request
+func (svc *Service) logChanges(ctx context.Context, msg proxy.AuditlogMessage, correlationID string) error {
+	for i, change := range msg.Changes {
+		changeMsg := svc.msgFactory.CreateConfigurationChange()
+		setMessageUUID(&changeMsg.Metadata, msg, fmt.Sprintf("change-%d", i))
+		changeMsg.Object = model.Object{
+			Type: change.Type,
+			ID: map[string]string{
+				"type":           change.Type,
+				"id":             change.ID,
+				"externalTenant": msg.Claims.Tenant,
+				"apiConsumer":    msg.Claims.ConsumerType,
+				"consumerID":     msg.Claims.ConsumerID,
+			},
+		}
+
+		for _, field := range change.Fields {
+			changeMsg.Attributes = append(changeMsg.Attributes, model.Attribute{
+				Name: field.Name,
+				Old:  field.Old,
+				New:  field.New,
+			})
+		}
+		changeMsg.Attributes = append(changeMsg.Attributes, model.Attribute{
+			Name: "correlation_id",
+			Old:  "",
+			New:  correlationID,
+		})
+
+		if err := svc.client.LogConfigurationChange(ctx, changeMsg); err != nil {
+			return errors.Wrapf(err, "while sending change of %s %s", change.Type, change.ID)
+		}
+	}
+
+	return nil
+}
+
+// logReads sends a data access message for every object whose sensitive fields were read by the request
+func (svc *Service) logReads(ctx context.Context, msg proxy.AuditlogMessage) error {
+	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
+	for i, read := range msg.Reads {
+		accessMsg := svc.msgFactory.CreateDataAccess()
+		setMessageUUID(&accessMsg.Metadata, msg, fmt.Sprintf("read-%d", i))
+		accessMsg.Object = model.Object{
+			Type: read.Type,
+			ID: map[string]string{
+				"type":           read.Type,
+				"id":             read.ID,
+				"externalTenant": msg.Claims.Tenant,
+				"apiConsumer":    msg.Claims.ConsumerType,
+				"consumerID":     msg.Claims.ConsumerID,
+				"correlationID":  correlationID,
+			},
+		}
+
+		for _, field := range read.Fields {
+			accessMsg.Attributes = append(accessMsg.Attributes, model.DataAccessAttribute{
+				Name:       field,
+				Successful: true,
+			})
+		}
+
+		if err := svc.client.LogDataAccess(ctx, accessMsg); err != nil {
+			return errors.Wrapf(err, "while sending data access of %s %s", read.Type, read.ID)
+		}
+	}
+
+	return nil
+}
+
+// setMessageUUID derives the UUID of the auditlog message sent for a part of the message from the ID of the message.
+// The auditlog messages sent again when a spooled message is delivered after a failure keep their UUIDs, so they can be deduplicated.
+// Messages spooled before they had an ID keep the UUID generated by the factory.
+func setMessageUUID(metadata *model.Metadata, msg proxy.AuditlogMessage, part string) {
+	id, err := uuid.Parse(msg.ID)
+	if err != nil {
+		return
+	}
+
+	metadata.UUID = uuid.NewSHA1(id, []byte(part)).String()
+}
+
 func (svc *Service) parseResponse(response string) (model.GraphqlResponse, error) {
 	var graphqlResponse model.GraphqlResponse
 	err := json.Unmarshal([]byte(response), &graphqlResponse)
@@ -47,6 +47,145 @@ func TestAuditlogService_Log(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, client, factory)
 	})
 
+	t.Run("Success mutation with changed objects", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
+
+		request := fixRequest()
+		response := fixNoErrorResponse(t)
+		claims := fixClaims()
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+
+		changeLog := fixFabricatedConfigChangeMsg()
+		changeLog.Object = model.Object{
+			Type: "Application",
+			ID: map[string]string{
+				"type":           "Application",
+				"id":             "app-id",
+				"externalTenant": claims.Tenant,
+				"apiConsumer":    claims.ConsumerType,
+				"consumerID":     claims.ConsumerID,
+			},
+		}
+		changeLog.Attributes = []model.Attribute{
+			{Name: "description", Old: "old", New: "new"},
+			{Name: "correlation_id", Old: "", New: fixCorrelationID()[correlation.RequestIDHeaderKey]},
+		}
+
+		client := &automock.AuditlogClient{}
+		client.On("LogConfigurationChange", context.TODO(), changeLog).Return(nil).Once()
+		client.On("LogConfigurationChange", context.TODO(), log).Return(nil).Once()
+		auditlogSvc := auditlog.NewService(client, factory)
+
+		//WHEN
+		msg := proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              request,
+			Response:             response,
+			Claims:               claims,
+			Changes: []proxy.ObjectChange{{
+				Type:   "Application",
+				ID:     "app-id",
+				Fields: []proxy.FieldChange{{Name: "description", Old: "old", New: "new"}},
+			}},
+		}
+		err := auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, client, factory)
+	})
+
+	t.Run("Mutation with changed objects delivered again keeps UUIDs of sent messages", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
+
+		var uuids []string
+		collectUUID := func(args mock.Arguments) {
+			uuids = append(uuids, args.Get(1).(model.ConfigurationChange).UUID)
+		}
+
+		client := &automock.AuditlogClient{}
+		client.On("LogConfigurationChange", context.TODO(), mock.Anything).Run(collectUUID).Return(nil).Once()
+		client.On("LogConfigurationChange", context.TODO(), mock.Anything).Run(collectUUID).Return(errors.New("test-error")).Once()
+		client.On("LogConfigurationChange", context.TODO(), mock.Anything).Run(collectUUID).Return(nil).Twice()
+		auditlogSvc := auditlog.NewService(client, factory)
+
+		msg := proxy.AuditlogMessage{
+			ID:                   "0f8fad5b-d9cb-469f-a165-70867728950e",
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              fixRequest(),
+			Response:             fixNoErrorResponse(t),
+			Claims:               fixClaims(),
+			Changes: []proxy.ObjectChange{{
+				Type:   "Application",
+				ID:     "app-id",
+				Fields: []proxy.FieldChange{{Name: "description", Old: "old", New: "new"}},
+			}},
+		}
+
+		//WHEN
+		err := auditlogSvc.Log(context.TODO(), msg)
+		require.Error(t, err)
+		err = auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, uuids, 4)
+		assert.Equal(t, uuids[0], uuids[2])
+		assert.Equal(t, uuids[1], uuids[3])
+		assert.NotEqual(t, uuids[0], uuids[1])
+		assert.NotEqual(t, TestMsgID, uuids[0])
+		mock.AssertExpectationsForObjects(t, client, factory)
+	})
+
+	t.Run("Success query with sensitive reads", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateDataAccess").Return(fixFabricatedDataAccessMsg())
+
+		claims := fixClaims()
+		log := fixFabricatedDataAccessMsg()
+		log.Object = model.Object{
+			Type: "Application",
+			ID: map[string]string{
+				"type":           "Application",
+				"id":             "app-id",
+				"externalTenant": claims.Tenant,
+				"apiConsumer":    claims.ConsumerType,
+				"consumerID":     claims.ConsumerID,
+				"correlationID":  fixCorrelationID()[correlation.RequestIDHeaderKey],
+			},
+		}
+		log.Attributes = []model.DataAccessAttribute{
+			{Name: "auths", Successful: true},
+			{Name: "packages.data.instanceAuths", Successful: true},
+		}
+
+		client := &automock.AuditlogClient{}
+		client.On("LogDataAccess", context.TODO(), log).Return(nil).Once()
+		auditlogSvc := auditlog.NewService(client, factory)
+
+		//WHEN
+		msg := proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              fixRequest(),
+			Reads: []proxy.ObjectRead{{
+				Type:   "Application",
+				ID:     "app-id",
+				Fields: []string{"auths", "packages.data.instanceAuths"},
+			}},
+			Claims: claims,
+		}
+		err := auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, client, factory)
+	})
+
 	t.Run("Unsuccessful mutation", func(t *testing.T) {
 		//GIVEN
 		factory := &automock.AuditlogMessageFactory{}
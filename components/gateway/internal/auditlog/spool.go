@@ -0,0 +1,270 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+const (
+	spoolEntryExtension = ".json"
+	spoolTmpExtension   = ".tmp"
+	rejectedDir         = "rejected"
+)
+
+// SpoolEntry is an auditlog message stored in the spool
+type SpoolEntry struct {
+	Sequence  uint64                `json:"sequence"`
+	CreatedAt time.Time             `json:"createdAt"`
+	Message   proxy.AuditlogMessage `json:"message"`
+}
+
+// Spool is a write-ahead log of auditlog messages on local disk. Every message is stored in its own file
+// until the auditlog service acknowledges it, so no message is lost if the Gateway crashes.
+type Spool struct {
+	dir        string
+	maxEntries int
+
+	mutex     sync.Mutex
+	nextSeq   uint64
+	pending   map[uint64]time.Time
+	rejected  int
+	recovered []SpoolEntry
+}
+
+// NewSpool opens the spool in given directory, creating it if it does not exist.
+// maxEntries limits the number of not acknowledged messages, 0 means no limit.
+func NewSpool(dir string, maxEntries int) (*Spool, error) {
+	if err := os.MkdirAll(filepath.Join(dir, rejectedDir), 0700); err != nil {
+		return nil, errors.Wrapf(err, "while creating spool directory %s", dir)
+	}
+
+	spool := &Spool{
+		dir:        dir,
+		maxEntries: maxEntries,
+		nextSeq:    1,
+		pending:    map[uint64]time.Time{},
+	}
+
+	entries, err := spool.load()
+	if err != nil {
+		return nil, err
+	}
+	spool.recovered = entries
+	for _, entry := range entries {
+		spool.pending[entry.Sequence] = entry.CreatedAt
+		if entry.Sequence >= spool.nextSeq {
+			spool.nextSeq = entry.Sequence + 1
+		}
+	}
+
+	rejected, err := ioutil.ReadDir(filepath.Join(dir, rejectedDir))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading rejected auditlog messages")
+	}
+	for _, file := range rejected {
+		if sequence, ok := parseSequence(file.Name()); ok && sequence >= spool.nextSeq {
+			spool.nextSeq = sequence + 1
+		}
+	}
+	spool.rejected = len(rejected)
+
+	return spool, nil
+}
+
+// Append stores the message on disk and returns its entry. It fails if the spool is full.
+func (s *Spool) Append(msg proxy.AuditlogMessage) (SpoolEntry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.maxEntries > 0 && len(s.pending) >= s.maxEntries {
+		return SpoolEntry{}, errors.Errorf("auditlog spool is full (size=%d)", len(s.pending))
+	}
+
+	entry := SpoolEntry{
+		Sequence:  s.nextSeq,
+		CreatedAt: time.Now().UTC(),
+		Message:   msg,
+	}
+
+	if err := s.write(entry); err != nil {
+		return SpoolEntry{}, err
+	}
+
+	s.nextSeq++
+	s.pending[entry.Sequence] = entry.CreatedAt
+
+	return entry, nil
+}
+
+// Ack removes the message acknowledged by the auditlog service from the spool
+func (s *Spool) Ack(sequence uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.Remove(s.entryPath(sequence)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "while removing auditlog message %d from spool", sequence)
+	}
+	delete(s.pending, sequence)
+
+	return nil
+}
+
+// Reject moves the message which cannot be delivered to the rejected directory of the spool, where it is kept for manual recovery
+func (s *Spool) Reject(sequence uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.Rename(s.entryPath(sequence), filepath.Join(s.dir, rejectedDir, entryFileName(sequence))); err != nil {
+		return errors.Wrapf(err, "while rejecting auditlog message %d", sequence)
+	}
+	delete(s.pending, sequence)
+	s.rejected++
+
+	return nil
+}
+
+// Recovered returns the messages which were not acknowledged when the spool was opened, in the order they were appended
+func (s *Spool) Recovered() []SpoolEntry {
+	return s.recovered
+}
+
+func (s *Spool) load() ([]SpoolEntry, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading spool directory")
+	}
+
+	var entries []SpoolEntry
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		if strings.HasSuffix(file.Name(), spoolTmpExtension) {
+			// message which was not completely written, it has not been accepted by the spool
+			_ = os.Remove(filepath.Join(s.dir, file.Name()))
+			continue
+		}
+
+		if _, ok := parseSequence(file.Name()); !ok {
+			continue
+		}
+
+		entry, err := s.read(file.Name())
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Sequence < entries[j].Sequence
+	})
+
+	return entries, nil
+}
+
+// Stats returns the number of not acknowledged messages, the age of the oldest one, and the number of rejected messages
+func (s *Spool) Stats() (int, time.Duration, int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var oldest time.Time
+	for _, createdAt := range s.pending {
+		if oldest.IsZero() || createdAt.Before(oldest) {
+			oldest = createdAt
+		}
+	}
+
+	var age time.Duration
+	if !oldest.IsZero() {
+		age = time.Since(oldest)
+	}
+
+	return len(s.pending), age, s.rejected
+}
+
+func (s *Spool) write(entry SpoolEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling auditlog message")
+	}
+
+	tmpPath := s.entryPath(entry.Sequence) + spoolTmpExtension
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "while creating spool file")
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "while writing spool file")
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "while syncing spool file")
+	}
+
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "while closing spool file")
+	}
+
+	if err := os.Rename(tmpPath, s.entryPath(entry.Sequence)); err != nil {
+		return errors.Wrap(err, "while renaming spool file")
+	}
+
+	return syncDir(s.dir)
+}
+
+func (s *Spool) read(name string) (SpoolEntry, error) {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return SpoolEntry{}, errors.Wrapf(err, "while reading spool file %s", name)
+	}
+
+	var entry SpoolEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return SpoolEntry{}, errors.Wrapf(err, "while unmarshalling spool file %s", name)
+	}
+
+	return entry, nil
+}
+
+func (s *Spool) entryPath(sequence uint64) string {
+	return filepath.Join(s.dir, entryFileName(sequence))
+}
+
+func entryFileName(sequence uint64) string {
+	return fmt.Sprintf("%020d%s", sequence, spoolEntryExtension)
+}
+
+func parseSequence(name string) (uint64, bool) {
+	if !strings.HasSuffix(name, spoolEntryExtension) {
+		return 0, false
+	}
+
+	sequence, err := strconv.ParseUint(strings.TrimSuffix(name, spoolEntryExtension), 10, 64)
+	return sequence, err == nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return errors.Wrap(err, "while opening spool directory")
+	}
+	defer d.Close()
+
+	return errors.Wrap(d.Sync(), "while syncing spool directory")
+}
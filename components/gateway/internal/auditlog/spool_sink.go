@@ -0,0 +1,188 @@
+package auditlog
+
+import (
+	"context"
+	"hash/fnv"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+// RetryConfig configures the delays between the attempts to deliver a spooled message
+type RetryConfig struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// SpoolSink stores auditlog messages in the spool before they are delivered by the workers, so they survive
+// Gateway restarts. Messages with the same correlation ID are delivered by the same worker in the order they were logged.
+type SpoolSink struct {
+	spool      *Spool
+	svc        proxy.AuditlogService
+	retry      RetryConfig
+	partitions []*partition
+	done       chan bool
+}
+
+func NewSpoolSink(spool *Spool, svc proxy.AuditlogService, workers int, retry RetryConfig, done chan bool) *SpoolSink {
+	if workers < 1 {
+		workers = 1
+	}
+	if retry.InitialBackoff <= 0 {
+		retry.InitialBackoff = time.Second
+	}
+	if retry.MaxBackoff < retry.InitialBackoff {
+		retry.MaxBackoff = retry.InitialBackoff
+	}
+
+	partitions := make([]*partition, workers)
+	for i := range partitions {
+		partitions[i] = newPartition()
+	}
+
+	return &SpoolSink{
+		spool:      spool,
+		svc:        svc,
+		retry:      retry,
+		partitions: partitions,
+		done:       done,
+	}
+}
+
+// Start queues the messages recovered from the spool and starts the workers. It does not block.
+func (sink *SpoolSink) Start() {
+	recovered := sink.spool.Recovered()
+	if len(recovered) > 0 {
+		log.Printf("Replaying %d auditlog messages from the spool", len(recovered))
+	}
+	for _, entry := range recovered {
+		sink.dispatch(entry)
+	}
+
+	for i, p := range sink.partitions {
+		go sink.work(i, p)
+	}
+}
+
+func (sink *SpoolSink) Log(_ context.Context, msg proxy.AuditlogMessage) error {
+	entry, err := sink.spool.Append(msg)
+	if err != nil {
+		return errors.Wrap(err, "while storing auditlog message in the spool")
+	}
+
+	sink.dispatch(entry)
+	return nil
+}
+
+func (sink *SpoolSink) dispatch(entry SpoolEntry) {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(entry.Message.CorrelationIDHeaders[correlation.RequestIDHeaderKey]))
+
+	sink.partitions[hash.Sum32()%uint32(len(sink.partitions))].push(entry)
+}
+
+func (sink *SpoolSink) work(id int, p *partition) {
+	log.Printf("Starting worker %d for spooled auditlog message processing", id)
+	for {
+		select {
+		case <-sink.done:
+			log.Printf("Worker %d for spooled auditlog message processing has finished", id)
+			return
+		case <-p.notify:
+		}
+
+		for {
+			entry, ok := p.peek()
+			if !ok {
+				break
+			}
+
+			if !sink.deliver(entry) {
+				log.Printf("Worker %d for spooled auditlog message processing has finished", id)
+				return
+			}
+			p.pop()
+		}
+	}
+}
+
+// deliver sends the entry to the auditlog service until it is acknowledged or rejected. It returns false if the worker is stopped.
+func (sink *SpoolSink) deliver(entry SpoolEntry) bool {
+	backoff := sink.retry.InitialBackoff
+	for {
+		ctx := context.WithValue(context.Background(), correlation.HeadersContextKey, entry.Message.CorrelationIDHeaders)
+		err := sink.svc.Log(ctx, entry.Message)
+		if err == nil {
+			if err := sink.spool.Ack(entry.Sequence); err != nil {
+				log.Printf("Error while acknowledging auditlog message %d: %s", entry.Sequence, err.Error())
+			}
+			return true
+		}
+
+		if IsPermanent(err) {
+			log.Printf("Rejecting auditlog message %d which cannot be delivered: %s", entry.Sequence, err.Error())
+			if err := sink.spool.Reject(entry.Sequence); err != nil {
+				log.Printf("Error while rejecting auditlog message %d: %s", entry.Sequence, err.Error())
+			}
+			return true
+		}
+
+		log.Printf("Error while saving auditlog message %d, retrying in %s: %s", entry.Sequence, backoff, err.Error())
+		select {
+		case <-sink.done:
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > sink.retry.MaxBackoff {
+			backoff = sink.retry.MaxBackoff
+		}
+	}
+}
+
+// partition is an unbounded FIFO queue of spooled messages processed by a single worker
+type partition struct {
+	mutex   sync.Mutex
+	entries []SpoolEntry
+	notify  chan struct{}
+}
+
+func newPartition() *partition {
+	return &partition{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (p *partition) push(entry SpoolEntry) {
+	p.mutex.Lock()
+	p.entries = append(p.entries, entry)
+	p.mutex.Unlock()
+
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (p *partition) peek() (SpoolEntry, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.entries) == 0 {
+		return SpoolEntry{}, false
+	}
+	return p.entries[0], true
+}
+
+func (p *partition) pop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.entries[0] = SpoolEntry{}
+	p.entries = p.entries[1:]
+}
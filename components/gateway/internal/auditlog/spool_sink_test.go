@@ -0,0 +1,192 @@
+package auditlog_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	proxyautomock "github.com/kyma-incubator/compass/components/gateway/pkg/proxy/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetry = auditlog.RetryConfig{
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+}
+
+func TestSpoolSink_Log(t *testing.T) {
+	t.Run("should deliver messages with the same correlation ID in order and acknowledge them", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		var mutex sync.Mutex
+		delivered := map[string][]string{}
+		svc := &proxyautomock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(func(_ context.Context, msg proxy.AuditlogMessage) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
+			delivered[correlationID] = append(delivered[correlationID], msg.Request)
+			return nil
+		})
+
+		done := make(chan bool)
+		defer close(done)
+		sink := auditlog.NewSpoolSink(spool, svc, 3, testRetry, done)
+		sink.Start()
+
+		//WHEN
+		for i := 0; i < 10; i++ {
+			for _, correlationID := range []string{"a", "b", "c", "d"} {
+				err := sink.Log(context.TODO(), fixSpoolMessageWithCorrelationID(string(rune('0'+i)), correlationID))
+				require.NoError(t, err)
+			}
+		}
+
+		//THEN
+		waitForEmptySpool(t, spool)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, correlationID := range []string{"a", "b", "c", "d"} {
+			assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}, delivered[correlationID])
+		}
+	})
+
+	t.Run("should retry message until it is delivered", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		svc := &proxyautomock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(errors.New("auditlog unavailable")).Twice()
+		svc.On("Log", mock.Anything, mock.Anything).Return(nil).Once()
+
+		done := make(chan bool)
+		defer close(done)
+		sink := auditlog.NewSpoolSink(spool, svc, 1, testRetry, done)
+		sink.Start()
+
+		//WHEN
+		err = sink.Log(context.TODO(), fixSpoolMessage("request"))
+
+		//THEN
+		require.NoError(t, err)
+		waitForEmptySpool(t, spool)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("should reject message which cannot be delivered", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		svc := auditlog.NewService(&automock.AuditlogClient{}, &automock.AuditlogMessageFactory{})
+
+		done := make(chan bool)
+		defer close(done)
+		sink := auditlog.NewSpoolSink(spool, svc, 1, testRetry, done)
+		sink.Start()
+
+		msg := fixSpoolMessage("request")
+		msg.Response = "not a graphql response"
+
+		//WHEN
+		err = sink.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		waitForEmptySpool(t, spool)
+		_, _, rejected := spool.Stats()
+		assert.Equal(t, 1, rejected)
+	})
+
+	t.Run("should replay messages recovered from the spool", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		previous, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+		_, err = previous.Append(fixSpoolMessage("first"))
+		require.NoError(t, err)
+		_, err = previous.Append(fixSpoolMessage("second"))
+		require.NoError(t, err)
+
+		spool, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		var mutex sync.Mutex
+		var delivered []string
+		svc := &proxyautomock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(func(_ context.Context, msg proxy.AuditlogMessage) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			delivered = append(delivered, msg.Request)
+			return nil
+		})
+
+		done := make(chan bool)
+		defer close(done)
+		sink := auditlog.NewSpoolSink(spool, svc, 2, testRetry, done)
+
+		//WHEN
+		sink.Start()
+
+		//THEN
+		waitForEmptySpool(t, spool)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		assert.Equal(t, []string{"first", "second"}, delivered)
+	})
+
+	t.Run("should fail when spool is full", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 1)
+		require.NoError(t, err)
+
+		done := make(chan bool)
+		defer close(done)
+		sink := auditlog.NewSpoolSink(spool, &proxyautomock.AuditlogService{}, 1, testRetry, done)
+
+		err = sink.Log(context.TODO(), fixSpoolMessage("first"))
+		require.NoError(t, err)
+
+		//WHEN
+		err = sink.Log(context.TODO(), fixSpoolMessage("second"))
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while storing auditlog message in the spool")
+	})
+}
+
+func waitForEmptySpool(t *testing.T, spool *auditlog.Spool) {
+	require.Eventually(t, func() bool {
+		depth, _, _ := spool.Stats()
+		return depth == 0
+	}, 5*time.Second, 5*time.Millisecond)
+}
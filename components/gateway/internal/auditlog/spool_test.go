@@ -0,0 +1,138 @@
+package auditlog_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpool(t *testing.T) {
+	t.Run("should recover not acknowledged messages after reopening", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		first, err := spool.Append(fixSpoolMessage("first"))
+		require.NoError(t, err)
+		second, err := spool.Append(fixSpoolMessage("second"))
+		require.NoError(t, err)
+		third, err := spool.Append(fixSpoolMessage("third"))
+		require.NoError(t, err)
+
+		require.NoError(t, spool.Ack(first.Sequence))
+		require.NoError(t, spool.Reject(second.Sequence))
+
+		//WHEN
+		reopened, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		//THEN
+		recovered := reopened.Recovered()
+		require.Len(t, recovered, 1)
+		assert.Equal(t, third.Sequence, recovered[0].Sequence)
+		assert.Equal(t, "third", recovered[0].Message.Request)
+
+		depth, _, rejected := reopened.Stats()
+		assert.Equal(t, 1, depth)
+		assert.Equal(t, 1, rejected)
+
+		next, err := reopened.Append(fixSpoolMessage("fourth"))
+		require.NoError(t, err)
+		assert.True(t, next.Sequence > third.Sequence)
+	})
+
+	t.Run("should return recovered messages in the order they were appended", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		for i := 0; i < 12; i++ {
+			_, err := spool.Append(fixSpoolMessage(string(rune('a' + i))))
+			require.NoError(t, err)
+		}
+
+		//WHEN
+		reopened, err := auditlog.NewSpool(dir, 0)
+		require.NoError(t, err)
+
+		//THEN
+		recovered := reopened.Recovered()
+		require.Len(t, recovered, 12)
+		for i, entry := range recovered {
+			assert.Equal(t, string(rune('a'+i)), entry.Message.Request)
+		}
+	})
+
+	t.Run("should ignore not completely written messages", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		tmpFile := filepath.Join(dir, "00000000000000000001.json.tmp")
+		require.NoError(t, ioutil.WriteFile(tmpFile, []byte(`{"sequ`), 0600))
+
+		//WHEN
+		spool, err := auditlog.NewSpool(dir, 0)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Empty(t, spool.Recovered())
+		_, err = os.Stat(tmpFile)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should fail when spool is full", func(t *testing.T) {
+		//GIVEN
+		dir := fixSpoolDir(t)
+		defer os.RemoveAll(dir)
+
+		spool, err := auditlog.NewSpool(dir, 1)
+		require.NoError(t, err)
+
+		entry, err := spool.Append(fixSpoolMessage("first"))
+		require.NoError(t, err)
+
+		//WHEN
+		_, err = spool.Append(fixSpoolMessage("second"))
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auditlog spool is full")
+
+		require.NoError(t, spool.Ack(entry.Sequence))
+		_, err = spool.Append(fixSpoolMessage("second"))
+		require.NoError(t, err)
+	})
+}
+
+func fixSpoolDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "auditlog-spool")
+	require.NoError(t, err)
+	return dir
+}
+
+func fixSpoolMessage(request string) proxy.AuditlogMessage {
+	return fixSpoolMessageWithCorrelationID(request, fixCorrelationID()[correlation.RequestIDHeaderKey])
+}
+
+func fixSpoolMessageWithCorrelationID(request, correlationID string) proxy.AuditlogMessage {
+	return proxy.AuditlogMessage{
+		CorrelationIDHeaders: correlation.Headers{correlation.RequestIDHeaderKey: correlationID},
+		Request:              request,
+		Response:             "{}",
+		Claims:               fixClaims(),
+	}
+}
@@ -0,0 +1,189 @@
+package auditlog
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	syslogSeverityWarning = 4
+	syslogSeverityNotice  = 5
+
+	syslogNilValue = "-"
+)
+
+// SyslogClient sends audit log messages as RFC 5424 syslog messages over TCP or TLS.
+// The messages are framed by octet counting as described in RFC 5425.
+// The connection is established lazily and reestablished after a failed write.
+type SyslogClient struct {
+	cfg       SyslogConfig
+	tlsConfig *tls.Config
+	hostname  string
+	procID    string
+
+	mutex sync.Mutex
+	conn  net.Conn
+}
+
+func NewSyslogClient(cfg SyslogConfig) (*SyslogClient, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("syslog address is required")
+	}
+	if cfg.Facility < 0 || cfg.Facility > 23 {
+		return nil, fmt.Errorf("invalid syslog facility: %d", cfg.Facility)
+	}
+
+	client := &SyslogClient{
+		cfg:    cfg,
+		procID: fmt.Sprintf("%d", os.Getpid()),
+	}
+
+	switch cfg.Network {
+	case SyslogTCP:
+	case SyslogTLS:
+		tlsConfig, err := syslogTLSConfig(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		client.tlsConfig = tlsConfig
+	default:
+		return nil, fmt.Errorf("invalid syslog network: %s", cfg.Network)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = syslogNilValue
+	}
+	client.hostname = hostname
+
+	return client, nil
+}
+
+func (c *SyslogClient) LogConfigurationChange(_ context.Context, change model.ConfigurationChange) error {
+	return c.write(syslogSeverityNotice, configurationChangeRecord(change))
+}
+
+func (c *SyslogClient) LogSecurityEvent(_ context.Context, event model.SecurityEvent) error {
+	return c.write(syslogSeverityWarning, securityEventRecord(event))
+}
+
+func (c *SyslogClient) LogDataAccess(_ context.Context, access model.DataAccess) error {
+	return c.write(syslogSeverityNotice, dataAccessRecord(access))
+}
+
+func (c *SyslogClient) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+func (c *SyslogClient) write(severity int, record Record) error {
+	msg, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(permanentError{err}, "while marshalling auditlog record")
+	}
+
+	frame := c.frame(severity, record.Type, msg)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.conn == nil {
+		conn, err := c.dial()
+		if err != nil {
+			return errors.Wrapf(err, "while connecting to syslog %s", c.cfg.Address)
+		}
+		c.conn = conn
+	}
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.cfg.Timeout)); err != nil {
+		c.reset()
+		return errors.Wrap(err, "while setting syslog write deadline")
+	}
+
+	if _, err := c.conn.Write(frame); err != nil {
+		c.reset()
+		return errors.Wrapf(err, "while writing to syslog %s", c.cfg.Address)
+	}
+	return nil
+}
+
+// frame formats the RFC 5424 message: <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (c *SyslogClient) frame(severity int, msgID string, msg []byte) []byte {
+	header := fmt.Sprintf("<%d>1 %s %s %s %s %s %s ",
+		c.cfg.Facility*8+severity,
+		time.Now().UTC().Format(time.RFC3339Nano),
+		syslogHeaderValue(c.hostname, 255),
+		syslogHeaderValue(c.cfg.AppName, 48),
+		syslogHeaderValue(c.procID, 128),
+		syslogHeaderValue(msgID, 32),
+		syslogNilValue,
+	)
+
+	message := append([]byte(header), msg...)
+	return append([]byte(fmt.Sprintf("%d ", len(message))), message...)
+}
+
+func (c *SyslogClient) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: c.cfg.Timeout}
+	if c.tlsConfig != nil {
+		return tls.DialWithDialer(dialer, "tcp", c.cfg.Address, c.tlsConfig)
+	}
+	return dialer.Dial("tcp", c.cfg.Address)
+}
+
+func (c *SyslogClient) reset() {
+	_ = c.conn.Close()
+	c.conn = nil
+}
+
+func syslogTLSConfig(caFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile == "" {
+		return tlsConfig, nil
+	}
+
+	caCert, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading syslog CA file")
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("syslog CA file does not contain any PEM encoded certificate")
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+// syslogHeaderValue returns the value limited to printable US-ASCII characters and the maximum length of the header field
+func syslogHeaderValue(value string, maxLength int) string {
+	result := make([]byte, 0, len(value))
+	for i := 0; i < len(value) && len(result) < maxLength; i++ {
+		if value[i] >= 33 && value[i] <= 126 {
+			result = append(result, value[i])
+		}
+	}
+
+	if len(result) == 0 {
+		return syslogNilValue
+	}
+	return string(result)
+}
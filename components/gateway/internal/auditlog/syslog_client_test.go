@@ -0,0 +1,127 @@
+package auditlog_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogClient(t *testing.T) {
+	t.Run("should send RFC 5424 messages framed by octet counting", func(t *testing.T) {
+		//GIVEN
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		frames := make(chan string, 2)
+		go receiveSyslogFrames(t, listener, frames)
+
+		client, err := auditlog.NewSyslogClient(fixSyslogConfig(listener.Addr().String()))
+		require.NoError(t, err)
+		defer client.Close()
+
+		//WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixFilledConfigChangeMsg())
+		require.NoError(t, err)
+		err = client.LogSecurityEvent(context.TODO(), fixFabricatedSecurityEventMsg())
+		require.NoError(t, err)
+
+		//THEN
+		configChange := <-frames
+		assert.True(t, strings.HasPrefix(configChange, "<109>1 "), configChange)
+		assertSyslogRecord(t, configChange, auditlog.ConfigurationChangeRecord)
+
+		securityEvent := <-frames
+		assert.True(t, strings.HasPrefix(securityEvent, "<108>1 "), securityEvent)
+		assertSyslogRecord(t, securityEvent, auditlog.SecurityEventRecord)
+	})
+
+	t.Run("should return error when syslog is not available", func(t *testing.T) {
+		//GIVEN
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		address := listener.Addr().String()
+		require.NoError(t, listener.Close())
+
+		client, err := auditlog.NewSyslogClient(fixSyslogConfig(address))
+		require.NoError(t, err)
+
+		//WHEN
+		err = client.LogSecurityEvent(context.TODO(), fixFabricatedSecurityEventMsg())
+
+		//THEN
+		require.Error(t, err)
+	})
+
+	t.Run("should return error for invalid network", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSyslogConfig("127.0.0.1:514")
+		cfg.Network = "udp"
+
+		//WHEN
+		_, err := auditlog.NewSyslogClient(cfg)
+
+		//THEN
+		require.Error(t, err)
+	})
+}
+
+func fixSyslogConfig(address string) auditlog.SyslogConfig {
+	return auditlog.SyslogConfig{
+		Address:  address,
+		Network:  auditlog.SyslogTCP,
+		AppName:  "compass-gateway",
+		Facility: 13,
+		Timeout:  time.Second,
+	}
+}
+
+func receiveSyslogFrames(t *testing.T, listener net.Listener, frames chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for {
+		rawLength, err := reader.ReadString(' ')
+		if err != nil {
+			return
+		}
+
+		length, err := strconv.Atoi(strings.TrimSpace(rawLength))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		frame := make([]byte, length)
+		if _, err := io.ReadFull(reader, frame); !assert.NoError(t, err) {
+			return
+		}
+		frames <- string(frame)
+	}
+}
+
+func assertSyslogRecord(t *testing.T, frame, recordType string) {
+	parts := strings.SplitN(frame, " ", 8)
+	require.Len(t, parts, 8)
+	assert.Equal(t, "compass-gateway", parts[3])
+	assert.Equal(t, recordType, parts[5])
+	assert.Equal(t, "-", parts[6])
+
+	var record auditlog.Record
+	require.NoError(t, json.Unmarshal([]byte(parts[7]), &record), fmt.Sprintf("invalid message: %s", parts[7]))
+	assert.Equal(t, recordType, record.Type)
+}
@@ -0,0 +1,477 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/httpcommon"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/parser"
+)
+
+// trackedObject is a Director object type whose state can be queried by its ID. The typeName is the GraphQL type
+// of the query result, which is used to resolve the sensitive fields of the state.
+type trackedObject struct {
+	name     string
+	typeName string
+	query    string
+}
+
+// The specification data of API and event definitions and the data of documents are not tracked because of their size.
+// Only the type and the identifiers of credentials are queried, so secret values, such as passwords, client secrets,
+// and additional headers and query parameters, never leave the Director.
+const (
+	credentialFields   = `__typename ... on BasicCredentialData { username } ... on OAuthCredentialData { clientId url } ... on PrivateKeyJWTCredentialData { clientId jwksURI url } ... on CertificateCredentialData { clientId subject thumbprint url }`
+	authFields         = `credential { ` + credentialFields + ` } requestAuth { csrf { tokenEndpointURL credential { ` + credentialFields + ` } } }`
+	fetchRequestFields = `url mode filter auth { ` + authFields + ` }`
+	versionFields      = `value deprecated deprecatedSince forRemoval`
+)
+
+var (
+	applicationObject = trackedObject{
+		name:     "Application",
+		typeName: "Application",
+		query:    `query ($id: ID!) { result: application(id: $id) { id name providerName description integrationSystemID healthCheckURL labels } }`,
+	}
+	runtimeObject = trackedObject{
+		name:     "Runtime",
+		typeName: "Runtime",
+		query:    `query ($id: ID!) { result: runtime(id: $id) { id name description labels } }`,
+	}
+	runtimeContextObject = trackedObject{
+		name:     "RuntimeContext",
+		typeName: "RuntimeContext",
+		query:    `query ($id: ID!) { result: runtimeContext(id: $id) { id key value labels } }`,
+	}
+	integrationSystemObject = trackedObject{
+		name:     "IntegrationSystem",
+		typeName: "IntegrationSystem",
+		query:    `query ($id: ID!) { result: integrationSystem(id: $id) { id name description } }`,
+	}
+	applicationTemplateObject = trackedObject{
+		name:     "ApplicationTemplate",
+		typeName: "ApplicationTemplate",
+		query:    `query ($id: ID!) { result: applicationTemplate(id: $id) { id name description applicationInput accessLevel } }`,
+	}
+	labelDefinitionObject = trackedObject{
+		name:     "LabelDefinition",
+		typeName: "LabelDefinition",
+		query:    `query ($id: String!) { result: labelDefinition(key: $id) { key schema } }`,
+	}
+	packageObject = trackedObject{
+		name:     "Package",
+		typeName: "Package",
+		query:    `query ($id: ID!) { result: package(id: $id) { id name description instanceAuthRequestInputSchema defaultInstanceAuth { ` + authFields + ` } } }`,
+	}
+	apiDefinitionObject = trackedObject{
+		name:     "APIDefinition",
+		typeName: "APIDefinition",
+		query:    `query ($id: ID!) { result: apiDefinition(id: $id) { id name description targetURL group version { ` + versionFields + ` } spec { format type fetchRequest { ` + fetchRequestFields + ` } } } }`,
+	}
+	eventDefinitionObject = trackedObject{
+		name:     "EventDefinition",
+		typeName: "EventDefinition",
+		query:    `query ($id: ID!) { result: eventDefinition(id: $id) { id name description group version { ` + versionFields + ` } spec { format type fetchRequest { ` + fetchRequestFields + ` } } } }`,
+	}
+	documentObject = trackedObject{
+		name:     "Document",
+		typeName: "Document",
+		query:    `query ($id: ID!) { result: document(id: $id) { id title displayName description format kind fetchRequest { ` + fetchRequestFields + ` } } }`,
+	}
+	webhookObject = trackedObject{
+		name:     "Webhook",
+		typeName: "Webhook",
+		query:    `query ($id: ID!) { result: webhook(id: $id) { id applicationID type url auth { ` + authFields + ` } } }`,
+	}
+	packageInstanceAuthObject = trackedObject{
+		name:     "PackageInstanceAuth",
+		typeName: "PackageInstanceAuth",
+		query:    `query ($id: ID!) { result: packageInstanceAuth(id: $id) { id context inputParams auth { ` + authFields + ` } status { condition reason message } } }`,
+	}
+	applicationSystemAuthObject = trackedObject{
+		name:     "ApplicationSystemAuth",
+		typeName: "SystemAuth",
+		query:    `query ($id: ID!) { result: systemAuthForApplication(authID: $id) { id expiresAt auth { ` + authFields + ` } } }`,
+	}
+	runtimeSystemAuthObject = trackedObject{
+		name:     "RuntimeSystemAuth",
+		typeName: "SystemAuth",
+		query:    `query ($id: ID!) { result: systemAuthForRuntime(authID: $id) { id expiresAt auth { ` + authFields + ` } } }`,
+	}
+	integrationSystemSystemAuthObject = trackedObject{
+		name:     "IntegrationSystemSystemAuth",
+		typeName: "SystemAuth",
+		query:    `query ($id: ID!) { result: systemAuthForIntegrationSystem(authID: $id) { id expiresAt auth { ` + authFields + ` } } }`,
+	}
+)
+
+// trackedMutation is a Director mutation which changes an existing object identified by the value of the argument at idPath
+type trackedMutation struct {
+	object trackedObject
+	idPath []string
+}
+
+var trackedMutations = map[string]trackedMutation{
+	"updateApplication":           {object: applicationObject, idPath: []string{"id"}},
+	"unregisterApplication":       {object: applicationObject, idPath: []string{"id"}},
+	"setApplicationLabel":         {object: applicationObject, idPath: []string{"applicationID"}},
+	"deleteApplicationLabel":      {object: applicationObject, idPath: []string{"applicationID"}},
+	"updateRuntime":               {object: runtimeObject, idPath: []string{"id"}},
+	"unregisterRuntime":           {object: runtimeObject, idPath: []string{"id"}},
+	"setRuntimeLabel":             {object: runtimeObject, idPath: []string{"runtimeID"}},
+	"deleteRuntimeLabel":          {object: runtimeObject, idPath: []string{"runtimeID"}},
+	"updateRuntimeContext":        {object: runtimeContextObject, idPath: []string{"id"}},
+	"unregisterRuntimeContext":    {object: runtimeContextObject, idPath: []string{"id"}},
+	"updateIntegrationSystem":     {object: integrationSystemObject, idPath: []string{"id"}},
+	"unregisterIntegrationSystem": {object: integrationSystemObject, idPath: []string{"id"}},
+	"updateApplicationTemplate":   {object: applicationTemplateObject, idPath: []string{"id"}},
+	"deleteApplicationTemplate":   {object: applicationTemplateObject, idPath: []string{"id"}},
+	"updateLabelDefinition":       {object: labelDefinitionObject, idPath: []string{"in", "key"}},
+	"deleteLabelDefinition":       {object: labelDefinitionObject, idPath: []string{"key"}},
+	"updatePackage":               {object: packageObject, idPath: []string{"id"}},
+	"deletePackage":               {object: packageObject, idPath: []string{"id"}},
+	"updateAPIDefinition":         {object: apiDefinitionObject, idPath: []string{"id"}},
+	"deleteAPIDefinition":         {object: apiDefinitionObject, idPath: []string{"id"}},
+	"updateEventDefinition":       {object: eventDefinitionObject, idPath: []string{"id"}},
+	"deleteEventDefinition":       {object: eventDefinitionObject, idPath: []string{"id"}},
+	"deleteDocument":              {object: documentObject, idPath: []string{"id"}},
+	"updateWebhook":               {object: webhookObject, idPath: []string{"webhookID"}},
+	"deleteWebhook":               {object: webhookObject, idPath: []string{"webhookID"}},
+	"setPackageInstanceAuth":      {object: packageInstanceAuthObject, idPath: []string{"authID"}},
+	"deletePackageInstanceAuth":   {object: packageInstanceAuthObject, idPath: []string{"authID"}},
+
+	"rotateClientCredentialsForApplication":       {object: applicationSystemAuthObject, idPath: []string{"authID"}},
+	"deleteSystemAuthForApplication":              {object: applicationSystemAuthObject, idPath: []string{"authID"}},
+	"rotateClientCredentialsForRuntime":           {object: runtimeSystemAuthObject, idPath: []string{"authID"}},
+	"deleteSystemAuthForRuntime":                  {object: runtimeSystemAuthObject, idPath: []string{"authID"}},
+	"rotateClientCredentialsForIntegrationSystem": {object: integrationSystemSystemAuthObject, idPath: []string{"authID"}},
+	"deleteSystemAuthForIntegrationSystem":        {object: integrationSystemSystemAuthObject, idPath: []string{"authID"}},
+}
+
+var objectsByName = map[string]trackedObject{
+	applicationObject.name:                 applicationObject,
+	runtimeObject.name:                     runtimeObject,
+	runtimeContextObject.name:              runtimeContextObject,
+	integrationSystemObject.name:           integrationSystemObject,
+	applicationTemplateObject.name:         applicationTemplateObject,
+	labelDefinitionObject.name:             labelDefinitionObject,
+	packageObject.name:                     packageObject,
+	apiDefinitionObject.name:               apiDefinitionObject,
+	eventDefinitionObject.name:             eventDefinitionObject,
+	documentObject.name:                    documentObject,
+	webhookObject.name:                     webhookObject,
+	packageInstanceAuthObject.name:         packageInstanceAuthObject,
+	applicationSystemAuthObject.name:       applicationSystemAuthObject,
+	runtimeSystemAuthObject.name:           runtimeSystemAuthObject,
+	integrationSystemSystemAuthObject.name: integrationSystemSystemAuthObject,
+}
+
+// skippedHeaders are not forwarded to the Director when the state of objects is queried
+var skippedHeaders = []string{"Content-Length", "Content-Type", "Accept-Encoding", "Connection"}
+
+// ChangeTracker resolves the fields of Director objects changed by a mutation. It queries the state of the objects
+// before and after the mutation on behalf of the caller, so only the objects visible to the caller are resolved.
+// The values of fields which the redactor considers sensitive are kept only as fingerprints, and they are masked in the changes.
+type ChangeTracker struct {
+	client      HttpClient
+	directorURL string
+	redactor    *Redactor
+}
+
+func NewChangeTracker(client HttpClient, directorURL string, redactor *Redactor) *ChangeTracker {
+	return &ChangeTracker{
+		client:      client,
+		directorURL: directorURL,
+		redactor:    redactor,
+	}
+}
+
+type NoOpChangeTracker struct {
+}
+
+func (t *NoOpChangeTracker) Snapshot(context.Context, http.Header, string) ([]proxy.ObjectSnapshot, error) {
+	return nil, nil
+}
+
+func (t *NoOpChangeTracker) Changes(context.Context, http.Header, []proxy.ObjectSnapshot) ([]proxy.ObjectChange, error) {
+	return nil, nil
+}
+
+// Snapshot captures the state of the objects which are changed by mutations in the request
+func (t *ChangeTracker) Snapshot(ctx context.Context, headers http.Header, request string) ([]proxy.ObjectSnapshot, error) {
+	var req graphqlRequest
+	if err := json.Unmarshal([]byte(request), &req); err != nil {
+		return nil, nil
+	}
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if gqlErr != nil {
+		return nil, nil
+	}
+
+	op := proxy.SelectOperation(doc, req.OperationName)
+	if op == nil || op.Operation != ast.Mutation {
+		return nil, nil
+	}
+
+	var snapshots []proxy.ObjectSnapshot
+	for _, selection := range op.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok {
+			continue
+		}
+
+		mutation, ok := trackedMutations[field.Name]
+		if !ok {
+			continue
+		}
+
+		id, ok := argumentValue(field, mutation.idPath, req.Variables)
+		if !ok {
+			log.Printf("Cannot track changes of %s: the %s argument is not a string", field.Name, strings.Join(mutation.idPath, "."))
+			continue
+		}
+
+		state, sensitive, err := t.state(ctx, headers, mutation.object, id)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, proxy.ObjectSnapshot{
+			Type:      mutation.object.name,
+			ID:        id,
+			State:     state,
+			Sensitive: sensitive,
+		})
+	}
+
+	return snapshots, nil
+}
+
+// Changes compares the snapshots with the current state of the objects
+func (t *ChangeTracker) Changes(ctx context.Context, headers http.Header, snapshots []proxy.ObjectSnapshot) ([]proxy.ObjectChange, error) {
+	var changes []proxy.ObjectChange
+	for _, snapshot := range snapshots {
+		if snapshot.State == nil {
+			continue
+		}
+
+		object, ok := objectsByName[snapshot.Type]
+		if !ok {
+			continue
+		}
+
+		state, sensitive, err := t.state(ctx, headers, object, snapshot.ID)
+		if err != nil {
+			return nil, err
+		}
+		if state == nil {
+			continue
+		}
+
+		for name := range snapshot.Sensitive {
+			sensitive[name] = struct{}{}
+		}
+
+		fields := diff(snapshot.State, state, sensitive)
+		if len(fields) == 0 {
+			continue
+		}
+
+		changes = append(changes, proxy.ObjectChange{
+			Type:   snapshot.Type,
+			ID:     snapshot.ID,
+			Fields: fields,
+		})
+	}
+
+	return changes, nil
+}
+
+type stateResponse struct {
+	Data struct {
+		Result map[string]interface{} `json:"result"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// state returns the flattened state of the object, an empty state if it does not exist, or nil if it cannot be resolved.
+// It also returns the fields of the state which hold fingerprints of sensitive values.
+func (t *ChangeTracker) state(ctx context.Context, headers http.Header, object trackedObject, id string) (map[string]string, map[string]struct{}, error) {
+	body, err := json.Marshal(graphqlRequest{
+		Query:     object.query,
+		Variables: map[string]interface{}{"id": id},
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while marshalling state query")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.directorURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while creating state query request")
+	}
+	req = req.WithContext(ctx)
+	req.Header = headers.Clone()
+	for _, header := range skippedHeaders {
+		req.Header.Del(header)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while querying state of %s %s", object.name, id)
+	}
+	defer httpcommon.CloseBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("while querying state of %s %s: unexpected status code %d", object.name, id, resp.StatusCode)
+	}
+
+	var stateResp stateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&stateResp); err != nil {
+		return nil, nil, errors.Wrapf(err, "while decoding state of %s %s", object.name, id)
+	}
+
+	if len(stateResp.Errors) > 0 {
+		log.Printf("Cannot resolve state of %s %s: %s", object.name, id, stateResp.Errors[0].Message)
+		return nil, nil, nil
+	}
+
+	f := &flattener{
+		redactor:  t.redactor,
+		state:     map[string]string{},
+		sensitive: map[string]struct{}{},
+	}
+	f.flatten("", stateResp.Data.Result, object.typeName)
+	return f.state, f.sensitive, nil
+}
+
+func argumentValue(field *ast.Field, path []string, variables map[string]interface{}) (string, bool) {
+	arg := field.Arguments.ForName(path[0])
+	if arg == nil {
+		return "", false
+	}
+
+	value, err := arg.Value.Value(variables)
+	if err != nil {
+		return "", false
+	}
+
+	for _, key := range path[1:] {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		value = object[key]
+	}
+
+	id, ok := value.(string)
+	return id, ok && id != ""
+}
+
+type flattener struct {
+	redactor  *Redactor
+	state     map[string]string
+	sensitive map[string]struct{}
+}
+
+// flatten stores the leaves of nested objects under dot separated keys, values other than strings are stored as JSON.
+// The concrete types of unions are resolved from their `__typename` field. Sensitive values are stored as their fingerprints.
+func (f *flattener) flatten(prefix string, value interface{}, typeName string) {
+	if object, ok := value.(map[string]interface{}); ok {
+		if concrete, ok := object["__typename"].(string); ok {
+			typeName = concrete
+		}
+
+		for key, child := range object {
+			name := key
+			if prefix != "" {
+				name = prefix + "." + key
+			}
+
+			if child != nil && f.redactor.isSensitiveOutput(typeName, key) {
+				f.state[name] = fingerprint(child)
+				f.sensitive[name] = struct{}{}
+				continue
+			}
+			f.flatten(name, child, f.redactor.fieldTypeName(typeName, key))
+		}
+		return
+	}
+
+	if prefix == "" || value == nil {
+		return
+	}
+
+	if str, ok := value.(string); ok {
+		f.state[prefix] = str
+		return
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	f.state[prefix] = string(raw)
+}
+
+// fingerprint identifies the sensitive value, so its changes can be detected without keeping the value
+func fingerprint(value interface{}) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return redactedValue
+	}
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// diff returns the changed fields, the values of sensitive fields are masked
+func diff(old, new map[string]string, sensitive map[string]struct{}) []proxy.FieldChange {
+	names := map[string]struct{}{}
+	for name := range old {
+		names[name] = struct{}{}
+	}
+	for name := range new {
+		names[name] = struct{}{}
+	}
+
+	var fields []proxy.FieldChange
+	for name := range names {
+		if old[name] == new[name] {
+			continue
+		}
+
+		change := proxy.FieldChange{Name: name, Old: old[name], New: new[name]}
+		if _, ok := sensitive[name]; ok {
+			change.Old = maskValue(change.Old)
+			change.New = maskValue(change.New)
+		}
+		fields = append(fields, change)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
+
+// maskValue masks the fingerprint of a sensitive value, a missing value is kept empty so it can be seen that the value was set or removed
+func maskValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return redactedValue
+}
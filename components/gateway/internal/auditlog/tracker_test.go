@@ -0,0 +1,340 @@
+package auditlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeTracker(t *testing.T) {
+	headers := http.Header{"Authorization": []string{"Bearer token"}}
+
+	t.Run("should resolve changed fields of an application", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, map[string]interface{}{
+			"id":          "app-id",
+			"name":        "app",
+			"description": "old description",
+			"labels":      map[string]interface{}{"scenarios": []string{"DEFAULT"}, "region": "eu"},
+		})
+		defer director.Close()
+
+		tracker := fixChangeTracker(t, director.URL)
+		request := fixGraphQLRequest(t,
+			`mutation ($id: ID!) { setApplicationLabel(applicationID: $id, key: "scenarios", value: ["DEFAULT", "FOO"]) { key } }`,
+			map[string]interface{}{"id": "app-id"})
+
+		//WHEN
+		snapshots, err := tracker.Snapshot(context.TODO(), headers, request)
+		require.NoError(t, err)
+
+		director.setState(map[string]interface{}{
+			"id":          "app-id",
+			"name":        "app",
+			"description": "old description",
+			"labels":      map[string]interface{}{"scenarios": []string{"DEFAULT", "FOO"}, "region": "eu"},
+		})
+
+		changes, err := tracker.Changes(context.TODO(), headers, snapshots)
+
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.Equal(t, "Application", snapshots[0].Type)
+		assert.Equal(t, "app-id", snapshots[0].ID)
+
+		assert.Equal(t, []proxy.ObjectChange{{
+			Type:   "Application",
+			ID:     "app-id",
+			Fields: []proxy.FieldChange{{Name: "labels.scenarios", Old: `["DEFAULT"]`, New: `["DEFAULT","FOO"]`}},
+		}}, changes)
+		assert.Equal(t, "Bearer token", director.authorization())
+	})
+
+	t.Run("should resolve all fields of an unregistered runtime", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, map[string]interface{}{"id": "runtime-id", "name": "runtime"})
+		defer director.Close()
+
+		tracker := fixChangeTracker(t, director.URL)
+		request := fixGraphQLRequest(t, `mutation { unregisterRuntime(id: "runtime-id") { id } }`, nil)
+
+		//WHEN
+		snapshots, err := tracker.Snapshot(context.TODO(), headers, request)
+		require.NoError(t, err)
+
+		director.setState(nil)
+		changes, err := tracker.Changes(context.TODO(), headers, snapshots)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []proxy.ObjectChange{{
+			Type: "Runtime",
+			ID:   "runtime-id",
+			Fields: []proxy.FieldChange{
+				{Name: "id", Old: "runtime-id", New: ""},
+				{Name: "name", Old: "runtime", New: ""},
+			},
+		}}, changes)
+	})
+
+	t.Run("should resolve changed credential identifiers of a webhook", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, map[string]interface{}{
+			"id":  "webhook-id",
+			"url": "http://old.com",
+			"auth": map[string]interface{}{
+				"credential": map[string]interface{}{
+					"__typename": "BasicCredentialData",
+					"username":   "old-user",
+				},
+			},
+		})
+		defer director.Close()
+
+		tracker := fixChangeTracker(t, director.URL)
+		request := fixGraphQLRequest(t,
+			`mutation ($id: ID!, $in: WebhookInput!) { updateWebhook(webhookID: $id, in: $in) { id } }`,
+			map[string]interface{}{"id": "webhook-id", "in": map[string]interface{}{}})
+
+		//WHEN
+		snapshots, err := tracker.Snapshot(context.TODO(), headers, request)
+		require.NoError(t, err)
+
+		director.setState(map[string]interface{}{
+			"id":  "webhook-id",
+			"url": "http://new.com",
+			"auth": map[string]interface{}{
+				"credential": map[string]interface{}{
+					"__typename": "BasicCredentialData",
+					"username":   "new-user",
+				},
+			},
+		})
+		changes, err := tracker.Changes(context.TODO(), headers, snapshots)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []proxy.ObjectChange{{
+			Type: "Webhook",
+			ID:   "webhook-id",
+			Fields: []proxy.FieldChange{
+				{Name: "auth.credential.username", Old: "old-user", New: "new-user"},
+				{Name: "url", Old: "http://old.com", New: "http://new.com"},
+			},
+		}}, changes)
+	})
+
+	t.Run("should resolve replaced credential of a system auth", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, map[string]interface{}{
+			"id": "auth-id",
+			"auth": map[string]interface{}{
+				"credential": map[string]interface{}{
+					"__typename": "BasicCredentialData",
+					"username":   "user",
+				},
+			},
+		})
+		defer director.Close()
+
+		tracker := fixChangeTracker(t, director.URL)
+		request := fixGraphQLRequest(t, `mutation { rotateClientCredentialsForRuntime(authID: "auth-id") { id } }`, nil)
+
+		//WHEN
+		snapshots, err := tracker.Snapshot(context.TODO(), headers, request)
+		require.NoError(t, err)
+
+		director.setState(map[string]interface{}{
+			"id": "auth-id",
+			"auth": map[string]interface{}{
+				"credential": map[string]interface{}{
+					"__typename": "OAuthCredentialData",
+					"clientId":   "client",
+					"url":        "http://token.com",
+				},
+			},
+		})
+		changes, err := tracker.Changes(context.TODO(), headers, snapshots)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []proxy.ObjectChange{{
+			Type: "RuntimeSystemAuth",
+			ID:   "auth-id",
+			Fields: []proxy.FieldChange{
+				{Name: "auth.credential.__typename", Old: "BasicCredentialData", New: "OAuthCredentialData"},
+				{Name: "auth.credential.clientId", Old: "", New: "client"},
+				{Name: "auth.credential.url", Old: "", New: "http://token.com"},
+				{Name: "auth.credential.username", Old: "user", New: ""},
+			},
+		}}, changes)
+	})
+
+	t.Run("should mask changed fields configured as sensitive", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, map[string]interface{}{"id": "webhook-id", "url": "http://old.com/?token=old"})
+		defer director.Close()
+
+		redactor := auditlog.NewRedactor(fixDirectorSchema(t), []string{"WebhookInput.url"})
+		tracker := auditlog.NewChangeTracker(http.DefaultClient, director.URL, redactor)
+		request := fixGraphQLRequest(t, `mutation { deleteWebhook(webhookID: "webhook-id") { id } }`, nil)
+
+		//WHEN
+		snapshots, err := tracker.Snapshot(context.TODO(), headers, request)
+		require.NoError(t, err)
+
+		director.setState(nil)
+		changes, err := tracker.Changes(context.TODO(), headers, snapshots)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []proxy.ObjectChange{{
+			Type: "Webhook",
+			ID:   "webhook-id",
+			Fields: []proxy.FieldChange{
+				{Name: "id", Old: "webhook-id", New: ""},
+				{Name: "url", Old: "***", New: ""},
+			},
+		}}, changes)
+		for _, snapshot := range snapshots {
+			for _, value := range snapshot.State {
+				assert.NotContains(t, value, "token=old")
+			}
+		}
+	})
+
+	t.Run("should not query secret values of credentials", func(t *testing.T) {
+		mutations := []string{
+			`mutation { updatePackage(id: "id", in: {name: "package"}) { id } }`,
+			`mutation { updateAPIDefinition(id: "id", in: {name: "api", targetURL: "http://api.com"}) { id } }`,
+			`mutation { deleteDocument(id: "id") { id } }`,
+			`mutation { deleteWebhook(webhookID: "id") { id } }`,
+			`mutation { deletePackageInstanceAuth(authID: "id") { id } }`,
+			`mutation { deleteSystemAuthForApplication(authID: "id") { id } }`,
+			`mutation { rotateClientCredentialsForIntegrationSystem(authID: "id") { id } }`,
+		}
+
+		for _, mutation := range mutations {
+			//GIVEN
+			director := newFakeDirector(t, map[string]interface{}{"id": "id"})
+
+			tracker := fixChangeTracker(t, director.URL)
+			request := fixGraphQLRequest(t, mutation, nil)
+
+			//WHEN
+			_, err := tracker.Snapshot(context.TODO(), headers, request)
+
+			//THEN
+			require.NoError(t, err)
+			query := director.query()
+			assert.NotEmpty(t, query, mutation)
+			for _, field := range []string{"password", "clientSecret", "jwks ", "additionalHeaders", "additionalQueryParams"} {
+				assert.NotContains(t, query, field, mutation)
+			}
+			director.Close()
+		}
+	})
+
+	t.Run("should not track mutations which do not change existing objects", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, nil)
+		defer director.Close()
+
+		tracker := fixChangeTracker(t, director.URL)
+		request := fixGraphQLRequest(t, `mutation { registerApplication(in: {name: "app"}) { id } }`, nil)
+
+		//WHEN
+		snapshots, err := tracker.Snapshot(context.TODO(), headers, request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+		assert.Equal(t, 0, director.requests())
+	})
+
+	t.Run("should return error when Director is not available", func(t *testing.T) {
+		//GIVEN
+		director := newFakeDirector(t, nil)
+		director.Close()
+
+		tracker := fixChangeTracker(t, director.URL)
+		request := fixGraphQLRequest(t, `mutation { unregisterRuntime(id: "runtime-id") { id } }`, nil)
+
+		//WHEN
+		_, err := tracker.Snapshot(context.TODO(), headers, request)
+
+		//THEN
+		require.Error(t, err)
+	})
+}
+
+func fixChangeTracker(t *testing.T, directorURL string) *auditlog.ChangeTracker {
+	redactor := auditlog.NewRedactor(fixDirectorSchema(t), auditlog.DefaultSensitiveFields)
+	return auditlog.NewChangeTracker(http.DefaultClient, directorURL, redactor)
+}
+
+type fakeDirector struct {
+	*httptest.Server
+
+	mutex        sync.Mutex
+	state        map[string]interface{}
+	count        int
+	lastAuthHead string
+	lastQuery    string
+}
+
+func newFakeDirector(t *testing.T, state map[string]interface{}) *fakeDirector {
+	director := &fakeDirector{state: state}
+	director.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		director.mutex.Lock()
+		defer director.mutex.Unlock()
+
+		director.count++
+		director.lastAuthHead = r.Header.Get("Authorization")
+
+		var req struct {
+			Query string `json:"query"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		director.lastQuery = req.Query
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"result": director.state},
+		})
+		require.NoError(t, err)
+	}))
+	return director
+}
+
+func (d *fakeDirector) setState(state map[string]interface{}) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.state = state
+}
+
+func (d *fakeDirector) requests() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.count
+}
+
+func (d *fakeDirector) authorization() string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.lastAuthHead
+}
+
+func (d *fakeDirector) query() string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.lastQuery
+}
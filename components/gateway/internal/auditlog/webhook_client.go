@@ -0,0 +1,117 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/httpcommon"
+	"github.com/pkg/errors"
+)
+
+// DefaultWebhookPayloadTemplate renders the Record as JSON
+const DefaultWebhookPayloadTemplate = `{"type":{{ json .Type }},"message":{{ json .Message }}}`
+
+// WebhookClient sends every audit log message in a separate HTTP request with the payload rendered from the template.
+// The template is executed with the Record, the `json` function encodes a value as JSON.
+type WebhookClient struct {
+	httpClient  HttpClient
+	url         string
+	method      string
+	contentType string
+	headers     http.Header
+	payload     *template.Template
+}
+
+func NewWebhookClient(cfg WebhookConfig, httpClient HttpClient) (*WebhookClient, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("auditlog webhook URL is required")
+	}
+
+	payloadTemplate := cfg.PayloadTemplate
+	if payloadTemplate == "" {
+		payloadTemplate = DefaultWebhookPayloadTemplate
+	}
+
+	payload, err := template.New("payload").Funcs(template.FuncMap{"json": toJSON}).Parse(payloadTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing auditlog webhook payload template")
+	}
+
+	headers := http.Header{}
+	for _, header := range cfg.Headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, errors.Errorf("invalid auditlog webhook header %q, expected <name>:<value>", header)
+		}
+		headers.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+
+	return &WebhookClient{
+		httpClient:  httpClient,
+		url:         cfg.URL,
+		method:      cfg.Method,
+		contentType: cfg.ContentType,
+		headers:     headers,
+		payload:     payload,
+	}, nil
+}
+
+func (c *WebhookClient) LogConfigurationChange(ctx context.Context, change model.ConfigurationChange) error {
+	return c.send(ctx, configurationChangeRecord(change))
+}
+
+func (c *WebhookClient) LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	return c.send(ctx, securityEventRecord(event))
+}
+
+func (c *WebhookClient) LogDataAccess(ctx context.Context, access model.DataAccess) error {
+	return c.send(ctx, dataAccessRecord(access))
+}
+
+func (c *WebhookClient) send(ctx context.Context, record Record) error {
+	var payload bytes.Buffer
+	if err := c.payload.Execute(&payload, record); err != nil {
+		return errors.Wrap(permanentError{err}, "while rendering auditlog webhook payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, c.method, c.url, &payload)
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+	for name, values := range c.headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", c.contentType)
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "while sending auditlog to: %s", c.url)
+	}
+	defer httpcommon.CloseBody(response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		log.Printf("Got different status code from auditlog webhook: %d\n", response.StatusCode)
+		output, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return errors.Wrap(err, "while reading response from auditlog webhook")
+		}
+		log.Println(string(output))
+		return errors.Errorf("Write to auditlog webhook failed with status code: %d", response.StatusCode)
+	}
+	return nil
+}
+
+func toJSON(value interface{}) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,103 @@
+package auditlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookClient(t *testing.T) {
+	t.Run("should send record with default payload template", func(t *testing.T) {
+		//GIVEN
+		var body []byte
+		var header http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			body, err = ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			header = r.Header
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, err := auditlog.NewWebhookClient(auditlog.WebhookConfig{
+			URL:         server.URL,
+			Method:      http.MethodPost,
+			ContentType: "application/json",
+			Headers:     []string{"X-Api-Key: secret"},
+		}, http.DefaultClient)
+		require.NoError(t, err)
+
+		//WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixFilledConfigChangeMsg())
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, "secret", header.Get("X-Api-Key"))
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+
+		var record auditlog.Record
+		require.NoError(t, json.Unmarshal(body, &record))
+		assert.Equal(t, auditlog.ConfigurationChangeRecord, record.Type)
+		assert.NotNil(t, record.Message)
+	})
+
+	t.Run("should send record with custom payload template", func(t *testing.T) {
+		//GIVEN
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			body, err = ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		client, err := auditlog.NewWebhookClient(auditlog.WebhookConfig{
+			URL:             server.URL,
+			Method:          http.MethodPut,
+			ContentType:     "text/plain",
+			PayloadTemplate: `{{ .Type }} by {{ .Message.User }}`,
+		}, http.DefaultClient)
+		require.NoError(t, err)
+
+		//WHEN
+		err = client.LogSecurityEvent(context.TODO(), fixFabricatedSecurityEventMsg())
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, "security-event by "+User, string(body))
+	})
+
+	t.Run("should return error when webhook responds with error status", func(t *testing.T) {
+		//GIVEN
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, err := auditlog.NewWebhookClient(auditlog.WebhookConfig{URL: server.URL, Method: http.MethodPost}, http.DefaultClient)
+		require.NoError(t, err)
+
+		//WHEN
+		err = client.LogSecurityEvent(context.TODO(), fixFabricatedSecurityEventMsg())
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "500")
+	})
+
+	t.Run("should return error for invalid header", func(t *testing.T) {
+		//WHEN
+		_, err := auditlog.NewWebhookClient(auditlog.WebhookConfig{URL: "http://localhost", Headers: []string{"invalid"}}, http.DefaultClient)
+
+		//THEN
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,6 @@
+package persistedquery
+
+type Config struct {
+	Src       string `envconfig:"optional,APP_PERSISTED_QUERIES_SRC"`
+	CacheSize int    `envconfig:"default=1000,APP_PERSISTED_QUERIES_CACHE_SIZE"`
+}
@@ -0,0 +1,81 @@
+package persistedquery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+)
+
+// Store resolves the persisted queries referenced by hash, so that the Gateway can audit them.
+// The registered queries are loaded on startup, the automatic persisted queries are learned from the requests
+// which contain both the query and its hash.
+type Store struct {
+	registered map[string]string
+	cache      *lru.Cache
+}
+
+func NewStore(registered map[string]string, cacheSize int) (*Store, error) {
+	for hash, query := range registered {
+		if Hash(query) != hash {
+			return nil, errors.Errorf("hash %s does not match the registered query", hash)
+		}
+	}
+
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating persisted query cache")
+	}
+
+	return &Store{
+		registered: registered,
+		cache:      cache,
+	}, nil
+}
+
+// LoadStore creates Store with the queries registered in the JSON file in the `{"<sha256 hash>": "<query>"}` form
+func LoadStore(cfg Config) (*Store, error) {
+	registered := map[string]string{}
+	if cfg.Src != "" {
+		content, err := ioutil.ReadFile(cfg.Src)
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading persisted queries file")
+		}
+		if err := json.Unmarshal(content, &registered); err != nil {
+			return nil, errors.Wrapf(err, "while decoding persisted queries file %s", cfg.Src)
+		}
+		log.Printf("Loaded %d registered persisted queries", len(registered))
+	}
+
+	return NewStore(registered, cfg.CacheSize)
+}
+
+func (s *Store) Get(hash string) (string, bool) {
+	if query, ok := s.registered[hash]; ok {
+		return query, true
+	}
+
+	query, ok := s.cache.Get(hash)
+	if !ok {
+		return "", false
+	}
+	return query.(string), true
+}
+
+// Add stores the query if it matches the hash, otherwise the Director rejects the request anyway
+func (s *Store) Add(hash string, query string) {
+	if _, ok := s.registered[hash]; ok || Hash(query) != hash {
+		return
+	}
+	s.cache.Add(hash, query)
+}
+
+// Hash returns the hex encoded SHA-256 hash of the query, as sent in the `persistedQuery` extension
+func Hash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
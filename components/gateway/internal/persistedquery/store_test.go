@@ -0,0 +1,80 @@
+package persistedquery_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/persistedquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	registeredQuery = "query { applications { data { id } } }"
+	automaticQuery  = "query { runtimes { data { id } } }"
+)
+
+func TestStore(t *testing.T) {
+	t.Run("Resolves registered and learned queries", func(t *testing.T) {
+		//GIVEN
+		store, err := persistedquery.NewStore(map[string]string{persistedquery.Hash(registeredQuery): registeredQuery}, 10)
+		require.NoError(t, err)
+
+		//WHEN
+		store.Add(persistedquery.Hash(automaticQuery), automaticQuery)
+
+		//THEN
+		query, ok := store.Get(persistedquery.Hash(registeredQuery))
+		assert.True(t, ok)
+		assert.Equal(t, registeredQuery, query)
+
+		query, ok = store.Get(persistedquery.Hash(automaticQuery))
+		assert.True(t, ok)
+		assert.Equal(t, automaticQuery, query)
+	})
+
+	t.Run("Ignores query which does not match the hash", func(t *testing.T) {
+		//GIVEN
+		store, err := persistedquery.NewStore(map[string]string{}, 10)
+		require.NoError(t, err)
+
+		//WHEN
+		store.Add(persistedquery.Hash(registeredQuery), automaticQuery)
+
+		//THEN
+		_, ok := store.Get(persistedquery.Hash(registeredQuery))
+		assert.False(t, ok)
+	})
+
+	t.Run("Returns error when hash does not match the registered query", func(t *testing.T) {
+		//WHEN
+		_, err := persistedquery.NewStore(map[string]string{"hash": registeredQuery}, 10)
+
+		//THEN
+		require.EqualError(t, err, "hash hash does not match the registered query")
+	})
+}
+
+func TestLoadStore(t *testing.T) {
+	//GIVEN
+	dir, err := ioutil.TempDir("", "persisted-queries")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	src := filepath.Join(dir, "queries.json")
+	content := `{"` + persistedquery.Hash(registeredQuery) + `":"` + registeredQuery + `"}`
+	require.NoError(t, ioutil.WriteFile(src, []byte(content), 0600))
+
+	//WHEN
+	store, err := persistedquery.LoadStore(persistedquery.Config{Src: src, CacheSize: 10})
+
+	//THEN
+	require.NoError(t, err)
+	query, ok := store.Get(persistedquery.Hash(registeredQuery))
+	assert.True(t, ok)
+	assert.Equal(t, registeredQuery, query)
+}
@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+type Config struct {
+	Enabled              bool          `envconfig:"APP_RATE_LIMIT_ENABLED,default=false"`
+	ConsumerLimits       []string      `envconfig:"optional,APP_RATE_LIMIT_CONSUMER_LIMITS"`
+	DefaultConsumerLimit string        `envconfig:"APP_RATE_LIMIT_DEFAULT_CONSUMER_LIMIT,default=50:100"`
+	TenantLimit          string        `envconfig:"APP_RATE_LIMIT_TENANT_LIMIT,default=200:400"`
+	IdleTimeout          time.Duration `envconfig:"APP_RATE_LIMIT_IDLE_TIMEOUT,default=10m"`
+}
+
+// Limit is a token bucket which is refilled with Rate tokens per second up to Burst tokens. Zero rate means no limit.
+type Limit struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+func (l Limit) unlimited() bool {
+	return l.Rate == 0
+}
+
+// ParseLimit parses the limit in the form `<requests per second>:<burst>`
+func ParseLimit(value string) (Limit, error) {
+	parts := strings.SplitN(strings.TrimSpace(value), ":", 2)
+	if len(parts) != 2 {
+		return Limit{}, errors.Errorf("invalid rate limit %q, expected <requests per second>:<burst>", value)
+	}
+
+	requestsPerSecond, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil || requestsPerSecond < 0 {
+		return Limit{}, errors.Errorf("invalid rate limit %q: rate must be a non-negative number", value)
+	}
+
+	burst, err := strconv.Atoi(parts[1])
+	if err != nil || burst < 0 {
+		return Limit{}, errors.Errorf("invalid rate limit %q: burst must be a non-negative integer", value)
+	}
+
+	if requestsPerSecond > 0 && burst == 0 {
+		return Limit{}, errors.Errorf("invalid rate limit %q: burst must be positive when rate is positive", value)
+	}
+
+	return Limit{Rate: rate.Limit(requestsPerSecond), Burst: burst}, nil
+}
+
+// Limits are the limits resolved from Config
+type Limits struct {
+	Consumers       map[string]Limit
+	DefaultConsumer Limit
+	Tenant          Limit
+	IdleTimeout     time.Duration
+}
+
+// ParseLimits parses the consumer limits in the form `<consumerType>=<requests per second>:<burst>` and the other limits of the Config
+func ParseLimits(cfg Config) (Limits, error) {
+	limits := Limits{
+		Consumers:   map[string]Limit{},
+		IdleTimeout: cfg.IdleTimeout,
+	}
+
+	for _, consumerLimit := range cfg.ConsumerLimits {
+		parts := strings.SplitN(consumerLimit, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return Limits{}, errors.Errorf("invalid consumer rate limit %q, expected <consumerType>=<requests per second>:<burst>", consumerLimit)
+		}
+
+		limit, err := ParseLimit(parts[1])
+		if err != nil {
+			return Limits{}, errors.Wrapf(err, "while parsing rate limit of consumer type %s", parts[0])
+		}
+		limits.Consumers[strings.TrimSpace(parts[0])] = limit
+	}
+
+	var err error
+	limits.DefaultConsumer, err = ParseLimit(cfg.DefaultConsumerLimit)
+	if err != nil {
+		return Limits{}, errors.Wrap(err, "while parsing default consumer rate limit")
+	}
+
+	limits.Tenant, err = ParseLimit(cfg.TenantLimit)
+	if err != nil {
+		return Limits{}, errors.Wrap(err, "while parsing tenant rate limit")
+	}
+
+	return limits, nil
+}
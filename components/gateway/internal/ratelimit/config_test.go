@@ -0,0 +1,57 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimits(t *testing.T) {
+	t.Run("should parse limits", func(t *testing.T) {
+		//GIVEN
+		cfg := ratelimit.Config{
+			ConsumerLimits:       []string{"Runtime=10:20", "Integration System=0.5:1"},
+			DefaultConsumerLimit: "50:100",
+			TenantLimit:          "0:0",
+			IdleTimeout:          time.Minute,
+		}
+
+		//WHEN
+		limits, err := ratelimit.ParseLimits(cfg)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Limits{
+			Consumers: map[string]ratelimit.Limit{
+				"Runtime":            {Rate: 10, Burst: 20},
+				"Integration System": {Rate: 0.5, Burst: 1},
+			},
+			DefaultConsumer: ratelimit.Limit{Rate: 50, Burst: 100},
+			Tenant:          ratelimit.Limit{Rate: 0, Burst: 0},
+			IdleTimeout:     time.Minute,
+		}, limits)
+	})
+
+	testCases := []struct {
+		Name string
+		Cfg  ratelimit.Config
+	}{
+		{Name: "invalid consumer limit", Cfg: ratelimit.Config{ConsumerLimits: []string{"Runtime"}, DefaultConsumerLimit: "1:1", TenantLimit: "1:1"}},
+		{Name: "invalid default consumer limit", Cfg: ratelimit.Config{DefaultConsumerLimit: "1", TenantLimit: "1:1"}},
+		{Name: "negative rate", Cfg: ratelimit.Config{DefaultConsumerLimit: "-1:1", TenantLimit: "1:1"}},
+		{Name: "zero burst", Cfg: ratelimit.Config{DefaultConsumerLimit: "1:0", TenantLimit: "1:1"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("should return error for "+testCase.Name, func(t *testing.T) {
+			//WHEN
+			_, err := ratelimit.ParseLimits(testCase.Cfg)
+
+			//THEN
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// AnonymousConsumerType is used for requests without a bearer token, such requests are limited by the client address
+	AnonymousConsumerType = "Anonymous"
+
+	externalAddressHeader = "X-Envoy-External-Address"
+)
+
+// Limiter limits the requests to a single component with token buckets per consumer and per tenant.
+// A request is rejected with 429 Too Many Requests if any of its buckets is empty.
+type Limiter struct {
+	component string
+	limits    Limits
+	metrics   *Metrics
+	now       func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+func NewLimiter(component string, limits Limits, metrics *Metrics) *Limiter {
+	return &Limiter{
+		component: component,
+		limits:    limits,
+		metrics:   metrics,
+		now:       time.Now,
+		buckets:   map[string]*bucket{},
+		lastSweep: time.Now(),
+	}
+}
+
+// Middleware rejects the requests which exceed the limits of their consumer or tenant
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		consumerType, consumerKey, tenant := identify(r)
+
+		delay, result := l.reserve(consumerType, consumerKey, tenant)
+		l.metrics.record(l.component, consumerType, result)
+
+		if result != resultAllowed {
+			log.Printf("Rate limit of %s exceeded for consumer %s of type %s in tenant %s", l.component, consumerKey, consumerType, tenant)
+			writeTooManyRequests(w, delay)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// reserve takes a token from the consumer and the tenant bucket. If any of them is empty, no token is taken
+// and the time after which the request can be retried is returned.
+func (l *Limiter) reserve(consumerType, consumerKey, tenant string) (time.Duration, string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	consumerLimit, ok := l.limits.Consumers[consumerType]
+	if !ok {
+		consumerLimit = l.limits.DefaultConsumer
+	}
+
+	var reservations []*rate.Reservation
+	cancel := func() {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+
+	if !consumerLimit.unlimited() {
+		reservation := l.bucket("consumer/"+consumerType+"/"+consumerKey, consumerLimit, now).ReserveN(now, 1)
+		if delay := reservation.DelayFrom(now); !reservation.OK() || delay > 0 {
+			reservation.CancelAt(now)
+			return delay, resultConsumerLimited
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	if tenant != "" && !l.limits.Tenant.unlimited() {
+		reservation := l.bucket("tenant/"+tenant, l.limits.Tenant, now).ReserveN(now, 1)
+		if delay := reservation.DelayFrom(now); !reservation.OK() || delay > 0 {
+			reservation.CancelAt(now)
+			cancel()
+			return delay, resultTenantLimited
+		}
+	}
+
+	return 0, resultAllowed
+}
+
+func (l *Limiter) bucket(key string, limit Limit, now time.Time) *rate.Limiter {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(limit.Rate, limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b.limiter
+}
+
+// sweep removes the buckets which were not used for the idle timeout, they are full again anyway
+func (l *Limiter) sweep(now time.Time) {
+	if l.limits.IdleTimeout <= 0 || now.Sub(l.lastSweep) < l.limits.IdleTimeout {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastUsed) >= l.limits.IdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// identify returns the consumer type, the key of the consumer and its tenant
+func identify(r *http.Request) (string, string, string) {
+	claims, err := proxy.ParseClaims(r.Header)
+	if err != nil || claims.ConsumerID == "" {
+		return AnonymousConsumerType, clientAddress(r), ""
+	}
+
+	return claims.ConsumerType, claims.ConsumerID, claims.Tenant
+}
+
+func clientAddress(r *http.Request) string {
+	if address := r.Header.Get(externalAddressHeader); address != "" {
+		return address
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeTooManyRequests(w http.ResponseWriter, delay time.Duration) {
+	retryAfter := int(math.Ceil(delay.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"message": "rate limit exceeded"}},
+	})
+	if err != nil {
+		log.Printf("Error while writing rate limit response: %s", err.Error())
+	}
+}
@@ -0,0 +1,151 @@
+package ratelimit_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Middleware(t *testing.T) {
+	t.Run("should limit requests of a consumer", func(t *testing.T) {
+		//GIVEN
+		handler := fixHandler(ratelimit.Limits{
+			Consumers:       map[string]ratelimit.Limit{"Runtime": {Rate: 0.001, Burst: 1}},
+			DefaultConsumer: ratelimit.Limit{Rate: 1000, Burst: 1000},
+		}, ratelimit.NewMetrics())
+
+		runtime := fixClaims("runtime-1", "Runtime", "tenant")
+		otherRuntime := fixClaims("runtime-2", "Runtime", "tenant")
+
+		//WHEN
+		first := serve(t, handler, runtime)
+		second := serve(t, handler, runtime)
+		other := serve(t, handler, otherRuntime)
+
+		//THEN
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+		assert.Equal(t, http.StatusOK, other.Code)
+
+		retryAfter, err := strconv.Atoi(second.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.True(t, retryAfter > 1, "unexpected Retry-After %d", retryAfter)
+		assert.Contains(t, second.Body.String(), "rate limit exceeded")
+	})
+
+	t.Run("should limit requests of a tenant", func(t *testing.T) {
+		//GIVEN
+		metrics := ratelimit.NewMetrics()
+		handler := fixHandler(ratelimit.Limits{
+			DefaultConsumer: ratelimit.Limit{Rate: 1000, Burst: 1000},
+			Tenant:          ratelimit.Limit{Rate: 0.001, Burst: 2},
+		}, metrics)
+
+		//WHEN
+		first := serve(t, handler, fixClaims("app-1", "Application", "tenant"))
+		second := serve(t, handler, fixClaims("app-2", "Application", "tenant"))
+		third := serve(t, handler, fixClaims("app-3", "Application", "tenant"))
+		otherTenant := serve(t, handler, fixClaims("app-4", "Application", "other-tenant"))
+
+		//THEN
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, http.StatusTooManyRequests, third.Code)
+		assert.Equal(t, http.StatusOK, otherTenant.Code)
+
+		expectedMetrics := `
+# HELP compass_gateway_rate_limit_requests_total Number of requests checked by the rate limiter, by the result of the check
+# TYPE compass_gateway_rate_limit_requests_total counter
+compass_gateway_rate_limit_requests_total{component="director",consumer_type="Application",result="allowed"} 3
+compass_gateway_rate_limit_requests_total{component="director",consumer_type="Application",result="tenant_limited"} 1
+`
+		err := testutil.CollectAndCompare(metrics, strings.NewReader(expectedMetrics))
+		assert.NoError(t, err)
+	})
+
+	t.Run("should limit anonymous requests by client address", func(t *testing.T) {
+		//GIVEN
+		handler := fixHandler(ratelimit.Limits{
+			Consumers:       map[string]ratelimit.Limit{ratelimit.AnonymousConsumerType: {Rate: 0.001, Burst: 1}},
+			DefaultConsumer: ratelimit.Limit{Rate: 1000, Burst: 1000},
+		}, ratelimit.NewMetrics())
+
+		//WHEN
+		first := serveFrom(handler, "10.0.0.1:1234")
+		second := serveFrom(handler, "10.0.0.1:5678")
+		other := serveFrom(handler, "10.0.0.2:1234")
+
+		//THEN
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+		assert.Equal(t, http.StatusOK, other.Code)
+	})
+
+	t.Run("should not limit requests when limits are zero", func(t *testing.T) {
+		//GIVEN
+		handler := fixHandler(ratelimit.Limits{}, ratelimit.NewMetrics())
+
+		//WHEN
+		for i := 0; i < 10; i++ {
+			resp := serve(t, handler, fixClaims("runtime", "Runtime", "tenant"))
+
+			//THEN
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+	})
+}
+
+func fixHandler(limits ratelimit.Limits, metrics *ratelimit.Metrics) http.Handler {
+	limiter := ratelimit.NewLimiter("director", limits, metrics)
+	return limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func serve(t *testing.T, handler http.Handler, claims proxy.Claims) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	req.Header.Set("Authorization", fixBearerHeader(t, claims))
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	return resp
+}
+
+func serveFrom(handler http.Handler, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	req.RemoteAddr = remoteAddr
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	return resp
+}
+
+func fixClaims(consumerID, consumerType, tenant string) proxy.Claims {
+	return proxy.Claims{
+		Tenant:       tenant,
+		ConsumerID:   consumerID,
+		ConsumerType: consumerType,
+	}
+}
+
+func fixBearerHeader(t *testing.T, claims proxy.Claims) string {
+	marshalledClaims, err := json.Marshal(&claims)
+	require.NoError(t, err)
+
+	header := `{"alg": "HS256","typ": "JWT"}`
+
+	tokenClaims := base64.RawURLEncoding.EncodeToString(marshalledClaims)
+	tokenHeader := base64.RawURLEncoding.EncodeToString([]byte(header))
+	return fmt.Sprintf("Bearer %s", fmt.Sprintf("%s.%s.", tokenHeader, tokenClaims))
+}
@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	resultAllowed         = "allowed"
+	resultConsumerLimited = "consumer_limited"
+	resultTenantLimited   = "tenant_limited"
+)
+
+// Metrics counts the requests checked by the rate limiters of all components
+type Metrics struct {
+	requests *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "compass",
+			Subsystem: "gateway_rate_limit",
+			Name:      "requests_total",
+			Help:      "Number of requests checked by the rate limiter, by the result of the check",
+		}, []string{"component", "consumer_type", "result"}),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+}
+
+func (m *Metrics) record(component, consumerType, result string) {
+	m.requests.WithLabelValues(component, consumerType, result).Inc()
+}
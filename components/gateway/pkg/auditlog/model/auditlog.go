@@ -20,6 +20,19 @@ type Attribute struct {
 	New  string `json:"new"`
 }
 
+// DataAccess records that the user has read the sensitive attributes of the object
+type DataAccess struct {
+	User       string                `json:"user"`
+	Object     Object                `json:"object"`
+	Attributes []DataAccessAttribute `json:"attributes"`
+	Metadata
+}
+
+type DataAccessAttribute struct {
+	Name       string `json:"name"`
+	Successful bool   `json:"successful"`
+}
+
 type SecurityEvent struct {
 	User string  `json:"user"`
 	IP   *net.IP `json:"ip"`
@@ -7,16 +7,22 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/form3tech-oss/jwt-go"
+	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/httpcommon"
 	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/parser"
 )
 
 var emptyQuery error = errors.New("empty graphql query")
 
+const errPersistedQueryNotFound = "PersistedQueryNotFound"
+
 //go:generate mockery --name=RoundTrip --output=automock --outpkg=automock --case=underscore
 type RoundTrip interface {
 	RoundTrip(*http.Request) (*http.Response, error)
@@ -33,10 +39,70 @@ type PreAuditlogService interface {
 	PreLog(ctx context.Context, msg AuditlogMessage) error
 }
 
+//go:generate mockery --name=RequestRedactor --output=automock --outpkg=automock --case=underscore
+type RequestRedactor interface {
+	Redact(request string) string
+	RedactResponse(response string) string
+}
+
+//go:generate mockery --name=ChangeTracker --output=automock --outpkg=automock --case=underscore
+type ChangeTracker interface {
+	Snapshot(ctx context.Context, headers http.Header, request string) ([]ObjectSnapshot, error)
+	Changes(ctx context.Context, headers http.Header, snapshots []ObjectSnapshot) ([]ObjectChange, error)
+}
+
+//go:generate mockery --name=ReadAuditor --output=automock --outpkg=automock --case=underscore
+type ReadAuditor interface {
+	IsSensitive(request string) bool
+	Reads(request, response string) []ObjectRead
+}
+
+//go:generate mockery --name=PersistedQueries --output=automock --outpkg=automock --case=underscore
+type PersistedQueries interface {
+	Get(hash string) (string, bool)
+	Add(hash string, query string)
+}
+
+// ObjectSnapshot is the state of an object changed by a mutation, captured before the mutation is executed.
+// State is nil if the state could not be resolved. Sensitive are the fields of State which hold fingerprints of
+// sensitive values instead of the values.
+type ObjectSnapshot struct {
+	Type      string
+	ID        string
+	State     map[string]string
+	Sensitive map[string]struct{}
+}
+
+// ObjectChange describes the fields of an object which were changed by a mutation
+type ObjectChange struct {
+	Type   string
+	ID     string
+	Fields []FieldChange
+}
+
+type FieldChange struct {
+	Name string
+	Old  string
+	New  string
+}
+
+// ObjectRead describes the sensitive fields of an object which were returned by a query
+type ObjectRead struct {
+	Type   string
+	ID     string
+	Fields []string
+}
+
+// AuditlogMessage is logged for every mutation. For queries, it is logged only if they read sensitive fields,
+// in which case it contains the Reads and no Response.
 type AuditlogMessage struct {
+	// ID identifies the message, so the auditlog messages sent for it can be deduplicated when it is delivered again
+	ID                   string
 	CorrelationIDHeaders correlation.Headers
 	Request              string
 	Response             string
+	Changes              []ObjectChange
+	Reads                []ObjectRead
 	Claims
 }
 
@@ -44,18 +110,48 @@ type Transport struct {
 	http.RoundTripper
 	auditlogSink AuditlogService
 	auditlogSvc  AuditlogService
+	redactor     RequestRedactor
+	tracker      ChangeTracker
+	readAuditor  ReadAuditor
+	queries      PersistedQueries
 }
 
-func NewTransport(sink AuditlogService, svc AuditlogService, trip RoundTrip) *Transport {
+func NewTransport(sink AuditlogService, svc AuditlogService, redactor RequestRedactor, tracker ChangeTracker, readAuditor ReadAuditor, queries PersistedQueries, trip RoundTrip) *Transport {
 	return &Transport{
 		RoundTripper: trip,
 		auditlogSink: sink,
 		auditlogSvc:  svc,
+		redactor:     redactor,
+		tracker:      tracker,
+		readAuditor:  readAuditor,
+		queries:      queries,
 	}
 }
 
+// operation is a single GraphQL operation of the request, with the persisted query resolved
+type operation struct {
+	request   []byte
+	mutation  bool
+	sensitive bool
+	audited   string
+	snapshots []ObjectSnapshot
+}
+
 func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
-	if req.Body == nil || req.Method == http.MethodGet {
+	if req.Method == http.MethodGet {
+		query := req.URL.Query()
+		if query.Get("query") == "" && query.Get("extensions") == "" {
+			return t.RoundTripper.RoundTrip(req)
+		}
+
+		request, err := queryRequestBody(query)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read query from URL")
+		}
+		return t.roundTripOperations(req, [][]byte{request}, false)
+	}
+
+	if req.Body == nil {
 		return t.RoundTripper.RoundTrip(req)
 	}
 
@@ -66,40 +162,71 @@ func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error
 	req.Body = ioutil.NopCloser(bytes.NewBuffer(requestBody))
 	defer httpcommon.CloseBody(req.Body)
 
-	correlationHeaders := correlation.HeadersForRequest(req)
+	if !isBatch(requestBody) {
+		return t.roundTripOperations(req, [][]byte{requestBody}, false)
+	}
 
-	isMutation, err := checkQueryType(requestBody, "mutation")
-	if err != nil && err != emptyQuery {
-		return nil, errors.Wrap(err, "could not check query type")
+	var batch []json.RawMessage
+	if err := json.Unmarshal(requestBody, &batch); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal batch")
 	}
 
-	if !isMutation && err != emptyQuery {
+	requests := make([][]byte, 0, len(batch))
+	for _, request := range batch {
+		requests = append(requests, request)
+	}
+	return t.roundTripOperations(req, requests, true)
+}
+
+// roundTripOperations sends the request and audits every mutation and every query which reads sensitive fields separately.
+// Queries which do not select sensitive fields are not audited.
+func (t *Transport) roundTripOperations(req *http.Request, requests [][]byte, batch bool) (*http.Response, error) {
+	operations := make([]*operation, 0, len(requests))
+	var unresolved []bool
+	resolvedAll := true
+	for _, request := range requests {
+		resolved, ok := t.resolve(request)
+		operations = append(operations, &operation{request: resolved})
+		unresolved = append(unresolved, !ok)
+		resolvedAll = resolvedAll && ok
+	}
+	if !resolvedAll {
+		log.Println("Request references unknown persisted query")
+		return persistedQueryNotFound(req, unresolved, batch)
+	}
+
+	audited, mutations := false, false
+	for _, op := range operations {
+		isMutation, err := checkQueryType(op.request, ast.Mutation)
+		if err != nil && err != emptyQuery {
+			return nil, errors.Wrap(err, "could not check query type")
+		}
+
+		op.mutation = isMutation || err == emptyQuery
+		op.sensitive = !op.mutation && t.readAuditor != nil && t.readAuditor.IsSensitive(string(op.request))
+		audited = audited || op.mutation || op.sensitive
+		mutations = mutations || op.mutation
+	}
+
+	if !audited {
 		log.Println("Will not send auditlog message for queries")
 		return t.RoundTripper.RoundTrip(req)
 	}
 
-	preAuditLogger, ok := t.auditlogSvc.(PreAuditlogService)
-	if !ok {
-		return nil, errors.New("Failed to type cast PreAuditlogService")
-	}
+	correlationHeaders := correlation.HeadersForRequest(req)
 
-	ctx := context.WithValue(req.Context(), correlation.RequestIDHeaderKey, correlationHeaders)
-	err = preAuditLogger.PreLog(ctx, AuditlogMessage{
-		CorrelationIDHeaders: correlationHeaders,
-		Request:              string(requestBody),
-		Response:             "",
-		Claims:               Claims{},
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "while sending pre-change auditlog message to auditlog service")
+	if mutations {
+		if err := t.preLog(req, correlationHeaders, operations); err != nil {
+			return nil, err
+		}
 	}
 
-	resp, err = t.RoundTripper.RoundTrip(req)
+	resp, err := t.RoundTripper.RoundTrip(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "on request round trip")
 	}
 
-	claims, err := t.getClaims(req.Header)
+	claims, err := ParseClaims(req.Header)
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing JWT")
 	}
@@ -109,21 +236,242 @@ func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error
 		return nil, err
 	}
 	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
-	defer httpcommon.CloseBody(resp.Body)
+
+	responses := operationResponses(responseBody, len(operations), batch)
+	for i, op := range operations {
+		switch {
+		case op.mutation:
+			err = t.logMutation(req, correlationHeaders, claims, op, responses[i])
+		case op.sensitive:
+			err = t.logReads(req, correlationHeaders, claims, op, responses[i])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// preLog sends the pre-change auditlog messages and captures the state of the objects changed by the mutations
+func (t *Transport) preLog(req *http.Request, correlationHeaders correlation.Headers, operations []*operation) error {
+	preAuditLogger, ok := t.auditlogSvc.(PreAuditlogService)
+	if !ok {
+		return errors.New("Failed to type cast PreAuditlogService")
+	}
+
+	ctx := context.WithValue(req.Context(), correlation.RequestIDHeaderKey, correlationHeaders)
+	for _, op := range operations {
+		if !op.mutation {
+			continue
+		}
+
+		op.audited = t.redactor.Redact(string(op.request))
+		err := preAuditLogger.PreLog(ctx, AuditlogMessage{
+			CorrelationIDHeaders: correlationHeaders,
+			Request:              op.audited,
+			Response:             "",
+			Claims:               Claims{},
+		})
+		if err != nil {
+			return errors.Wrap(err, "while sending pre-change auditlog message to auditlog service")
+		}
+
+		op.snapshots, err = t.tracker.Snapshot(req.Context(), req.Header, string(op.request))
+		if err != nil {
+			return errors.Wrap(err, "while resolving state of objects before the change")
+		}
+	}
+	return nil
+}
+
+func (t *Transport) logMutation(req *http.Request, correlationHeaders correlation.Headers, claims Claims, op *operation, response string) error {
+	changes, err := t.tracker.Changes(req.Context(), req.Header, op.snapshots)
+	if err != nil {
+		return errors.Wrap(err, "while resolving changes of objects")
+	}
 
 	err = t.auditlogSink.Log(req.Context(), AuditlogMessage{
+		ID:                   uuid.New().String(),
 		CorrelationIDHeaders: correlationHeaders,
-		Request:              string(requestBody),
-		Response:             string(responseBody),
+		Request:              op.audited,
+		Response:             t.redactor.RedactResponse(response),
+		Changes:              changes,
 		Claims:               claims,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "while sending post-change auditlog message to auditlog service")
+		return errors.Wrap(err, "while sending post-change auditlog message to auditlog service")
 	}
-	return resp, nil
+	return nil
 }
 
-func checkQueryType(requestBody []byte, typee string) (bool, error) {
+func (t *Transport) logReads(req *http.Request, correlationHeaders correlation.Headers, claims Claims, op *operation, response string) error {
+	reads := t.readAuditor.Reads(string(op.request), response)
+	if len(reads) == 0 {
+		return nil
+	}
+
+	err := t.auditlogSink.Log(req.Context(), AuditlogMessage{
+		ID:                   uuid.New().String(),
+		CorrelationIDHeaders: correlationHeaders,
+		Request:              t.redactor.Redact(string(op.request)),
+		Reads:                reads,
+		Claims:               claims,
+	})
+	if err != nil {
+		return errors.Wrap(err, "while sending data access auditlog message to auditlog service")
+	}
+	return nil
+}
+
+// resolve returns the request with the query document of the persisted query it references.
+// It returns false if the request references only the hash of a query which is not known to the Gateway.
+func (t *Transport) resolve(request []byte) ([]byte, bool) {
+	var params map[string]interface{}
+	if err := json.Unmarshal(request, &params); err != nil {
+		return request, true
+	}
+
+	hash := persistedQueryHash(params)
+	if hash == "" {
+		return request, true
+	}
+
+	if query, ok := params["query"].(string); ok && query != "" {
+		if t.queries != nil {
+			t.queries.Add(hash, query)
+		}
+		return request, true
+	}
+
+	if t.queries == nil {
+		return request, false
+	}
+	query, ok := t.queries.Get(hash)
+	if !ok {
+		return request, false
+	}
+
+	params["query"] = query
+	resolved, err := json.Marshal(params)
+	if err != nil {
+		return request, false
+	}
+	return resolved, true
+}
+
+func persistedQueryHash(params map[string]interface{}) string {
+	extensions, ok := params["extensions"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	persistedQuery, ok := extensions["persistedQuery"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	hash, _ := persistedQuery["sha256Hash"].(string)
+	return hash
+}
+
+// persistedQueryNotFound responds to the request without sending it, so that the client sends the query document
+// together with its hash as described in the Automatic Persisted Queries protocol
+func persistedQueryNotFound(req *http.Request, unresolved []bool, batch bool) (*http.Response, error) {
+	responses := make([]graphqlErrorResponse, 0, len(unresolved))
+	for _, notFound := range unresolved {
+		message := errPersistedQueryNotFound
+		if !notFound {
+			message = "operation not executed, the batch references unknown persisted query"
+		}
+		responses = append(responses, graphqlErrorResponse{Errors: []graphqlError{{Message: message}}})
+	}
+
+	var body []byte
+	var err error
+	if batch {
+		body, err = json.Marshal(responses)
+	} else {
+		body, err = json.Marshal(responses[0])
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling persisted query response")
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(http.StatusOK),
+		StatusCode:    http.StatusOK,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// operationResponses splits the response of a batch into the responses of its operations.
+// If the response is not an array of the expected length, such as an error of the whole request, every operation gets the whole response.
+func operationResponses(responseBody []byte, count int, batch bool) []string {
+	responses := make([]string, count)
+	var batchResponses []json.RawMessage
+	if batch && json.Unmarshal(responseBody, &batchResponses) == nil && len(batchResponses) == count {
+		for i, response := range batchResponses {
+			responses[i] = string(response)
+		}
+		return responses
+	}
+
+	for i := range responses {
+		responses[i] = string(responseBody)
+	}
+	return responses
+}
+
+func isBatch(requestBody []byte) bool {
+	trimmed := bytes.TrimSpace(requestBody)
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+type graphqlErrorResponse struct {
+	Errors []graphqlError `json:"errors"`
+}
+
+type graphqlError struct {
+	Message string `json:"message"`
+}
+
+// queryRequestBody returns the JSON encoded GraphQL request sent as URL query parameters
+func queryRequestBody(query url.Values) ([]byte, error) {
+	request := map[string]interface{}{}
+	if q := query.Get("query"); q != "" {
+		request["query"] = q
+	}
+
+	if variables := query.Get("variables"); variables != "" {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(variables), &decoded); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal variables")
+		}
+		request["variables"] = decoded
+	}
+
+	if operationName := query.Get("operationName"); operationName != "" {
+		request["operationName"] = operationName
+	}
+
+	if extensions := query.Get("extensions"); extensions != "" {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(extensions), &decoded); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal extensions")
+		}
+		request["extensions"] = decoded
+	}
+
+	return json.Marshal(request)
+}
+
+// checkQueryType checks whether the operation executed by the request is of the given type. Requests whose operation
+// cannot be resolved are treated as mutations, so that they are audited.
+func checkQueryType(requestBody []byte, typee ast.Operation) (bool, error) {
 	var query map[string]interface{}
 	if err := json.Unmarshal(requestBody, &query); err != nil {
 		return false, errors.Wrap(err, "could not unmarshal query")
@@ -136,11 +484,29 @@ func checkQueryType(requestBody []byte, typee string) (bool, error) {
 	if !ok {
 		return false, errors.New("query is not a string")
 	}
-	queryString = strings.TrimSpace(queryString)
-	if strings.HasPrefix(queryString, typee) {
-		return true, nil
+	operationName, _ := query["operationName"].(string)
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: queryString})
+	if gqlErr != nil {
+		return typee == ast.Mutation, nil
+	}
+	op := SelectOperation(doc, operationName)
+	if op == nil {
+		return typee == ast.Mutation, nil
+	}
+	return op.Operation == typee, nil
+}
+
+// SelectOperation returns the operation of the document which is executed for the given operation name: the named
+// operation, or the only operation of the document if the name is empty. It returns nil if there is no such operation.
+func SelectOperation(doc *ast.QueryDocument, operationName string) *ast.OperationDefinition {
+	if operationName == "" {
+		if len(doc.Operations) != 1 {
+			return nil
+		}
+		return doc.Operations[0]
 	}
-	return false, nil
+	return doc.Operations.ForName(operationName)
 }
 
 type Claims struct {
@@ -154,7 +520,8 @@ func (c Claims) Valid() error {
 	return nil
 }
 
-func (t *Transport) getClaims(headers http.Header) (Claims, error) {
+// ParseClaims returns the claims of the bearer token from the Authorization header, the token signature is not verified
+func ParseClaims(headers http.Header) (Claims, error) {
 	token := headers.Get("Authorization")
 	if token == "" {
 		return Claims{}, errors.New("no bearer token")
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
@@ -39,12 +40,28 @@ func TestAuditLog(t *testing.T) {
 		roundTripper := &automock.RoundTrip{}
 		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
 
+		redactor := &automock.RequestRedactor{}
+		redactor.On("Redact", string(graphqlPayload)).Return("redacted-request").Once()
+		redactor.On("RedactResponse", string(graphqlPayload)).Return("redacted-response").Once()
+
+		snapshots := []proxy.ObjectSnapshot{{Type: "Application", ID: "app-id", State: map[string]string{"name": "old"}}}
+		changes := []proxy.ObjectChange{{Type: "Application", ID: "app-id", Fields: []proxy.FieldChange{{Name: "name", Old: "old", New: "new"}}}}
+		tracker := &automock.ChangeTracker{}
+		tracker.On("Snapshot", mock.Anything, req.Header, string(graphqlPayload)).Return(snapshots, nil).Once()
+		tracker.On("Changes", mock.Anything, req.Header, snapshots).Return(changes, nil).Once()
+
+		isRedacted := mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-request"
+		})
+		hasChanges := mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.ID != "" && msg.Request == "redacted-request" && msg.Response == "redacted-response" && reflect.DeepEqual(changes, msg.Changes)
+		})
 		auditlogSink := &automock.AuditlogService{}
 		auditlogSvc := &automock.PreAuditlogService{}
-		auditlogSink.On("Log", mock.Anything, mock.Anything).Return(nil)
-		auditlogSvc.On("PreLog", mock.Anything, mock.Anything).Return(nil)
+		auditlogSink.On("Log", mock.Anything, hasChanges).Return(nil)
+		auditlogSvc.On("PreLog", mock.Anything, isRedacted).Return(nil)
 
-		transport := proxy.NewTransport(auditlogSink, auditlogSvc, roundTripper)
+		transport := proxy.NewTransport(auditlogSink, auditlogSvc, redactor, tracker, nil, nil, roundTripper)
 
 		//WHEN
 		output, err := transport.RoundTrip(req)
@@ -54,6 +71,371 @@ func TestAuditLog(t *testing.T) {
 		require.NotNil(t, output)
 		roundTripper.AssertExpectations(t)
 		auditlogSvc.AssertExpectations(t)
+		auditlogSink.AssertExpectations(t)
+		redactor.AssertExpectations(t)
+		tracker.AssertExpectations(t)
+	})
+
+	t.Run("Success query with sensitive fields", func(t *testing.T) {
+		//GIVEN
+		query := `{"query":"query { application(id: \"app-id\") { id auths { id } } }"}`
+		response := `{"data":{"application":{"id":"app-id","auths":[{"id":"auth-id"}]}}}`
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(query))
+		req.Header = http.Header{
+			"Authorization": []string{fixBearerHeader(t)},
+		}
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(response)),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		redactor := &automock.RequestRedactor{}
+		redactor.On("Redact", query).Return("redacted-request").Once()
+
+		reads := []proxy.ObjectRead{{Type: "Application", ID: "app-id", Fields: []string{"auths"}}}
+		readAuditor := &automock.ReadAuditor{}
+		readAuditor.On("IsSensitive", query).Return(true).Once()
+		readAuditor.On("Reads", query, response).Return(reads).Once()
+
+		hasReads := mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-request" && msg.Response == "" && reflect.DeepEqual(reads, msg.Reads) && msg.Claims == fixClaims()
+		})
+		auditlogSink := &automock.AuditlogService{}
+		auditlogSink.On("Log", mock.Anything, hasReads).Return(nil).Once()
+
+		transport := proxy.NewTransport(auditlogSink, &automock.PreAuditlogService{}, redactor, nil, readAuditor, nil, roundTripper)
+
+		//WHEN
+		output, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(output.Body)
+		require.NoError(t, err)
+		require.Equal(t, response, string(body))
+		mock.AssertExpectationsForObjects(t, roundTripper, redactor, readAuditor, auditlogSink)
+	})
+
+	t.Run("Success query without sensitive fields", func(t *testing.T) {
+		//GIVEN
+		query := `{"query":"query { applications { data { id } } }"}`
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(query))
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("response")),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		readAuditor := &automock.ReadAuditor{}
+		readAuditor.On("IsSensitive", query).Return(false).Once()
+
+		transport := proxy.NewTransport(nil, nil, nil, nil, readAuditor, nil, roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, roundTripper, readAuditor)
+	})
+
+	t.Run("Success mutation resolved by parsing the query", func(t *testing.T) {
+		testCases := []struct {
+			Name  string
+			Query string
+		}{
+			{
+				Name:  "Preceded by a comment",
+				Query: `{"query":"# register\nmutation { registerApplication(in: {name: \"app\"}) { id } }"}`,
+			},
+			{
+				Name:  "Selected by operationName",
+				Query: `{"query":"query apps { applications { data { id } } } mutation register { registerApplication(in: {name: \"app\"}) { id } }","operationName":"register"}`,
+			},
+			{
+				Name:  "Unparsable",
+				Query: `{"query":"mutation {"}`,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				//GIVEN
+				req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(testCase.Query))
+				req.Header = http.Header{
+					"Authorization": []string{fixBearerHeader(t)},
+				}
+				resp := http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString("response")),
+				}
+
+				roundTripper := &automock.RoundTrip{}
+				roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+				redactor := &automock.RequestRedactor{}
+				redactor.On("Redact", testCase.Query).Return("redacted-request").Once()
+				redactor.On("RedactResponse", "response").Return("redacted-response").Once()
+
+				tracker := &automock.ChangeTracker{}
+				tracker.On("Snapshot", mock.Anything, req.Header, testCase.Query).Return(nil, nil).Once()
+				tracker.On("Changes", mock.Anything, req.Header, []proxy.ObjectSnapshot(nil)).Return(nil, nil).Once()
+
+				auditlogSink := &automock.AuditlogService{}
+				auditlogSink.On("Log", mock.Anything, mock.Anything).Return(nil).Once()
+				auditlogSvc := &automock.PreAuditlogService{}
+				auditlogSvc.On("PreLog", mock.Anything, mock.Anything).Return(nil).Once()
+
+				transport := proxy.NewTransport(auditlogSink, auditlogSvc, redactor, tracker, nil, nil, roundTripper)
+
+				//WHEN
+				_, err := transport.RoundTrip(req)
+
+				//THEN
+				require.NoError(t, err)
+				mock.AssertExpectationsForObjects(t, roundTripper, redactor, tracker, auditlogSink, auditlogSvc)
+			})
+		}
+	})
+
+	t.Run("Success query resolved by parsing the query", func(t *testing.T) {
+		testCases := []struct {
+			Name  string
+			Query string
+		}{
+			{
+				Name:  "Shorthand",
+				Query: `{"query":"{ applications { data { id } } }"}`,
+			},
+			{
+				Name:  "Selected by operationName",
+				Query: `{"query":"mutation register { registerApplication(in: {name: \"app\"}) { id } } query apps { applications { data { id } } }","operationName":"apps"}`,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				//GIVEN
+				req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(testCase.Query))
+				resp := http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString("response")),
+				}
+
+				roundTripper := &automock.RoundTrip{}
+				roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+				readAuditor := &automock.ReadAuditor{}
+				readAuditor.On("IsSensitive", testCase.Query).Return(false).Once()
+
+				transport := proxy.NewTransport(nil, nil, nil, nil, readAuditor, nil, roundTripper)
+
+				//WHEN
+				_, err := transport.RoundTrip(req)
+
+				//THEN
+				require.NoError(t, err)
+				mock.AssertExpectationsForObjects(t, roundTripper, readAuditor)
+			})
+		}
+	})
+
+	t.Run("Success HTTP GET query with sensitive fields", func(t *testing.T) {
+		//GIVEN
+		req := httptest.NewRequest("GET", "http://localhost?query=query+%7B+runtime%28id%3A+%24id%29+%7B+auths+%7B+id+%7D+%7D+%7D&variables=%7B%22id%22%3A%22rt%22%7D", nil)
+		req.Header = http.Header{
+			"Authorization": []string{fixBearerHeader(t)},
+		}
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("response")),
+		}
+		request := `{"query":"query { runtime(id: $id) { auths { id } } }","variables":{"id":"rt"}}`
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		redactor := &automock.RequestRedactor{}
+		redactor.On("Redact", request).Return(request).Once()
+
+		reads := []proxy.ObjectRead{{Type: "Runtime", Fields: []string{"auths"}}}
+		readAuditor := &automock.ReadAuditor{}
+		readAuditor.On("IsSensitive", request).Return(true).Once()
+		readAuditor.On("Reads", request, "response").Return(reads).Once()
+
+		auditlogSink := &automock.AuditlogService{}
+		auditlogSink.On("Log", mock.Anything, mock.Anything).Return(nil).Once()
+
+		transport := proxy.NewTransport(auditlogSink, nil, redactor, nil, readAuditor, nil, roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, roundTripper, redactor, readAuditor, auditlogSink)
+	})
+
+	t.Run("Success batch with mutation and query with sensitive fields", func(t *testing.T) {
+		//GIVEN
+		mutation := `{"query":"mutation { updateApplication(id: \"app-id\") { id } }"}`
+		query := `{"query":"query { runtime(id: \"rt-id\") { id auths { id } } }"}`
+		plainQuery := `{"query":"query { applications { data { id } } }"}`
+		mutationResponse := `{"data":{"updateApplication":{"id":"app-id"}}}`
+		queryResponse := `{"data":{"runtime":{"id":"rt-id","auths":[{"id":"auth-id"}]}}}`
+		response := fmt.Sprintf(`[%s,%s,{"data":{}}]`, mutationResponse, queryResponse)
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(fmt.Sprintf("[%s,%s,%s]", mutation, query, plainQuery)))
+		req.Header = http.Header{
+			"Authorization": []string{fixBearerHeader(t)},
+		}
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(response)),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		redactor := &automock.RequestRedactor{}
+		redactor.On("Redact", mutation).Return("redacted-mutation").Once()
+		redactor.On("Redact", query).Return("redacted-query").Once()
+		redactor.On("RedactResponse", mutationResponse).Return(mutationResponse).Once()
+
+		tracker := &automock.ChangeTracker{}
+		tracker.On("Snapshot", mock.Anything, req.Header, mutation).Return(nil, nil).Once()
+		tracker.On("Changes", mock.Anything, req.Header, []proxy.ObjectSnapshot(nil)).Return(nil, nil).Once()
+
+		reads := []proxy.ObjectRead{{Type: "Runtime", ID: "rt-id", Fields: []string{"auths"}}}
+		readAuditor := &automock.ReadAuditor{}
+		readAuditor.On("IsSensitive", query).Return(true).Once()
+		readAuditor.On("IsSensitive", plainQuery).Return(false).Once()
+		readAuditor.On("Reads", query, queryResponse).Return(reads).Once()
+
+		auditlogSvc := &automock.PreAuditlogService{}
+		auditlogSvc.On("PreLog", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-mutation"
+		})).Return(nil).Once()
+
+		auditlogSink := &automock.AuditlogService{}
+		auditlogSink.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-mutation" && msg.Response == mutationResponse
+		})).Return(nil).Once()
+		auditlogSink.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-query" && msg.Response == "" && reflect.DeepEqual(reads, msg.Reads)
+		})).Return(nil).Once()
+
+		transport := proxy.NewTransport(auditlogSink, auditlogSvc, redactor, tracker, readAuditor, nil, roundTripper)
+
+		//WHEN
+		output, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(output.Body)
+		require.NoError(t, err)
+		require.Equal(t, response, string(body))
+		mock.AssertExpectationsForObjects(t, roundTripper, redactor, tracker, readAuditor, auditlogSvc, auditlogSink)
+	})
+
+	t.Run("Success persisted mutation referenced by hash", func(t *testing.T) {
+		//GIVEN
+		mutation := `mutation { deleteRuntime(id: "rt-id") { id } }`
+		request := `{"extensions":{"persistedQuery":{"sha256Hash":"hash","version":1}}}`
+		resolved := `{"extensions":{"persistedQuery":{"sha256Hash":"hash","version":1}},"query":"mutation { deleteRuntime(id: \"rt-id\") { id } }"}`
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(request))
+		req.Header = http.Header{
+			"Authorization": []string{fixBearerHeader(t)},
+		}
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("response")),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		queries := &automock.PersistedQueries{}
+		queries.On("Get", "hash").Return(mutation, true).Once()
+
+		redactor := &automock.RequestRedactor{}
+		redactor.On("Redact", resolved).Return("redacted-request").Once()
+		redactor.On("RedactResponse", "response").Return("response").Once()
+
+		tracker := &automock.ChangeTracker{}
+		tracker.On("Snapshot", mock.Anything, req.Header, resolved).Return(nil, nil).Once()
+		tracker.On("Changes", mock.Anything, req.Header, []proxy.ObjectSnapshot(nil)).Return(nil, nil).Once()
+
+		auditlogSvc := &automock.PreAuditlogService{}
+		auditlogSvc.On("PreLog", mock.Anything, mock.Anything).Return(nil).Once()
+		auditlogSink := &automock.AuditlogService{}
+		auditlogSink.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-request" && msg.Response == "response"
+		})).Return(nil).Once()
+
+		transport := proxy.NewTransport(auditlogSink, auditlogSvc, redactor, tracker, nil, queries, roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, roundTripper, queries, redactor, tracker, auditlogSvc, auditlogSink)
+	})
+
+	t.Run("Learns persisted query sent with the query document", func(t *testing.T) {
+		//GIVEN
+		request := `{"query":"query { applications { data { id } } }","extensions":{"persistedQuery":{"sha256Hash":"hash","version":1}}}`
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(request))
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("response")),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		queries := &automock.PersistedQueries{}
+		queries.On("Add", "hash", "query { applications { data { id } } }").Once()
+
+		transport := proxy.NewTransport(nil, nil, nil, nil, nil, queries, roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, roundTripper, queries)
+	})
+
+	t.Run("Responds with PersistedQueryNotFound for unknown hash", func(t *testing.T) {
+		//GIVEN
+		request := `{"extensions":{"persistedQuery":{"sha256Hash":"unknown","version":1}}}`
+		known := `{"query":"query { applications { data { id } } }"}`
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(fmt.Sprintf("[%s,%s]", known, request)))
+
+		queries := &automock.PersistedQueries{}
+		queries.On("Get", "unknown").Return("", false).Once()
+
+		transport := proxy.NewTransport(nil, nil, nil, nil, nil, queries, &automock.RoundTrip{})
+
+		//WHEN
+		output, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, output.StatusCode)
+		body, err := ioutil.ReadAll(output.Body)
+		require.NoError(t, err)
+		require.Equal(t, `[{"errors":[{"message":"operation not executed, the batch references unknown persisted query"}]},{"errors":[{"message":"PersistedQueryNotFound"}]}]`, string(body))
+		queries.AssertExpectations(t)
 	})
 
 	t.Run("Success HTTP GET", func(t *testing.T) {
@@ -69,7 +451,7 @@ func TestAuditLog(t *testing.T) {
 		roundTripper := &automock.RoundTrip{}
 		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
 
-		transport := proxy.NewTransport(nil, nil, roundTripper)
+		transport := proxy.NewTransport(nil, nil, nil, nil, nil, nil, roundTripper)
 
 		//WHEN
 		_, err := transport.RoundTrip(req)
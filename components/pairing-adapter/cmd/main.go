@@ -8,13 +8,12 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/executor"
 	"github.com/kyma-incubator/compass/components/director/pkg/handler"
-	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/vrischmann/envconfig"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/clientcredentials"
 )
 
 func main() {
@@ -22,27 +21,34 @@ func main() {
 	err := envconfig.Init(&conf)
 	exitOnError(err, "while reading Pairing Adapter Configuration")
 
-	authStyle, err := getAuthStyle(conf.OAuth.AuthStyle)
-	exitOnError(err, "while getting Auth Style")
+	registry := adapter.NewRegistry(adapter.NewClientFactory(conf.ClientTimeout))
+	if conf.ConfigurationFile != "" {
+		err = registry.LoadFile(conf.ConfigurationFile)
+		exitOnError(err, "while loading adapters configuration file")
 
-	cc := clientcredentials.Config{
-		TokenURL:     conf.OAuth.URL,
-		ClientID:     conf.OAuth.ClientID,
-		ClientSecret: conf.OAuth.ClientSecret,
-		AuthStyle:    authStyle,
+		executor.NewPeriodic(conf.ConfigurationFileReload, func(ctx context.Context) {
+			if err := registry.LoadFile(conf.ConfigurationFile); err != nil {
+				logrus.Errorf("Got error on reloading adapters configuration file, previous configuration is kept: %v", err)
+			}
+		}).Run(context.Background())
+	} else {
+		err = registry.Load(adapter.AdaptersConfiguration{
+			Default: adapter.DefaultAdapterName,
+			Adapters: map[string]adapter.AdapterConfig{
+				adapter.DefaultAdapterName: {
+					Mapping:  conf.Mapping,
+					AuthType: conf.AuthType,
+					OAuth:    conf.OAuth,
+					Basic:    conf.Basic,
+					Bearer:   conf.Bearer,
+					MTLS:     conf.MTLS,
+				},
+			},
+		})
+		exitOnError(err, "while loading adapter configuration from environment variables")
 	}
 
-	baseClient := &http.Client{
-		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
-	}
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, baseClient)
-
-	client := cc.Client(ctx)
-	client.Timeout = conf.ClientTimeout
-
-	cli := adapter.NewClient(client, conf.Mapping)
-
-	h := adapter.NewHandler(cli)
+	h := adapter.NewHandler(registry)
 	handlerWithTimeout, err := handler.WithTimeout(h, conf.ServerTimeout)
 	exitOnError(err, "Failed configuring timeout on handler")
 
@@ -50,6 +56,7 @@ func main() {
 
 	router.Use(correlation.AttachCorrelationIDToContext())
 	router.Handle("/adapter", handlerWithTimeout)
+	router.Handle("/adapter/{name}", handlerWithTimeout)
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
 		writer.WriteHeader(http.StatusOK)
 	})
@@ -69,16 +76,3 @@ func exitOnError(err error, context string) {
 		log.Fatal(wrappedError)
 	}
 }
-
-func getAuthStyle(style adapter.AuthStyle) (oauth2.AuthStyle, error) {
-	switch style {
-	case adapter.AuthStyleInParams:
-		return oauth2.AuthStyleInParams, nil
-	case adapter.AuthStyleInHeader:
-		return oauth2.AuthStyleInHeader, nil
-	case adapter.AuthStyleAutoDetect:
-		return oauth2.AuthStyleAutoDetect, nil
-	default:
-		return -1, errors.New("unknown Auth style")
-	}
-}
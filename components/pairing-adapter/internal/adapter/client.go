@@ -8,7 +8,11 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"text/template"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -60,13 +64,7 @@ func (c *ExternalClient) Do(ctx context.Context, app RequestData) (*ExternalToke
 		return nil, fmt.Errorf("wrong status code, got: [%d], body: [%s]", resp.StatusCode, string(b))
 	}
 
-	tkn, err := c.getTokenFromResponse(resp.Body)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &ExternalToken{Token: tkn}, nil
+	return c.getTokenFromResponse(resp.Body)
 }
 
 func (c *ExternalClient) prepareRequest(reqData RequestData) (*http.Request, error) {
@@ -146,18 +144,63 @@ func (c *ExternalClient) getHeaders(reqData RequestData) (map[string][]string, e
 	return h, nil
 }
 
-func (c *ExternalClient) getTokenFromResponse(in io.Reader) (string, error) {
+func (c *ExternalClient) getTokenFromResponse(in io.Reader) (*ExternalToken, error) {
 	b, err := ioutil.ReadAll(in)
 	if err != nil {
-		return "", errors.Wrap(err, "while reading response body")
+		return nil, errors.Wrap(err, "while reading response body")
 	}
 	respBody := map[string]interface{}{}
 	if err = json.Unmarshal(b, &respBody); err != nil {
-		return "", errors.Wrap(err, "while unmarshalling response body")
+		return nil, errors.Wrap(err, "while unmarshalling response body")
 	}
 
 	logrus.Infof("Got response: %s\n", string(b))
-	respTpl, err := template.New("response").Option("missingkey=error").Parse(c.mapping.TemplateTokenFromResponse)
+	tkn, err := executeResponseTemplate("response", c.mapping.TemplateTokenFromResponse, respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateToken(tkn, c.mapping.TokenPattern); err != nil {
+		return nil, err
+	}
+
+	externalToken := &ExternalToken{Token: tkn}
+
+	if c.mapping.TemplateExpiresAtFromResponse != "" {
+		expiresAt, err := executeResponseTemplate("expiresAt", c.mapping.TemplateExpiresAtFromResponse, respBody)
+		if err != nil {
+			return nil, err
+		}
+		if externalToken.ExpiresAt, err = parseExpiry(expiresAt, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.mapping.TemplateConnectorURLFromResponse != "" {
+		connectorURL, err := executeResponseTemplate("connectorURL", c.mapping.TemplateConnectorURLFromResponse, respBody)
+		if err != nil {
+			return nil, err
+		}
+		externalToken.ConnectorURL = strings.TrimSpace(connectorURL)
+	}
+
+	if c.mapping.TemplateMetadataFromResponse != "" {
+		metadata, err := executeResponseTemplate("metadata", c.mapping.TemplateMetadataFromResponse, respBody)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(metadata) != "" {
+			if err := json.Unmarshal([]byte(metadata), &externalToken.Metadata); err != nil {
+				return nil, errors.Wrap(err, "while unmarshalling token metadata")
+			}
+		}
+	}
+
+	return externalToken, nil
+}
+
+func executeResponseTemplate(name, tpl string, respBody map[string]interface{}) (string, error) {
+	respTpl, err := template.New(name).Option("missingkey=error").Parse(tpl)
 	if err != nil {
 		return "", err
 	}
@@ -167,5 +210,40 @@ func (c *ExternalClient) getTokenFromResponse(in io.Reader) (string, error) {
 	}
 
 	return out.String(), nil
+}
+
+func validateToken(token, pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+
+	matches, err := regexp.MatchString(fmt.Sprintf("^(?:%s)$", pattern), token)
+	if err != nil {
+		return errors.Wrap(err, "while matching token with pattern")
+	}
+	if !matches {
+		return fmt.Errorf("token does not match pattern %s", pattern)
+	}
+
+	return nil
+}
+
+// parseExpiry accepts an RFC3339 timestamp or a number of seconds after which the token expires.
+func parseExpiry(value string, now time.Time) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		expiresAt := now.Add(time.Duration(seconds) * time.Second).UTC()
+		return &expiresAt, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing token expiry %s", value)
+	}
 
+	return &expiresAt, nil
 }
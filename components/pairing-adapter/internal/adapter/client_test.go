@@ -17,6 +17,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -210,6 +211,106 @@ func TestClient(t *testing.T) {
 		// THEN
 		assert.EqualError(t, err, "wrong status code, got: [418], body: [detailed message]")
 	})
+
+	t.Run("returns token with expiry, connector URL and metadata", func(t *testing.T) {
+		// GIVEN
+		mockDoer := fixDoerWithResponse(`{"token":"abc-123","expiresAt":"2030-01-02T15:04:05Z","connector":"https://connector.example.com","details":{"system":"ticketing"}}`)
+		defer mockDoer.AssertExpectations(t)
+
+		cli := adapter.NewClient(mockDoer, adapter.Mapping{
+			TemplateTokenFromResponse:        `{{ .token }}`,
+			TemplateExpiresAtFromResponse:    `{{ .expiresAt }}`,
+			TemplateConnectorURLFromResponse: `{{ .connector }}`,
+			TemplateMetadataFromResponse:     `{"system":"{{ .details.system }}"}`,
+			TokenPattern:                     `[a-z]+-[0-9]+`,
+		})
+		// WHEN
+		actualToken, err := cli.Do(context.TODO(), adapter.RequestData{})
+		// THEN
+		require.NoError(t, err)
+		expectedExpiry := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+		require.NotNil(t, actualToken.ExpiresAt)
+		assert.True(t, expectedExpiry.Equal(*actualToken.ExpiresAt))
+		assert.Equal(t, "abc-123", actualToken.Token)
+		assert.Equal(t, "https://connector.example.com", actualToken.ConnectorURL)
+		assert.Equal(t, map[string]interface{}{"system": "ticketing"}, actualToken.Metadata)
+	})
+
+	t.Run("returns token with expiry in seconds", func(t *testing.T) {
+		// GIVEN
+		mockDoer := fixDoerWithResponse(`{"token":"abc-123","expires_in":3600}`)
+		defer mockDoer.AssertExpectations(t)
+
+		cli := adapter.NewClient(mockDoer, adapter.Mapping{
+			TemplateTokenFromResponse:     `{{ .token }}`,
+			TemplateExpiresAtFromResponse: `{{ .expires_in }}`,
+		})
+		before := time.Now()
+		// WHEN
+		actualToken, err := cli.Do(context.TODO(), adapter.RequestData{})
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, actualToken.ExpiresAt)
+		assert.WithinDuration(t, before.Add(time.Hour), *actualToken.ExpiresAt, time.Minute)
+	})
+
+	t.Run("fails when token does not match pattern", func(t *testing.T) {
+		// GIVEN
+		mockDoer := fixDoerWithResponse(`{"token":"<html>error</html>"}`)
+		defer mockDoer.AssertExpectations(t)
+
+		cli := adapter.NewClient(mockDoer, adapter.Mapping{
+			TemplateTokenFromResponse: `{{ .token }}`,
+			TokenPattern:              `[a-z]+-[0-9]+`,
+		})
+		// WHEN
+		_, err := cli.Do(context.TODO(), adapter.RequestData{})
+		// THEN
+		assert.EqualError(t, err, "token does not match pattern [a-z]+-[0-9]+")
+	})
+
+	t.Run("fails when expiry has wrong format", func(t *testing.T) {
+		// GIVEN
+		mockDoer := fixDoerWithResponse(`{"token":"abc-123","expiresAt":"tomorrow"}`)
+		defer mockDoer.AssertExpectations(t)
+
+		cli := adapter.NewClient(mockDoer, adapter.Mapping{
+			TemplateTokenFromResponse:     `{{ .token }}`,
+			TemplateExpiresAtFromResponse: `{{ .expiresAt }}`,
+		})
+		// WHEN
+		_, err := cli.Do(context.TODO(), adapter.RequestData{})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing token expiry tomorrow")
+	})
+
+	t.Run("fails when metadata is not a JSON object", func(t *testing.T) {
+		// GIVEN
+		mockDoer := fixDoerWithResponse(`{"token":"abc-123"}`)
+		defer mockDoer.AssertExpectations(t)
+
+		cli := adapter.NewClient(mockDoer, adapter.Mapping{
+			TemplateTokenFromResponse:    `{{ .token }}`,
+			TemplateMetadataFromResponse: `{{ .token }}`,
+		})
+		// WHEN
+		_, err := cli.Do(context.TODO(), adapter.RequestData{})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling token metadata")
+	})
+}
+
+func fixDoerWithResponse(body string) *automock.HTTPDoer {
+	givenResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+	mockDoer := &automock.HTTPDoer{}
+	mockDoer.On("Do", mock.Anything).Return(givenResponse, nil)
+
+	return mockDoer
 }
 
 func fixError() error {
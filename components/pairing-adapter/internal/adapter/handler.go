@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -13,16 +15,21 @@ type Client interface {
 	Do(ctx context.Context, req RequestData) (*ExternalToken, error)
 }
 
-func NewHandler(cli Client) *Handler {
-	return &Handler{cli: cli}
+//go:generate mockery -name=Selector -output=automock -outpkg=automock
+type Selector interface {
+	Select(name string, reqData RequestData) (Client, error)
+}
+
+func NewHandler(selector Selector) *Handler {
+	return &Handler{selector: selector}
 }
 
 type Handler struct {
-	cli Client
+	selector Selector
 }
 
 // swagger:route POST /adapter adapter
-// Request token from external solution
+// Request token from external solution selected based on the Application
 // 		Consumes:
 //		- application/json
 //   	Produces:
@@ -30,6 +37,19 @@ type Handler struct {
 //		Responses:
 // 		200: externalToken
 //		400:
+//		404:
+// 		500:
+
+// swagger:route POST /adapter/{name} adapterByName
+// Request token from external solution with the given name
+// 		Consumes:
+//		- application/json
+//   	Produces:
+//		- application/json
+//		Responses:
+// 		200: externalToken
+//		400:
+//		404:
 // 		500:
 func (a *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
@@ -48,7 +68,18 @@ func (a *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	logrus.Infof("Got ApplicationData %v", reqData)
-	token, err := a.cli.Do(req.Context(), reqData)
+	cli, err := a.selector.Select(mux.Vars(req)["name"], reqData)
+	if err != nil {
+		logrus.Warnf("Got error on selecting adapter: %v\n", err)
+		if errors.Cause(err) == ErrAdapterNotFound {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	token, err := cli.Do(req.Context(), reqData)
 	if err != nil {
 		logrus.Warnf("Got error on calling external pairing server: %v\n", err)
 		rw.WriteHeader(http.StatusInternalServerError)
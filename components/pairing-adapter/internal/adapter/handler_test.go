@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
 	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter/automock"
 	"github.com/pkg/errors"
@@ -27,7 +28,11 @@ func TestHandler(t *testing.T) {
 		givenRequestData := givenReqData()
 		mockClient.On("Do", mock.Anything, givenRequestData).Return(givenToken, nil)
 
-		sut := adapter.NewHandler(mockClient)
+		mockSelector := &automock.Selector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", "", givenRequestData).Return(mockClient, nil)
+
+		sut := adapter.NewHandler(mockSelector)
 		// WHEN
 		// THEN
 		rr := httptest.NewRecorder()
@@ -62,7 +67,11 @@ func TestHandler(t *testing.T) {
 		givenRequestData.ClientUser = "P98754321"
 		mockClient.On("Do", mock.Anything, givenRequestData).Return(givenToken, nil)
 
-		sut := adapter.NewHandler(mockClient)
+		mockSelector := &automock.Selector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", "", givenRequestData).Return(mockClient, nil)
+
+		sut := adapter.NewHandler(mockSelector)
 		// WHEN
 		// THEN
 		rr := httptest.NewRecorder()
@@ -110,7 +119,11 @@ func TestHandler(t *testing.T) {
 		givenRequestData := givenReqData()
 		mockClient.On("Do", mock.Anything, givenRequestData).Return(nil, errors.New("some error"))
 
-		sut := adapter.NewHandler(mockClient)
+		mockSelector := &automock.Selector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", "", givenRequestData).Return(mockClient, nil)
+
+		sut := adapter.NewHandler(mockSelector)
 		rr := httptest.NewRecorder()
 
 		buf := new(bytes.Buffer)
@@ -124,6 +137,56 @@ func TestHandler(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rr.Result().StatusCode)
 	})
 
+	t.Run("uses adapter with name from path", func(t *testing.T) {
+		// GIVEN
+		mockClient := &automock.Client{}
+		defer mockClient.AssertExpectations(t)
+		givenToken := &adapter.ExternalToken{
+			Token: "some-token",
+		}
+		givenRequestData := givenReqData()
+		mockClient.On("Do", mock.Anything, givenRequestData).Return(givenToken, nil)
+
+		mockSelector := &automock.Selector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", "ticketing", givenRequestData).Return(mockClient, nil)
+
+		router := mux.NewRouter()
+		router.Handle("/adapter/{name}", adapter.NewHandler(mockSelector))
+		rr := httptest.NewRecorder()
+
+		buf := new(bytes.Buffer)
+		err := json.NewEncoder(buf).Encode(givenRequestData)
+		require.NoError(t, err)
+		givenReq, err := http.NewRequest(http.MethodPost, "/adapter/ticketing", buf)
+		require.NoError(t, err)
+		// WHEN
+		router.ServeHTTP(rr, givenReq)
+		// THEN
+		assert.Equal(t, http.StatusOK, rr.Result().StatusCode)
+	})
+
+	t.Run("returns not found when adapter does not exist", func(t *testing.T) {
+		// GIVEN
+		givenRequestData := givenReqData()
+		mockSelector := &automock.Selector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", "", givenRequestData).Return(nil, errors.Wrap(adapter.ErrAdapterNotFound, "no adapter matches"))
+
+		sut := adapter.NewHandler(mockSelector)
+		rr := httptest.NewRecorder()
+
+		buf := new(bytes.Buffer)
+		err := json.NewEncoder(buf).Encode(givenRequestData)
+		require.NoError(t, err)
+		givenReq, err := http.NewRequest(http.MethodPost, "", buf)
+		require.NoError(t, err)
+		// WHEN
+		sut.ServeHTTP(rr, givenReq)
+		// THEN
+		assert.Equal(t, http.StatusNotFound, rr.Result().StatusCode)
+	})
+
 }
 
 func givenReqData() adapter.RequestData {
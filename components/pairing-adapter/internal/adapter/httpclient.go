@@ -0,0 +1,160 @@
+package adapter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// NewClientFactory returns a ClientFactory which creates clients calling the External Token Service
+// with the authentication configured for the given adapter.
+func NewClientFactory(timeout time.Duration) ClientFactory {
+	return func(cfg AdapterConfig) (Client, error) {
+		httpClient, err := NewHTTPClient(cfg, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewClient(httpClient, cfg.Mapping), nil
+	}
+}
+
+func NewHTTPClient(cfg AdapterConfig, timeout time.Duration) (*http.Client, error) {
+	switch cfg.AuthType {
+	case AuthTypeOAuth, "":
+		return NewOAuthHTTPClient(cfg.OAuth, timeout)
+	case AuthTypeBasic:
+		if cfg.Basic.Username == "" {
+			return nil, errors.New("username for basic auth is empty")
+		}
+		return newAuthHTTPClient(baseTransport(), timeout, func(req *http.Request) {
+			req.SetBasicAuth(cfg.Basic.Username, cfg.Basic.Password)
+		}), nil
+	case AuthTypeBearer:
+		if cfg.Bearer.Token == "" {
+			return nil, errors.New("bearer token is empty")
+		}
+		return newAuthHTTPClient(baseTransport(), timeout, func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+cfg.Bearer.Token)
+		}), nil
+	case AuthTypeMTLS:
+		transport, err := mtlsTransport(cfg.MTLS)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Client{
+			Transport: httputil.NewCorrelationIDTransport(transport),
+			Timeout:   timeout,
+		}, nil
+	default:
+		return nil, errors.Errorf("unknown auth type %q", cfg.AuthType)
+	}
+}
+
+func NewOAuthHTTPClient(cfg OAuth, timeout time.Duration) (*http.Client, error) {
+	authStyle, err := getAuthStyle(cfg.AuthStyle)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting Auth Style")
+	}
+
+	endpointParams := url.Values{}
+	for key, value := range cfg.TokenParams {
+		endpointParams.Set(key, value)
+	}
+	if cfg.Audience != "" {
+		endpointParams.Set("audience", cfg.Audience)
+	}
+
+	cc := clientcredentials.Config{
+		TokenURL:       cfg.URL,
+		ClientID:       cfg.ClientID,
+		ClientSecret:   cfg.ClientSecret,
+		Scopes:         cfg.Scopes,
+		EndpointParams: endpointParams,
+		AuthStyle:      authStyle,
+	}
+
+	baseClient := &http.Client{
+		Transport: baseTransport(),
+	}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, baseClient)
+
+	client := cc.Client(ctx)
+	client.Timeout = timeout
+
+	return client, nil
+}
+
+func getAuthStyle(style AuthStyle) (oauth2.AuthStyle, error) {
+	switch style {
+	case AuthStyleInParams:
+		return oauth2.AuthStyleInParams, nil
+	case AuthStyleInHeader:
+		return oauth2.AuthStyleInHeader, nil
+	case AuthStyleAutoDetect, "":
+		return oauth2.AuthStyleAutoDetect, nil
+	default:
+		return -1, errors.Errorf("unknown Auth style %q", style)
+	}
+}
+
+func baseTransport() http.RoundTripper {
+	return httputil.NewCorrelationIDTransport(http.DefaultTransport)
+}
+
+func mtlsTransport(cfg MTLSAuth) (*http.Transport, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading client certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if cfg.CAPath != "" {
+		caPEM, err := ioutil.ReadFile(cfg.CAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading CA bundle")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("no certificates found in CA bundle %s", cfg.CAPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+type authTransport struct {
+	transport http.RoundTripper
+	setAuth   func(req *http.Request)
+}
+
+func newAuthHTTPClient(transport http.RoundTripper, timeout time.Duration, setAuth func(req *http.Request)) *http.Client {
+	return &http.Client{
+		Transport: &authTransport{transport: transport, setAuth: setAuth},
+		Timeout:   timeout,
+	}
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	authReq := req.Clone(req.Context())
+	t.setAuth(authReq)
+
+	return t.transport.RoundTrip(authReq)
+}
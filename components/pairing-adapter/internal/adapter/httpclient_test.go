@@ -0,0 +1,201 @@
+package adapter_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Run("uses basic auth", func(t *testing.T) {
+		// GIVEN
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "pass", password)
+		}))
+		defer srv.Close()
+
+		cli, err := adapter.NewHTTPClient(adapter.AdapterConfig{
+			AuthType: adapter.AuthTypeBasic,
+			Basic:    adapter.BasicAuth{Username: "user", Password: "pass"},
+		}, time.Second)
+		require.NoError(t, err)
+		// WHEN
+		resp, err := cli.Get(srv.URL)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("uses bearer token", func(t *testing.T) {
+		// GIVEN
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer static-token", r.Header.Get("Authorization"))
+		}))
+		defer srv.Close()
+
+		cli, err := adapter.NewHTTPClient(adapter.AdapterConfig{
+			AuthType: adapter.AuthTypeBearer,
+			Bearer:   adapter.BearerAuth{Token: "static-token"},
+		}, time.Second)
+		require.NoError(t, err)
+		// WHEN
+		resp, err := cli.Get(srv.URL)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("uses OAuth with additional token parameters", func(t *testing.T) {
+		// GIVEN
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/token" {
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+				assert.Equal(t, "read write", r.Form.Get("scope"))
+				assert.Equal(t, "https://tickets.example.com", r.Form.Get("audience"))
+				assert.Equal(t, "eu", r.Form.Get("resource"))
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(`{"access_token":"oauth-token","token_type":"bearer"}`))
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, "Bearer oauth-token", r.Header.Get("Authorization"))
+		}))
+		defer srv.Close()
+
+		cli, err := adapter.NewHTTPClient(adapter.AdapterConfig{
+			OAuth: adapter.OAuth{
+				URL:          srv.URL + "/token",
+				ClientID:     "client",
+				ClientSecret: "secret",
+				AuthStyle:    adapter.AuthStyleInParams,
+				Scopes:       []string{"read", "write"},
+				Audience:     "https://tickets.example.com",
+				TokenParams:  map[string]string{"resource": "eu"},
+			},
+		}, time.Second)
+		require.NoError(t, err)
+		// WHEN
+		resp, err := cli.Get(srv.URL)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("uses client certificate", func(t *testing.T) {
+		// GIVEN
+		dir, err := ioutil.TempDir("", "mtls")
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, os.RemoveAll(dir))
+		}()
+
+		clientCert, certPath, keyPath := fixClientCertificate(t, dir)
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(clientCert)
+
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Len(t, r.TLS.PeerCertificates, 1)
+			assert.Equal(t, "pairing-adapter", r.TLS.PeerCertificates[0].Subject.CommonName)
+		}))
+		srv.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+		srv.StartTLS()
+		defer srv.Close()
+
+		caPath := filepath.Join(dir, "ca.crt")
+		writePEM(t, caPath, "CERTIFICATE", srv.Certificate().Raw)
+
+		cli, err := adapter.NewHTTPClient(adapter.AdapterConfig{
+			AuthType: adapter.AuthTypeMTLS,
+			MTLS:     adapter.MTLSAuth{CertPath: certPath, KeyPath: keyPath, CAPath: caPath},
+		}, time.Second)
+		require.NoError(t, err)
+		// WHEN
+		resp, err := cli.Get(srv.URL)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("returns error when client certificate does not exist", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewHTTPClient(adapter.AdapterConfig{
+			AuthType: adapter.AuthTypeMTLS,
+			MTLS:     adapter.MTLSAuth{CertPath: "testdata/missing.crt", KeyPath: "testdata/missing.key"},
+		}, time.Second)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading client certificate")
+	})
+
+	t.Run("returns error when username is empty", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewHTTPClient(adapter.AdapterConfig{AuthType: adapter.AuthTypeBasic}, time.Second)
+		// THEN
+		require.EqualError(t, err, "username for basic auth is empty")
+	})
+
+	t.Run("returns error when bearer token is empty", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewHTTPClient(adapter.AdapterConfig{AuthType: adapter.AuthTypeBearer}, time.Second)
+		// THEN
+		require.EqualError(t, err, "bearer token is empty")
+	})
+
+	t.Run("returns error when auth type is unknown", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewHTTPClient(adapter.AdapterConfig{AuthType: "digest"}, time.Second)
+		// THEN
+		require.EqualError(t, err, `unknown auth type "digest"`)
+	})
+}
+
+func fixClientCertificate(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "pairing-adapter"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	writePEM(t, certPath, "CERTIFICATE", der)
+	writePEM(t, keyPath, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+
+	return cert, certPath, keyPath
+}
+
+func writePEM(t *testing.T, path, blockType string, bytes []byte) {
+	err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	require.NoError(t, err)
+}
@@ -14,36 +14,113 @@ const (
 
 type AuthStyle string
 
+const (
+	AuthTypeOAuth  AuthType = "oauth"
+	AuthTypeBasic  AuthType = "basic"
+	AuthTypeBearer AuthType = "bearer"
+	AuthTypeMTLS   AuthType = "mtls"
+)
+
+// AuthType specifies how the Pairing Adapter authenticates to the External Token Service.
+type AuthType string
+
 type Configuration struct {
-	Mapping       Mapping
-	OAuth         OAuth
-	Port          string        `envconfig:"default=8080"`
-	ClientTimeout time.Duration `envconfig:"default=30s"`
-	ServerTimeout time.Duration `envconfig:"default=30s"`
+	Mapping                 Mapping
+	AuthType                AuthType `envconfig:"default=oauth"`
+	OAuth                   OAuth
+	Basic                   BasicAuth
+	Bearer                  BearerAuth
+	MTLS                    MTLSAuth
+	ConfigurationFile       string        `envconfig:"optional"`
+	ConfigurationFileReload time.Duration `envconfig:"default=1m"`
+	Port                    string        `envconfig:"default=8080"`
+	ClientTimeout           time.Duration `envconfig:"default=30s"`
+	ServerTimeout           time.Duration `envconfig:"default=30s"`
 }
 
 type Mapping struct {
-	TemplateExternalURL       string
-	TemplateHeaders           string
-	TemplateJSONBody          string
-	TemplateTokenFromResponse string
+	TemplateExternalURL       string `envconfig:"optional" json:"templateExternalURL"`
+	TemplateHeaders           string `envconfig:"optional" json:"templateHeaders"`
+	TemplateJSONBody          string `envconfig:"optional" json:"templateJSONBody"`
+	TemplateTokenFromResponse string `envconfig:"optional" json:"templateTokenFromResponse"`
+	// TemplateExpiresAtFromResponse returns the token expiry as an RFC3339 timestamp or a number of seconds.
+	TemplateExpiresAtFromResponse    string `envconfig:"optional" json:"templateExpiresAtFromResponse"`
+	TemplateConnectorURLFromResponse string `envconfig:"optional" json:"templateConnectorURLFromResponse"`
+	// TemplateMetadataFromResponse returns a JSON object with additional information about the token.
+	TemplateMetadataFromResponse string `envconfig:"optional" json:"templateMetadataFromResponse"`
+	// TokenPattern is a regular expression which the whole token has to match.
+	TokenPattern string `envconfig:"optional" json:"tokenPattern"`
 }
 
 type OAuth struct {
-	URL          string
-	ClientID     string
-	ClientSecret string
-	AuthStyle    AuthStyle `envconfig:"default=AuthDetect"`
+	URL          string    `envconfig:"optional" json:"url"`
+	ClientID     string    `envconfig:"optional" json:"clientID"`
+	ClientSecret string    `envconfig:"optional" json:"clientSecret"`
+	AuthStyle    AuthStyle `envconfig:"default=AuthDetect" json:"authStyle"`
+	Scopes       []string  `envconfig:"optional" json:"scopes"`
+	Audience     string    `envconfig:"optional" json:"audience"`
+	// TokenParams are additional parameters sent to the token endpoint.
+	TokenParams map[string]string `envconfig:"-" json:"tokenParams"`
+}
+
+type BasicAuth struct {
+	Username string `envconfig:"optional" json:"username"`
+	Password string `envconfig:"optional" json:"password"`
+}
+
+type BearerAuth struct {
+	Token string `envconfig:"optional" json:"token"`
+}
+
+// MTLSAuth contains paths to the PEM encoded client certificate and key.
+// The files are read every time the adapters configuration is loaded.
+type MTLSAuth struct {
+	CertPath string `envconfig:"optional" json:"certPath"`
+	KeyPath  string `envconfig:"optional" json:"keyPath"`
+	// CAPath is an optional path to the CA bundle used to verify the External Token Service.
+	CAPath string `envconfig:"optional" json:"caPath"`
+}
+
+// AdaptersConfiguration represents the content of the configuration file with named adapters.
+type AdaptersConfiguration struct {
+	// Default is the name of the adapter used when no other adapter matches the request.
+	Default  string                   `json:"default"`
+	Adapters map[string]AdapterConfig `json:"adapters"`
+}
+
+// AdapterConfig describes a single External Token Service and the Applications it is used for.
+type AdapterConfig struct {
+	Mapping Mapping `json:"mapping"`
+	// AuthType defaults to oauth.
+	AuthType AuthType        `json:"authType"`
+	OAuth    OAuth           `json:"oauth"`
+	Basic    BasicAuth       `json:"basic"`
+	Bearer   BearerAuth      `json:"bearer"`
+	MTLS     MTLSAuth        `json:"mtls"`
+	Selector AdapterSelector `json:"selector"`
+}
+
+// AdapterSelector specifies which requests are handled by the adapter.
+// All specified criteria have to match. An empty selector does not match any request.
+type AdapterSelector struct {
+	IntegrationSystemIDs []string          `json:"integrationSystemIDs"`
+	ApplicationLabels    map[string]string `json:"applicationLabels"`
+	// ApplicationTemplateNames match Applications registered from one of the Application Templates.
+	// The name of the Application Template is resolved by the Director.
+	ApplicationTemplateNames []string `json:"applicationTemplateNames"`
 }
 
 // swagger:response externalToken
 type ExternalToken struct {
-	Token string
+	Token        string
+	ExpiresAt    *time.Time             `json:",omitempty"`
+	ConnectorURL string                 `json:",omitempty"`
+	Metadata     map[string]interface{} `json:",omitempty"`
 }
 
 // Request Data represents information about an Application for which token is going to be created.
 //
-// swagger:parameters adapter
+// swagger:parameters adapter adapterByName
 type RequestData struct {
 	// in: body
 	Application graphql.Application
@@ -51,6 +128,19 @@ type RequestData struct {
 	Tenant string
 	// in: body
 	ClientUser string
+	// in: body
+	Labels graphql.Labels
+	// in: body
+	ApplicationTemplateName string `json:",omitempty"`
+}
+
+// AdapterName is the name of the adapter defined in the configuration file.
+//
+// swagger:parameters adapterByName
+type AdapterName struct {
+	// in: path
+	// required: true
+	Name string `json:"name"`
 }
 
 type ResponseData struct {
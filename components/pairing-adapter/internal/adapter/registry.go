@@ -0,0 +1,211 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultAdapterName = "default"
+
+var ErrAdapterNotFound = errors.New("adapter not found")
+
+// ClientFactory creates a Client for the given adapter configuration.
+type ClientFactory func(cfg AdapterConfig) (Client, error)
+
+type namedAdapter struct {
+	name     string
+	selector AdapterSelector
+	client   Client
+}
+
+type Registry struct {
+	mutex       sync.RWMutex
+	adapters    []namedAdapter
+	defaultName string
+	factory     ClientFactory
+}
+
+func NewRegistry(factory ClientFactory) *Registry {
+	return &Registry{factory: factory}
+}
+
+// Load replaces all registered adapters with the ones from the given configuration.
+// If the configuration is invalid, the previously registered adapters are kept.
+func (r *Registry) Load(cfg AdaptersConfiguration) error {
+	if err := validateConfiguration(cfg); err != nil {
+		return errors.Wrap(err, "while validating adapters configuration")
+	}
+
+	names := make([]string, 0, len(cfg.Adapters))
+	for name := range cfg.Adapters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	adapters := make([]namedAdapter, 0, len(names))
+	for _, name := range names {
+		adapterCfg := cfg.Adapters[name]
+		client, err := r.factory(adapterCfg)
+		if err != nil {
+			return errors.Wrapf(err, "while creating client for adapter %s", name)
+		}
+
+		adapters = append(adapters, namedAdapter{
+			name:     name,
+			selector: adapterCfg.Selector,
+			client:   client,
+		})
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.adapters = adapters
+	r.defaultName = cfg.Default
+
+	return nil
+}
+
+// LoadFile reads the adapters configuration from a JSON file and loads it.
+func (r *Registry) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "while opening adapters configuration file")
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logrus.Warnf("Got error on closing adapters configuration file: %v", err)
+		}
+	}()
+
+	cfg := AdaptersConfiguration{}
+	if err := json.NewDecoder(file).Decode(&cfg); err != nil {
+		return errors.Wrapf(err, "while decoding file [%s]", path)
+	}
+
+	return r.Load(cfg)
+}
+
+// Select returns the Client of the adapter with the given name. If the name is empty, the adapter is chosen based on
+// the request data. The adapter with the most matching selector criteria wins, ties are resolved by the adapter name.
+// If no adapter matches, the default one is used.
+func (r *Registry) Select(name string, reqData RequestData) (Client, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if name != "" {
+		return r.getByName(name)
+	}
+
+	var selected *namedAdapter
+	bestScore := 0
+	for i, a := range r.adapters {
+		score := matchScore(a.selector, reqData)
+		if score > bestScore {
+			selected = &r.adapters[i]
+			bestScore = score
+		}
+	}
+
+	if selected != nil {
+		logrus.Infof("Selected adapter %s for application %s", selected.name, reqData.Application.ID)
+		return selected.client, nil
+	}
+
+	if r.defaultName == "" {
+		return nil, errors.Wrapf(ErrAdapterNotFound, "no adapter matches application %s", reqData.Application.ID)
+	}
+
+	return r.getByName(r.defaultName)
+}
+
+func (r *Registry) getByName(name string) (Client, error) {
+	for _, a := range r.adapters {
+		if a.name == name {
+			return a.client, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrAdapterNotFound, "adapter with name %s does not exist", name)
+}
+
+func matchScore(selector AdapterSelector, reqData RequestData) int {
+	score := 0
+
+	if len(selector.IntegrationSystemIDs) > 0 {
+		if reqData.Application.IntegrationSystemID == nil || !contains(selector.IntegrationSystemIDs, *reqData.Application.IntegrationSystemID) {
+			return 0
+		}
+		score++
+	}
+
+	if len(selector.ApplicationTemplateNames) > 0 {
+		if !contains(selector.ApplicationTemplateNames, reqData.ApplicationTemplateName) {
+			return 0
+		}
+		score++
+	}
+
+	for key, value := range selector.ApplicationLabels {
+		if !labelMatches(reqData.Labels[key], value) {
+			return 0
+		}
+		score++
+	}
+
+	return score
+}
+
+func labelMatches(labelValue interface{}, expected string) bool {
+	switch value := labelValue.(type) {
+	case string:
+		return value == expected
+	case []interface{}:
+		for _, elem := range value {
+			if str, ok := elem.(string); ok && str == expected {
+				return true
+			}
+		}
+	case []string:
+		return contains(value, expected)
+	}
+
+	return false
+}
+
+func contains(values []string, expected string) bool {
+	for _, value := range values {
+		if value == expected {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateConfiguration(cfg AdaptersConfiguration) error {
+	if len(cfg.Adapters) == 0 {
+		return errors.New("at least one adapter has to be defined")
+	}
+
+	if _, ok := cfg.Adapters[cfg.Default]; cfg.Default != "" && !ok {
+		return fmt.Errorf("default adapter %s is not defined", cfg.Default)
+	}
+
+	for name, adapterCfg := range cfg.Adapters {
+		if adapterCfg.Mapping.TemplateExternalURL == "" {
+			return fmt.Errorf("external URL template for adapter %s is empty", name)
+		}
+		if _, err := regexp.Compile(adapterCfg.Mapping.TokenPattern); err != nil {
+			return errors.Wrapf(err, "while compiling token pattern for adapter %s", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package adapter_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	intSysID      = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	otherIntSysID = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+)
+
+func TestRegistry_Select(t *testing.T) {
+	clients := map[string]*automock.Client{}
+	factory := func(cfg adapter.AdapterConfig) (adapter.Client, error) {
+		client := &automock.Client{}
+		clients[cfg.Mapping.TemplateExternalURL] = client
+		return client, nil
+	}
+
+	cfg := adapter.AdaptersConfiguration{
+		Default: "generic",
+		Adapters: map[string]adapter.AdapterConfig{
+			"generic": fixAdapterConfig("generic", adapter.AdapterSelector{}),
+			"ticketing": fixAdapterConfig("ticketing", adapter.AdapterSelector{
+				IntegrationSystemIDs: []string{intSysID},
+			}),
+			"ticketing-eu": fixAdapterConfig("ticketing-eu", adapter.AdapterSelector{
+				IntegrationSystemIDs: []string{intSysID},
+				ApplicationLabels:    map[string]string{"region": "eu"},
+			}),
+			"ecommerce": fixAdapterConfig("ecommerce", adapter.AdapterSelector{
+				ApplicationLabels: map[string]string{"applicationType": "ecommerce"},
+			}),
+			"marketing": fixAdapterConfig("marketing", adapter.AdapterSelector{
+				ApplicationTemplateNames: []string{"marketing-cloud", "marketing-automation"},
+			}),
+			"ticketing-template": fixAdapterConfig("ticketing-template", adapter.AdapterSelector{
+				IntegrationSystemIDs:     []string{intSysID},
+				ApplicationTemplateNames: []string{"ticketing"},
+			}),
+		},
+	}
+
+	registry := adapter.NewRegistry(factory)
+	require.NoError(t, registry.Load(cfg))
+
+	testCases := []struct {
+		Name            string
+		AdapterName     string
+		ReqData         adapter.RequestData
+		ExpectedAdapter string
+	}{
+		{
+			Name:            "selects adapter by integration system",
+			ReqData:         fixReqData(intSysID, nil),
+			ExpectedAdapter: "ticketing",
+		},
+		{
+			Name:            "selects the most specific adapter",
+			ReqData:         fixReqData(intSysID, graphql.Labels{"region": "eu"}),
+			ExpectedAdapter: "ticketing-eu",
+		},
+		{
+			Name:            "selects adapter by string label",
+			ReqData:         fixReqData(otherIntSysID, graphql.Labels{"applicationType": "ecommerce"}),
+			ExpectedAdapter: "ecommerce",
+		},
+		{
+			Name:            "selects adapter by array label",
+			ReqData:         fixReqData("", graphql.Labels{"applicationType": []interface{}{"marketing", "ecommerce"}}),
+			ExpectedAdapter: "ecommerce",
+		},
+		{
+			Name:            "selects adapter by application template name",
+			ReqData:         fixReqDataFromTemplate("", "marketing-automation"),
+			ExpectedAdapter: "marketing",
+		},
+		{
+			Name:            "selects the most specific adapter with application template name",
+			ReqData:         fixReqDataFromTemplate(intSysID, "ticketing"),
+			ExpectedAdapter: "ticketing-template",
+		},
+		{
+			Name:            "does not select adapter by application template name when application is not registered from template",
+			ReqData:         fixReqDataFromTemplate(intSysID, ""),
+			ExpectedAdapter: "ticketing",
+		},
+		{
+			Name:            "selects default adapter when nothing matches",
+			ReqData:         fixReqData(otherIntSysID, graphql.Labels{"region": "eu"}),
+			ExpectedAdapter: "generic",
+		},
+		{
+			Name:            "selects adapter by name",
+			AdapterName:     "ecommerce",
+			ReqData:         fixReqData(intSysID, nil),
+			ExpectedAdapter: "ecommerce",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			client, err := registry.Select(testCase.AdapterName, testCase.ReqData)
+			// THEN
+			require.NoError(t, err)
+			assert.Same(t, clients[testCase.ExpectedAdapter], client)
+		})
+	}
+
+	t.Run("returns error when adapter with name does not exist", func(t *testing.T) {
+		// WHEN
+		_, err := registry.Select("unknown", fixReqData("", nil))
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, adapter.ErrAdapterNotFound, errors.Cause(err))
+	})
+
+	t.Run("returns error when nothing matches and there is no default adapter", func(t *testing.T) {
+		// GIVEN
+		registry := adapter.NewRegistry(factory)
+		require.NoError(t, registry.Load(adapter.AdaptersConfiguration{
+			Adapters: map[string]adapter.AdapterConfig{
+				"ticketing": fixAdapterConfig("ticketing", adapter.AdapterSelector{
+					IntegrationSystemIDs: []string{intSysID},
+				}),
+			},
+		}))
+		// WHEN
+		_, err := registry.Select("", fixReqData(otherIntSysID, nil))
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, adapter.ErrAdapterNotFound, errors.Cause(err))
+	})
+}
+
+func TestRegistry_Load(t *testing.T) {
+	factory := func(cfg adapter.AdapterConfig) (adapter.Client, error) {
+		return &automock.Client{}, nil
+	}
+
+	t.Run("keeps previous adapters when configuration is invalid", func(t *testing.T) {
+		// GIVEN
+		registry := adapter.NewRegistry(factory)
+		require.NoError(t, registry.Load(adapter.AdaptersConfiguration{
+			Default: "generic",
+			Adapters: map[string]adapter.AdapterConfig{
+				"generic": fixAdapterConfig("generic", adapter.AdapterSelector{}),
+			},
+		}))
+		// WHEN
+		err := registry.Load(adapter.AdaptersConfiguration{
+			Default: "missing",
+			Adapters: map[string]adapter.AdapterConfig{
+				"generic": fixAdapterConfig("generic", adapter.AdapterSelector{}),
+			},
+		})
+		// THEN
+		require.EqualError(t, err, "while validating adapters configuration: default adapter missing is not defined")
+		_, err = registry.Select("generic", adapter.RequestData{})
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error when external URL template is empty", func(t *testing.T) {
+		// GIVEN
+		registry := adapter.NewRegistry(factory)
+		// WHEN
+		err := registry.Load(adapter.AdaptersConfiguration{
+			Adapters: map[string]adapter.AdapterConfig{
+				"generic": {},
+			},
+		})
+		// THEN
+		require.EqualError(t, err, "while validating adapters configuration: external URL template for adapter generic is empty")
+	})
+
+	t.Run("returns error when client cannot be created", func(t *testing.T) {
+		// GIVEN
+		registry := adapter.NewRegistry(func(cfg adapter.AdapterConfig) (adapter.Client, error) {
+			return nil, fixError()
+		})
+		// WHEN
+		err := registry.Load(adapter.AdaptersConfiguration{
+			Adapters: map[string]adapter.AdapterConfig{
+				"generic": fixAdapterConfig("generic", adapter.AdapterSelector{}),
+			},
+		})
+		// THEN
+		require.EqualError(t, err, "while creating client for adapter generic: fix error")
+	})
+
+	t.Run("loads configuration from file", func(t *testing.T) {
+		// GIVEN
+		var loaded []adapter.AdapterConfig
+		registry := adapter.NewRegistry(func(cfg adapter.AdapterConfig) (adapter.Client, error) {
+			loaded = append(loaded, cfg)
+			return &automock.Client{}, nil
+		})
+		// WHEN
+		err := registry.LoadFile("testdata/adapters.json")
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, loaded, 2)
+		assert.Equal(t, "generic-client", loaded[0].OAuth.ClientID)
+		assert.Equal(t, adapter.AuthStyleInHeader, loaded[1].OAuth.AuthStyle)
+		assert.Equal(t, []string{intSysID}, loaded[1].Selector.IntegrationSystemIDs)
+	})
+
+	t.Run("returns error when file does not exist", func(t *testing.T) {
+		// GIVEN
+		registry := adapter.NewRegistry(factory)
+		// WHEN
+		err := registry.LoadFile("testdata/missing.json")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while opening adapters configuration file")
+	})
+}
+
+func fixAdapterConfig(name string, selector adapter.AdapterSelector) adapter.AdapterConfig {
+	return adapter.AdapterConfig{
+		Mapping: adapter.Mapping{
+			TemplateExternalURL: name,
+		},
+		Selector: selector,
+	}
+}
+
+func fixReqData(intSysID string, labels graphql.Labels) adapter.RequestData {
+	reqData := adapter.RequestData{
+		Application: graphql.Application{ID: "app-id"},
+		Labels:      labels,
+	}
+	if intSysID != "" {
+		reqData.Application.IntegrationSystemID = &intSysID
+	}
+
+	return reqData
+}
+
+func fixReqDataFromTemplate(intSysID, templateName string) adapter.RequestData {
+	reqData := fixReqData(intSysID, nil)
+	reqData.ApplicationTemplateName = templateName
+
+	return reqData
+}
@@ -0,0 +1,15 @@
+BEGIN;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM system_auths WHERE value ? 'envelope')
+        OR EXISTS (SELECT 1 FROM packages WHERE default_instance_auth ? 'envelope')
+        OR EXISTS (SELECT 1 FROM package_instance_auths WHERE auth_value ? 'envelope')
+        OR EXISTS (SELECT 1 FROM fetch_requests WHERE auth ? 'envelope') THEN
+        RAISE EXCEPTION 'Stored credentials are encrypted with data keys, they have to be decrypted before data keys are dropped';
+    END IF;
+END $$;
+
+DROP TABLE data_keys;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE data_keys (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid,
+    FOREIGN KEY (tenant_id) REFERENCES business_tenant_mappings(id) ON DELETE CASCADE,
+    master_key_id varchar(256) NOT NULL,
+    wrapped_key bytea NOT NULL,
+    active boolean NOT NULL DEFAULT TRUE,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ON data_keys (master_key_id);
+CREATE UNIQUE INDEX ON data_keys (coalesce(tenant_id, '00000000-0000-0000-0000-000000000000')) WHERE active;
+
+COMMIT;
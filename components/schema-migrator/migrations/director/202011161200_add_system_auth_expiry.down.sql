@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE system_auths
+    DROP COLUMN expires_at;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE system_auths
+    ADD COLUMN expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX ON system_auths (expires_at) WHERE expires_at IS NOT NULL;
+
+COMMIT;
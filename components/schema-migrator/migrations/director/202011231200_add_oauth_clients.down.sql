@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE oauth_clients;
+DROP TABLE oauth_client_registrations;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE oauth_client_registrations (
+    client_id varchar(256) PRIMARY KEY,
+    registration_client_uri text NOT NULL,
+    registration_access_token text NOT NULL
+);
+
+CREATE TABLE oauth_clients (
+    client_id varchar(256) PRIMARY KEY,
+    secret_hash varchar(64) NOT NULL,
+    scopes text NOT NULL,
+    grant_types text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+
+COMMIT;
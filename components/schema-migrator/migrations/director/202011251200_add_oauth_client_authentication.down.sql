@@ -0,0 +1,12 @@
+BEGIN;
+
+DELETE FROM oauth_clients WHERE secret_hash IS NULL;
+
+ALTER TABLE oauth_clients
+    DROP COLUMN tls_client_auth_subject_dn,
+    DROP COLUMN jwks_uri,
+    DROP COLUMN jwks,
+    DROP COLUMN token_endpoint_auth_method,
+    ALTER COLUMN secret_hash SET NOT NULL;
+
+COMMIT;
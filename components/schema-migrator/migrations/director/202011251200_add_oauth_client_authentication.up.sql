@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE oauth_clients
+    ALTER COLUMN secret_hash DROP NOT NULL,
+    ADD COLUMN token_endpoint_auth_method varchar(64) NOT NULL DEFAULT 'client_secret_basic',
+    ADD COLUMN jwks jsonb,
+    ADD COLUMN jwks_uri text,
+    ADD COLUMN tls_client_auth_subject_dn text;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE role_bindings;
+DROP TABLE roles;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE roles (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    FOREIGN KEY (tenant_id) REFERENCES business_tenant_mappings(id) ON DELETE CASCADE,
+    name varchar(256) NOT NULL,
+    description text,
+    scopes jsonb NOT NULL
+);
+
+CREATE INDEX ON roles (tenant_id);
+CREATE UNIQUE INDEX ON roles (tenant_id, name);
+CREATE UNIQUE INDEX ON roles (tenant_id, id);
+
+CREATE TABLE role_bindings (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    role_id uuid NOT NULL,
+    FOREIGN KEY (tenant_id, role_id) REFERENCES roles (tenant_id, id) ON DELETE CASCADE,
+    user_name varchar(256),
+    group_name varchar(256),
+    system_auth_id uuid,
+    FOREIGN KEY (system_auth_id) REFERENCES system_auths (id) ON DELETE CASCADE,
+    CONSTRAINT valid_subject CHECK (num_nonnulls(user_name, group_name, system_auth_id) = 1)
+);
+
+CREATE INDEX ON role_bindings (tenant_id);
+CREATE INDEX ON role_bindings (role_id);
+CREATE UNIQUE INDEX ON role_bindings (role_id, coalesce(user_name, ''), coalesce(group_name, ''), coalesce(system_auth_id, '00000000-0000-0000-0000-000000000000'));
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE object_owners;
+DROP TABLE access_rules;
+
+COMMIT;
@@ -0,0 +1,39 @@
+BEGIN;
+
+CREATE TABLE access_rules (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    FOREIGN KEY (tenant_id) REFERENCES business_tenant_mappings(id) ON DELETE CASCADE,
+    user_name varchar(256),
+    group_name varchar(256),
+    integration_system_id uuid,
+    FOREIGN KEY (integration_system_id) REFERENCES integration_systems (id) ON DELETE CASCADE,
+    object_type varchar(256) NOT NULL CHECK (object_type IN ('APPLICATION', 'RUNTIME')),
+    label_key varchar(256),
+    label_query text,
+    owned_only boolean NOT NULL DEFAULT FALSE,
+    CONSTRAINT valid_subject CHECK (num_nonnulls(user_name, group_name, integration_system_id) = 1),
+    CONSTRAINT valid_rule CHECK ((label_key IS NOT NULL) <> owned_only),
+    CONSTRAINT valid_label_query CHECK (label_query IS NULL OR label_key IS NOT NULL)
+);
+
+CREATE INDEX ON access_rules (tenant_id, object_type);
+
+CREATE TABLE object_owners (
+    tenant_id uuid NOT NULL,
+    app_id uuid,
+    FOREIGN KEY (tenant_id, app_id) REFERENCES applications (tenant_id, id) ON DELETE CASCADE,
+    runtime_id uuid,
+    FOREIGN KEY (tenant_id, runtime_id) REFERENCES runtimes (tenant_id, id) ON DELETE CASCADE,
+    user_name varchar(256),
+    integration_system_id uuid,
+    CONSTRAINT valid_object CHECK (num_nonnulls(app_id, runtime_id) = 1),
+    CONSTRAINT valid_owner CHECK (num_nonnulls(user_name, integration_system_id) = 1)
+);
+
+CREATE UNIQUE INDEX ON object_owners (app_id);
+CREATE UNIQUE INDEX ON object_owners (runtime_id);
+CREATE INDEX ON object_owners (tenant_id, user_name);
+CREATE INDEX ON object_owners (tenant_id, integration_system_id);
+
+COMMIT;
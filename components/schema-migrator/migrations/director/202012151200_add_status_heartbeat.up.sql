@@ -0,0 +1,82 @@
+BEGIN;
+
+-- runtimes
+
+ALTER TABLE runtimes
+    ALTER COLUMN status_condition TYPE VARCHAR(255);
+
+ALTER TABLE runtimes
+    ALTER COLUMN status_condition DROP DEFAULT;
+
+ALTER TYPE runtime_status_condition
+    RENAME TO runtime_status_condition_old;
+
+CREATE TYPE runtime_status_condition AS ENUM (
+    'INITIAL',
+    'PROVISIONING',
+    'CONNECTED',
+    'DISCONNECTED',
+    'FAILED'
+);
+
+ALTER TABLE runtimes
+    ALTER COLUMN status_condition TYPE runtime_status_condition
+    USING status_condition::runtime_status_condition;
+
+ALTER TABLE runtimes
+    ALTER COLUMN status_condition
+    SET DEFAULT 'INITIAL' ::runtime_status_condition;
+
+DROP TYPE runtime_status_condition_old;
+
+ALTER TABLE runtimes
+    ADD COLUMN last_seen_timestamp TIMESTAMP;
+
+-- consumers connected before the last seen timestamp was recorded are treated as last seen during the migration,
+-- so they are not marked as inactive before they have a chance to call the Director again
+UPDATE runtimes
+    SET last_seen_timestamp = NOW()
+    WHERE status_condition = 'CONNECTED';
+
+CREATE INDEX ON runtimes (status_condition, last_seen_timestamp);
+
+-- applications
+
+ALTER TABLE applications
+    ALTER COLUMN status_condition TYPE VARCHAR(255);
+
+ALTER TABLE applications
+    ALTER COLUMN status_condition DROP DEFAULT;
+
+ALTER TYPE application_status_condition
+    RENAME TO application_status_condition_old;
+
+CREATE TYPE application_status_condition AS ENUM (
+    'INITIAL',
+    'CONNECTED',
+    'DISCONNECTED',
+    'FAILED'
+);
+
+ALTER TABLE applications
+    ALTER COLUMN status_condition TYPE application_status_condition
+    USING status_condition::application_status_condition;
+
+ALTER TABLE applications
+    ALTER COLUMN status_condition
+    SET DEFAULT 'INITIAL' ::application_status_condition;
+
+DROP TYPE application_status_condition_old;
+
+ALTER TABLE applications
+    ADD COLUMN last_seen_timestamp TIMESTAMP;
+
+-- consumers connected before the last seen timestamp was recorded are treated as last seen during the migration,
+-- so they are not marked as inactive before they have a chance to call the Director again
+UPDATE applications
+    SET last_seen_timestamp = NOW()
+    WHERE status_condition = 'CONNECTED';
+
+CREATE INDEX ON applications (status_condition, last_seen_timestamp);
+
+COMMIT;